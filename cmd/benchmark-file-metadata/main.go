@@ -5,6 +5,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -15,6 +16,7 @@ import (
 
 var (
 	basePath = flag.String("base-path", "", "base path to explore")
+	backend  = flag.String("backend", "native", "how to read metadata: native or ffprobe")
 )
 
 func main() {
@@ -24,7 +26,12 @@ func main() {
 		log.Fatal("must set -base-path")
 	}
 
-	cache := media.NewMetadataCache()
+	prober, ok := media.ProberByName(*backend)
+	if !ok {
+		log.Fatalf("-backend must be one of %q", media.ProberNames)
+	}
+
+	cache := media.NewMetadataCache(prober, 0)
 
 	cold := getMetadata(cache, *basePath)
 	fmt.Printf("cold cache: %v\n", cold)
@@ -35,6 +42,8 @@ func main() {
 
 func getMetadata(cache media.MetadataCache, basePath string) time.Duration {
 	start := time.Now()
-	cache.Warm(basePath)
+	if err := cache.Warm(context.Background(), basePath); err != nil {
+		log.Fatal(err)
+	}
 	return time.Since(start)
 }
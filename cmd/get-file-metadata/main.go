@@ -14,7 +14,8 @@ import (
 )
 
 var (
-	path = flag.String("path", "", "path of file to get metadata from")
+	path    = flag.String("path", "", "path of file to get metadata from")
+	backend = flag.String("backend", "native", "how to read metadata: native or ffprobe")
 )
 
 func main() {
@@ -26,7 +27,14 @@ func main() {
 		os.Exit(2)
 	}
 
-	cache := media.NewMetadataCache()
+	prober, ok := media.ProberByName(*backend)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "-backend must be one of %q\n", media.ProberNames)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cache := media.NewMetadataCache(prober, 0)
 
 	md, err := cache.MetadataForPath(*path)
 	if err != nil {
@@ -59,10 +59,10 @@ func main() {
 	}
 
 	ctx, _ = context.WithTimeout(context.Background(), 1*time.Second)
-	uri, metadata, _, _, err := transport.MediaInfo(ctx)
+	info, err := transport.MediaInfo(ctx)
 	if err != nil {
 		log.Fatalf("could not get media info: %v", err)
 	}
-	fmt.Println(uri)
-	fmt.Printf("%+v\n", metadata)
+	fmt.Println(info.CurrentURI)
+	fmt.Printf("%+v\n", info.CurrentMetadata)
 }
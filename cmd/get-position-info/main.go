@@ -56,12 +56,12 @@ func main() {
 	}
 
 	ctx, _ = context.WithTimeout(context.Background(), 1*time.Second)
-	uri, metadata, duration, reltime, err := transport.PositionInfo(ctx)
+	info, err := transport.PositionInfo(ctx)
 	if err != nil {
 		log.Fatalf("could not get media info: %v", err)
 	}
-	fmt.Println(uri)
-	fmt.Printf("%+v\n", metadata)
-	fmt.Println(duration)
-	fmt.Println(reltime)
+	fmt.Println(info.TrackURI)
+	fmt.Printf("%+v\n", info.TrackMetadata)
+	fmt.Println(info.TrackDuration)
+	fmt.Println(info.RelativeTime)
 }
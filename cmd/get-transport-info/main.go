@@ -56,10 +56,10 @@ func main() {
 	}
 
 	ctx, _ = context.WithTimeout(context.Background(), 1*time.Second)
-	state, status, err := transport.TransportInfo(ctx)
+	info, err := transport.TransportInfo(ctx)
 	if err != nil {
 		log.Fatalf("could not get media info: %v", err)
 	}
-	fmt.Printf("state: %s\n", state)
-	fmt.Printf("status: %s\n", status)
+	fmt.Printf("state: %s\n", info.State)
+	fmt.Printf("status: %s\n", info.Status)
 }
@@ -9,6 +9,9 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/ethulhu/helix/flag"
 	"github.com/ethulhu/helix/flags"
@@ -16,9 +19,11 @@ import (
 	"github.com/ethulhu/helix/media"
 	"github.com/ethulhu/helix/netutil"
 	"github.com/ethulhu/helix/upnp"
+	"github.com/ethulhu/helix/upnpav"
 	"github.com/ethulhu/helix/upnpav/connectionmanager"
 	"github.com/ethulhu/helix/upnpav/contentdirectory"
 	"github.com/ethulhu/helix/upnpav/contentdirectory/jackalope"
+	"github.com/ethulhu/helix/upnpav/mediaserver"
 
 	jackalopeDB "go.eth.moe/jackalope"
 )
@@ -31,7 +36,17 @@ var (
 	basePath      = flag.Custom("path", "", "path to serve", flag.RequiredString)
 	jackalopePath = flag.Custom("jackalope-path", "", "path to Jackalope db", flag.RequiredString)
 
+	metadataBackend      = flag.Custom("metadata-backend", "native", "how to read metadata: native, falling back to ffprobe for other formats, or ffprobe", flags.MetadataProber)
 	disableMetadataCache = flag.Bool("disable-metadata-cache", false, "disable the metadata cache")
+	metadataCachePath    = flag.String("metadata-cache-path", "", "path to a file to persist the metadata cache in (if unset, the cache is only kept in memory)")
+	warmParallelism      = flag.Int("warm-parallelism", 0, "how many files to probe at once when warming the metadata cache (if 0, one per CPU)")
+
+	disableWatch      = flag.Bool("disable-watch", false, "do not watch the path for changes")
+	watchPollInterval = flag.Duration("watch-poll-interval", time.Minute, "how often to check the path for changes if inotify is unavailable")
+
+	transcodeProfiles  = flag.Custom("transcode", "mp3,lpcm", "comma-separated formats to offer transcodes of audio in (mp3, lpcm), or empty to disable transcoding", flags.TranscodeProfiles)
+	transcodeCachePath = flag.String("transcode-cache-path", filepath.Join(os.TempDir(), "helix-transcodes"), "path to cache transcodes in")
+	transcodeCacheSize = flag.Int("transcode-cache-size-mb", 1024, "maximum size of the transcode cache in MiB, or 0 for unlimited")
 )
 
 func main() {
@@ -40,12 +55,19 @@ func main() {
 	friendlyName := (*friendlyName).(string)
 	iface := (*iface).(*net.Interface)
 	udn := (*udn).(string)
+	metadataBackend := (*metadataBackend).(media.Prober)
 
 	basePath := (*basePath).(string)
+	transcodeProfiles := (*transcodeProfiles).([]media.TranscodeProfile)
 	jackalopePath := (*jackalopePath).(string)
 
 	log, _ := logger.FromContext(context.Background())
 
+	basePath, err := filepath.Abs(basePath)
+	if err != nil {
+		log.WithError(err).Fatal("could not get absolute path")
+	}
+
 	ip, err := netutil.SuitableIP(iface)
 	if err != nil {
 		name := "ALL"
@@ -80,9 +102,55 @@ func main() {
 		SerialNumber:     "00000000",
 	}
 
-	metadataCache := media.NewMetadataCache()
-	if *disableMetadataCache {
-		metadataCache = media.NoOpCache{}
+	metadataCache := media.NewMetadataCache(metadataBackend, *warmParallelism)
+	switch {
+	case *disableMetadataCache:
+		metadataCache = media.NoOpCache{Prober: metadataBackend}
+	case *metadataCachePath != "":
+		persistentCache, err := media.NewPersistentMetadataCache(*metadataCachePath, metadataBackend, *warmParallelism)
+		if err != nil {
+			log.AddField("metadata-cache-path", *metadataCachePath)
+			log.WithError(err).Fatal("could not load metadata cache")
+		}
+		defer persistentCache.Close()
+		metadataCache = persistentCache
+	}
+
+	go func() {
+		log := log.WithField("path", basePath)
+		log.Info("warming metadata cache")
+
+		if err := metadataCache.Warm(context.Background(), basePath); err != nil {
+			log.WithError(err).Warning("could not warm metadata cache")
+			return
+		}
+
+		status := metadataCache.WarmStatus()
+		log.AddField("duration", status.Finished.Sub(status.Started))
+		log.AddField("probed", status.Probed)
+		log.AddField("failed", status.Failed)
+		log.Info("finished warming metadata cache")
+	}()
+
+	updateIDs := contentdirectory.NewUpdateIDs(uint(time.Now().Unix()))
+	if !*disableWatch {
+		watcher, err := media.NewWatcher(basePath, *watchPollInterval)
+		if err != nil {
+			log.WithError(err).Fatal("could not watch path")
+		}
+		defer watcher.Close()
+
+		go func() {
+			for paths := range watcher.Changes() {
+				log := log.WithField("paths", len(paths))
+				log.Info("path changed")
+
+				for _, p := range paths {
+					metadataCache.Invalidate(p)
+				}
+				updateIDs.Update(contentdirectory.Root)
+			}
+		}()
 	}
 
 	jackalopeDB, err := jackalopeDB.Open(jackalopePath)
@@ -90,16 +158,38 @@ func main() {
 		log.WithError(err).Fatal("could not open Jackalope DB")
 	}
 
-	cd, err := jackalope.NewContentDirectory(basePath, fmt.Sprintf("http://%v/objects/", httpConn.Addr()), metadataCache, jackalopeDB)
+	cd, err := jackalope.NewContentDirectory(basePath, fmt.Sprintf("http://%v/objects/", httpConn.Addr()), metadataCache, transcodeProfiles, jackalopeDB, updateIDs)
 	if err != nil {
 		log.WithError(err).Fatal("could not create ContentDirectory object")
 	}
 
 	device.Handle(contentdirectory.Version1, contentdirectory.ServiceID, contentdirectory.SCPD, contentdirectory.SOAPHandler{cd})
-	device.Handle(connectionmanager.Version1, connectionmanager.ServiceID, connectionmanager.SCPD, nil)
+	updateIDs.SetEventNotifier(device.Notify)
+	// Everything is served over plain HTTP, whatever its MIME-type.
+	sources := []upnpav.ProtocolInfo{{
+		Protocol:       upnpav.ProtocolHTTP,
+		Network:        "*",
+		ContentFormat:  "*",
+		AdditionalInfo: "*",
+	}}
+	cm := connectionmanager.NewManager(connectionmanager.DirectionOutput, sources, nil)
+	device.Handle(connectionmanager.Version1, connectionmanager.ServiceID, connectionmanager.SCPD, connectionmanager.SOAPHandler{Interface: cm})
 
 	mux := http.NewServeMux()
-	mux.Handle("/objects/", http.StripPrefix("/objects/", http.FileServer(http.Dir(basePath))))
+	var transcoder *media.Transcoder
+	if len(transcodeProfiles) > 0 {
+		transcoder, err = media.NewTranscoder(media.FFmpegEncoder{}, transcodeProfiles, *transcodeCachePath, int64(*transcodeCacheSize)<<20)
+		if err != nil {
+			log.WithError(err).Fatal("could not create transcoder")
+		}
+	}
+
+	objects, err := mediaserver.NewFileHandler(basePath, metadataCache, transcoder, nil, nil)
+	if err != nil {
+		log.WithError(err).Fatal("could not create media handler")
+	}
+	mux.Handle("/objects/", http.StripPrefix("/objects/", objects))
+	mux.Handle("/status", mediaserver.NewStatusHandler(metadataCache))
 	mux.Handle("/upnp/", http.StripPrefix("/upnp", device.HTTPHandler("/upnp/")))
 
 	httpServer := &http.Server{Handler: mux}
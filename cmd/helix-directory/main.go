@@ -6,9 +6,15 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
 
 	"github.com/ethulhu/helix/flag"
 	"github.com/ethulhu/helix/flags"
@@ -16,9 +22,11 @@ import (
 	"github.com/ethulhu/helix/media"
 	"github.com/ethulhu/helix/netutil"
 	"github.com/ethulhu/helix/upnp"
+	"github.com/ethulhu/helix/upnpav"
 	"github.com/ethulhu/helix/upnpav/connectionmanager"
 	"github.com/ethulhu/helix/upnpav/contentdirectory"
 	"github.com/ethulhu/helix/upnpav/contentdirectory/fileserver"
+	"github.com/ethulhu/helix/upnpav/mediaserver"
 )
 
 var (
@@ -27,20 +35,58 @@ var (
 	iface        = flag.Custom("interface", "", "interface to listen on (will try to find a Private IPv4 if unset)", flags.NetInterface)
 
 	basePath = flag.Custom("path", "", "path to serve", flag.RequiredString)
+	views    = flag.Custom("views", "folders,albums,artists,genres,tracks", "comma-separated views for the root to list (folders, albums, artists, genres, tracks), or just folders to serve the directory tree as the root", flags.ContentDirectoryViews)
+	photos   = flag.Bool("photos", false, "serve images as photos, and directories of them as photo albums, rather than only as cover art")
 
+	metadataBackend      = flag.Custom("metadata-backend", "native", "how to read metadata: native, falling back to ffprobe for other formats, or ffprobe", flags.MetadataProber)
 	disableMetadataCache = flag.Bool("disable-metadata-cache", false, "disable the metadata cache")
+	metadataCachePath    = flag.String("metadata-cache-path", "", "path to a file to persist the metadata cache in (if unset, the cache is only kept in memory)")
+	warmParallelism      = flag.Int("warm-parallelism", 0, "how many files to probe at once when warming the metadata cache (if 0, one per CPU)")
+
+	disableWatch      = flag.Bool("disable-watch", false, "do not watch the path for changes")
+	watchPollInterval = flag.Duration("watch-poll-interval", time.Minute, "how often to check the path for changes if inotify is unavailable")
+
+	transcodeProfiles  = flag.Custom("transcode", "mp3,lpcm", "comma-separated formats to offer transcodes of audio in (mp3, lpcm), or empty to disable transcoding", flags.TranscodeProfiles)
+	transcodeCachePath = flag.String("transcode-cache-path", filepath.Join(os.TempDir(), "helix-transcodes"), "path to cache transcodes in")
+	transcodeCacheSize = flag.Int("transcode-cache-size-mb", 1024, "maximum size of the transcode cache in MiB, or 0 for unlimited")
+
+	imageProfiles   = flag.Custom("resize", "JPEG_TN,JPEG_SM", "comma-separated DLNA profiles to offer album art and photos resized to (JPEG_TN, JPEG_SM), or empty to disable resizing", flags.ImageProfiles)
+	resizeCachePath = flag.String("resize-cache-path", filepath.Join(os.TempDir(), "helix-resized"), "path to cache resized images in")
+	resizeCacheSize = flag.Int("resize-cache-size-mb", 256, "maximum size of the resized image cache in MiB, or 0 for unlimited")
+
+	coverArtCachePath = flag.String("cover-art-cache-path", filepath.Join(os.TempDir(), "helix-cover-art"), "path to cache cover art extracted from media files' tags in")
+	coverArtCacheSize = flag.Int("cover-art-cache-size-mb", 256, "maximum size of the extracted cover art cache in MiB, or 0 for unlimited")
 )
 
 func main() {
 	flag.Parse()
 
 	basePath := (*basePath).(string)
+	transcodeProfiles := (*transcodeProfiles).([]media.TranscodeProfile)
+	imageProfiles := (*imageProfiles).([]media.ImageProfile)
 	friendlyName := (*friendlyName).(string)
 	iface := (*iface).(*net.Interface)
 	udn := (*udn).(string)
+	metadataBackend := (*metadataBackend).(media.Prober)
+	views := (*views).([]fileserver.View)
 
 	log, _ := logger.FromContext(context.Background())
 
+	// ctx is cancelled when the server is asked to shut down.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		<-signals
+		cancel()
+	}()
+
+	basePath, err := filepath.Abs(basePath)
+	if err != nil {
+		log.WithError(err).Fatal("could not get absolute path")
+	}
+
 	ip, err := netutil.SuitableIP(iface)
 	if err != nil {
 		name := "ALL"
@@ -74,33 +120,133 @@ func main() {
 		SerialNumber:     "00000000",
 	}
 
-	metadataCache := media.NewMetadataCache()
-	if *disableMetadataCache {
-		metadataCache = media.NoOpCache{}
+	metadataCache := media.NewMetadataCache(metadataBackend, *warmParallelism)
+	switch {
+	case *disableMetadataCache:
+		metadataCache = media.NoOpCache{Prober: metadataBackend}
+	case *metadataCachePath != "":
+		persistentCache, err := media.NewPersistentMetadataCache(*metadataCachePath, metadataBackend, *warmParallelism)
+		if err != nil {
+			log.AddField("metadata-cache-path", *metadataCachePath)
+			log.WithError(err).Fatal("could not load metadata cache")
+		}
+		defer persistentCache.Close()
+		metadataCache = persistentCache
+	}
+
+	go func() {
+		log := log.WithField("path", basePath)
+		log.Info("warming metadata cache")
+
+		if err := metadataCache.Warm(ctx, basePath); err != nil {
+			if errors.Is(err, context.Canceled) {
+				log.Info("stopped warming metadata cache")
+				return
+			}
+			log.WithError(err).Warning("could not warm metadata cache")
+			return
+		}
+
+		status := metadataCache.WarmStatus()
+		log.AddField("duration", status.Finished.Sub(status.Started))
+		log.AddField("probed", status.Probed)
+		log.AddField("failed", status.Failed)
+		log.Info("finished warming metadata cache")
+	}()
+
+	updateIDs := contentdirectory.NewUpdateIDs(uint(time.Now().Unix()))
+	if !*disableWatch {
+		watcher, err := media.NewWatcher(basePath, *watchPollInterval)
+		if err != nil {
+			log.WithError(err).Fatal("could not watch path")
+		}
+		defer watcher.Close()
+
+		go func() {
+			for paths := range watcher.Changes() {
+				log := log.WithField("paths", len(paths))
+				log.Info("path changed")
+
+				for _, p := range paths {
+					metadataCache.Invalidate(p)
+				}
+				updateIDs.Update(fileserver.ChangedContainers(basePath, views, paths)...)
+			}
+		}()
 	}
 
-	cd, err := fileserver.NewContentDirectory(basePath, fmt.Sprintf("http://%v/objects/", httpConn.Addr()), metadataCache)
+	cd, err := fileserver.NewContentDirectory(basePath, fmt.Sprintf("http://%v/objects/", httpConn.Addr()), fileserver.ContentDirectoryOptions{
+		MetadataCache:     metadataCache,
+		TranscodeProfiles: transcodeProfiles,
+		ImageProfiles:     imageProfiles,
+		UpdateIDs:         updateIDs,
+		Views:             views,
+		Photos:            *photos,
+	})
 	if err != nil {
 		log.WithError(err).Fatal("could not create ContentDirectory object")
 	}
 
 	device.Handle(contentdirectory.Version1, contentdirectory.ServiceID, contentdirectory.SCPD, contentdirectory.SOAPHandler{cd})
-	device.Handle(connectionmanager.Version1, connectionmanager.ServiceID, connectionmanager.SCPD, nil)
+	updateIDs.SetEventNotifier(device.Notify)
+	// Everything is served over plain HTTP, whatever its MIME-type.
+	sources := []upnpav.ProtocolInfo{{
+		Protocol:       upnpav.ProtocolHTTP,
+		Network:        "*",
+		ContentFormat:  "*",
+		AdditionalInfo: "*",
+	}}
+	cm := connectionmanager.NewManager(connectionmanager.DirectionOutput, sources, nil)
+	device.Handle(connectionmanager.Version1, connectionmanager.ServiceID, connectionmanager.SCPD, connectionmanager.SOAPHandler{Interface: cm})
 
 	mux := http.NewServeMux()
-	mux.Handle("/objects/", http.StripPrefix("/objects/", http.FileServer(http.Dir(basePath))))
+	var transcoder *media.Transcoder
+	if len(transcodeProfiles) > 0 {
+		transcoder, err = media.NewTranscoder(media.FFmpegEncoder{}, transcodeProfiles, *transcodeCachePath, int64(*transcodeCacheSize)<<20)
+		if err != nil {
+			log.WithError(err).Fatal("could not create transcoder")
+		}
+	}
+
+	var resizer *media.Resizer
+	if len(imageProfiles) > 0 {
+		resizer, err = media.NewResizer(imageProfiles, *resizeCachePath, int64(*resizeCacheSize)<<20)
+		if err != nil {
+			log.WithError(err).Fatal("could not create resizer")
+		}
+	}
+
+	coverArt, err := media.NewCoverArtExtractor(*coverArtCachePath, int64(*coverArtCacheSize)<<20)
+	if err != nil {
+		log.WithError(err).Fatal("could not create cover art extractor")
+	}
+
+	objects, err := mediaserver.NewFileHandler(basePath, metadataCache, transcoder, resizer, coverArt)
+	if err != nil {
+		log.WithError(err).Fatal("could not create media handler")
+	}
+	mux.Handle("/objects/", http.StripPrefix("/objects/", objects))
+	mux.Handle("/status", mediaserver.NewStatusHandler(metadataCache))
 	mux.Handle("/upnp/", http.StripPrefix("/upnp", device.HTTPHandler("/upnp/")))
 
 	httpServer := &http.Server{Handler: mux}
 	go func() {
 		log := log.WithField("http.listener", httpConn.Addr())
 		log.Info("serving HTTP")
-		if err := httpServer.Serve(httpConn); err != nil {
+		if err := httpServer.Serve(httpConn); err != nil && err != http.ErrServerClosed {
 			log.WithError(err).Fatal("could not serve HTTP")
 		}
 	}()
 
-	if err := upnp.BroadcastDevice(device, fmt.Sprintf("http://%v/upnp/", httpConn.Addr()), nil); err != nil {
-		log.WithError(err).Fatal("could not serve SSDP")
+	go func() {
+		if err := upnp.BroadcastDevice(device, fmt.Sprintf("http://%v/upnp/", httpConn.Addr()), nil); err != nil {
+			log.WithError(err).Fatal("could not serve SSDP")
+		}
+	}()
+
+	<-ctx.Done()
+	log.Info("shutting down")
+	if err := httpServer.Close(); err != nil {
+		log.WithError(err).Warning("could not close HTTP server")
 	}
 }
@@ -114,7 +114,12 @@ func searchUnderObjectJSON(w http.ResponseWriter, r *http.Request) {
 
 	criteria, err := search.Parse(query)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("could not parse %q: %v", query, err), http.StatusBadRequest)
+		msg := fmt.Sprintf("could not parse %q: %v", query, err)
+		if serr, ok := err.(*search.SyntaxError); ok {
+			// Point at where the query went wrong.
+			msg = fmt.Sprintf("could not parse query: %v\n%s\n%s^", serr, query, strings.Repeat(" ", serr.Pos))
+		}
+		http.Error(w, msg, http.StatusBadRequest)
 		return
 	}
 
@@ -231,11 +236,11 @@ func getTransportsJSON(w http.ResponseWriter, r *http.Request) {
 			continue
 		}
 		transport := avtransport.NewClient(client)
-		state, _, err := transport.TransportInfo(ctx)
+		info, err := transport.TransportInfo(ctx)
 		if err != nil {
 			continue
 		}
-		data = append(data, transportFromDeviceAndInfo(device, state))
+		data = append(data, transportFromDeviceAndInfo(device, info.State))
 	}
 
 	httputil.MustWriteJSON(w, data)
@@ -252,13 +257,13 @@ func getTransportJSON(w http.ResponseWriter, r *http.Request) {
 	transport := avtransport.NewClient(client)
 
 	ctx := r.Context()
-	state, _, err := transport.TransportInfo(ctx)
+	info, err := transport.TransportInfo(ctx)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("could not get status from AVTransport: %v", err), http.StatusInternalServerError)
 		return
 	}
 
-	data := transportFromDeviceAndInfo(device, state)
+	data := transportFromDeviceAndInfo(device, info.State)
 
 	httputil.MustWriteJSON(w, data)
 }
@@ -367,6 +372,46 @@ func setControlPointElapsed(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func setControlPointVolume(w http.ResponseWriter, r *http.Request) {
+	raw := mux.Vars(r)["volume"]
+
+	volume, err := strconv.Atoi(raw)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not parse volume %q: %v", raw, err), http.StatusBadRequest)
+		return
+	}
+
+	if err := controlLoop.SetVolume(volume); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+func stepControlPointVolume(w http.ResponseWriter, r *http.Request) {
+	raw := mux.Vars(r)["step"]
+
+	step, err := strconv.Atoi(raw)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not parse volume step %q: %v", raw, err), http.StatusBadRequest)
+		return
+	}
+
+	if err := controlLoop.StepVolume(step); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+	}
+}
+func muteControlPoint(w http.ResponseWriter, r *http.Request) {
+	raw := mux.Vars(r)["mute"]
+
+	mute, err := strconv.ParseBool(raw)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not parse mute %q: %v", raw, err), http.StatusBadRequest)
+		return
+	}
+
+	if err := controlLoop.SetMute(mute); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+	}
+}
+
 // Queue handlers.
 
 func getQueueJSON(w http.ResponseWriter, r *http.Request) {
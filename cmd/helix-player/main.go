@@ -15,7 +15,9 @@ import (
 	"time"
 
 	"github.com/ethulhu/helix/httputil"
+	"github.com/ethulhu/helix/netutil"
 	"github.com/ethulhu/helix/upnp"
+	"github.com/ethulhu/helix/upnp/gena"
 	"github.com/ethulhu/helix/upnpav/avtransport"
 	"github.com/ethulhu/helix/upnpav/contentdirectory"
 	"github.com/ethulhu/helix/upnpav/controlpoint"
@@ -34,6 +36,8 @@ var (
 	ifaceName      = flag.String("interface", "", "network interface to discover on (optional)")
 	initialRefresh = flag.Duration("initial-upnp-refresh", 5*time.Second, "how frequently discover new UPnP devices when the server hasn't found any yet")
 	stableRefresh  = flag.Duration("stable-upnp-refresh", 30*time.Second, "how frequently discover new UPnP devices when the server has found some already")
+
+	disableEvents = flag.Bool("disable-upnp-events", false, "poll renderers for their state instead of subscribing to their UPnP events")
 )
 
 var (
@@ -84,6 +88,28 @@ func main() {
 	// TODO: support multiple Queues.
 	controlLoop.SetQueue(trackList)
 
+	if !*disableEvents {
+		ip, err := netutil.SuitableIP(iface)
+		if err != nil {
+			log.Fatalf("could not find suitable IP for UPnP events: %v", err)
+		}
+		eventConn, err := net.Listen("tcp", (&net.TCPAddr{IP: ip}).String())
+		if err != nil {
+			log.Fatalf("failed to listen for UPnP events: %v", err)
+		}
+		defer eventConn.Close()
+
+		subscriber := gena.NewSubscriber(fmt.Sprintf("http://%v/", eventConn.Addr()))
+		controlLoop.SetSubscriber(subscriber)
+
+		go func() {
+			log.Printf("listening for UPnP events on %v", eventConn.Addr())
+			if err := http.Serve(eventConn, subscriber); err != nil {
+				log.Fatalf("UPnP event server failed: %v", err)
+			}
+		}()
+	}
+
 	m := mux.NewRouter()
 	m.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		msg := fmt.Sprintf("not found: %v %v %v", r.Method, r.URL, r.Form)
@@ -191,6 +217,30 @@ func main() {
 		MatcherFunc(httputil.FormValues("elapsedSeconds", "{elapsedSeconds}")).
 		HandlerFunc(setControlPointElapsed)
 
+	m.Path("/control-point/").
+		Methods("POST").
+		MatcherFunc(httputil.FormValues(
+			"action", "set-volume",
+			"volume", "{volume}",
+		)).
+		HandlerFunc(setControlPointVolume)
+
+	m.Path("/control-point/").
+		Methods("POST").
+		MatcherFunc(httputil.FormValues(
+			"action", "step-volume",
+			"step", "{step}",
+		)).
+		HandlerFunc(stepControlPointVolume)
+
+	m.Path("/control-point/").
+		Methods("POST").
+		MatcherFunc(httputil.FormValues(
+			"action", "mute",
+			"mute", "{mute}",
+		)).
+		HandlerFunc(muteControlPoint)
+
 	// Queue routes.
 
 	m.Path("/queue/").
@@ -121,6 +121,10 @@ type controlPoint struct {
 	State         string  `json:"state"`
 	Elapsed       float64 `json:"elapsedSeconds,omitempty"`
 	Duration      float64 `json:"durationSeconds,omitempty"`
+
+	// Volume and Mute are omitted until the volume is known.
+	Volume *int  `json:"volume,omitempty"`
+	Mute   *bool `json:"mute,omitempty"`
 }
 
 func controlPointFromLoop(cl *controlpoint.Loop) controlPoint {
@@ -131,13 +135,19 @@ func controlPointFromLoop(cl *controlpoint.Loop) controlPoint {
 		transportName = t.Name
 	}
 
-	return controlPoint{
+	cp := controlPoint{
 		TransportID:   transportID,
 		TransportName: transportName,
 		State:         humanReadableState(cl.State()),
 		Elapsed:       float64(cl.Elapsed().Seconds()),
 		Duration:      float64(cl.Duration().Seconds()),
 	}
+	if volume, ok := cl.Volume(); ok {
+		mute := cl.Muted()
+		cp.Volume = &volume
+		cp.Mute = &mute
+	}
+	return cp
 }
 
 type queue struct {
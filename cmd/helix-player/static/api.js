@@ -75,6 +75,15 @@ export async function setControlPointElapsed( elapsedSeconds ) {
 export async function setControlPointTransport( udn ) {
 	return postForm( `/control-point/`, { transport: udn } );
 }
+export async function setControlPointVolume( volume ) {
+	return postForm( `/control-point/`, { action: 'set-volume', volume: volume } );
+}
+export async function stepControlPointVolume( step ) {
+	return postForm( `/control-point/`, { action: 'step-volume', step: step } );
+}
+export async function muteControlPoint( mute ) {
+	return postForm( `/control-point/`, { action: 'mute', mute: mute } );
+}
 
 // Queue APIs.
 
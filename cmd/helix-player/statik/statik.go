@@ -8,7 +8,7 @@ import (
 
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00Vs\xc8P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x00api.jsUT\x05\x00\x01\xa5J\xde^\xac\x96\xdfk\xe2N\x14\xc5\x9f\x93\xbf\xe2>\x14\x12\xc1\x9a\xd2\xc7\x14\x0b\xd2\x1f\xd0/\xdfn]\x94eaY\xe8t\xe6\xaa\xd3\xc6\x99\xec\xccM[\x91\xfc\xef\xcb$1Fk\xd4\xa5}+\xe4\xdc\xcf\xb9g\xe641\x8a`4\xbc\xfeyz+\x13\xbc\xd2\xe9\xc2\xc8\xe9\x8c\xc6\xf8N1\x9c\x9f\x9d\x9f\xc1\x0d\xcd0\x81{m\xa6L\xf9Q\xe4\xaf\xf4\xffK\x8e\xca\xe2\xe9\x9d@Er\"\xd1\xc4p\x7f7\xf6}f\x17\x8a\xc3$S\x9c\xa4V0E\xfao\xf4\xf0-\x84\xcc$\xd0\x81\xa5\xefq\xad,\x81\xb1)\xf4\x81\xbd1I0A\xe2\xb3B\xd1u\x02o\x86L\xa0\xb11,a\xc09\xa6\x14C\xc0\xd24\x91\x9c9f\xf4l\xb5\n \xef\xfa^\x0e\x9d\x0b\xdf3H\x99Q\x0e\xd9s\x8f\xc2\xce\x85\x9f\x7fX$\xd5\x96n\xb5\x99W>\x82\x11+\xf7I\x90`\"\xa0\x0f\n\xdf\xc0)\xae\x191\xc7\xf0\x1e\x9e\x9e\x91S\x0f\x15\x19\x896\xacfz\x13mn\x98[8\x84_\xf0\xd2\x85W\xf8\x0d\x1d\xe8_\x16\xbbOD\x8f\xa5)*\x11\x96\x8f\x1cgc\xcb\xed\xb0s\xa4\x99\x161\x04\xc3\x87\xd18\xe8\xfa\x9e\xf7\xa4\xc5\"\x86\x89X\xe5\xcb}w\xeaWZ\x11*\xba\x96\x069i\xb3\x80\xc1\xf0\xce\xf6|\x1f\xdfSm\x08\xb6\xd2\x16.+\xad[\xbe\x88Z\xadP\xdfI\x10\x89\xb5$\n*\xb3=\xc4\xf2DBX\x8d-\xba \x05\xecd?n\xb0O\x96\xf5H\x1e\x9d,\xa5\xc8\x1f7\xdd\xaaVhM\xa5\x07\xf4!8\x0b.\xfc\"\xfb\xe0\xc7\xd80e\x0b\xe1\xe1\xd8\xb5\xb655\xd5\x8acB\xd7\xbc\x102\xa1\xda\xd26\x98'\xcbL\xa8\xed\x80\xdbuL\xd8b\x0fx\xdd\xd6]\xe4.,\x81\x15\x9c\x18\x02G\n \xdf\xef\xc62\x8b_d\xe7P\x87\xfc,\xe9\xf4k\xec\x1ci\xedV\xfd\x1f\x18\x9d\xc0PKuL\x1b*}!\xdf\xdd\x87\xc7\x88\x97\x9a\xd3\xd4\x89\xa2\xea\xe2\xda\xef\xad\x15\xd9\xcc\xb5\xc5t\xa1,1\xc2\xea\xc6\xa4\x9a\xd6\xb1\xf6\xdc\xd9\xa7\xad\x1cD\x1cprg\xfcY#\xc7H\x0f;!5\x8dn\x12\x96Z\x14!`\xf9\xc7\x08\xb9V\xc2\xb6ve\x87\xff\xe6d\xbcM\xfa\x97m\x8e,\xec\x8e%\xea\x0e\xc7\xc5d\xa3\xad\xdf3\xcc\xf0\x88\x96\x16\xba\xb6z\xfeq\x0f\xf7\xd7\xb2\xfc\xe6\x8cu\xc9\xd9\xf3~n&\xa9\xb8.A=\x107gu\xf1*\x8e\x1d#\x87\x8e\xefy=\x9a\xa1\n\xcb\x8f\xf7e\xe3\x83{\xe8\x943cPQ\xb1\xdc\xd80\xfe\x12\x1e\xb9\x16/\x07W\x1b\xb4\x9b\x18\x9c\xebW\x1c$\xc9\xad\xd1\xf3\x8f\x87\xd9\x82/\xa7\xdc\xaf\x8b$9\xd0\xdcRZ,\xbf\xb682\xc5\xcaf\x1d\xe2o\x00\x00\x00\xff\xffPK\x07\x08\xf2\xfe\xa6=\xab\x02\x00\x00v	\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00common.cssUT\x05\x00\x01\x93\xef\xd3^\xa4S_k\xdb0\x10\x7f\xae>\xc5\xbd\x14\xd2b\xb5\xaa\x1b\x97\xd5y\x19\xec\x0f\x14V\x18\xac\x0f\xdb\xa3l\x9dcQY2\xd2\xa5u6\xf2\xdd\x87d'M\xbbv\x81-\x10\x08\xc7\xef\xef]t~\xca\xe0\x14\xbe}\xfd\xf8\x9d\x7f\xd6\x06?\xb8~\xed\xf5\xb2\xa5;\x1c\xa8\x84\\\xe4\x02>Q\x8b\x06n\x9d_J\xcb\xe0	\xffE\xd7h\x03\xf2\x1b\x85\x96t\xa3\xd1\x97p{s\xc7\xe0\xf4\x9c\xb1\xd2;G\xf0\x8b\x1dq^\xc9\xfa~\xe9\xdd\xca*^;\xe3|	\xd0\x063\x13\x19\x88\xe3\x0c.\x848>YD\\-\x8dq+\xda\x81\xe0\x19\xee:\xc1\"\xaeq\x1e\xdf\xd6\xdb\xaa\xb5z\xd9\x9a\xd8\xe4I/\xa2rQdpu}\x9cA\x91\x90\x1b\xf6\xbeC\xa5%\xccz\x8f\x0d\xfa0\xc2y\xa8[\xec\xb0\x04%\xfd\xfdI\xec\xb1+t\xb8Q\x9e\"\x1cl\x94\xcf\xc7F\x07+\xbd\xdb	\xbeU\xaa\xb8\xce`\x1eK]\x15	\xbaa\x1b\xc6*\xa7\xd61\xf0+i\x1f\xa4\x9f\xfdY#2\xf7\xa2n?#\xf8e\xc4\x14\xbdq\x96x\xd0?11.\xe6=-\xa6a#;m\xd6%@\x906\xf0\x80^7\x91\xd0i\xcb\x1f\xb5\xa2v$\xe4\xd8-\xd8Q/\x95\xd2v9\xb9\x8a\xb3\"Nw\x87	\xb5G\xb4 \xad\x82\x19\xec\xf1\xe7\x02;H\xa7\xd9\x16=\xea\xa4_j;\xea\x08\x90+r\x8b4\x1dv\x9e\x97\"9\x8e\xfbY\x119\x9bA@\x835e\xa0\x90\xa46\xe1\xaf+{v\xd1\xff\xdeW~p_\x95\xf3\n=\xf7R\xe9U(\x01\x8a~Xl\xa7O\xae\xc1\x19\xad\xe0\xb2\x1f\xdeJ\xd9hC\xfb\x04\xe5]\xcfC+\x95{\x9c]\xf4\x03l\xbf\x95\x91\xf5}$\x8c\x9b\xe4\xe4\xfa\x91t\x99|\xa7i\xe5\x88\\WN\xd3\xcd\xb4\xc8R\xd6\xa4\x1f0\xaeo\xdf\xee5+qV\xec\x9b\x91\x9764\xceG\xc9\xf4\xdbH\xc2\x1f\x11\x9d\x1e(k\xd1\xe8\x81\xf7F\xae\x8d\x0eT\x96\xbd\xf44\xabW\xde\xa3\xa5\xf4\x07\x98\x8e0\x96\x7f\xf1F\x92\xc4\xa8@\xb2\n\x13{\xcc\xfa\x8fd\x92Udn\xd8\xef\x00\x00\x00\xff\xffPK\x07\x08\xddK\x92P\x16\x02\x00\x00=\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00@\x8c\xc9P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00	\x00directory-tree.jsUT\x05\x00\x01	\xc8\xdf^\xd4V\xdd\x8a\xdc6\x14\xbe\xb6\x9f\xe2\\\x14,\xc3\xac7\xe4\xd2\xc3&\x94M\xd2\x04\x92\xb6$[Z\x08aWc\x9d\x19+\x91%G:NfX\xa6OQ\xe8e_\xb1\x8fP$\xd93\x9a\xd9\xd94\xd0B\xe9\xcc\x85e\x9d\xef\xfc\x7fG\xd6\xf99\xbc\xf9\xf1\xc9/g\xcf\xa4\xc2K\xd3o\xac\\\xb5t\x85k\xaa\xe1\xe1\x83\x87\x0f\xe0)\xb5\xa8\xe0\x95\xb1+\xae\xf3\xf3\xf3|\xc2\xbf\x94\x0dj\x87g/\x04j\x92K\x89\xb6\x86W/\xae\xf2\\v\xbd\xb1\x04\xb7 L3t\xa8\xe9\x99\xe5+\xff\x9c\x01*\xec\xbeC\x8d\x96\x93\xb1\xb0\x85\xa55\x1d\x14\xd5\xb9\xdfw\xd5{W\xcc\xf7\xdaK\xa4\xa6}\"-6d\xacD7\x8b;?,\xdecC3\xb0\xc6P\\'vx/\xa3\x95\xbc1\xda\x11\\s\x08\xbf\x8bC\xcf\x0c\n^@9\x9fP\x8b\x81\xc8\xe8\x13\xa8(H\xa1R\xf7\x03\x9d2\x18\x04)R\xf1\x05\xaaS\xc8 8@\xca{\x82T2\x859\xda(<\x05\x0b\x82\x149\xa8{\x0c\x0e\xd1\xef\x88#\xecz\xc5	\xe1\xe2N\xabX\x9eE\x7f\x0cn\xf2,\x8bu\xa8\x85t|\xa1P\xc0m\x9ee\x19\xe1\x9a\xce\x046\xc6r\x92F\xd7\xa0\xa4\xc63j\xad\x19V\xed<\xcf\xb2m\x9ee\xa1,oi\xd3\xe3E\xd3b\xf3aa\xd6\xef\xe0W\x18T\xb4\xf1I:\xb9\x90J\xd2\xa6\x86V\n\x81\xfaK\x8auX\xa1\xb8\xc7@X+\x1c-\xdc@9\xcb}\xb2\xb8\x0e\x8cj\x14w\x0e\x9e\xa3\x92\xeb\x89U\x9b+\x8b\x08\xb8&\xd4\xc2\xc1\xf3\xabW/\x9f*\xf4\xe9{\xdb\xa1Fv\xf08V\x06gn\xe8\xd12o2\xcb\xa8\x95\xae\xe2D\xbci\xdf\xb4\\\x98\xcf\x0cn\xa13\x02k(L\x8f\xba\x80\xad\xaf\xf4\x08t\x01\xf2\xda\x18\xaax\xdf\xa3\x16\x97\xadT\x82\xed:P5\xcah\xfc\xde\x08d@v@(!\xba9\x1e\x02V\xfa\xa4+jQ3\x10\x0e.\x1e\xf99s\x953\x96\x180\xe03X@\xe9\xb7y\xa5y\x87\x952\x0d\xf7s\xdd\xf5\xdc\"\x83E\xd8\x0d\xe6\xc1\"\x0dV\x83ps\x1f\xeb\x1d\xbb\xd7\x83\xf2\xcb\xaa\xe3=\x83\x90\x85\xc6\xcf\xbb\xcaU\x0b\xa9E\xdc\xf7\xd6\xfc?\xb10(o\xe1\x8b\xa9\x0f*&\x99ms\xcf5\xd4\xe2\xe9'O<\xf0\x01\xce\xa0\xe7\x1be\xb8\x80X\xf9\xc8WOT\x8d\x9f\xe1rpd\xba\x03\xf8-\x08$.U\xbdSL\xcb/\xa4\xeb95\xed\xa8\x82;\xbfiF\x0c&oca\xae\x95d>\xa78\xf3,\xf2-\x93\xa2\x06Q\x0dB\xcf\xc2\xab'h\x0d\xc5D\xd1\"\xee6-\xd7+\xac}\xc4\x8fF\xbdL.\x81\x01V\xc4\xed\n\xa9\x9a\x98\\N\xe21\xc7(\xf6\xa3;\"\xe7\xa389\xfc\xd8\x18@z\x06\x96#l\xea\x80	\x0d\x88\xbe|\xeb5\x8d\xd4>\xe8\xc2\xa4\x94Iwi4q\xa9\xd1z\xdd\x12\x1e\xefDY \x82\xa9\x1a\xafaQ\x1f\x12\"\xba\xbf\xcb\x86\xfaH\xff\x10\x1f}\x94\xfb\xa8\xf7\xe17\xbeQ\x0c|5\x8c\xc2\n\xad56\xb62\xcb\xb2-\xa0rxT2O\xb6}a\x0f\x93\xfd8\xa0\xdd\xbcA\x15Z\xbc?\x00\xa3\xfa=:\x16;\xf3	\x13\x9aN\xce\xc3c\x1b:\xbc\xf5\x87\x8b\xcf-\x1c\xe6\xbe!\x13\x0f\x97\xc6\x8e\x04\x81	$\xaa\xde\xa2CM\xe1\x9c\xfc\xe9\xf5Kx\x0co\xa1\x80b\x06\xd7\x9cA\xf1\xe7\x1f\xbf\xfd^x\xdd\xd6\xe2\xd2+\x1f\xc3\xb7P\xc2;\xa8A\x0fJy\x8b	}\xd3z&\xdc\xbd\xdb\xcf]\x07\x8e\x04\xf5N\xf0\x82\xb0\x0b{{\xebG\xd8\xaf\x1a\x8e\x9bonM%\xa6\xa9\xda\x9e\xfbW)\xb67\xff\xe5\xbc$\x01\xcd\xc0\x87\xf3O\xe7\xc5sz\xcf\xf0d8\x9c\xe9\x90\x81t\xbe\x98\xfb\xb2s!\xbeUj*x1f}\xac\xfb\xf7\x83\xb5\xd7\xdb/\x93)\xfa\x9f\x0e\x8f\xa9H\x92\xdaO\xcf}\x0c\x9a\x06*!\xe8\x8e\xb3'\xb9\x19\xaf-\xa9\x83\x10E\xd1(\xd9|(j`\xe5\xee3\x95~\x81\n\xd4\x1f\x07\x1c\xb0\x98y\xcbi\xc8\x93\xe7\xb4\x9f_r\\\xbc\x05.\x04p\xa5\xe0]\xf1o\xb9\xdfN\xf7\xb7d\xc8\xe1\"2\xd8T\x92\xb0\xbb\xf4W\x9d\xca\x11\xb7\xe4~\x96\xd42(L\xa4S3)$\xd7\xc5\x91\xac\xe3\x95\xf1\xab\xccx\xe9x\x93\x8c\xdf\xe2\xd8}W	\\J\x8d\x0c\x8a\xd6\xdf\xb3\xcevM<#\x8b>\xa1\x13\xd7\xafr\x9e\xff\x15\x00\x00\xff\xffPK\x07\x08\xec\x1e\xd69U\x04\x00\x00\x83\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00A\x8c\xc9P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x08\x00	\x00elems.jsUT\x05\x00\x01\n\xc8\xdf^\x8cX[o\xdb\xb8\xf2\x7f\xb6>\xc5\xec\xfe\x1f$\xb7\x8a\xec\xa4/\x85\xb3\xee\x1fi\xda\xdd\x14h\xb7\xc5\xa6X\x1c\xa0\xa7hhi,qC\x93Z\x92\xf2\x05A\xbe\xfb\xc1\x90\xa2$_\xda\xa6@c\x99\x9a\xf9\xcd\xfdBO&p\xfb\xe9\xcd\x7f\xce~\xe7\x02\xafU\xbd\xd3\xbc\xac\xecg\xdc\xda\x19\\L/\xa6\xf0\x1a%\x16<\xb7p\xc3t\xae\x1am\xa3\xc9$\nL\xefy\x8e\xd2\xe0\xd9\xbb\x02\xa5\xe5K\x8ez\x06\xafo\xdf\x9c]\x9c]\x0b\xd6\x18\x8c\xa2\xb81\x08\xc6j\x9e\xdb\xf82\x8a&\xcf\x9eE\xf0\x0cP\xe0\xea\x0f\x94\xa8\x99U\x1a\xb8\x01\x06\xcbF\xe6\x96+yVv\xe7\x9b\x8a\xe7\x15\xa8\xc6\xd6\x8d5\x1d\x81!\x80\xa5\xd2\xd0\x12rY\xc2\x9b\x8f\x1f\x1c&Jk`\xcd\x190X)\x8d\x90+\x99s\x83\xc0\xa5E\xbdd9f\x118\x05\xae\x99\x10\x06\xac:\xd0\xc4\xd4\x98\xf3\xe5\x0e\x18XV\x82d+\x04&\x8b\x14TM\x92\x99\x10\xbb\x14\x98;75\xcb\x91\x90\x98,`\xc7Q\x14\x03\x1b\xc0V\xcc\xc2\x86\x0b\x11\x94\xc4}\x15\xd5\xd2\x93\xd8]\xdd\xa9\xf4\xb9B\xd0h\x1b-\xb1\x18 \xb1{4\xc0\xe4\x0ed\xb3Z\xa0&V\xa6\xcb\xa6\xc3)\xf8r\x89\x1a\xa5%\x0c\xc23\xa9\xd3i\xd1pQ\x18hj\xb0\x15\x06\xc9\xc0\xf2\\\xe9\x82\\f\x15\xbdX\x05\xe9WZ\xb3\x9d\x99\xd1#\xc0\x95\xdc\x01s\x07`\x9a\xba\x16\x1c\x0b\xe0\xd2\x01\x05\xd9 \xb8\xb1\xc04\x82\xd5\xc8,\x16\xc0\x0c\xb0\xa2\xe0\xdeQ\x1e\xa7S4\x83\xcf\x157P\x11\x0d\xac\xd8\x96\xaf\x9a\x15H4\xc4\xe7|\xa1\x11A\xb0\x1dj\x03\x05b\x1d\xb4\xba\xb5\x9a\xcb2\xa8\xd5~sRs%\xd7\xa8\x89\x9f\x0c\xc1\xad\x05\xa9\n4A\xcdB\xe5N\xb2\xf7\x05+\nG\xe8a\x06\xfe\x08r\xfe$\xde\xc9\xdb6<\x93+ku\x10\xfa\xd1V\xa8]\xf4\x1cQ\n\\\xe6\xa2q.\xec\xc2\xe9dX\xab\xf9\xa2\xb1\x98\xf6>s>\xe6\xc6\x03u!uy\xb1\xc0N\xab\xbd\x00\xb9\xe4\xadk\x94\xc5u\xc5E11h\xaf\x02r\xebTC\x04Z\xd5\x9aS^\xb5\xfc\xc3L\xfa\xb8\xf8\x07s\xdb\x19\xe0\xbf\xc1\x86\xdb\n\xb8E\xcd\x16\x02\xe1\x1ew\xa6\xd3d\x18\xc2 \xcc\x19\x85k\xd2i\xc5j\x13\xb0\x01\xfef\xa2A\xd3\x16'\x85\xa2+\xcc\xc3t\xf0\xdc\x15\x93\x85@\x1dr\xa01\xd8:\xe4\x8e\x15\xc5[\"y\xcf\x8d\xa52\xb9K\xc1xk\xee\x94\xbc\x83\x9ai\xeb\xb3\x03[(W\x90\xa6R\x8d(@*\x0b\x8b\xe0\x92=\xda{\xdc\xf5\xda\xfa\xf0\xad\xbd\xce\xa4\x9fO\x1e\xd4\xb9\xf7\xbd	9%\x8b\x93n0\xa9s\\\x90\xb3\xc4\x0d\xe06G\xd7\x0f\x82\x87\xe1\x0c\xeed#\xc4\x1d\xe4\xd4\xf4\x0cH\x05\xac-_\x05\x95\x0bg\xd6\x912X(%\x90\xc9@\xed:\x81\xd3\x90\xc8\x17\x08\x06\xad\x13\xaa\x1a\x0b\xac}\x93X\xdd\xe0\x18\x94N[ \x9f\xc7}\xb8<\xab\xc6\x95Zc\x01\xc9\x92	\x83\xe3^\xaaj\xb3\xc0\xa5*\x95\xb6\x97\x0eL\xf6\xf6\x04\xaf}\xd2j\xcd]\x86S\xdfQ\xce\x83\x94_T\\L\xf6-\xc0\xa5\x8fF\xd3\x08\xdb\xbe\xea\xa0`\x81\xae\xc9TZm:\xdc\xb7[\xb6\xaa\x05\xc29\x9c\xc1\x15\xfc\xdb\xf0\xfc\xde\xb5\x11z7\xff\xce\xbf\x96\xf5\x9dt\x95\x04\xe8!R\xd8`\xac\x11J\xd5\xb6\xb2\xdc\x85\x0e\x18\xfc\xd6\x88W>\xd5\x97\xaa\xd1\x94\xef+\n\xa1K\xd6\x8a\xad]\xc2\xb4 ._pk\xd3a\xa2rY\xa6`\xec\xce?\x90\xb7\xa8MqY\x06#r%\x8d\x85F\xc0|\x7fv$q#\xe2qO!\xf81\x85\xe0\x8e\x82\x88B\x83\xca\x16\xaa\xd8e\x83\x8aO\xa0\x11\x89\x0f\x9b\xe0	\xc47(\x84\x8aa\x9c\x0e\xce\xae\x05\xb9\xee\x03\xc6)<@N_f\x800\x7f\x05L\xa0\xb6I|\xc3\xe31<\xee\xf3\xbcQ\xf2\xbf\xb1\x85\x9f\xb1\xbe\x03\xc38UW<\xf6~\xc0\x19\xc4\xb9\x12J\xcf@cq\x19\x1f\xe2\xde6\x0b\xb8E\x1b\xa7\xe4\x93\xa4KN\xff\xf2\xa3\xf4\x82\xe2\x82Yv\xb6T*\x9eA|>\xc4\xe8H?o\x06V\xf6\xa74\x19b\x18\xfb\xe3\xb1\x7f\x18\xc3\xf82\x82g\x93\x08\xb7\xb5\xd2\xb6\xef\xac\xfb\xee\xb6\xacLA\x9aq\xf4\x10\x8d\xfc`\x85\x04\xb2,c\xba40\x86\xf9\xabh\xf4\x10\x8dF>\\\xc4\ns\x90\x06\xfe\xbf\x8f\x8d\xcf\xaav,\xfcy\x9b\x804\xa9\xdb\x0c\xc60\xfb\x0eU\xe2\xdf_F\xd1h\xc4\x97\x90\xc0/\x90\x80\x93\xc8\xa5\xb1L\xe6\xa8\x96~\xd8:kFN\x85\x91#\x98\xc3\x17O\xf9\xf52\x1a\x8d\x1e\xa3\xd1\x08\x85\xc1\x03\x12\xfa\xc8\x96\x82\xd9\x04^\x90\x18\"\x8c\xfc\xdbl\xa9\xf4[\x96WN\x9e3\xcfs:5\xdc\xd1|\x0e\xd4\xa7\x9c\\\xff\xaeu\x0c\xe18\x89N$t\x1cC\x9d\xad\xd5CFrX6\x9cN\x8e#\xa3\x16\x9d\xba'\xdf\xb7\xc6O\x80\xa6\xc1z\x04\xbdW\x12\xc4p\x12\x89\xda\x92\xdf\x88\x9cu\xb1o\xe7\xf1\x8f\xd1\x0e\"G\xcb.\xa9\xd0\x8ay\x9a \xdfO\xf7\x04\xf9A\x9b\xd1dm\xa1\xfa\x88\xdc\xe3\xceG$\x10\x8f\x0eP\xbf\xdc\xe3\xee\xab\x87\x0e\xe9\xdc\x82w\x1c\xad%\x07C\xd3a\xa7=\x84\xd7\xbe\xd5\xff\xc0\xe9\xbd\x10\x9a&'\xe1\xf7#\xea\xa0\xe3\xf8\x89\xa0n\xe6\x9cD\xf5ci\x1f\xf8\x89\xa0}\xc2\xf6\x98\x83\xa4=\xc1~\xe8\xd4_\x8e\xe2\xf5s\x9b\x7f\xe4\xce}\x047\xe2 ~'\xd7L\xf0\xc2\xcfI\xba\x9d\xf4\x939\x86\xe7\xce\xde\xe7\x10\xd3\x98\x0c\x9b\x1e\x1d[V\xee\xe1?\x1e&_\x9f]\xa7\xe4p9\xb8\x0b\x90\xd0\x03l\x12\xe9l\x81\x0d3\xfe\xb0O\xe3\xe7@\xcd\x18\x9e\xd3\x97N\xe8c\xdb\xba\xda~Ix\x97\xd1\xe8\xf12z\xdc\xbb\xbb\xfd\x85%\xa5_{u\xabP\xd4\xa8\x07w\x16\x05:\x10\xec\xdf\xaf\xfaU\x91K\xc2\xa2\x15\xa6\x14j\xc1\x04\x98\\\xd5\xe8\x97-\xda$\xc2\x8d\x0bj\x8dK\xbe=X!fp\x05V\xf35g\"l\x10\xf4\xf6\x065\xc2\x86VD\xe66\x93V\x87V\xc2P\xbf\xeeZF\xfb\x82\xdf\x8a~\x13\xfc\xd5`\xcd\x1d\x9a\x99@\xfc-N]*\xa6@s>\x05\x9a\xe5~\x0cy\xf2o\xdd\xd4\x86\x87\x13s3\x86\xc7n\xb4};5\xd7\xc3\xf1\xcf\xe6\xf3\x0d\x89\xa5\xf9\xd9N\xc4C\xbfxw\x9d	4\x06n\xff\xfe\xa3\xcb\x88\xeeVm\xbeg!\x19UY[\xcf&\x93\xcdf\x93m^dJ\x97\x93\x8b\xe9t:1\xeb\x92\xde\xb6\x1f5\xb3\x15}\xd2\xee\xb4\xe7\x03\xb3.\xbb\x05\xe0\x01\xd6\x1c7\xaf\xd5v\x06\xbfNa\n\x17S\xf7\xff\xd7\x81\x1f\x08'\x81\x07(f\x10\x7f8\x9f\xa6\xe7\xd3\xea\xfc\xe5t}\xferZ\x9d\xd1\x03\xfd\x89i	\xd1\xea\x9e\xbc\xe9\xdd8\xf0\x18)@\x00[Z*\xa6D\xbb\x9bA\xfcrJ\xce\xee\x1d\xdc;\xea\xf4\xc2\xd0\xbb\xc0\xfb.uS>\xcb2\xcbhO\xa0\xed\x81\x9e\xfa^N\x855\xa7%S\x16j\xf3\xc53\xf9z\xfbz\xb4\xf2A\xbb\x84\xf8\xb1\xd2\x17Q\x18A\xbfkV\xba\xf8|\xaf\x90\xfa\xdf\x0fZ\x0e\x08,\xe1\xae\x1bj\xd2d\xff\x18\x9fy'\x8a\xe5\xba1V\xad\xa0]R\xc0\xe2\xaa\x16\xcc\xdd(\xbd{\xfc\x12\xf4\xad:?\xb6 \xae\xce\xdb(\xf7tF({\x82\x92\x8e\xf72\xc2\x93\x07i0?\xb2\xbb\xcb\x97o\xd5y\x02\xb1\xbb\xc9r+\xfa\x95\x0f\xbc\xb0$D}\x08.\x981\xf0a\xf7\x89iVjVW\x80[\x8b\xb20p\xf3\xf9\xc3\xfb`\xeaC\xc0q\xba\xe8&'U\xc7\xfd1\xd0u\x1du\x12,t\x89Uq\x931kY^\xddV\xacP\x1b\xca\xb2\x95*(\x0bU\x8d\xd2\xa5\xe1!\xbdq\x94\x7f)e\xf7\xb7\x8d`}\x96\x0b%\xd1/\x1a~\xfc\x0e0\x1e\xfd\xc3\xe3\xe5Q\xe8\x1c\x14\xddrV\x8d\xb0\x9c\xae-\xfe\x87\x0e\xf7S\x8a\xe1\xb2\x14\xfe\xb7%\x7f\xe1<\x0c\xe8\x89[\xc8\xa0uut\xee\x9a\xf4\xa3\xf0|\x81\xf3\x14.Rx\x01_\xb3\x15\xab\x13(\xa8\x04\\\xcb*\xc8\x90\xe3\xe8\x08l\x7f#\xeaQ\xb3\x7f\x1b\xd4\xbb[\x14\x98{E\xfe\x8f\x08z]\xe8\xdb\xbe\xef\xbc^\xdf\xd9\xf6\x8f\x94\xed\x97{*Zo\xd92\x14\xd8\x1c$n\xba*\xeax\xdc\xb8{\xca\xa2\xfe\x10\x9dZ\xd3\x1f\xa3vL\x0f^\x1f\xad\xe8\xb4\xa1\x9fZ\xd0;\xe5N,\xbb~\x0e\xb7c8\xd0Q\x07\xf9_\x00\x00\x00\xff\xffPK\x07\x08\x949\xe6\xd8;\x08\x00\x00\xb0\x15\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00df\xd0P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00helix-trans.pngUT\x05\x00\x01=\xc0\xe8^t\xbbePT\xf1\xf7?~w\xe9\xeeNABX`\xe9\x0ei\x14P@	i\x96\x94\xee\x12XT\xba\xa5\xbbAAbA\xe9X@\xe9\x14\xe9\xce\x15\x97\x92n\xfe\xa3\x9f\xcf\xe77\xf3}\xf0\xbf3\xf7\xc9\xb9s\xce\x83\xfb\x9e9\xe7\x15\xe7\x1d\xf5\\[\x8d\x08\x9f\x1e\x1f\x00\x00\"\x0due]\x00\x00b\x01\x00\xd8&\xc5\x06\x00\xc0@\x1e\xf1\x18\x00\x00L\xbb\xc7Z\x8f\x01\x00\x91@pc\x89\x05\x00\x00+L]W\x0b\x00\x028\x01\x00\xfe\x1e\x00\xae\x00\x00\x80\xff\x02\x00o(\x00\xfc\xb6\x00\x00\xa9\x0c\x00\xa0q\xcbi\xd1\x91\x01\x00\x00\xdb\xea\xa9\x9a\xf2\xfd\xbf\x07\xcf\xf0|\x11\x00\x00<7u#O\x00\x00,\xfe\xbd\x87\x8e\x8ac\x00\x00\xe0xih\xa9\xe0\xd4\x81@\x00\x00r \xb2\xcd\x05\x00Q\x0f\x0d\xe5\xc7/\xfc\xe6\xf7r\xfd\xbd\xac\x0d\x82P\x9d\xe7\x1d\xe7\xafuR\xd3\xa6;\x04\x96~\x9b\xb6\x1b\xefU-50H7)\xb1\xe6$?x\xd8\x85$\xf4<L\xf4\x01s=pg\xcc\xa0\xc3\x8d\x11\xcey\x0fm@mB5X\xe1\xceB= %\xa4E`\xedd\xafW\xa3R\xeb\xf9\xcb*\xb3\xd2\xe9W\xba\x1a\x1c\x9f^p\xbcl\xf7\x0d9\xee\x9b\xaa\xcfr\xf9\xf4\xf5\xe5T\xe0\xcb\x96|\xf9D\x87O\xe8\xe5\x8a\xea\xab\xdf\xbe!\xe7\xe7\xd7w\xb4ddd\x94\xf8al[n\xe5\xe2\x05\xcf\xb9=h\x82\x10!I\xdcI\xea\x9cQ\xefq\xc3\xc2\xc3\x81\"{\x11\xe6\xfc;9XW\xc1\n\x89\x9b\x99\x99\x99	!t\xfb\x0f-6L[\xde2L\x11\x14\x1b\x9b\xc1\xa9\xe0u\xe3'\xf2\x04\xd3\xcb\xf7\x99\xf6\xf2\xc5m\x16}\x0d\x0d\xd0\xe0L\x80M\x87\x81O\xe0\xcddx\x81\xf2]\x06+\x89Hf\xaa\x93f\x0e\xe8\x95\xf3\x9baB\xd0\xf3_\xf3\x98\x01M\xdc\x07.\xe74\x92(\xc6\xf1/\xbd\xbd\xbf_\xb3-\xcd\xb6\xf9\x9f\xbe\x86\x0b\xd6\xccl\xe8\xbc-~n\xfdV]\x19\x7fp\xe5>\xe8Y\x02)rH \x02'\nn}tvI\xd6\x93\xc82\xd1\x1e\xf8\xf4	\x1d\xfb|cMK\x96g\xc1G\xfb\xe0\xb7=\xdb\x83]\xa4q\xb1\xca\xc5\xcaQ\xdf\xb2]\x99e\xc1\xa4\xdf\xad(\x92=ew\x9d\xa9\xad\xfd\xfd\xe5\x04V\xff\xec.\xb7o\x10\xc3\x11\xdd\x8a\x9f\x92\xf0\xc3\xd8\xa6L:\x18\x13(\xc8n\x07\xf2MZ\x1eBX)\x9fp\"\xde?3\xf6o\xb6\x84\xc3k\x82\xaa\x9a3\x1d\x91\x15/\x83\xe5a\xf5!\xc1v#]\xb3dO+\xde\xdb\\\xb9omh\xcaW\x9bp\xb5\x0b\xd4+\"\xdaj\xd9\xd3^\xe0\xc5\xc4\xddJ\x9cm\xcc\xa3\x87\xcf\x8e\xeb\xf1c\x83(\x9f\xa4\xd6.\xc6\xde6\x9dI\xe1\x87\xb1y\xcf\xaa\x15\xbe\xc0\x1b\x1a\x99Mp\xac\x11fh\xc4\x8d\xcdm\x0b\xe2\xea\xfb`\xbb\xeb\xbd\xeb=\xd9Y\xa4\x98\xd4:;`,\xdd\xf7\xc1\xe5o\xc0\xf5\xee\x8e\x0f\x0c[\xc1\x1f\xc9l\xd3\xa2\xd2C\xb7\xd5W+\x16\x1a\x8a\xf4\xc5\x0f\x18\x176\x7fh\xfc\xd4m\xd6gvnk\x1bi\xd1\xdbk\x85\x18\xee\x84*O|\xa4@\xf3P6+\x1b\xccX\xd3\x93\xd3\xc7\x98\xfb\x863\xc6\xea\xa5\xb6\x0f\x948H!\xaa\x15\x93\xa4c\x13\x8c\xd3(\xf2W\xe6\xf4s\x1f[x\x99N\nu>Fj\xbeJ\xfb\x97\xa3$\x93\x97\xf4\xad\x97\xef\xe2\xf6M/A\xb1\xf1]\xa2\xa0\x1dfx\xff\x97\x14$7\xe5\xd3\x9eS0\x86}\x960Y\xac\x0dO\xa8\x0ewZ\xef\xb7\xf7[\xeaE]\xa8l#\xd1E\xca.\xee\xbf!u\x19~\xc6\x81\x82\xab7\xc7\xf1\x16\x8c\x83]\xcfe\xf65\xef\x8c\x0b\xfb\x99\xba\xdf\x0d\xbc\xa7@\xf3\xafFn\xfe\xa1U\xdd\x16W\x8f\x8c\xaft|\x1df\xe5\xe0u\xfe\xf0\xc3\xa6\x02uY\xad[\x9e\xda\xc3\xd1w]mm\xc3\xae\xcf\xf5\x8f\xc0G-\xaf^\x9e\xf0P\x12<\xa0\xff\n\x99\xcbr\xca\xda\xb1'\xca\x88\xa9\xe0\xa9\xf1-K\xeb\xa3c\xa3\xed\x19\xf7\xee-	y\xba-\xce\xf3\xed\xe2\"\xaeC\x08?\xcc\xca% \x80\x93+\xf7\xe2\xab\xc9\x8b\x12G 1\xbf\xc6AxN|x~\xb7\xdf\x10\x9a\x81\x1a\xbb	\x8f\xea\xed\xed\xed\x13\xee\xacVL\x1a1\xe9\xdc\xd7\xc4\xaeo\xf9}V\xad\xddH\x91\x01_!\x0b\xa5c\x8cT\x16\xfa\xf7-GJTn_\x19SO\x8f7\xf7\xc0vC\x0cL\x81\xbb\x8d%\xd4i\xf9\x00\xbb\xa7\xa7'\xd3\xdc\xa0\xb0\xdfV;/V5\xd7\xb0\xb1\xac\x17\xdd\xbat\xee\xca\x0e\x0d\x0b\xeb\xb2\xd8\x16\x87|;\x1c\x9f\xa5\x052\xca\x84M\xa9\xf0U\xceXy}\xa3\xf8\xcdb\xfbr\x9bd_\xbf>\xba\xa4\xf6V^o\xfb\xf2\xe5#\xf71\x036\xae2\x9ct\xadV\x8a\xe7\x11e#j\x98\xce_\xa4\x0e\xc2\xc6\xe5\x92\xd0\xe0\xd4\xdc\xbe\xb6\xb7\x17\xaf\x0e\xdai\xc8D\x9d/\x89\xe1>!I\x9bZb\xc2\xb7	6\x0dc\xcb\xf6;\x1eQ\xff\x16%\xd1\xf9Y3\xe0X_?@\xa6Q\xf8>\xcf\x93\x9f\xdcnTYH\xd1\xe4`\xf7\x00\xa7$\xe8\xfd\xdf\x92\xe2l\xc6\xf4Qg\xf7\xc6\xd6\xb3Q\xcf\xfa\x1a\x9c\xbb\x80\xec\xbe\x17_\x9b\xb1Ty\xf9\x8c\x11l\xc7\xcf\xe0\xf6V\x1e\x1e\xe4\xa4V\x16\n\x1cAT\xd1\xe6\xa8Y\x04B\x90\xcd\x02\x9a\xe3U\xd2\xec~\xbcmq\xf7\xc66t\xf8\x1146U\x92\x0d*\x96657\xb7\x80^\xbc\xc2\x0fc\xf0\xdf\xfbj\xa9\xc6F\x93=\x92\x8d\xf2\xc5\x12\xf1\xc5\xec\x89\x89>:\xa6\x83A\x12Q\xbe\x0fi&'&\x16*5\xbb\xa8\xf0\x01Z\x86d>PM\x87\x10\xa5\xcf\xfa\xf4\xc7\xa2E\x18\x80\xfc=\x15\x1c\xf2\x84\x8d\x96A\xab\xb2\xaa\xf2\xe3\xf0\x0d\xc5\x87\x99)\xb8\x8di\x8b\xd7m\x0b\x05\x9a\x97r\x7f\xedz\x85\x85\x96\x15\xbcN\xd1\x9dd\\\x1c\xc1l\xa63\x9c\x91;\x9dkl\xb5\xcd\x07\xcd\x99\x991\x90b\x83\x92\x0f\xb5\xcc.\xbbk\xdd\xa5W$\xe8@\xc4\xadO\xb4On\x0fH\x08qq\x82\x92\x99\x03\xec\xbfG\x87\x0d[\x9a\xc2\x91\x9444\xd4B\xbd\xab/\x12~|\xb3f\xf3\xef\xd1t\n\xb3\xa2\xfb\x93\x9c\xff\xbeGYA\"'\xef\xf1kO\x1d\xbd\xbdS\x00\xd9\xe2\xed\xef\xef@F\xcb\xa0USY\xf9y\xf0\x86\xe2\x8b\xe0\x88p\xb9\x80)fdJ\xd5;\xb6s:\x96t\xc9t\xd7\xe0\xe2\xc6>\xbaF\xd3\x81\x9eNDmq\xd2H7\x96\xaa\xa3\xfd\xc7\n\xb0\x82\xdbf\x8f\xb3\x9aP\x99\xff\xf6I\xe9S\xed]\x85\xc2~\xc6\xfbC\x92\xf6vE	_\xa6\x11\x00\x0e_\xb9\x88[_\x0fS\xe6\x17#\xa0\xa6\x9d+\xfeF;SQ14\x98\xe37H>\xd4\xe6\x87\xa8\xc7`\x8dV\xb6V\x81+&I\x9b4\x0b	>V>yw@B(\xe1nl\xed9\xd6\x1e\x16\x16\x0b\xe25\xb8z\x13\xcbgbE\x0d\x81\x8a\xb5t\xb8\x98\xc2\x8a=\x9f1j\x86?\x1f\xd8\x9c(F\x8d\x82*\xd6\x0d\x92\x08\xfa	\x08\x8b:\x83+\x8a\xdfaZH\xfd\x84Y\x1f\x17\x92\xa4\xa6\x8f\x95[\xf9\x8aQ\xdf\x1aH\n\x08\x08\xf0\xdb\xc6\x1e\x7f \xb7\xcbb\xe3P$$Ve;\xea\xf7\xba\xac\xf3\xbc~\xce\xad\xdb%\x7fW,C\x9e+',\xb7\xbf\xe9:D\x84>\xbe\x89\x05-4\x0e\xf14}\x97\x1b%\xff\xa2Q\xe4@\xac&\xa4\x971p\xecl\xc0\x8f\xa1xr:\xf0\xa6U1I\x96QVJLU\xf9$\xf7\xe0\xe4\\\xda\xffa\xdde\xbf\x1ao%\xb5\x00\xefd\x89\x82\xc5\xeb\xd7\x83C\xa0\xde\xa3c\x0c\xe2\x8c\xb0N\x8cdT\xb1\xf5\xbaA\xa7\x9479kt_\xae\xfb\xa3\x97\xdc\xdbc\xab\x16Bx\xa4\xb7\x8d\xf7\x01{Y\xb5\xc0\x83\x1cYr\xd1\x9f\xd7oX-\xce\xf7\x8b\xd8\x0cp	\x91\xb4\xb0X\xba\xde\xb3=\xf4\xb4\xf4y\xf7,)''\xb9\x06k\x92\xdf1\x8dZB\x05\xda)L:\xea\xfb\xe6\xe9q\xfe\xed\xdc\xd6\x90\"\xa0\xd43\xfa\xb3qq\x98K\xfe\xa5^\x8f\xa8\x82\xb6\xe4\xab\x96o%\n\x16e\xcc\x91\x06\xfaiiB\xbd\xfe\xde\xa9\xc9\x8dO\xc5\x94\x93\x92\x10\x82\xac\x992n\xab\x17\xee\x00\xa9\".\x87\x08\xbe\x1d\x82\xd7\xe3\xfeh\xa0\xd2 \x0c\x98\x9f\xb7\x12\x14o\xe3\xf5\xdd\xe3\x81X\x106\x9d/ww\x97n\x9a\xb8p\xda\x80a0\xda\x85\x86\x1ac\x1c\x80\x1e\xc5\xff\xdc\xfa\x81ba\xe1\x03\xd6L\x19\xaf\xc3M\x0d\x85%\xb1G9\xc83\xd7\xcb\x1bT\x8d)\x05\xf8bjmBG\xbc\x8d\x80H)X]\xfer`^*\xcfCT\xfd\x1d\x12JM\xb8\xbd%X\x86v\x9c\x11\xab\xd2\xbcF\x0b\xbbB*[\xb9(\xc1\x1f\x06z\"\xc6r@)\xe3\xdcB\xa1A{7[\x02\xbe^\xb8\x04~\xb7\xdev\xce\xd4.\xdb\x13j\xbc<t\x18\xbf\xd1\xed\xcb/\x18\xa3\\8\x89\xb28&N\x89\x18\xb2\xb3\x80p\x85<\x8a\x8dg\xb6=\xfb6O4\xe7\xd5\xb8\xa6d>\xa9\xcb&'\xff\x88\xbd\x16\xb8[\x1f\xb4y\x00\xcf\xbf\x13a\xf4\x99\xcc\xfe\xdc2\xb8\x01\x82'\x04\x7f\xa9\x13bJ\x8a\xd1\xa2gy5)\x12'\xd8\xe39\xd6n\xdc\x00\x00v.\x12Bs\xb8\xbfw\xe8h\x01\xf2\xe4\xeb\xf3\xce\xdb\x86\xa3l\x9d\x12\xec\x85\x9a-\xbd\xfc6/\x0d\xdcUd(\x99\x01c\x98\x93H\x9c \xe9\xe1e\xe7k\xa7M\xc6\xc3K\x89gj\x9bV\xc9\xaa\xa1\x99\xaf,*\x08\x8cg\xc4\x9d]\xccr\xf7\xa7\xa8\xe3\x0b\xdc\xfc\xac1\xd55\xef\x16:\x99\xe4\x08IU\x8a\xf7\xd5[\x1f\xd5EUW\x0fh\xc0\x7fQ\xe4\xdbw\xb2BV\xbf\x0d\xd8\xff\x8c;(z\xf6\xa6\xa8%\xcb\xf1G\x12\x7f\xea\xc0\xe2\x9cz+r\xf6\xd7U\x8b\xb7\x96V\xac\xbb\x99\x9e\xa9Y\xde\xa7\x97(\x12K\xeb\x87\xf4X\x9c\\fA\xa7U\x8d\xc0PKp{\xcdX\x9c+YX\xf6\xc5\x96\x80\x9ajd\xe4\xddd\x88\x93c=d\xf5h\xeb\xe2=\xc3\x8a\x88\xef\x8a\xe9s$ se\xaf\xa2recC\x9f\xb6\xf3\xa2<\x88R\xe3\xa1Y\xd0\xe9\xf8\x0387uvF\xff\xe2\x15\x81\xca\xa4~UE\x15\x16!\xec\xfa\xec\x14\x0d\x82{\x8e\n_>+q\xa3\xf0\xf9q\x866?\xd8!\x03\xfb\xddI\x1a\xf9?\x05\x16\x9f\xf7y\x08\x9f\xf9%\xa1\xf2\x8c;\xcd&C\x8b\xd8L\x9f\xa1\x92~\xd9\n\xf1C\xc5\x14	\x89\x89I(8\xc8]w\xe5\x8f\xc4\x00C\xc5&\x9d\xab\xf2\xda\xc0j\xc2\x8dQi\xe3We<\xe9\x18O\xec\xd3\x08\x98\x98((\x80	\x9a\xce\xf1\x89\x07\x98n\xdb\x9f\x85\xeb\x0bP1}\xecz\x8dcr\x0b\x8b\xc3\xcd\xb2\xac|\xc7\x07\x06}\x98\xbd\xaa\x91\xfb\xfb\xcf#\xb9\x93$\xfcV\xbf\xe1!\xf3v\x7f\xbdR\xef\x15hA\xf88\xc3\xd8\x0b\xd0||##\xf0h'\x0f\x02i\xdd\xcb}_\xa5\x07E;\x9c\xdf\x94\xbb5\x16\xca\x9b\xd7L8\xdd;OJ\xcb8\xabA\x81g\x94T\x92\x0f b\xf1\x04\xb1q6\xa6\xcd?\xa6TR4;n\xcf\xe6\xea\xb5\x0fl\xef\xa0\xfa\x86\x89,\x98\x84\xc5\xde\xa8^j\xba\x1du\x1c\"\x15\xb1\x1f\xcb\xcc	\x14d\xb2\xa7/\xd4p\x92\x02y\x9cQC\xe5\xf2\xa6\x06}\x92\x87\xa1$)\xa9\x92\x8f b\xd5d\xb4\x0c\x932\x81gG6l\x932\x9e\x9c\x95\x8c\xe8\xc8P\xd0\xf8\xc9\xcb\x91\xaf\xde\xf0j\xd7\xc5\xd9EB\xe8\xf6\xb1\xbf\xcc\xe8\xbd\x12\xaf\xad\xad\xbd\xba\x18\xc3\xdd\xa4\xfc\xe9)>\xe8\xe6\x97\xda;K\xf2Qq&\xfe\x94>\xe6\xca\x8au\xc6\x8b\xd9\xbe\x8a\x8aEV\x88X5\x05m\xb6\xf5\xfa,\xe2\x18\x15\x05q\xffceW>\xd9jD\xd8R\xe6\xae03S\xe5\xfa\n\xefW\x8b\x8c.\xa8\xa5\xdaa\xdf\x1f\x03\"\xbe\xeen\x04\x9cw\x13\xdc\xde*#	\xc6\xce\xcf\xf1\x86\x9a\x05Y\x9f\xaa\xa8\xf2\xf8l\xaew\x9c\xa7:b\xf5\xf6\x8a<\xcbsr\xe5\x12\x1aih\xa84\xc8]\x1eY\xb7\x1cF\x9d\x06\x80LWf\xeb^S\x158\xf0\x1aLO\x83\x8f~\xeev\x88\xabV\xb3J\xefD\x05\xdflt=f[eX\xf9\x85\xa6H\xf3\xdc\xbf\xf9\xb4\xde\xc3\x9263r}6\xf5S3}\xb0\xf9 h3 \x14\x81\xf8|v\x16O\\d\xbd\xfe-\x8a\xc0\xd9\xaf\xef\x9e\xc3\x18\x81\xa56\xa9/:3\x0d\xce\xe2dg\xec%T\x9aY:W\x1d\xa0\xde\xc5\xfdN\x9d\x12r\x99\xfe\\\x1d\xb7\xa0F \xc9-\xc0\xf5i\x97\xd8\xca\xf2y\xd3\x17\xbdD\xf0\xc6\xdd\xb9\xc64nl\x9c\x8d\xb7\xd7\xc9	)\x07\x85\x9eV\x0cDM\x93\xb6\x7f\xdc\x9bh\xfc\xa4,U\xf4\xebd^_\xec\xe5\xad\\\xfe\xf5\x8a\xba\xb6\xa0\x8cS\x160A\x12\x0c\x85\xb2b\x1e\xb6\x1f\x0c\x0cD\x14\x17\x97\xb1\xf3\xdf|\xea\xed\xa0\n8\x1a\xb8\n\xa8\x06\x1d\x04\x9akh\x88\xe1\xc6hM\xe3\xc5\xc6\x91\xb6\xb7c`(G}+T\xfa\x11\xfe8>c\x84\xa9d)\xac\x97\x81\xbe\xd2\x11\xd0J\xc7\n\xf1A\x87M\xb6zF{\xa2\xac\x04\xd9\x8aXn\xd5U\xb9k\xda\x84D\x947\xef\xd0\x1ba\xeb\xe7C\xa3\xed\x02!\xcd\x0e\x1c(\xa4\xecy\xc9t9\x87\x9eu\x15r\x964.>>\x81\x82\x83<\x828O\x02D\x1a\x17\xabZ\xac}\xc0\x13\x04\xd5Od\xe9\xc7\x10\xa9B|*\xd3\xdf\xf6x\xfd\x1a\xe8m[6i\xa9\xaa\xcb\xc6v\x0e|G\x13\x02\x95P\x00\xdbw\xd2\xc6\xd8\xfdY\xf0\xd6\xfe\x8dng&=\xfc\xa9\xc7k\xe0Nd\xefrv]\xafc\xe8TgfQ&\xa85\xf9\xf0	'	\x8b\\\xe0\xf4>\x87G\xc03:\xb8\xc4_\x14>\x9fu\xe4\xe1\xff\xedC\x92\xb6\x8a\x8a\xbb\xa0\x85q\x96T\xfb\xc8\\-\xc1|<c\xd2d\x95Y\xc0#\x19u\xcc\xb6?\xaa\x0f\x151\x1f&K\xfe\xd9\xceI\xf6m\x7f:\xfa\xe6n\xd7\x0e\x8d\x96\xc49:\x12\x19\x89\xd3X\x08\xfb\xa0\x04>F\x1bNo\x0d\xd5\xb9\x1d\xccN\x1c\xa3\x00\x85\xc4lc2]\"\x18s\x82%\x90c`\x90\x9e\n\xce\xe2T\xbb\xf3032\xd2\x05[\xb7\x1c\x19\xda\x0eFj\xe7\xa7q\x9c\xd5tR\xd3q\x82\xcd\x02\x9d%\x14\xf9\x8fJF(\x1b\x9c\xdf.-\xe5\xf0\xfd-Y\x0f\x86\xb1\x7f\x1b\x1f\xef\xc7\xd5\x11\xba\x9c.L\xe6\x02\x8a~\xf9-~\x1fI\xdd\xe9\x83z\x05\x05\xd0\xbf/\x9b\x9a\xd6\x7f\xb1\xc8-\xf3\xa3\xcf\x0e\x91 '\xf8\xc0\xd5}9\xd8X\xf5\xe1\xf59	I2\x10|\xb9\xdd\x8f\x0b\xa2\x8d\xd9\xd6/s*\x9cU\xda\xec\xbc(\xed\xe5\x1c\x0d%b\x00\x87\x9aPRg\xe7\xd0d8\x94x\xe6~\xa6\xc4\x1e\xb6^\xb8t4\xf83\xfa^\x1d\x0b\x03\x06\xd8qp\x84\x85\x013\x15\x8e\x83C\xe5a\x11\xc7EE7o\x90r\x1e\xde\xbeO\xef)\x8c\xd5\x89\x19i\xa3\x12\x01b\x16\xe6\x18r\x0f\x81\x9d\x16C	\x0c\x05\xe4up\xeb\x97\x14\xf7U\xbd\xfc\x8a\xaa-\xc6\xc3\xebI\xc7\x93M\xea\xb4$\xe4\x8d\xd4d\xd2\xc8B\xc4X^n\x9ebAF\xe6\x9f\x8f\x96~\x84\xab\xfd:\x9d\x9d%\x94\x12~\xe3\x07\x02\xfc\xd8\xf1\xcc#C\xbc\xfc\xd8\xdd\x96\xa6\xe7\x01\xb9\xcb\xa5\x81\x7f\xcf\x89\x16>\xf6\xa6\xac0\xc9\xdd\xa2'\xbc\xad\xcd$\xfe\x85-l\x0e\x7fX\xe4\x88\n:\xd2\xd0\xe08\x03L\xca\x04n\xffPA\xee\x9d\x91\xb9\x14\x97\xf7Ao\xee\x1c\xba\x10\x9d\xcc\x92\x1f\xd2\x0dt\xc1\xca\xc59\xe8\x96\xc9VM\x96\xab\x17\xda[\xe7\xf7\n\xa3\x1e\xc1\xbb\x88\xdf\xafGA=\x16N\x92\xca<\xf5\x85\x98\xdb\x9c\x91\xbd\xbdV\x82l\xc2\xfeW/\x02[\xdb\xe2~\x1f\xe0 \xd3\xf4\xf0\x85\xbc3\xcan\xda\x83\xee\xdaf\x8c\x0dq\x19e\xe9q)i0\"H\x9e\xae98\x1e\x07\x10\xe0\x03\x0f\xea\xd0\xe7\xad\xa6F\xd7\xabyEl\xbd\xa1\xf27JFu\xe6\x06\xe9\xcc\xbewN*x\xc3\x8c\xb3j\x8f&e\xfc\xebn\xbb\x7f\xe6\x94Z\x96\xfb\xa6J\xc7\xc6{\xb8\xd0\"\x110\xa1u\x7ft\x95B\xc1\x17J	7VZ\x08/Fo\xdf\x12\xd5\xe5\x17\xa7\x141X\xc8I\x9f\xa8h,\xc8\xb8Q\n\xb6\x99\xc2\xc7\x1dj\xc4\xaaxrBHH\xca\x91\xcc\xf5\xecK#\x86r1\xac\xd9d\x16E9\xf0\x10\x96\x83\xd1\x97\x80\xda\xaa2\x041\xca\xd0\xe2\xa6\xa4\x82\"\x88\xb7\xb6R\xd3\xc0\x18\x18\xe1k\xc7\xa5!,ZZr\x02\xb8~7\x7f\xb2^U\x83Z}l\xecT\xaa\xf4\xb8C\x8dD\x8eR\xeb?\xc7\xc7/\x9b!E\xc6\xf2[\x03^\xe2i\xb7 \x12t\xed\xc6\x06\xd9g\xeen//\xa9\xa8&\xcd#\"D\xb5\xb4o\x8c\x18\x1b\n\xfe\x8e#\x8e\x19x{`L\x0c]\xf6o<]\xb5|\xacbL\xce\xef\xb8\x13+,\xc4\x14\x99\xb6\xd7a\xf1\xc4\n\x91y\xcb\xa1\xa0r\xdf\xd4\xeb\xd9n\xcf\xfd\x9c\xe1\x9d\xb6?\xe1\x9493f\x04\xd8\xbc\xbc\xa1\xea\xca3v\xe4\xcc\x92\x1d\x18\x17\xab8\xa55\x83\xefH\xa8\xa9\x1e=\xd6`zb\xf1#\xd6&\xac'	w\x02\x89\xdc\x99\x9c\xa9\x06\x85a\x15\xde=\xc7\xb5X\xdcZ\xee\x91|\x9bk}w\x1e\x92\xae\xf6y4\xbeb0\x8b~u\xb4u\xfa3\x1f\x1f\x96\x98\x0b!\xf6\x92\xfd\xcf\x14^\xeeP\x0dAY\xd9(L\x85\x93\x1fO\xd7jI\x91O\x05\x88\x88?\x00\x8a\x0f\x8c\xf0\xcd\x8d\xb3\xd5\xbfY!]o\xa7\x7f\xd9?#9\xdc\xf7\x1d\xaa\xe1\xd57\xd4\xf7\x91N\xb3\xa6\x89\xdd\xda\xbal\x83\x97\x89j\x89\xc6\x89\x89\x0e\x94M( \x0f\x0c\xde&\xf3\xa4 !\xe4F\xe5ON\xb6\xb3\xeb\x1e\x1d|\xa7\xc1\xc1\x89\x84\xb3\xbb&\xc4'\x01\x98\x96f\x9e\x1e^\xa6\x1dw\xbe+\xb2rrP\xa0\x0d%\xcb\xce.+\x8b\x1c\xcdE\x18J\x10\xba\x11S\xedX\x83?d\x1a\x8f\xf2\xf2D?-\x91U5]\xf2z\x1a\xb6\x90\xad\xba\x14M\x9e\xe1o%]z\xbb\xc9\xf5#b\xf3\xfe.?4\x91T\xc1w\xcc\xfa\xd5z|]\x9d\xb5\x87\x15x\xb6\xd5\xd7\xe9s9\x8e\xdff\xd2\xc4\xc4\xb2YA\x8bTfl_n\xb0h\x1aI,\x16F0K\x01\xbarFDd\xd9\xde\xbb\xa2-\x15\xf5\x8e<\xc3\x087\xa2t\xe1\x86$DB\xe2\x17g\xf9\x97\xb2\xb2\x81\x81?\xee\xabw\xd7\xc5\x13w\xc1\x16c\xcc\x97_;\xe4\x92hY|\xaf^\x1ac/\xae\xcb\xd8\x1b\xe7no=Bu\x19\xeb\x80\x85\xc3N\x9c8\xae\xb6\xc5\xaf\xaf\xde\x03\xc1g\x93n\x19\x98\x16\xaf\xe6\x04;\x8a\x9a\xfd=\x84\xe8\xb1|\x0e\xe2U\x95\n\x1d@\xaf\xed\x9fw'\xeb\x97a\xffqx\x05\x83i\x11\x1500\x88}6\xf9\xe4!)6\xa4o\x81\x8dc\xf3\xfe\xa8\xcaU\xe3\xf1\xe5LH\xaad\x8a\x89\xb0D\x1d\x96-j\xf4\xcf\x1f7Z{\xe7\xb8\xbe\x1eK\x8b\xe3mC\x089\xe8\x8d\\\xd1\x96sA\xe2k\x89d\xfdr\xbdcD\xec)\xbat\xf5\xe2\xd6\xec\xe0\x88\"\xc7\x86\x1d\x9a\x14)\x12qw\xd6\xb89\x1bM\x13\xc2\x8de\xe3!$\x8dZ\x83P%D\xa8\xe3ZL\x96\xf9\xc9\x90\x83^\x19\xc3\x10\x85\x0fV\xe3\xa5\xad\x10]\x8f-\xa6x\xe8\xc8\xf8:\x13\x94\xa3\x1f8\xbf\x96\xd3\xf7\xf4tf*\xd8iX\xddL\xfb\xbe\xe3\xb4\xf9\x199\xab\x1f+\xd0\xc1\x9d\\w\x7f\xd8\x19\x18\xf0\xfey\xb8\xdaqi\xd3l\x1d'W(\x93t\xc9\xe1b\xe03\x13\xfc\xe7\x8eo\xac\xfchq\xb1)\xa2\xa2l\x9c\x17\xbf\x1a\x96\xac\xa5[:\xcd\x7f	\x10-p\x18\x19\xf1\xf6V\xa8^\xd6\xdd\xcaA2\xca3\xc7\x91\x93\x05\x9dV\xceHk\x00\xe9\xfc\xdf\xb9<~\xed\x94\x12-|\xef(\xe5 \xb5&\x9cm\xae/\x80\x0b\x16|}9\xafYe\x9a\x9d.n\xba0H\xab#B\x9f@10h\xa3\x10y\xd7\xf1\xa9\xa6\xd0\x8d\x96\xf5\xc9\xc3\x0f\x1a\xe5\xf9\xa9\xd7\x96\x9f\x0bXI\x01B\xd8B\xc3)\xda\xf9]\xcd\"Y\xd6g\x1a\xda\xeb\x06\xd4Nyk5\x01\xe8\xe6\xe6^#\xc1\x00\xbc\xfd2\xa2n\xb16\xd0\xd9g\x8f\x07b\xb5\xe8\xb2\x8c\xf1\xea\x95uzm[\x80\xe9 \x83IG\xf1{\xdc\x08v\xb2VI\xca\xda\xcf\xfb\xd5\xa1\xa0\xa9JQ^\xbd\xde\xe8,\xb3\x95\xe7/\xb1~]58;\xce\x0c\xcem\xcf\xe5\xb4\x0e\xbc\n\x9d\xeb\x94'\x7f\xc0v5h %\xfd\x1e`#\xd3c\n\x82\xca~\xac,\xe5 \xb5`l:\x90{\xc4z6\xd3\xab\x9a?60\x8c?q\xd1\x14\xfb\xe6\xea\xaa\xdb\x12Y\x17\xd0\xa2\xf4@!\xb2\xa3\xe3\x90\xb5vqx\xce\xaeO\xee\xc9\xde,\xc2\x12\xa3\xc0\x81\x88\xe8\xe5\xcb9.\x11#v\xe8\xc4\xc7\x9c\xe4\xe3\xa9\xce\xf6\xe5G\x90\xd5-\x83\xf0\xf0z\x8b\x05\x87\x1e\x9fRW\xe7\x11\x12!l\x99\x9b\xc3h\xab\xde\xd0\xa5V\x9bz\xb3\xe0`\x9f\xb8v\xd6'\x9cZ\xc9\xea\x91\xd5\xd5w\xc1\xc8\xbaj\xdf\xb3S-\xbcg\xb4@\x9a\xefAZ\x99\xa8\x98\xbe\x01(\x1c\x11t}E\x04\xa7d=\xbf\xbfn1\xb3\x88gb``g\xbb\xea\xa6\x91\x92\xf2\xb0\xec=TD\xb0\x7fp6~\x07\xea\x0d\xfd\xcb{\xdf\xc0f\\u\x92\xaa\xed\x92\x13!\x94E\x03\x03\xca\xeb\xaak;)\xdd0\xa2\xf7\xe1\x11\xb5\xbb\x9a\x0b6\x8fY,h}\x97\x9b\x1d(\x90\xfe\xfe\xcd\")n\\\xd5T\x19\x07A\x02\x94ll\x01W;\xfb.\x96ZK\xf8\xcdg6\x9aLg\xd6\x9d\xe7\x1d\x8d_vU\xe5?\xfc\xd0\xed\xe9\xb7y`\xc4\xad\xfc\xe7b\xb3\xe4dcg\x07\x049\x1e\xdc\xda\xe7\x87C\xa9\x99\x12$\xdb[5Y\xcb\x15h{\xa7\x9d\x1dg\xc0Y\x0d\xb6ZJ\xef\xae\x97Y\xaa\xc92\xacu\xcdx\xefGV\xbc|\xf0A7\x17}k\xb5\x0cg\xa9\xfc2\xcc\xa3\xa1\x9f\x16q\xe1\xe7\x9d\xe6\xf1_\x9b6\xe2on\x18\\\x1ds\x96\xed\x1a\x1e|;<\xfc\xc5\x9ddc\xda\xf1\xe6V\xca\x82\xe1\xed\xd6\xf2\xee\x91\xb9\xda\xc6\xdf?\n_sp^j\"3\xfc\xfd\xa6\xf2\xc5Z\x13r\xb6Q\xe1\xd9\xdd\xf7T\xden\xb0v>\x1d\xcb\x98\x8a\xe9\xa7\xaa$%Y\xb9\x8b\xc0\xad\xad\x9cgj\x9c]\x16>[O\xeaH2\x17\x10\x9f\x1dg\x02\x02\x80I'\xd7\x83&lR\x95b\x04\xa3\x13\xfdV\xdb\x97/\x1fq\xa1\xb1\x81\x07K-S\x97\xc6\xdc}\x98\xbd\xfb\xb7\xfeoV\xbe\xfb\x0c=17\xa8\xd3\xfbl\xcc\x85V\x0d>\xf7\x1d\x1a\xa2\xc3`\xf6W\x1ee\xd6\xd7v\xd0\x86\x99\x17\x9f\x9f\x11\x13\x83'G\xdf\xd5\x05s\x19X@\x12Q\xe7 \xe61\x0c\x92\x86}LZ\x02\xec\xdc_q\x83M\xc8Y\n\x10K\xc8\xcd\xba\xca\xd0\xe0]I\x95l\xf3A\x97,\xaf>\x94\xf8o\xaf\xc7\x82!\xafN\x87<\xc7\xf5\x1b\xd8\xbe\x8d'\xca\xbc\xfa\xfa\xd5\xb4\x1d\xdf\xd0\xd6\xc0 \x84e\x93\xeb\xdb\xce\x08\x1f\xa8<\x8c\x13\x06\x9e\\\xe0eO\x0b\xba\x9e_\xe0\x1b\xba\xbb\x9f\x8b\x90%A-\x9b\x86a\xc5\x82\x12d\xf5_\x8eH\xd4\xda\xc6\x91\xa7\x98\xbc\xb5\xf7\x12\xa1\xe7\x10\x1a9F\xd5\x98\x81 \x9e<\xe9\xc9\xa5oa\xd2\x17\xfe2$2\xaca\x9c\xf2\xe6\xbd\xb3\x0ef\xe2K`\x98\x1a\xe4~\x0bqF\x90\x86E\x18\x9e\x1c\x7f\xf5	\x92f\xda\x8aO@\x9bR\x001\xd8\xddD8\xe4I\x06\xed\xe8\x9ee5\x0b_\xdc\x9a	\xac\xfe\xd9\x9f\xf3D\xe5\xee$\xd8\xff\xe0\x84\"2 \xb1\xd7\x17}\x0b\x0baX&\x1d\x8c	v\xc9G\xe2\x7f\xf9\xf6\xea\xb3\xbb/\x0di\x8eHS_*J\xee\xc7:\xb3\xb8\x82P\xa8\x88r\x8a&\xb3\xec\x0f\x1ffVz:\xa6\xa1\"j\xdc^\xfc#\x0c\x7f\xf8\xcc\xf5x\x8f\xef\x12QI\xa5\x9b\xc0\x16\x18\x168%\xf7\xe7\xdb\xd6SE\x00\x8786\xd6VI\xd3A\xfc:\xe8\x97\x12Uj,\x88\xe5\xd6\xde\xa64\x8b\xefs\x06C\xa0\xdc\xf4\xf7\x98\xdc\\\x05!\xff\xed/9\xcey\"\xf5\x85\xfea\xc7\x15\x83?t\x91\x1b\xcd\xae\xe7g{\n\x15\xad-z\xfc\xd01\xb9\x85%>\xcc	\x02\x81\x93s\xbbw\xb52\x87\xf0\xcf\x91A\xebQ-R\xa3\xa1\xebV/\xc7\xcb\x0ce\x94)(\xa20\x932e\x026\x17\x8d\x90\xe4\xa6K\xb7\x0f\xca}E\xc5\xffi \xec\x05h\xe3\x86\xcf\xa6h\xc5PD6\xc9\xfd\x91@\x8dI\x92N\xac4\x1e\xb6(\x11\xe6\xcdN\xfe\xf4\x94;\xd1\xc5\xc8\n\x84__\x8dE\xa5%\xf1T\\y`\x13Q\x8c\x1a\x03\xb9$8\xed^\xd1iUV\xa5\x9e\x17>\x85\x0d\x80\x85\x85\xd8r\xd7\x83\xef\xcfG\xf3\x1b5-\xec\x0dG\xef\xdd\x19\x16\x1a\xb7p\xe8\xe0\xf7w\xcd\xe1\xaa\xd5\xa0\xd4T\xe6\x84\xe8\x17\x94\x99o\xff2K\xd6'\x9c!\xa7\x95\x93\xe5\xc0\x03\xc5\xc2B\xcbr_o^B\xb9\xfc>@f\xb9=\xd0w	\x07\xba\xfd\xa7_\xa4k}\xf5\xe9\xd2\xf8\xca\xad\xa9Az\xed\"/\xe4 $\xc4\xe1\x11\x0c)<&V\xa3\xdf]\xe4\x86\x1f\xf8\x1b\xdew\x8c\xc4\xf0\xfd\x02n\xe9\x08\x0e\xd2K\x9elm\xa1\xea\xfe\xa6\xcc\x86\x97\xb1\x8d(vv~\x1a\xd2\xa6B0\\\xa8\xc4\xce\xae\xac\\\xd3!%\xb2Y\xf6\"\xc6R}\xefb\xd7\x8e\x81^\x02'\xefM]G\x9eg\xafu\xb8	^L\xb7\x835\xf9\xcb\x19qW\xb3\xfap\xc1\x9e&t\xf5H\xf6\x9fx\xdf\x1a\xe3\xda\xcf\xf1\xa3y\xcc\n\x7f\xf9\xa6\xfd\x1f\xbb\x1c,\xf9\xd2\x9d\xb2o\xe8\x99\xa5\x14\xc7\x81\xe3\xa1\xc5A\xe1\xb1\xcff=\x92\x87\xcb\xe7i)\x1f\x17\xa3\xbb\xf7fUu\x99\x0fn\xcf\x0f\x9a0\x80\x9d\x96j\xe9\xf6J\x87\x0fQ\x1b}6\x0f\xd9\xdf\x93\x12\xd0da\x11\x0c\x8f\x05s\xb6\xe6,\xe9F9r9\xe7\x07\x975g:\"\x1b\x9c\xbb\n\xc8\xa9U\x1a\xba\xfc\x8d\xe6\xa2\xa9b\xfd\xdbH\xe4f\xe7_\xa3\xe4r\x96\xa9\x14hY\x12\xfa\xf6\xacO\xd9\xf2f\xde\x84\x8c\xfb\xec\xdc\x8f6^_/\x926c\x82\xb3\xb0\x811M\xbc\xa12\xadP\xf8\xdf\xdc\x1e\x7fw\xf7\xc7 X\xb8\xfb\xfd\xad\xb4\xcfj\xae\xc6\x07mX\x16j\xcc\xb4\x99p\xd6[\xfe8X\xee\xf8\xb6\xcb\xc0\x8c\x8e\x9e\xcd\xa3\x03=\xe7\xc2\x7fS\xf9O\x8a\x98\xad}\x8c\x17\xc3v\xbe\xda\xd6\xd4T\x9b(\x98p\x9e\x17\xd8\xe8\x84\xa50<!MY\xf4\xcb\xef\xcd\xbajH\xae\xbf\\\xc2\xe5\xdd\xaa\xe7\x8a\xbb\xda\xe6\x91Z\x88\x89^\x8f\xe8\x02\x919^\x0c[\xe3Z[]\xdd\x97\x14\xc1\xa5\xd9:\xef\x91W\xe5\xbe\x9e\xb0\x87\xc2c\xb7c\xa7\x18{{\x7f$\xc8&\xbe\xc6Gip\xe5g\x98\xba_\xe3\x84<\xd7)|\xaf\x82X\x1c?\xfd\xea\xc8\xc0@7\xe9\xe7\xac\xa5\xd2\xecy\xcdJ\xcf\xc4\x90\x00RTf\x83\xc1<YR\xb7)3\xf2\xefM\x98\xe3r\x8a\n\x14\xb6\x7f\xee\xe9\x06\x87YI\x163\xf8~q\x99\xc5\x85\xfa\xc92\xe3$#\x15\xbd|FQ\x1fE\xd0j\xfa\xd1\xf8\x13\x9d\xed\xcct>\xba\x16r\xa7\x9f\xb2\xe8\xa7\xd8K\x9e\xab\xeffNk\xb3dB\xd0\x01v+\xba\x98eU|\xd4\x9eB\x9f\x16fW\x8f\xc1\xc8\xe5\xf6f\x11u\xbdBu\xa2\xac\xe9YCSY\xe7\xd6D\x87\xdd\x92\x9a=L\x89\x85\xb1\x11\x1eh_BB\x9cM\xf0\xc2\xe4My\xc2K}w\xe2~X\xd2\x07\xda\xb0\xf5\x19\x04\xef[q'\x93\xe6\xc1fSu\xf3\x9c\xb9N\xf9J\xa4I\x13iB2F\xa3*\x07G8\x08\xcc\x1cl\x98e\xcdK\x19=7\xf1\xe5\xea\xaa\x82\x07.\x98o0Z\xd5\x0fy\x18\x1e\xa1\xa6fw\x7f\xb7\x17\xaf\x1e\x1fK2W_\xfe1\xd2}\xab\xa3\xe6\xf90	\xdd\x80\x9b\xbe\x81\xb9N\xd2\xe5\x14\x16\x11I\\\xfc\xf0\xdd\xad1&'3\x0d\x98\xb5eYRp\xe7\x9b\xe6\xa8l\xe0\x8b\xcd\xa2\xe7\x10J\xad\x9aJOE\x0b\xf5X\x15\x98\xa0\x02qC\xab(\x8dg\x0c\xd2\xa4UL\xf01&.X\xc8H\xca\x9e\xc4s\xa5\xf6\x01\x85\x19\n_\xc5\xe5\x01o\x08(\xb0\xc4\xa97#\xab\x8e\xf5\xdb\xaf_\x17\x17\x84\xd7-\x97$%\xa1/\xb6\x1d^\x1f\xf9\xd3a\xb4\x99\x8dNM\xa8\x17\x1ar{\xbc\x80\xc0\xb0\xb0\xba\xe0\x14h.r\xe1\xaf\xf2+l\xd0\xc2Da\xd3\x1fS/\xcdX\xf4\xc2\xbc\xf0\xbf\x7fX\x95\xe9d\x1d\x03\x85\x92\xb1QggdX\xca\x8b\xdfw5{\xfei\xa2\x08\xf3\x8ez_/\\G\x15m\xa2\x80;\xf9\x85\xf1\xf0\x86#\xebRl\x87:t\x07\x9d\x9b\x931\x86Q\x96e\xad\xdb4\x8boT\xd8OL[\x0f\xe1\x92\xd3P\xde\x98\x88\xba\xa8\n	\x8eAB\xab\xc9Y\xf1U\xce\xd6g\xfb|U\xe5\xa3\x8c1\xcb\xea\xba\xa3<\x98\x14f\xba~\x92i\x14\x1a\xd2+\xc7J&\x0d\xa5\x94m7\xde]\xf2\x19\x1buv\x1d\xf8v\xcf\xf5\xa2\xa7D\xa8\xa4\x1eQ>}\xe7i\xea\xd9=\x99\x8eX_\x7f\xfb\x96\xee\xcc\xf3\x98%%=PF\xbb\x0d\xba$\x9b\x1cOy\xf6\x88\xb2\xf9}m\xd1\x86\xf0Tq	\xc3\xe8\xf1\xd1\xcd\x1d\xdd\xdd\xcd\xb6<7\xc4\xabwr\x91\xff0;'%\xf5\x9b\xf6\xee\xe3\xc2~3\xc8P]$n1\xbb6.!\xb4^\xe3\x92\x0f<9\xfb\xebXPl/(\xbb9=\x02\x97P\xc2W{^\x99\x9b2\xfa\xf2?\x0c\x16xn\x1b\"\x9b\xfc%}\xb3\xad\x80\x15\xaat;\xc2\x07\xde8\x1fz2\x7f\x15\x90?\x13e2\x8c\xae!\x91~\xedV\xfa\xdc\x9a=mjnn%\xfd\xd2\x9bn\x8c\x04\xfb-P\x80\x16\xb6\xd1\xb1\x1b\x11\xaf1M\xa7qcC\xb5\x84\x84<T\x04'*\xe5\xa5\xfc\xe4\xa6lT\x8b'#+B\x07\x84p\x1fWz6\x14I\xc8\xdf\x8b\xad\xe4\xef1\xa0\xa7\xb7\xb6$\xdd\x97\xa8\x88\x87\xe8f\xe4\xef\x9b\xa3\xad)\xa3\x9bk!;qOE\x04%w\xe0|\xf2\x1b\xbc\xfa\xfd\x9cC\x99\x99/\x15\x1b\\\x88\xb0\x85;\x97\x1c\xee.\xb3\x83\xda\x15\x01\x8f\x17\xd1\x1f\xe8\xc3\xd6g\x92\x92b\x1c\xf2\xa4[\x13\x05\xd9>\xa9j\x97\xb4\x8d\x9c\xdb]\xf7\xb6x\xff\x10+,\x9bG\xf7\x7f\xfd*\xcb\xb9\xb5\x95\xbe\xf5\xf1U\x98\xa2`\x9c\xec\xe0\x143\xfb\x07\x07Z\x02\xec\x9b\x8e\x1d\x8d\x94\xfa[\xc6\xfc[\xd0\xe6}\x07\xba\x90\xea\xbb65\xf2v\xac\xc6\xdb\x1b\x0fDH\xf80\x99\xe7\x83\x0e\xc47\x92\x93\x93\x93+J\xf3\xf8\xdb\x1f\x02\xe7\xd1=\xa5\xc9\xdf\xbf++Pw\xcd\x92\xf5\x10\x12\xd9\xcd\xcf=\x8b\x86/\xce\xc2\x83O+gjZ-\xebJ\x14\xd7\x1e\xda\x1e\xfdJ:F\x01E\xcaB;\x91\x9e\xc9\xef\x8f\xaf\xae\x06\x07\xfdo\x16M\xe4km\xe3V\xc6hag\xb4d\xe5~\x1bq\xea\xefq/\xf6}\xaf\xafS\x11\xef\xac\x9a-\xb3h\xf8MZU\xad\xa3\xaa]\xb8\\b\x9cv\x05\x1f\xe5\xd0Pm\xa1\xc6\x98\x02\x86\xaf\xf3~\xf5\xb0|\xfa\xe8\xd5c\xfd\xfd\xfe\xd4\xc9\xb7\xb1\x16\x8e\x81\xa3\xaa\x9a2\xce\xed\xc1\xf4\xe0\xea\n\x1b'jxs\x80b\x8f&g\x99\xa8\x00\x8d\x16\x87Xk\x0e\xb6ql$\xa9E\xc7\xe4j^\xcd\xcf\x9au\xe5_/~uT\x04P\xa3q	\xbc\x85:\x9b\xf4uB\xe9\xdc\x82\xfe\xbfV\x07(\x00Z\xc9\xf9\n;.o\xd1\xaa\xc2\xc2\x8a\xd7w!\xae\xcb`\xf6\xca\x13\xda\xeb~\xb5%\xb2\x81\xd8\x84\xa1\xc5\xe1\x8dk\xfe\x06\x02\x02	\x1f''M\xbc8\xb6\x052+7(\xf9\x83'\x9a\x1d\x88\xfb\xfe\x05\xc0\xf0\x87\x88\xa9\xc5w1\xa6\xe6~\xc1G\xc7\xb7\x81\x04i\xd1+7Z\x92\x83_+\x063\xab\xd1\xd9Q\xcfp\xc1\x18w\xbb\x93\x06\xd5\xb8\x04\x12\xc12\xf4/\x15\x8b\xfb\xc5\xde\xd3\xd2\xd22s>\xd1\xbc[\xa7QK\xe8\x16\xed\xee&\xc5\xbd\xb8\x9d,~M3\xf8\x16\xab\x17j\xfd\xf0\x18\x15bf\x80\nW\xef\xe5\xa4n\x84@W\x93\xf3\xdbf\xf91\x0f\x93\xf3\xdf\xf7<\xb1\xa7t\xe9\xab\x93\x92\n\x08,\x7f\xbf\x85\x0b\xd8f\x8d\x0e\x9ff\xd0\xf5\xd4;/\xb4x\x03\xc8f\xc6\xf0&(5\x1f\xd9\xf9\xa2\x9ea?-,\xa1\xad\xb5iVKNg\xa0\xe4g\x8a@Hs\xb1\x12\xeej\xc8M\xc2\xa8\xf6\x83HM\xbdS\xb1G\x8bp\xd3\xd0\xc0\xdf/\x02\xa5u\x84.\x0f\xee\x83\x7fS\x02\xb6Fi1\x83P\x91*\x04\xa2\x05\x7f\xb5\xad\xac\xac\xed\xcd\x9f0\x1eM\xb9\xa5\xef\xae\xca\xa3:lW\xb7+\xd2!\xcbB\xa1\x85\x96sO7\xc5\x1a\\\x87H\x84\x80\xfb[O\xa28\x13\x0c\x1e^Yf\xd1\x10\x8d\xa4G\x91V\x88(\xbc\"\xeb#\x83N\xa9 |R6Jzz\x9f\x00\x83\xc6\xee\xd5c\x7fB\xe4\xc6R\xab\xefL\x10\x00cN\x90\xf9\n\x89\xe7`\xf1(yL\x05\x0b\xb9S6e\x9a\xc9	\xf6\xb7E\xcd\xfeAp\x83\xfcNt\x1e\xbd\x97\xbf\x9e\x1bO\x94R|\xf4#\x0e\xb2\xc3\x95\x95\xf3fk\xeb\x1d\xce\"/D\xac\x05\x01\xeb\x0b\x8f{JC\x9d\xcd\xa1\x06\x15?\xde\xad_ZR$m?T\x0c	\x11{\xf3\x86\xc5\xaf\xe0c\xa4\xe0j\x14\x97\xb5\xf6\xa7\xa6Y\xe8\x0b\xebbC\xc8\xffA2;\xddM\x97Eh\xf4\xb4\xa8\x18\xb8\xa5\xc3fh\xfe\xeb\xa7\xc0\xfbr\x13\x8b\xdd}\x14W^\xd3\x9b\x7fJ|\xd2\x84\x13)}g\xbe0p1\x1e\xc1\xc3C\x87!w51\xf8d\xb4<y^\xfb\xe0\xd9\x9a\xfe&W\x7f\x1d\xbf\xd9\xee.\xe6\x87\x81\x7f\x16\x15\xe0\xbb\x08\x1e\xc1B\xbdL--\x85\xf7y\xae\xec\xef-\x84\x86\x99)\x8d\xa9\x0cb\xf5rR\xd7C\xe0\xbf6X\\]i\xa8\xdd.=d\xf2\xb0\xa4\x8e\x89\x89N\xe5Xp/\x92\xe5\xab\xaa\xb6\xe8\xddfL\xb1\xb0>\xbdY\xa3\x9a\x8b#\xc5\xc0PRR\x1e\xd8\x9c`wF\x81\x8a\x92&\x10\x1f'[\xb3\xe4}\x06:\xff\x8b\x00\x81\xf0B\xf4\xa7\x90\x90r-\x0b\xa7\x96V..P\xd1\xfd\x91\xe15\xd6\x10\x18\xbbG\xbb\xc1\x9e/\xef\x1f \x85\xf71WV\xd82\x1a\xd6s\n<\xc3\x1b=z\x1c\xb9\xb66\xa1\xc36)\xe3y\xf5\x83\xe3\x7f\x12\xc8W7\xda\x9b\xe0\x1a\x9aC\xbf\xf3\xdd\xe6cm8\xc2b\xfc\xe0\xd9\xca\xb5\xc4\x1e\x186\xb9\x90\xad\x0e(_\x9f}m4N\xbb\xbd\xafO\x9f\xd6\x9f\xfb}\xda\xf7w\x98g\x90\xe7\x98\xbc\xfb\x0b\x95S\xdfz\\\nYd\xce\xd4\x9c\x9fZ\xa6]\x86\xedG d:\xc7p\xfcdH\xf6\x1d\x9cS\xbb\xd9\x98\xf2\x7f\xb4\xb9\x94a\xf6\xf6\xc6\xdb3\xff\xc3\xee\xe0\xab\xab:c\xfb\xdd\x1d\x02\x92}#\xc4[\xf7\xd5	5^\x1eZ\x8c\xe9\x1a\x83\xb7\xc9Z\xb8\x82\"\"RO\xac\x8d\x9c\x9d\x0dj\xc0isSS+8\x97x\xee\x01\x9eO\xe1\x08\x85\xbc\x95\xbfEef[\x84o\xc9\x86\xf8\xd0v\xaf,\x9cJjIe;\x0e\xacZm@K\xb2\xc9F|\"\xe20i\xf0\xdd\xfe\xc1\xa0P\xa9\x1asR\x87T\x1d\xbd.^\x82\xe7,\xe7\\{\xfb\xbbwp\x87\x06\xf3_\xbf\x80\xf7\xbfH\xc2z\xb4\x0f\xf8\xbc%\xf8 \x07\xbb=\x8fB\x11x\x11\xa5zK\xc9!\x0f\x02\xa3\xfeQ\x9d\xed\x15\xdaz\x1dcv\xbb\xe0\x90\xc3\xf3\xc1!I\x9c\xb3\xfd\xd4\xa5\x9d\xae\x82\xb2G]\x1a\\\x03%\x0e\xe4s\xd4\xc9es\xcb\xcb\xeeD\xac\xd1\xaa0\xf7\xf2\xf3\xb4\x00	\x01>>\x1e0\xe1\xc6r`\xa3\x0b\x16\xed\xf1e\x9b\x19j\xd9<\x9e VK\xe8\xd1\xd3\xcb\xfbmW)\xe90 \xf8fc\xf1l\xfd\xd4\xcf\x9ak&\xe8\x9c\x8aZ\x08\xf8\x07D7\x90L\xbe\xfd\x8b\xf1\xceF\xbfO\xe4\xea\x89\xa4\xe9\x1c\x8d\xd2\x05\xcc;]\xce\xaf\x00\xb8\xc3+#7\xe76C\x11~gJ\xc2g\x8e\xe8\xde8\xa0\xc0\xc1%\xe3l\x9f>\xb49\xf3\x7fL\xd2\xc1\x01j\xf3,x\xd8\xfb?\xa2\xf0\xf2yZ\x9a\xbb\xb3\xd4\x8fG|/\xb1\xe0\xe2\x8a\x94	\xcf\xb4\xc9\x8d\xf4\xd5\xd4\x9e\x91Xd\x1a\xf0\xfb\xfa\x82\xde#>Rp\xcefF\x84\n\"\xa1\x0d.\xc6\xad\xd4\xe3_D\x1d\xe9\xe9\x17\xcf\xef\xdc\xaa\x90\xb3\xb3r\x02\xe6\x17o\x9d\xcc\x86\xcfJ\x0e\x15\xd7r\x04Ik\x9d\x1d\xa1H\x89\xc3\xf1\x9e\x1f\xefo\xbbpl\xb0<t\x92\x9c\xa6\xdc\x02\xf2w\xde\xda{\x08%\xb1\x92\x17UVv\x9a\x8f\x82~\xa31\xde\x8b\x92\xd23\xbf\xddP\xd5\xcc\x0cG\xb4\xc9\xe4\x93@c\xa5\x0f\x16\xbf\xe0\xad\xb6\xb5\xb5\xcd\xea\x18\xdfI\x9e\xa1}B\x11Q\xaa6k\xcb\x06\xf7g\x01\xe5\xb5\xf0ts:\xfa\xd1\xb7\xce\xe5DZ\xc9\xdd\x93\xf3Y,\xd6\x84~\x17kT\xdfc1Z}\x9f\xae\x0d\x8c\x8dU/\x12'\x0d\xa7j\x12\x0cx?zXT\xf3\x8c\xd3\\X\xc6s\xcefvi\xe4?\x1c]\xa1\x05\x7fCWk\x1awxdw\x85%\x8a\x94,h\x8b7\x97I\x11HR\x17\xa3\xb4-g\x91^wp\xc0\xc3\xa4\xcd\x1f\xfcS\xbbS\x13\x17'\x97\x9f\xdc\xad$\"\xc7\x9c@1\xd7mi$\xbbT8\x9e\x92H\x08\x86\xb3&\x85\x1d\xf4\xfd\x84\xd1\xb6\x10\xd3\xf6\xc631\x88\xcd\xea\xa7\xee\xd3\xa4d\x0f\xbc\nE \\\x0b\xf3\x82[\xd0-\xa4\xc8\xb1\xfb\x15\xb7\xf7\x98\xd6\xb39\xde\xf7\x8c;\xe5\x9f\x06PX\xa0V\x1f*jA\xe2\xddy\x8e\xb0\xf2R\x0eR\x0b\xa7yz\xef?\xbc\xb33\xc85\xb7\x87`\xdc\x89Ia(`\xa8\xef;\x83\x843RR\x8f\x14}\xb8\x8a\x15\xf4\xfe1yr\x15^\xc1\xa30\xd3\xe8l\xdc:}i\\P\xfa\xe1\x14\xec)\xdc`\xb3m\xd0\x19\xd0\x9e\x8c\xe9\xb6\xa3\xfb\x86-\"\xcfl\xbe6[\xd6\xf8\x15\xf8q/<Wz\xab\xadcZ_r\xfe\xcd\xde+\xa3\x19\xd8\x8a\x0b\x99J\xb1\x10\xf9\xce\x01I\x04\xe8\xc1\xd5uYGx\xb7%\x9c;I\x9d37X\x7f?\x02\xf1\xf6\xad\x02\x00\x8du^\x98\xae\xc1\x91\xa8\xf9\x9cR\xf6\xa5\xf9\xf1(^l\x9c\xcd\x80\xe3\x01\x8d9#\xe3C\xf0\xddZ\xc8\xf9	\xae\xa0l\xbf\x16\x02\xfa\xe4i\xe4\x07\x8d\xd5\xf8i?'{\xdcY\x04\xcc\xcb\xc9\x8dt\x16\xc1N\xcd@\x0f\x8e\x8e\xe1\x1b\x8aZC\xcfE\x04\x9d\xcc}q8}\xad0\x99\x95\x8d\xfe\x0d\x7f\xe1+\xd8\x1a\x96C\x95\x91\x1d\xa5\\r\xbc\xdc\xd895\xc3\x05Y\xfd\xceBLL\nJN\xb622	)'\xb8fG\xbc\x9e\xda\xb4\x16\xc1_tW\x83\x88I\xe0~ix\xb26\xf7\xe5\x11s\x822\x90\x10ao\xa4\xbd\xfdPkR\xa2\x00=2\xf2\xd5\x1b^Vc\xde\xe9\xc1\x81\xcc\x98A\xff\xd9\xcc+X\x1c\xae#\xcb\xc8\xa6cLi\xcev\x0d\xaa\xacy\x86\xbbj~\xff\x12?~o\xa7R\x9dH\x8e%\xc9\x06\x94-\xdeg\x95\x84;>O\x9f\x10\xd1\x83\x1c\xcd\xdd\xd8X\x83\x8e\x8f'\xaa\xc7&H\xb7\x0f\xbd\xe8\xa6\xb3\x83\xf7\x1a\x88\xceL\x83Z,	\xa2W\xf2G\x9aS\xbe\xd79am\xdb\xee.\x7ft\x0d\xd6\xc3\x1f\x1d\x0b\xa6'N\x06\x86mtWQ\x94\x0d\xc8\xc5\x90\x10\x91\xf9\x15\x92\xdde\xa6\xb8pE\x05\xcf\xfa\xd8H\x13\x92\x81}!-G\xa7\xcf\x9f@^\xb0\x1e\xecG\xdc\xa1p\x8avy\x17%\xbca\xc8l\xa3\x9e\xfc\xcd\xdaj\x01)\xf2\xbcC\x1c\xaa\x80\x19\x1b\xdf\xe2\xf0Qy_\xd7(S\xb3w\x03\xd9~\x1e\x9f@B\x08\x90\xc8@\xc5\xa8\xf1A||\x14\x14I\x91\xefh\xf6\xac\x7f\xb5`\xdb\x07L\x0ef\xbdR\x98\x99\xa9vv\xfaB\xb0\x9aR\xb0\x18K\x9e\xe1O\x9e@\x00\"\xbd\xdd\xe4\x12r\xb8	\xb9\x1fSP\x8f\\D\x83}\xa5\xa7\xa6t\xfd\x98\xfd\xad\xbd\xbe4\xd2\xfcW]\xe5\xe6}\xca\xc9\xc5\xa9\xdb\x99'+\xf4r\xc6\xa0\xc6\xc1\x1eS\xc4ykp\xfe\x150\xee-~r\xd5\xc4\xc4\x01\x8d\xf9v\xc6\x1c|<\xa2\xfe\x1e\xd7\xef\x8fJf\x8f\xe4\xd1\xd9/\xda\xa8\x1d\xba\xf9\xe8\x93\xee\xe6\x1c\x9f\xaf\x9ev\x10jb\x8c\x03\xff\xb1~\xf5g$n\xe7KBG\x13\xa7\xfe\x1d\x9d\x1afknf::&`\xfb\xab\x8a\x97\xde\xde\xf0\xb2\xa9\x9f)\xe9\x18\xcd\x01C\xc9.)\xc8\x92\x8c<\xe6\xcc!\xff\x9dR7C\xdc\xd5\xd2tl\x1c\x0d\xa00Q\x1a\xa5\xb2\xc5\xc3\xabo\xa8\xcf\x06j\xbb^*.\x96\x95E\xba\xde\xa6\xdd\xbc\xfa\xa7T\xeb\xa9\xd1\x0b\x148hi\x89~\xd53w|\xb1\xffp\x8a;TC\xb8\x8c\x8e8!\x96\x94IjvYN\x02h;\xd1\x9b$\xc4\x84+E\x1c\x1d=\x19\x02&_t\xd2\x08\x87\xee\xc9\xf8\xad\x04\xc9\x99\xb3\xe1\xbd\xb9`'\"\x92\xcb7\xed\xfa|\x87\xf5#\x8c<\x83\x92\x9d\xd78\xaa,\xf7\x87\xadX\x043\xc3\x86\xc9L\xa8R\xc4\xdb\xae:\xa1U\x9aN\\l^\x1exO\xc4\xf1\xd1\x93')\x87\xd6\xd1#\xd9\xef\x1e\xf7\xc2I\x82k?\xef\xf6\xf2{o\xc9d\xd0\xa7 !\xf4\x81\xcc\xf1\"\"B#\xc7\xa8j3\xd3\x80\x9a\x91\xc3\xda2\x7f\x19\xe4\xff,\x81\xb1\xbc\xd3\x00\x02\x02\xd0\x83\x94\xc3\x12\x8aV\x03\xf4\xdfa\xe3z\xb2\xa1\xf3\xb6^\"\x05	!\x9dkSK\xd1\xcc\x93\x99X\xcek\xden\x0f\x05\xf5\x1c^\x96\xc5\xeb\x97\"\xbb\xde\x85*\x18\xd1\xb9\xdd\xf8\x99-GS)\x08\x0d\xd0\x9a\x1f\xb1Z|\x1f\x99}{|\xb1!\xfa\xd5\xa4>[)u|\x98\x86\x03\xea\xc9\xfa\x8f\x03$9\xe4\xc9\xcc\x05w\x05\xd5\x87qq\xd2N\x8a\xac\xce\n>\x12qm\xe7\x08C\x8d\x17*\x14|\xa1\xc4\x8d\xf06m\x01\xf1\xea>\x1eR\x9d\xf3\xbeS\xd3\xdbx\xfd\x17\xa1\xa7\xae\x0e\xc7\x93gd\xb3\xd23\xc4|\x8f\x1c\xde\xdc\xd1\xdb\xdb\x83\xdb\x98v.\xaf$|\xce\x8e\x80\xb1\x94\xce\xcf\xb7-\xbf}\xbb\xb38:Q\x1c\xaa\xe0.\xe8v\xd7&\xe3\xf1\xc9dl\xdd\x02\xf9\xb3)\xeb?\x8eMO\x16\xfdhh\x8bT\x8d\x01Si\x99\x0eX8\xf5\xa9\xe0\xffs\x93TG\xa9\x1b\x94\xf9\x19X\xe6\x92\xc6\xe6\xea\x83C,\x1f\xb8mF\xae\x98\x9b@\xb0>\x96`6\xf9M\xa0G\xc6\xfa\xea|\x92\x8cq\xafRzV\x0f\xee\x1f\xfbKn a	/\x0d*\xe7\xb6\x87\x0c-v\x8b\x9f	]\x9e\xad\xd5\xdb\x81\x8a\xfa\xfbg3&[=H\xce&~\xff\x9ahk[\xb7Z\xdd\xeb\xe9\xb2p\xc0s\xdbTg\xad)\xc1\xa4\x8d\x87\xef\x84O\xb6z	\x8c\x8c\x04\xb3`\x1cf\x8e\x89\x88\x08\x11;\xcd\xd6\xca\xe0\xfc\xe1c\x0fE@\x86\xd8\x84F\xea\xed\x04\xcc\xff\xeb\xc5!v5\x8b\xed\xd4Z~z\xea\xeb\xea\x1a\xe2\xbaU\xb5\x94\xb5-\x8a)\x80%\x04x>L\xeb\xd5\x15M\xe5\x8d_\x9e-\xc0`\xca\xc8P\x0cv2r\xe4Z\x0fL\x07\x9c\xa8\x0ey\xea\x19n\\\x8bv]>_\xf0\x80\x8c\x94\xcb\xbd4\x1e\x92\\=\xf7\xec`d\xcb\xce\xa1\xc9\xd8Q\xdf\xaaR\xe2z\x06(O\xfc\xf1\xa3\x0f\xd0\xaf\xc3q\xf0\xb3NG:\xbervV\xf9\xfe%\x11\xe2\x9d7\xfa\xed\xfd\xee\\]\x98\xdd\xfd\xdd\x9a%9R`\x85\x91\xee\x01wOM\xd6\xeb\x1a\x1c\xa0\xe3\x7f\xd6\xa1G\xeb\xb8\x80\x1dVo\x1d\xbf\xf9\xfeo\xb6'\x9c	L\xb2!\xc4\x86.\xb6?b\xfde\xc2Anf\xa6\xa6\xfa\xf8\xf6\xafLMm\xa6\xe2\x14\xbb\x9b?\xef\xf5\x13*\xaaGtM\x96\xf7\xf5E\xc1g\xccc\x08)@5\xed\x9c\xb2)\x11\xe1\xe9\x06\xbca\xb2T\xeb\x14\xf9\x1f\xab\xb7\xe9\x0f\xd7H\x0c^l\x16\x8do&\"3\xca\x1c\xc89\x92#H\xf2ey]C\xae\xaer\x96\xf5\xb0YG%qD\xaa\xf4U\x1f\x06L\x0e\xce\xcf\x7f*k\xb7B\xeei\x054|\xddIb\x17Z\xf7\xdf)=d%;\xece\xa8\x8b\xf2j\xa6\x85\xb7\xa3M=|.\xed\xe4\x8d\xcd\xc7$\x13\xd3\xd3Mav\xb6\xech\xacp{/\xaf79\xdcI\x12^\x87\xdf\xf0\xe0\xf6\xd6\"\xbc\xb1\xd1\xd4x0\xac\xcbP\x19\x85I\xfa\xf2\xac\x13\xfd\xea\x14\xad\x80f\x9b.	1N\x94E\xfb\xdd\xd5!\x94\xecp\x9d\x04\x1bG\x1d\xd8\xa3\x81\xfd\x81\x04\xb7\x8eF\xc9\xce\x1d\x7f*\xd7\xaf^\xa7\x1f_\xdc=o\xbd\xa4\xf9fb]\x85\xc4\x9dX\x90\x86*\xa4\xa83Jy\x1d=L\x0c\xac\x8f\x10\xe5\xaf\xa2a\xa0\xaft,	\x82\xc7jF\xc7l\x15$m\x0d4{\"h\xc9&\x12\x82\x1f\x06\xf9\x9b\xf9\xdb\xd8Gr\x9f\x7f\x18<K\x89\xf8\x7f\xa6\xb7\xb5\x1d\x96\xfb4^l\x8e\x8af\x8a&\xa3\xdc\x9bmB\x80\xf3\xd9\xb9\xd1\x18\x9f\xb6\x03zZ\xa3\\\xa4\xd3\\\xb2td\xf4\xb0V\xc4\xf3\xbe\x8b\x04\x1b\xdbc\xc2\x7f\x9c\xb9\xe4|3\xf9\xf4\xd4\x04c\xf9\xbc\xe3\xc3\x07'\xf2\xac\xcfd\xb4C\xaf]\x0f~\xb1>\xe1\xd4\xcei\x1a\x17\xfb\xeaY.\xfe\x15\x93\xf6\xd8\xbf\xddLaR_tf\xd9\xa2=\x83\x8f\xfd\xf1R\xf4\x9e\xcc\xc5\x06\xcb\x10.\xca\xfc\x8e\x83r0,s\xdbg\xeb\xb0\xa3\xcf\xf1u\xdd\xa3G\xbb\x01\xa1\x82#\xc9\\\x0b\x0b6\xa5{S\x95\xda\x07\xb6\x7ff\xdf\x16\xa1\x19\x8c\xf5\xabZ&\x02\x92\x8e\x9d\xfc\x8a\xady\xcb\x1e%\xf77\xac\xbe\xd9`\xf0p\xd2\x92\xc8Yj\xd4\xd7\xb5\x80\x07][\xc7\xbc\x95%\x1d\xdb	Z\x8au\xc4\xe2\xcc\x94\xf0\xd9\xbd\xf1g{j\xd26;\xb7s\xe2B\xfdNN\x94\xc4n\xe9\xaa,U\\\x95{phJc\x17\x17\x15Fsu\x1d\x05\xa7\xc9;8\xa0\xa0&\xcd\xef\x1f\xbd4d\xb9\x80\x9f .O\xca\xd1\xbf\x85\x8c|\xa0\xe6&\\\xd0\xba\x1fi\xfd\"c9\xcd^\x03;K\x10\xe0\xc3Rb\x96\x9c(\x89\xdaJ\x13\xa2\xd9h\x94z\xb4t!\xd7\x04f\xaf\xae\x9dpQ\x1e\xf2\x1bM\x05\xbe\x98H\xc0\xeeyX\xf6\xdb\xa7\xee\x97\xf9\x1e\xa2\xaf\xa2B\x02G\xee\x0cQ\xc1#\xae\xf2\xad\x9a,c\xa7\xbb\x89]m4\xcf;@\xfc8\x02\xd1\xfd\xf6\x86\xd5\x82\xb6JZ\xbc\x01\xc8IO\x81\xa0\x8c\xf3\x9c\xca\xdbe\x99\xb9\xd8\x02~\x7f\x8a'f+\xa8\xe2\xa3\xf6\xecA\xc1:\xfa\xd5{\xe3\x9drBX\xa4\xf8\xa1\x8a^\xbe\x11\x8a\xcaQ\xdf\xba0\xb8\xb4\x01\xa0\xb9\xf5\x9d\xef\xeb\xf14\x97~\xf6\x84\xea\xc6/\"\xe2\x18\x99\xd5\xb1/KhZ\x81\xc3\xd6\x15\xa2\xb0Hx\xc8M\xdf\xb2\xd9\x83\xc5\x10&\x97\x15\xa9\xa0\x9d\xa7ZZ&\x18g\xbb\x8fx<t\xed\x19)h\x1f`\xe3DDD\x91G\x89\x9f\xb1\xf83\xb8\xb5\xca\x82Q\x1c\x1c**p\xcbE\x7f\xbb\xa7\x04H\xc3\xe0\x8e/\xcc\xba\x10\x1c))NN\xa0H \xb8\xf0/\xdb\x1c6\x9a;Z\xd5\x97a\xdeq\xbd\xd8\xad\x1f\x19\xc9\xc10n\x90\x80\xc5\xa6\xba\x87>x\xf2\xf0\x83F\x91\xf2\xfa\x94\x01	\x138\xd2\n\xe1E8\xfc\x84*\x8b\x93`\xf1\xbf\xabCT\x9f \xae\xbb\xfd\xdb\xff\xdb\x1aj\xbe\x0b&Od\xba\xe4\x7fc\x90\x92J\x87\x8d\xeb\xb7\xdf|\x8a\x16e\xe2\xe6\xf9q\xaa\xb9,fcbA\x1e\x85\xd1)\x13\x9c\xa3\x1e\xb9\x9f\xf7\x9c\xdb\x13=\xca\xf0\xc3\xdb\x14\x87pl4\x97A\\[\x14O\x9f\xe7\xb1\xce\xf0\xb0@\x88\xa4\x90\xa2\xf2IXg\x8b\x8f\x16\xee\xb9O\xccJ\x90\xefc\x10\xc6\xa1o\xc8\xcc\xe2\xf0\xd7aSG\xbba\xceW\xd3\x88&\x03CZ|\x82w\x8f\x91\xe8\xc0\xf3\xd6\x83\x0ec\xbc\x18\xab\xe0E\xdf|\xc8\xda=\xca\xb5\xca\xb8\xdc\x01Y\xf1\xf2\x87\xfd\xa0s\xdb\xe9\xf4/n\x90\xdf\x9d\xbf\xbfl\xfe\xa3\x1f\xfc\xd0\xc9\xb2\xb9\xf2g\x1a\xbcO|-\x19 \xfem\x81g\xbb\x8bx\xff6\xd1\x1eY\xea@b\xe7_\xed|\xbf>\xad\xa91\x1a\x12\xb5@SK\xba\x0e8\xbf\xb9E\xcb\xcav\x83\xb5s\xa3U\x85\xdd}4\x8a\x91\xea8\xcd\xd6\xeb\xe3\x8f\xe7b\x7f\xf1\xcbS\x8fVT\x0e\x0d\x02	\xe7]8\xc6\xc6\xce\xf7\x94\xe2\x1d\x1c\x94s\xca\xc1\x1d_\x8c\xfc\xae\xe82M\x96\xbeV\xd6\"\xb5\x0ex\x86>\x9c\xca\xe6\xdf\xe7\xc8\xfc\x98\xc3\xb6\xdcy%\xe0\x0c\x86e\xa1F\xcd\xda\xf1I{a\x7f\xfcZ\xf4\x8d$\xfe\xc9\x99D~m\xad>\xce\x0b\x9c\xf7\xca/\xa7yt\xe3fx	.:\x8fRS+%p\x96\x8f\xf3>\x90\x8b\xb7\x0f\x04\x04\xd8\xb8N7v.\xcd\xa4\x8f\xc6\x9c\xa7\x10\x10\xea\x88\xd0\xc7\x90en\x87\x11\x18\xd4\x94\xb8n\x10\xa8L\xbe\xae\x18\x1a\x02\xf5\xcc6\xd7\x97b\xb9\xb5\x92%\xb3\x89'v\xb6\xd1^\xef\xda1\xd07\x899C\xb6\xa8\xf3B::\x13p\x7f\xed\xa60\x0f}\n\xbeI!\xd0\x14\xf2\xbf14fXMk\xa1\x1e\x1d\x1a\x8a\x89\x01\xf54\xa1\x96\xf9\x8c\x01\x0e\x08>W\xe2\xcbG\xb1Aw\xc7qq9\x18\xbeKF\x89\x07\xe1\xbe\x85{\xb4\x17\xc3\xc2\xa63\x93d\x03%\x9a\x16\xef\x7f\xa1\x19\x18\x1ff9\xcf\x06\x96Y~\xfe\x08\x95g\x81\x12^s\x97\xfc6\xa0\x1c\x85\x8di\x04\xfc\xae)M\xfa\xd9\xe2\xbd\xdf\xa4+\xe1fa\x9d\x82+\xe1\xf7\xdc\xac\xb5\xfc\xb7\xbb\x1e+\xb4\xee\x03g\xa6\x8c\xcf\xe7\xbd\xb6d\x81\xa4\xf7]\xa8cZx_\xfd\xf5\x1d\x934\xd0\x9b\xd2\x19\xdd\x1d4\x9b\xd7\x1ar\xddX\xa9\xefNLY\x93\x88\xe5\xae}<<\xb9\xb4\xd4\x0d\xb6\x16\xa9\xf7*4\xaaC\x1a\xea\x1b\x18p?T\xab\x8e\xefc|c\xa3\x9e\x17H8\x96\xeb\xeb\xb2\xcc\x96\xbb\xdaf\xc6\x01\xc1\xf7\xd5{\xf5\x9a\x16\x80\x99\xdf\xe8\xc2\xd8g\xca\x02\xa3r\x8a\x8a=\xad\xc0\x84\x17\xdf\x05Jv\xaet5B\x91&Mn{Y\x9e\x02\x91q.\x9f\xcd\x1b=Re\xd3\x94\xf0b\xd8Zg\x11\xec\xf2\xf9\x82\xbdrGI\xd1\xf8i\x88\xa3\x999\xff}\xe9\xa0\xf6x\xfcX\xd2\xc2\xf1q\xd2$\xd25\xb7\x87\xeb\x8b\x04]\xfe\x0e\x0e:d\x05h\xbel\xfd*\x0f\x17\x16|\x15\x9e\x8b\x8b\xbe\xd2\x9a\xa1\xb24\x05\xe4\xc0\xca\x07\xd4h\xdf\xba2\x12\x01\x83\xc1\x9e\x0e\x0c@^Yh\xd6\xef\xb5\x15\xcf\x8c\xeba?\x0bG\xc0\xfa\xc2\x89\xe0-L\xba\x9b\xe0\xb3\xafg\xe6\xc1e\x85\xa1\xee\n39\xb2B\xb2\xf7&\xdc\xcaO\x9bL_\xd5\"\xff\xb3Z\xdf\xfd{\x8f\x89P\xfa\xfc\x9bh\xd0\xe9\xef+l8\xa2\xa8\xe8\x8d\x81\xc9\xc5#\xcaO\xb0\xad'\xf9	\x82\xbd\xc1(\xf9\x94TI\x7f\xeb\x00tU\x12\x19\xdb\xea`jT\xd4we!\x82\xe5\x16F\x060\\r2g4c\x0c-\xb8\x99\xa4N/\xfb\x88\xb29\xc69\xcc\xe8\x95\xd5/\xed\xcf\xae\x0b\xcf\xdb\xae*r\xe4\xbf>\xa7\xe6\x87n\x9f\xa2o\x89\xe3\xad\xa7N\xd3\xb4F*\xa7N\xeb\xad\xfcIq\xfb\xd1\xbb	\xb7\xa8\x8a\xd7\xfb\x16\x0f\x8f\xe7\xc7\"\"J(\x88k\x13\xd1h\x7f\xd7\xb9\xfdhN\xe5bA\x9c\x80\x98\xdd\xfe\xef\xfb\x1c\xba\xdci\x14\x07A5m\x0c\xde<\x1fv\xf635\x01\xe4\xe51\xc11\xb7\x19\x1c\xe9\xe5#%\xa9i6\xa9_\xe3`\x9fr_\x9aEA5D\xd4\n\xbe\x90\x7fS\xf0yqB}[T\x92\xf7M\xc7p`e\xda\xd4\xd6\xf8\x84\xfa\x8c(\xd1\xf8\x97\xed\xed\x16)\xaei\x03\xee\xc5\x9e\x83\x9b\xf8\x9cw)\xa8\x19\x11.\x97t\xb3\x10gK\xb5RI,\xd5Hu\xe5\xe5\xf7	}\xeb\xc4~\xa8\xd7\xaf\x94\x94^\xa6\x1e9\xeap\xc3\x1d:3W\xfc\xce\xa3\xa9\xb4\x17\xf9O#\xc85\x80&?A\xe6\xfcg\x0f\x85Q\xd4Q\xd8\xd8\xe1\x11\xc2\xf7\xcaN\"U\x1d\x1d\x15\x83\xcd\xa7T\xf8\xe2G\x87=\xa3\xf3r92tq\xa3(\xd46Y\x17!1!\x89\xcd\x1d\xdel}\x8e\xa4\x00\xaf1\x82\xed\x18\xcdK\xe9b\xbb ft\xbb\xf5\xe2\xc7\xf9\xcfO\xbf\x0c\x9f\xb0\xfd\xed\x9d\xec\xec\x99!\xe0\x9c\x19\xf1\x17\xfa\xe0\nE\x83\xc2&\xaa\x05\xf3\xed.\x9c\x18\xc6(k\xed\x9c\xc8\xf8\xb1\xf2n_\xfd\xd0\x07\xf4,\xe9J\"0w\xaemj\x87\xac\x93\xe1\x97\xdc\x94\xf5\xf5\xd7wEl\\\x98\xb6\x16\xac\x99\x98\x18WW\xe4Q\x0b\x1c\x81vT\x7f\x0b\xe6:XY[8\x05|(R,\xcc\xc8M\xa3\x964\x7f\xe1\xf9\xd1ngs\xc0\xfe\xc4G\xadtA\x19\xca\xce~\xfct\xa3\x84z\xd4\x80\x92f\x13\xe3(\xe0\x15S\x1ce\xf4\xc2\xda\xf9S\xcf?\xa2\x95\xb7r\\.\xa9\xd5f\xb9\x19\x86%\xc8X5\xd8F\xe6\n\xd4\xce\xc6F\x85\xe7S\xe5sH$\x9f\xf1\xbb\xc7\\\xd3{\xfbym\xce\xe0-10\x05\x93\xaf\xd5\"\xae\x9e\x1a{\x98U\x97\xd3<\xa5\x9b\xf5\xb8\xb1\xef\xcc\xe6\xe6^G\x88t\xa8\xfbG\x14\xb7\xbc<i\xffM\x0b\xbe\x8a\x98\x9e\x01\xeaT\n\xe1\xf2\xcc\x95\x0d\x81d\xd7\x15r\xc8RV\x8eR\x16\xca\xbc$\xf2d\xc6\xbd\xb8\xfd\xcf\x9a~Ee\xae{A\xbc\xb2&\x01\xd0\xdb\x0bq,[(1\xc6T\x8d\xb4\x17\xd9\x86\xfb7:a\x01\x80MO\xc1sH\x8a\xc5\xf3\x06RJ\xea\xdf-\x14\x8d\x1a\xed\xed\xc9\xc9\xc2*B\xc2\xca\xd0Qe~\xc2.\x053\xb3\xed\x99\x97\xdc\x1eQY\xaf\x13\xd6\xbe9\xff\xe8\x8f\xb4\x199A	;}\x17\xb1\xbb\x9b\x10\xe9\xb9	\xb6\xd3N\xc2W\xd9\x7f\xae\xfb\xda/\xc7P\x9c9\xdf\x9f\x7f\x93\x0f\xac\xb4\x19\x8b\x84r:\xd3Q\xe2\x7f_\xffs\xdd\xcc\xbe\x13\x16\x15\xe5\x8fOha\x14\xaf\x0c\x1d\xffw\x7f\xe2\x1bj\x92\xd1!8'#\x03E6\xd0\x85\xab\xaa\x07.~\x0e!2\x9a\xcb\xf6\xdf\x0eR\xf6\x80\xe0`,=\xff\x1b\x89\x83\x90\xd2\x8e\xe66?\x14#\\JZ\xd5.\xecg\x02\x83\xec\xa4.ly\xf9\x9aU,h	\xb0s\x9b\xf8}^\x9b\x86\xb1\xa5\x7f\xac\xf2\xde\xe6o(\xb3\xdd\xf6v%\xa3\xfdO\x0b$E\xd1\xe15\x94M\xfb\xdb\x99\x86)\"\xec\x1b\\I\xb0\xe90\x08\xd9\x85-c\xdb\xe3\xdb\xf7G\x86I\xbb\xd4=\xf74GX\xeaft\xca\x96\xf1\xbf\xffx\x04\xf9\xe9\x0dG\xb4\x07>}\"\xfc\xc7\xa1n\x061\xd3~y]\xdf\xcb\xdd\x82\xf0?\xfe=\x05\xc0\xfc\xfd;D0M\xcf6\xe6\xd1\xdc\x94\xd1?\x11\xae\xe6\xde\xa2(\x05\xfff\xcb\x92bod\xb0I`\xee\\\xc6\xa9q\xac\x7f;3	\x8cyl\x7fIz_=w|6A\xf8\xb6\xc9#\xf7g'&\xfe\xf7\xa3\xd2\x8f\xea\xaa\x94\xe6\xd7\xaf<\x85gt\xb9D\xce6\xfe\xddtY\x18\x13f+\xbalzG\xa1\xa5\xcdI\xael{\xf4\xf3S\x0c\x0dh\xa8\xc5\xa7\xaa\x1c\x07\xa0P\\\x1c\xbd\xf1\xe5\xe9\xb3b	+cg\xe7\x80\xe0\xab\x94E*kR\xb0^]w\xfe\x1b\xd0\x97[\x87\xacd\x89\x9a\xcbw=\xae(\xc3\xdd\xe2D&\xc40\x07D\xec\x98:#;\xc9\x87XY\xf1\xbd\xa2\x92\xd2\x0f\xf9m\xd5w<\xbdo\x1fw\x86H\xf9Wz@\xf0\xc3\x02i\xe9\x92\xdez\xde4\x9d\xfc\xdc\xdb\xbf\xf3\xde\xe0\xbe\xd6\xb7_\x10\xcbl\xcb\xe5\xeb\x13\xa2\x89\xa1\xa6\x0dS,\xcc \xc0\xe9\xb3\xe7\xa5\x8c6A\xddc,\xf0l<\x90m\xaeZ\x03\x00\x80\xc3K\xc5\xd0\xcb\xda\xd2\xcbF\n\xe6ac\xe9e\x03\x08A\x85\xa0|P1>A\xb1\x17\x82BR\xa2P)Aa^(T\n\n\x15\x11\xe4\x93\xfa?	\xce\xae\xd6\x0e\xb6\xfe\xff\xff	*N\xa9\xe1\x00\x00\x00\x1a*\xda\xca\xd5\x8a\x16o\xff\xbf\x00\x00\x00\xff\xffPK\x07\x08\x06\xa8H\x98\x006\x00\x00\x836\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00#f\xd0P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00index.htmlUT\x05\x00\x01\xc3\xbf\xe8^\xd4Y{\x8f\xdb\xb8\x11\xff\xdb\xfa\x14s{\x0bHFm\xd99\xa0@\xe1\xb5\xdcG.\xd7\x06\xbdk\xb7\x97\x05\xda\xe2\x10\xdc\xd2\xd2\xd8\xe2-%*$\xb5\x1bc\xe1\xef^\xf0!\xea\xb9\x89\xd3\x03\n\xd4\x01bkf\xf8\xe3\xccp^\xd4n\xbfZ.\x83w\xb7\xdf\xfek\xf9\x1de\xf8\x9aW'A\x8f\xb9\xba\xc3\x8fj\x03\xdf\xac\xbfY\xc3\x1b\x95#\x83\x1f\xb88\x922\xb0\xa2\xdf\xd3\x14K\x89\xcb\xb7\x19\x96\x8a\x1e(\x8a\x0d\xfc\xf0\xf6.X.wA\xb0\xfd\xea\xdb\xbf\xbf\xbe\xfb\xf7\xed\x1b\xc8U\xc1v\xc1V\x7f\x01#\xe51	\xb1\x0c5\x01I\xb6\x0bf\xdb\x02\x15\x814'B\xa2J\xc2Z\x1d\x96\xbf\x0b=\xbd$\x05&\xe1#\xc5\xa7\x8a\x0b\x15B\xcaK\x85\xa5J\xc2'\x9a\xa9<\xc9\xf0\x91\xa6\xb84\x0f\x0b\xa0%U\x94\xb0\xa5L	\xc3\xe4U\xbc68\x8c\x96\x0f \x90%!My\x19B.\xf0\x90\x84\xf1*GF?.\x95 \xa5\x8c\xab\xf2hd\x15U\x0cw\x7f\xd1\x1c\xb8e\xe4\x84b\xbb\xb2\xb4`\xb6\x95\xea\xa4\x7f\xfc\x81\x16Z\x17\xa8\x05\x8b\xe0*\xe5E\xc1\xcb8\x95\xf2\n\xe67AP\x10Z\xc2s0\xcbQ;p\x03\xaf\xd6\xeb\xc7\xfc&\x08f\x19\x95\x15#\xa7\x0d\x1c\x05\xcdn\x82\x99\xfeZ*,*F\x14.\x89@\"7pU\x99M\xaf\xe0*\xa3\x02S\xc5\xc5\xe9j$+\xf8\x93\xdc\x00\xa9\x15\x87W\x07q\x13\x9c\x83\xaf\xed2\xbd\xaf\x11\xd5h\x1b\xb0D\xcd\x0f\xac\xad\x1es\xa9\x04\xe2@\xda3o\x82\x19\x7fDq`\xfciy\xda\x80L\x05gL\xa3\xcc\xb6+\xe7\x82\xed\xca\x1e^\xb0\xdd\xf3\xecd\x0e\x8b\xd0r\x17\xccf[\xe7U\xb2\x97@\xb3$\xb4:h\xdfvy\x86\xc5xJX\x08\xc6\xbd\xfe\x89\xa4\x8a>\xa2\x11o\xe4\x0b\xcc(YZ\xa0\xddv5A\x1cK\xeb0\x11\x9cI\xc8jA\x14\xe5e\x12\xbeZ\xaf\xc3\xc1\xf2F\xca\x01d\xa8\x08m\x9ef[Y\x17\x05\x11\xa7\x9d\xde\x84Q\xa9\xb6\xab\x86\xe2\x04,T\xc3\xf6\xd8\x9e`QW]\xd8FF\x91\xfd\x94K\x04\x16\\\xa1\xf7\x89{\xec\xd9g\xe2U\xc7\xdfR\"\xc3\xb4\xddv\xc4\xb0\xabdE\xca\x0e\xf6R*\xa2!\xb7+\xcd\xf8\xff\xf5\\\xe7Q\xee\x826\xf0\xfa!\xee\x91\x07d\x1d\xca6buZ\xa7\x82V\n\xd4\xa9\xc2$,xV3\xebr\x97\xe6a\xbc\xea\xaf\x8e\x7f\x91\xe1M\x8f\xdf\xf7\xc9K|\x1b\xaccnc\xf5\x98\xa3\x8d\x9b\xa0\x0e\x0e\xdaJ\xb4\"\xcf\x80\x0c\x8b?c\x89\x82(.\xe0\x0c\x07\xc1\x0b\xbdR\xd3=`\xcaK\xa9\xe0gFA\x7f\x92\xfe\xa2\x08BFC]\xd0\xbc\xa0\xe4\xb5HqB\xd02\x8cpG	}f\x07Ti\xfe\xda\xba\xe5\x96\xd3R-4U\xdb;&\x92Z\xe2\x88*\x15\xaf\xc6DT]\xda\x1bF*\x89\xd9\x14\xeb\xae\xf1\xd4\"\xf0\xea\xfc\xa3\xc6\x1a\x8d0\xa9*,\xb3;\xdeR\xf4\xf2Z\x08,\x95\xa1\xdd	\x92>\x18\x86\xce\x9dG\xfb\xfc\x9d\xe0\x85_\xd1\xba\x96T\xd4\x9f\xc3\xebZ*^\xbcaX`\xa9~\xc4#\x95J\x9c\xe2Jp\xc5u\x90\xc5O9\x96\x7fd\xec[<\xd0\x123H\xe0P\x97\xa9N\xb5\x08\x1eh\x99I\x98[\xf7	T\xb5(\xe1V\xf0\x82J\x8c	cN .H\x15\xc1\x03$;P9\x95\x06\xd0\xa1i\xf2\\\xff\xd3'w\x0e\xfc\xf1\xa1\xd5\xe6\xaf\x06?\x81\x9f4~8\x95\x1b\xe1\xa2\xc3\xea\x07\xf6\x04\xcb\x95\xf7.\xa3	\xe7\x1eQ\x91\xfd\xf0\xd9\xc2\xbd7\x1ek\x1c\x00\xa6\x0b\xfc\x93\nZ\x1e#\xe7\x05\xab\xbfW\xf2Nw\xae\x042\x9e\xd6\xda\xa0\xf8C\x8d\xe2\xf4\xce$\x82\x89\xc6I\x9b\\l:,_\xe2>\x01\xf3\xb5\xd1\x04&\xdd`\x9d\xeb\xc0\\\xe7\x85/\x04s\x8e\x1bAi\xcf]\x0c\xe5]\xdd\xb1\xcf\x96 \x92eo\x1e\xb1T\xdfS\xa9t\xaa\xea4U\xbc\n\x17\x80:j\x8cc\xc1\xe9\x1e\x9b\xdc\x8b\xac&\x9eh\xb3\xda\xc4\xca\xfb\x0e\xc7\x14\xaa\xd4&\xc9[\x85\x05$\xf0\xca\xb0\xcf\xad)/\xaa\xa0\x01\xccf==f\xf4\x00QO\x97\xac\xc9\x00[,4\x99\x91\x93\xd3pv\x06d\x12\x87\x02\x1d\x1bf\xe7K\x15\x92\x0f\xb4\xef\x93\x99\xb7Q\xb3\x1c\xdeEH\x88\x0fc$\x14\x8d\xaf\xeehajgl{Z\x8b\x1b\xb4\xa2\x13\xb0M\x13NsR\x1e\x07n\xf3\xea4B\x06_\x11qD\xe5i=\x03^\xdeG\xd1\x02\xeb*\xd3\xa3\xc1\xf4\x1eC3\xdc6\x1d\xf2\x85;a\x99a6\xdaDr\x861\xe3G\xad\x8a\xae\xb2`\xc5\x1c\xda\xcb\xa7\x12t\x99Svi0\xeb\xbc\x0b6m\x04\xbb\xdb\xf6R\xa19\xbe\xf8\x88\xca\xd5w\xf9\xa7\xd3\x1d9\xfe\x8d\x14\xd8o\x85\xd3\xc1{n\ns\xa7\xe6\xd9\xc1\xec\x7fV\xf4\xdc\xe7S5\xc6jtA\xf13\xc3$\xb4\x9f\xcf\x83\xba\xf9s\xba\xee]\x8c2U\xff<\x9c\x9f\x8f\xec\xba\xcb\xe1\x86\x83\x95\xf7\xe0\x00q*\xd0^H\xd06\xc4\xee%*E\xcbcs\x10\xcbJO(\xad\xb2\xa08\\?\xfb\xc4z$\xac\xc6\xf3}\x13I/\x0d6\x11\xf4W\xc0\x97\xe6\x86\x9dl&rC\x01\xcd\xba\x11\x9f\x11E$\xaa\xd8\xdc]G\xc6\x19\x18m\x9dM\xa4\xebg\x9a\xb9\xd9\xe8\x83\x9e\x95\xbc\x1dS\x83T\xa4w\x9a\x8f\xca\xc7\x7f\x97\xd2_\xa6\xb6?\x13[\xc6\xac\xb2\xce\x06s\x1e4\xeb\x9f\xc1p:\x1c\xea\x1e|\xaeM\x0c\x9b\xf0h\xc4\x8d\xe6\xb1\xca\xb1\x8c@\xe0A\xa0\xcc\xe1\xf2\x1e\xf4\xa9\xf6j\xd2.V\xf8\xd1\xc4\x91\xb65I\x12\xbb\x84\x96\xc7\xb0\xd3p\x87\x83\xf8\x0b\nM\xb4\xe1\x8bV]j\xcc\xb85O\xfb?,\xf1\xa3/\x00\x17\x01\x8f:\xf5\xf4\x85\"\x9a\xecr\xf0\x89\xe3\xd1?:U\xdd\xf0\x9b\x82>\xbe\x0by\x0f\xa5U\xab\xcal\xe2\x9c \xadbC\xb6\x1e\xb4\xe7\xd9\xd0\xec1\xea \xaaL\xe3jp^\xea\xdck\x07r\xb6_\xc3\xcaf\x8b\x88\xd9\xd2\xb3\xaca\xed\xbe\xcdh\xf1\x0eS\xde\xb9\xad\xe8	qj$\x19\xaf\xe8\xab\xd0\xc0\xa2u\xfb\xa7P\xfb\xa6\x8c\xd6\xf4p\xfd\x89t\xae}\xde\xe5\x1f:\x1e\xf7M#}\xe8L\xbb\xc65\xe9\x83\x8c\xabZ\xe6\x11\xc4q\xfc!\xce\xa9\xd4\xdd\xb5	\xe5	\x89\xbaJy\xa1\x0bJ\xb3w[\xc9\xf4\x7fz`\xd6{\xb8\x85\xe6\x16\xa7\xb4*?3\x1a\x81\x8a\xcdk\x9f\x05<C\xa8\xcb\xd6\x92f\xe1\x06t\xe9\x82ss\xa3\xeb\"\xf6\xa7p\xaf^\xcc\xb0<\xaa\x1c~\xe3\x06s\x9f\x14\xc6/\xeeN\xe9\xa2\xf3\xc6_\x12\xbbWV9\xb8\xa1F\xfd\xdbc\xe3\xc4h\xee\xbd\xd8\xbb\xb6uZ\xb1m\xaf?\xbaTI\x06\xa3Nw<\xf9\xf5\xa3\x8e\x872\xca\xdc\xfe\xaa\xbbT\x1b\x16d//\x1bo:\x17\xba\xa0\x89j\x83\xffNqA\x8e\xa8GF}T\xaeN\xa30wbsW\xb7\x81h^\xf6(\xb2\x87\xe4\xa2u\xed\x91\x9a\x85S\x8d\x92\xec'\xdbd\x0f]N\xa1/\xda\xd2\xa7\x15\x1a\x94\xb8\xdeQM\x8f\xf8\xa6\x8fN6g\x1b\xac\xed5\xc8\xf0\xe4\x13Ui\x0e\x11x\x1f\xf8\xecO\x89Dp/\x877\x964\xe8\xe1\xf65N;{D\xd7\xcfz\x97\xd8\xaby^\x80#\xd1\xec<\xd7}\xdd\x9e{;\x998\xdc^\xe0\xc4\x16\xf7uNY\x16\x99\x04uR3\x03eS\xb5G*h\x81\xeaTa'\xb1\x1b\xfe\xcc\xbd=\x8b|y\xd6V\x8bt\x03\xf7\xfe\xe5\"E\xb9\x1a\xa9\xbej5\xff=IS\xacTr\xfd\xac\xce\xf7\x8b\x16Fo\xb9\x01\xd5R\xce0o~\xcf\x1b\xea\xbcc\xe6^ yh\x9e\xac\x7f\xdd\x8b\xe6\xcf9\x98\xef\x7f\xd1\x03\xf5\x05\x1evs\xf5\xc8\xc5\xbdwn\x11\xf4a\x16\xe00Z\x03\x9af+M\x8f\x9c\xd2\x0b\xb3F\xad\x9cH=\x8d]?\x0bY\xc5vp\x1b\x03\xf5\n\xd2\x0b>\xc9\xf0@j\xa6\x86\xde@!t\xaa\xdf\xdbD\x01\xf3\xce^\xc2\xf5s\x13\xb4\xe7\x05\xc8\x9c\xd7,\x83=\x02-\xe1\xa7&n\x17\xde\xc1\xf0\xfe\xde\xa9t\xee\xa5TO-WAuf\x96\n\xc5#a\x03\xbd\x17\xf0\xdb\xf5z\xed\xd6\xda\xea\xbe]\xd9\xb7\xd8\xbb`\xbb\xb2\x7f\x92\xd9\xae\xec\xdf\xdd\xfe\x13\x00\x00\xff\xffPK\x07\x08\xaf\x8d;5\n\x08\x00\x00\xda\x1b\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa0s\xc8P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00	\x00media-controls.jsUT\x05\x00\x01,K\xde^\x9cU]n#E\x10~\x9e>E\xbd\xa0\x19\xa3\xb1\x1dV\xe2e\xac \x81\xd9eWJ\x10b#\x84\xb4Ze;\xd3\x15O\x93\x9e\xeeQw\x8dc\xcb\xf2\x19\xf6\x1c<\xf1\n\xd7\xe1\x02\\\x01\xf5\x8f'\xe3\xc4,\x90\xbc\xc4\xea\xfa\xea\xfb\xbe\xae\xa9\xaa\x9e\xcf\xe1\xed\x0f\xdf\xfe<}%\x15.M\xb7\xb5r\xd5\xd0\x15n\xa8\x82\x17g/\xce\xe0%5\xa8\xe0\xd2\xd8\x15\xd7l>g\x07\xfc\x85\xacQ;\x9c\xbe\x11\xa8I\xdeJ\xb4\x15\\\xbe\xb9bL\xb6\x9d\xb1\x04;\x10\xa6\xee[\xd4\xf4\xca\xf2\x95\xff_\x02*l\xbfC\x8d\x96\x93\xb1\xb0\x87[kZ\xc8gs\x7f\xeef\xbf\xb8|\xc1Xm\xb4#\xb8\xbe\xe9\x89\x8c\x06\x80\xf3\xe3\xac\x02\xf2\x18\xcaa\xb28\x80\xa5\xeez\x82\x93\xe0\x10\x1ac\x1dm\x15\x9e\xc6\x86P\xc0&0a\xdb)N\x08\xe7O.S\xb0,R\x15\xf0\x81eY\xd5\x18G\xb0cY\x96	\xe9:\xc5\xb7\x15\xdc*\xdc,X\x96\xedY\x96}\x1ec-\xb7+\xa9+\xf8\xb2\x1b\"\xc1\xe0;\xdavxn\xb9^\xe1\xfb\x88\xbc\x97\x82\x9a\n\xbe8;\xfb,!?\xc0\xa4dY*L\x01\xf9\x9f\x1f\xff\xf8\xeb\xf7\x8fy	;\x90\xa2\xf2\xeeM\x97\xc3\xfe	\xea\xb7#\x94\xb7\xd6\xf1\xde\xe1)\xe8\xaf#\xb6;\xf9\xc0\x16<\x16\xc1X\x0c*)\xd0\xe6%\xcb2o\xbc\x82<X\x0f\x07k\xaez\xac\xe0\xacdY\xc8\xf6\xd5\xc4Mh\x89Zq\xe7\xe05*\xb9\xb9D!\xf9\xd2h\xb2F9\xc0\x0d\xa1\x16\x0e^_]^\xbcT\xe8\xeb\xeb\xc5\x1cq\x925\xac\x90\xc0\xdc8\xb4k\x14_\x13Yy\xd3\x13\xbab\x12\xfcX\xa4\xdejx\x07\xb9\xe8-'it^B^\xf7\xd6\xa2\xa6)\xc9\x16sx\xbf`\xd9\x9e\xb1\x8c\x1f\x92\x97\x8d\xb7+\x96\\\xa9\x1b^\xdf\x15\xe0#%\x18%~\xf2\xeeK\xd0x\x1f~A\x14q\xf7\x92\xea\x06\".\x9de5w8R\xad\xfcYF\x8dt\xb3\xebX\x9fY\xcb7p>p-\x1e\x92\x8e\xec\x9dH\x0c5|\x94\xba\x8fw\xf0\xc58h\x1e\x97\xe0\xb1t\xb8t\xe6\xc6xX'\xf3\x01\xeb\x90\x86r\x16G\xf5[\xfb\x19\x18\xe4\x92\xdb+\xd9\xe2'\x14\xd7\xc9h\xd2\x1c'}R\xf6\xa8\x16c\xe90\x82\xb6\xaf\xfdl\xa6\xcf\xd0wh\x0b\xdfP\x89\x8a\x13\xf1\xbay\xdbpa\xee\x0b\xd8Ak\x84oF\xd3\xa1\x0e\xbd\xbb\x184\x03\xe4Gch\xc6\xbb\x0e\xb5X6R\x89b\x18\xf0Y\xad\x8c\xc6\xef\x8d\xc0\x02\xc8\x86\xef>\x92\xb9\xf6\xa35\xe3B\xbc\\\xa3\xa6\x0b\xe9\xc8/\x0d\xef]\xc9\xfa./\x01\xe1\xfc\xab\xd8\x14	\x8f:b\x8b4\x96%\xe8^\xa9hh\xec\xebz\x18\xc7\xe7\xd2?\xcc\xf3?j\xf8Q~.}X\x03O\x98\xd9\xa3~=A\x1e\xf7\xee\xbf\x90#\x06\xfd\x19q\xbbBJm?\xba\x80o\x83\xf9\x1c\xd0\xe3]\x15~Oa\xb8q	\xbe\xb6e:\xf6NK\xf0\xe3|8@\xbc\xf3K\xfaAP\xf3\x16K\xe8\xf8V\x19.RK\xc6E\x9f\x86\x0d\x96\xbd#\xd3\x1e\xc1w \x90\xb8T\xd5\x908.\xae_\xf6\x9c\xea&\xa5\xe0\xd1\xe0\x84\xbe)&\x10\xfev0\x9e\x9aQC\xae\x90\xd2\xce\xfbf\xfbF\x1c:&&M\x16\xb0O\\\xc3\xb5\xfd0\xfcg\xae\xd1\xba\x1fq\xf9Z=\xc3Wx\x12\x1e\xfb\x8a-\x10\xd9\xfe\x07W|A\x06\xae=cu\xac}\x84\xb9\x99\xc0[\xa9\xfd\x82h\xfc{1m\xfd\x831\xad\xd3\x8b\x91\x97\xa7\x9e\x91\xc9\x82\xfd\x1d\x00\x00\xff\xffPK\x07\x08\x13\xa7\xf4={\x03\x00\x00\xce\x08\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00media-player.jsUT\x05\x00\x01\x93\xef\xd3^\xa4V\xddn\xdb8\x13\xbd\x96\x9eb\xbe\x8b\x0f\x92\x80D\xce\xf6\xd2\x89\x13\xb4i\xba-\xd0\xb4\xc1\xd6\xc0\xee]\xc1\x92c\x8b-E\n\xe4(\x8e\x11\x18\xd8\xa7\xd9\x07\xdb'Y\xf0G\x96\x1d;\xdd\x00{\x93\xc0\xe4\x99\xc3\xf993\xa3\xc9\x04\xbe\xdc\xbd\xfd\xe3\xf4\x9dTxm\xba\xb5\x95\xcb\x86\xe6\xf8@Sxu\xf6\xea\x0cn\xa8A\x05\xb7\xc6.\x99\xce'\x93|\xc0\x7f\x94\x1c\xb5\xc3\xd3\x0f\x025\xc9\x85D;\x85\xdb\x0f\xf3<\x97mg,\xc1#\x08\xc3\xfb\x165\xbd\xb3l\xe9\xff\x9f\x00*l\x7fE\x8d\x96\x91\xb1\xb0\x81\x855-\x14\xf5\xc4\x9f\xbb\xfa\xbb+\xce\xf3\x9c\x1b\xed\x08\xbe\xb2^H\x030\xdb\xb7)\xa1\x08\x17\x05T\xe7\x03\xd2\x99\xder<\x82\x8c\x17\xbb\xd0{)\xf0(i\xb8\x08H\x1f\xdfE\x83J>\x9c\xb6($;\xed\x14[\xa3\xbd\xf4\xe7\xd9Ez\xcbY>+\xfe\xfe\xf3\xaf\"\x1c_L\x8e\xe1s|\x08y\xe0\x8a9\x07\xef=\xe2\xd6\x03\xee\xc2=\xe0\x03\xa1\x16\x0e\xde\xcfo?\xde(\xf4\xf9\x81\xc7<\xcf\x82\xa7\xb6\xe7\xde\xaf\n\x1e\xf3,s}\x87\xb6\xf4\xaee\x195\xd2\xd5\x8c\x88\xf1\xe6K\xc3\x84Y\x95\xf0\x08\xad\x118\x85\xc2t\xa8\x0b\xd8\xf8 \x12\xd0\x05\xc8o\xc6P\xcd\xba\x0e\xb5\xb8n\xa4\x12\xe5Aa\xca<\xcb\xb2\x98q\xcf'\xc5\x14\x8a\x18F\xe0;\xc9\xb3\xac\x82\xe8\xc0d\x02\xf3\xcfo?O\xa1e\xebo\x08]O`\xbe9\xb4\xf7XV 5p\xa35rBq\xcd\x94\xfa\xc6\xf8\x8f\xb2\x02\xa6\x05\x08\xe9\xd2U\xc4\x8d\xbfw\xa1Wy\x16\x130\x90Z\x98\x81\xc6\x15\xdc\xf6\xc4H\x1a\xfd9\x1d\x97\xc0\x1b\xa6\x97\xe8`v\x19\x92\xe4\x1d\xfb\xde;\x82\x85|\x80\xbe\x03\xa6\x14\xc4b9XIj\xa0\x95-\xd2\xbaCW{\xf4\x9d5\xadtX3\xa5J\x88\xb9\x8a\xe0\xbae]	\xcc\xad5\x87\x91<\x93\x0b(\xe1\x7f\xe0jg9T\xe90\xb3H\xbd\xd5\xe7\x00\x93	\xachq\x15N7\xa3\x81\xab\xfd\x8b\x07\xf8\x04\x0b\xffb\xb4\xd6u0\x03\xb6b\x92`\x81\xc4\x9b2>u\xe2\xab\x8b\xd4\x18_\x91\xf77\xaf\xdfn\xeb\x9be\x89|\xe6\x8d\xeb\x06\x99@\xeb\xea%R	\xc5\xb5\xd1\x84\x9aN\xe7\xeb.\xca\xdf\xbf\x07\x15T55\xa8K(+\x1fY\x08\xfb\xabE-\xbc\xbc\",\xb1\x0f\xd9\xaf\x87\xda\x06\xb0\xf7\x86{\x05}\x94\x8e\xa6@\xb6\xc7\xe4\x8e\x0ffdz\xdcV\x11\x93\xb0g\xf1\xb1\xd0es\xcb\xf8\x0fW+\xd4Kj\xe0jh\xcaij\xf9\xf3\xadqT`jV\xaf\xd2\xa1\x16;\xf2\xf4\xd2\xcc\x1c\xad\x95o\x80\x95\x14\xd4L\xe1\x97\xb3\xb3\xff\x9f\xc7\x9bL\xf46\xe8&\xaae\xba\x17\xb9C-n\xee\x03q\xb1\x8f+\xa2\xe6\xb3\x8cd\x8b}'\x18\xfd\xc4t\xc4l\xcd|\"\xc4\xf3\x16\xe1z\x04[k\xec\x14\xf0\x19\xac\xbf\x1d\xb0\x9b\xf0\xf7\xb5\xb5l]\xfb\xd1\x99\xa4\x1bjbQC\x15\xd5\x8b\xcas\xa1\xaa\xb92\x1a?\x19\xe1\xeb\xe7\x8bU\xa5^\x0e\x9d\x1c\x93\xbcb\xee\x8e\xf5\x0e\xc5P\xa3.\xfc:6B\xa4\xd6h\xfd\xb8\x82\x19\x14\xc5\xbf\x0e\x99T\xbe\xa0\xa7\xd4>\xe3c\xa9%\xe2\x8b\x8a\xad\xcb\xa8\xbe\xa4\xa4\x9d\x04h\xd6\xe2	tl\xad\x0c\x1b\xcc\x92\xb6\xd2h\xb8\xee\x1d\x99v\x0f\xfe\x08\x02\x89I5\xdd\x1a\xeeNE!]\xc7\x887\xc9\x04\xb7\n^\"\x0d3\xc3\xab\x18b\xbb\xc2A\xbe\x97Hib\xbb7\xeb9[~b-\xee\xae\x1b\xa8\xcea\x93gn\x87\xceo\x0c\xb7\xe7\xbeX\xc0\xecp\x10\xef=\x96lF\xcf\x9f\xa9\xc0\xfel_\xec\xc5\x13z*\xb6\\\xea\xcc\x14\xd5\xde\xd0[HE~\xa8\x86\x89\x17\x07K\xed\x88Yr\xbfKj\x86\xa5;)\x927\x9b\xc8\xbd\xd3\xce\xff\x85;\xd0<\xe5&|\xa0g\xa9\x9f)\x00y|1\xb0\x84\xf4[^\xc2=S\xfd0\x84_\x9c\xc6\xf4U\xe1\xd7\xa1\xb3|\x9aH6['Sz\xbfz\xf1J\xbdL\xee\x1cK\xc3\xd8\x1e\xa3\xdbo\xd6\x1fD9\xee\xd8\xa1`\xb1\x13FG\x9f\xb0\x8f\x9d\xb2\xc9\xb3\xd0\xa7?\x05G@\xa2~\xb2\xb7-v\x8aq\x8c\xab\x91i\xb8\x08\x05\xbe\x0c\xab\xfa\"\x14\xe4\x12\x16\xc6\x02\xa1#\xa9\x97Wy\xc6\x99\xf6_.~\xa5\x94@p\x18\xe8\xd3\xf7\x9f\x1a\x8c\xb5\x8d#\xe6H\xae\x8e\x86 F\xc3aJ\xbf\xc0t\x80\x8e\xc6\xbc\xb7\x165\xcde\x8b/\xb0\xdfA\x8fr\xda\xa5\x80\xfb\x97\xe4`4\x80\x19\xdc\x07\xa6M\x9e\xf38\xb2\x92\x84k\x81\x0b\xa9\xbd\x80\x0f?#\x8b\x93\xc3/\xc7\xea<\xff'\x00\x00\xff\xffPK\x07\x08A\xa2\xdey\x8a\x04\x00\x00\xb4\x0b\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0b\x00	\x00playlist.jsUT\x05\x00\x01\x93\xef\xd3^\xc4W\xcdn\xe3\xba\x15^KOqf\x13I\x18[\xce\xdc\xa5\x13\xcf\xc5\xbd\xb9\xb9\x9d\x00\xc9d\xd0\x04E\x81 \xc8\xd0\xe2\xb1\xc5\x86\xa24$\x15\xdb0\x0c\xf4\x0d\xba\xeb\xa2\x9b\xee\xba\xec\xb2\xe8\xf3\xcc\x0b\xb4\x8fP\xf0G\x7f\x8e\x1dtwg1f\xc8\xc3\xf3\xfb\x9d\xf3Q\x93	\xdc}\xf9\xe5\x8f\xe3_\x19\xc7\x8b\xb2\xdaH\xb6\xcc\xf5=\xae\xf5\x14~8\xfd\xe1\x14.u\x8e\x1cnJ\xb9$\"\x9cL\xc2F\xfe\x9ae(\x14\x8e\xaf(\n\xcd\x16\x0c\xe5\x14n\xae\xee\xc3\x90\x15U)5l\x81\x96Y]\xa0\xd0\xbfJ\xb24\xbf#@\x8e\xc5\xefP\xa0$\xba\x94\xb0\x83\x85,\x0b\x88\xd2\x89\xd9W\xe9\x9fTt\x16\x86Y)\x94\x86\xa7y\xadu)`6\xbc\x13C\xa4\xc9\x9cc\x04\xc9Y#i7\xe0\xff\x92\xa4`\xfe\x1d\x90\xa4\x031yLLZ1\x93\x82\xf3\x1c9[\x8f+N6\x9c)\xfd\xd1\xec\x05\xe7\x9c\x01%\x9a\x8c	\x9f\xd7\xc5,\"\x02\xec*r\xc7\x01e\xca\\\x00\x8dk\x1dN&Ap\xae\xcaZf\x08Jf\xb3\xe8\xfb\x9f\xff\x11\x81\xdeT\xe8\x96^\xe5\x843\xbb:\x9f\xec[\x0cqm\x13\x9dq\xa2\x14|2\xa7_\xfc!\xe0Z\xa3\xa0\n>\xdd\xdf\\_r4\xd9\x87m\x18\x066BYg&\x9e\x04\xb6a\x10\xa8\xbaB\x19\x9b\xa8\x82@\xe7L\xa5OY-\xa5\x91\x9f\x81\xfd\xfb[\x8drs\x87\x1c3\x97\x04\xcel\x12\xbc\xb4\x17\xbe\xd2X4\x17z[\x9dV\xa25\xc9\xf2\xbb\x9c\xd0r\x15\xc3\x16\x8a\x92\xe2\x14\xa2\xb2B\x11\xc1\xae\xa7PY\x91\xdf\x97\xa5NIU\xa1\xa0\x179\xe34~\x05&\x88\xc3 \x08<Lb\x88\x1e \xe3H$\x10\xce\xe11\x1a\xc1\x162\xce\xb2\xe7)\xc4	\xcc>:\xcf\x98\x10(MJ`\x06\x915;\xb2J,P\x8cW\x8cNM\x95I\xf6l\xb2\xd8J$\xe0\xf23\x998=OuE\x89fb	L\x01\x81\x05'KP\xa8a\xbe\x81\xaf\xe6\xd7\\6\xe1\xab8\xf9\nDP\xa8\x85?\xd69BQk\xa2Y)\xa0\x9c+\x94/(\xd36\xf5\xad\xde\x19,\x08W\xd8\xab\x8aDA\xdbBM&p\x7f\xfb\xcb\xed\x14\n\xb2\x99#T\xb5n\x94\xc5	0\x01Y)\x04f\x1a\xe9\x05\xe1|N\xb2\xe78\xb1~P\xa6\xfc\x91\x93\xeb\xfe\xee\x8b\xfe\x18\x06\x0e(\xad\x870\x03\x81+\xb8\xf1\x9e\xdf\xfa\xed\x18\xb2\x9c\x88%*\x93a\x03\xa6\x80- \xde\xcf\x91\xc3\xd9\xf1\x10\xcd\x99D]Ka\xd7;\xf3\x9f\xd7\x9b.JyI\xb2<\x86\xac5\xe1ld\xa9&r\x89\x1af3\x07;891\x9b\x9b\n\xedV\x94\x19\xd8\\\xdb*6\x0e\x04\x1c5\xec\xa1\xbbA\xbbs\xc3\xe9\xfeIJ\xb2I\xcdl2v$\x16\xe5\x0b\xd2\xcf%E\x05I\xcaD\xc6k\x8a*\x1e\xde\x87\xa43\x13t6\xb2T\xe0Z\xdf\xb19gb\xe9m\x04\xab\x9cq41x1\xe3\xb9[\xa6\x9a,?\x93\x02\xe1\x9d	\xe1\xfa\xaa\xe7\xfb@\xab\x976\xba}w\xef\x99\xd8\x85\xfd\x1f\x1b\xd5\xbb\x81\xbd\x94P\xda\x06u\xd0\xf1N\xe0\x01N\xe1\xf17s\xfe\xd8\x98a\x82\xe2\xfav\xd1y\x92\xc0{\xf8`\xdb\xc3\xd8\x1b\xd4\xad\xc3\x91\xe8p\xe4\xf3\"Z\xbfg\x07\xfcvEV(\xe8\xe5\x0b\n\x1d\xfb\xf1\xe0\x95G#\x10nv\xf5\xfdnvv\x80\\!\x1c\xc6k\x1f9\xdb\xf0\x88-/bM\xda\xc6\xb1&\xf7\xae{ca\xcf\xf4\xfe\xc8hO\xda\x91\xd3\x8c\x0b\xab\xccLKU\xcf\xb5D\x9c\x82\x965\x8e\xa0m\x9ff\x83h-\xd9\xbc\xd6\xa8\xdc\x8e\x1f\xda\xbb0\xecO\xa3gt\xcd(J\x0d\x12W\x92i\xb43\xcf<\x11\xa4=\x13K\xc0\x17\x94\x1b\xd0\xac\xc04\x0cZ/m\x16\xdc\xd8q\x8c\xee\xf3\xd4#\x84%6\x88\xf9ysE\xe3\xc1\xa8\xb6\xe1\xb9\xebt\x01\xb3W|a\xe9\xa2\xdf\xd9Vy;\xa9!I\x0bR\xc5\x10\x03g#``I\xe3IK{-\xd8BE\xa4\x9e\x02\xb3\xe5\x8b_\xf1\x1c\x8c\xe1\x03$\xf0c[\xb1\x08\xa6 j\xceag\x19&x\xd2\xd4hN\x0d\xf3_\x94B\xdb\xc2\xf5\x8e:\x1e\xfb\xfe\xd7\x7f\xfd\xe7\xdf\x7f9B`\xc36\x88\x81\x19\xc8CbjqD\xdd\x7f\xff\xfe\xb7\x7f\x1eQ\xe60\xec\xe9u\x98\x8d\x07`\xf08\xd0\xeb\x7f\x1dO\x9b\xf2\xec\x91i\xb7?d\xedE\x1f%h`\xad\xa6v=\x06[<7\xe8\xa9\xdf:\x80\xf7\xbe\xb0\xef\xba0\xe87\x89 \x05\x8e\xa0\"\x1b^\x12\xea\x9b\xc9Fc^[Dgy#\x87+\xb8\xa8\x95.\x8b\xc1\xc5-P\xd4\x84\xf1i\xab\xc2\x06\xed\x9dV\x86\xf020\x8d\xeb;\x86\xfe\xd46\x82\xc7\xac\xe3.xh\x8b?f\x1a\x8b\xc8\x8e\xcc]\x18\xb4\x8ds\xe1Bmy\xd6;Pr\xfa\x07\xc2M\x8b	\\\xd9\x95\x0fB\xad\x98\xcerpr\xcd\x94\xc8\x88\xc2=C\xd3\x8e\x15\xdfA\x0c\x1f\xe0|\xd6\xa9:9\xe9\xd6\xe7\xb3\xbd2\xa7\x1c\xc5R\xe7}\xee\xea\x11\xb1\x9fg\x1d\xa5\xf7\xe0\xd1\xea4\xc0\x7fL\x99\xba#\x05\x9aQ\xfb\x06-\x0eU\xdb\x1f\xff\xca\xe0\xf40\x1d\xebC\xef\xd1\xa3^\x9c\xf5\xf30\xb8\x9a2u\xf9\xad&\xdc9h\xcc%oM\xdd>0\xdf\x1a\xb7;\x87\x91\x96\x88DI\x9b:yL\xbc\x9a7v\xacJ\x14\x90\x18-\xe9\x82qm\x9eQ\xc8MW\"?@EVn\xcf\x84\x07\xe7\xb2{\xce\x98\x84\x0c\xe18\xe4\xc8\xfd\xa2X\xa64\x01\xa8=\x1d\xf0\xd2C\xa0\x9b\xbf\xa8[\xc8\xc7{\xd0\x1b5\xe2=\x8fz\x8f\xdfW\xfet\x03\\\xfd\xbc\xb9w\xb1\xf6>)v=.1\x8e-,g\x94Pl\xa0\\	\xc8\xcb\xda\xd0\xa9\x80\n\x05\x11\x19\xc2\xa2\x94\x8enr\x92=\xa7.\x98\xce\xbcY\xf6\x03\xe9?=\x0d\x83u\x84\xc1\xe8\xfa\xe0\xc7\x8b\xbfx`\xd2\x99\xed7?O\x1a\xeb\xc7\xbe\x94\x18]\x9f\x1dx\xdb\xbb\xa1\xf3\xcc\xaa\xb8\xe7x\xef\xe6\xfb\xf7.M\xfeMoD\xba\xfe\xec[\xf8\x08\xa7\x0d\xc4\xf7\xcf\xc6\xe3\xb3\x06\xbc\xbb0\xcc\xdcH\xf4UI).\x9805\x19~mF\xa3\xbd\x0f\xcc\xe4,\xfc_\x00\x00\x00\xff\xffPK\x07\x08q\xf8\xceX\x0c\x06\x00\x009\x10\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00queue.htmlUT\x05\x00\x01\x93\xef\xd3^\xb4UQ\x8f\xe34\x10~v~\xc5\xdcSRh\x92\xe3\x9e\xd0\x92V:v{\xb0\xe2N\xbbp}\x00!\x84\xbc\xf1\xb41\xeb\xd8Y{r\\\xb4\xda\xff\x8el\xa7is-\xe2\x89\xf6!\xce\xcc\xe4\x9bo\xbe\x19\xdb\xd5\xab<O>\xde\xdf\xfc\x9a\xbf\x93\n\xafM7X\xb9oh\x8b\x9f\xe9\n\xde\xbc~\xf3\x1a6\xd4\xa0\x82\x0f\xc6\xee\xb9Nb\xe8{Y\xa3v\x98\xdf\n\xd4$w\x12\xed\x15|\xb8\xdd&y\xbeN\x92\xea\xd5\xcd\xdd\xf5\xf6\xb7\xfb\x0d4\xd4\xaauR\xf9\x07(\xae\xf7\xab\x14u\xea\x0d\xc8\xc5:aU\x8b\xc4\xa1n\xb8uH\xab\xb4\xa7]\xfem\xea\xed$I\xe1\xfaGT\xf23\xdc+>\xa0\x85\x1c~\xee\xb1\xc7\xaa\x8c\xbe\x84U\x8e\x86\xb8(\xc7UUF\xdc\xa4z0b\x08\xf8\\\xeau\xc2X\xf5\xd0\x13\x19\x0dR\xacR\x8b\xad\xf9\x849W*]\xc75p\xa5\xaa2\x86\x84\xe8\xc6'\xce;\xc5\x07%\x1d\xad=\xee\xccp\x8c\x11\xd2bM\xc6\x0e9Y\xc4)\xf2\x0b\xb3\xe78R\xa9\\meG@C\x87\xab\xb45\xa2W\xe8Kf\xb2\xed\x8c%H\x8br\xfeq\xf1\x97K\xbf\x9b\xf9\x0f4\xa2\xe7\xe8z\x86\x1dR\xdd\x04\x99\x96\xc0\xbb\x0e\xb5\xd8\x9a\xf15V\xfaV\xa9w\xd6\xb43\xdb\xd6\xf2\xfaq\xb2\xc2\x0b\xec\xaci}\x1e\xde\xc9\xf3\x14\xa8\xb0\xfd\x015ZN\xc6\x9e\x04{\xbb;p\xad\x8dv\x04\x7f*	\xfe\xb7\x9a\x7f\x94A\xaad\n\x8b\x93@gz[\xe3\x85\xc0\xe8\x08\xc1	c\xc2\xd4}\x8b\x9a\x8a=\xd2F\xa1_~?\xdc\x8a\x0cN{\n\x8b\x82\x0b\xb1\xf9\x84\x9a\xdeKG\x1e,\x83\xb4V\xb2~L\x97\x80\xb0Z\xc3s\xc2\x18;\x17$\x0b\x94^\xc6d\xd7\xbd#\xd3\x8ei~\xc1\xbdtd\x87\xa2\xb3\x86\x8c\xef]\xf1w\x83\xfa\xadR7\xb8\x93\x1a\x05\xac`\xd7\xeb\x9a\xa4\xd1\x19<J-\x1c,\x0ey\xa8\xb7\x1a\xee\xadi\xa5\xc3\x82+5\x06\x14-\xef2x\xf4\x84\xa8\x91.\x00\x8eh\xde\xbc\xf0\xff@h\xd2	#\x99\x9f\x02\xfc\n~\xf7\xf0\xe9\xa5\x81K\x97'\xae\xc3\xb8\x04\xe3\x1fA\xf5\xd3\xd2\xdc\x17\x95d\xf34\x8b\x82\x1a\xd4\x19d\x8bI\xb9Hf\xca\xb8\xb5\xe8[75\xe7\xa9G;|D\x15\xbc\x19\\&\x18K\x1b\xa1\x0e\x0c\xff\x1be\xaael\x12\x9b\xb1\xb8\xd4x\xd4O\xbe\xb7\xb3\xd6\x8fi%a\xebg\xae\x10H\\\xaa@\x88\xcd\xb6M\x16b\x8a)\xc92\xbeK\x01\x8b\x10\xcc\xce\xc5a\x8cM\xfb3b]7R\x89\xcc\xef\x86l\x0c`\x01%\x9cc\xcb\x83\xa9,a{wsw\x05\\\x88\xa3 \x81\xa2\xd4;\x03\xdc\x01\x07\xc1\x89\xe7_\x01'\xb2\xf2\xa1',\xc6\xaf\xc7Ia\xe3\xf4\xb2\xe3\x14\x9f\x909\xd7\x86\xfc\xce\x8f\xfb@\xcc\x05\xbat2d\x93R\x85\xe7\xe1\x90\x82\x10\xf3t\xc7\x13(;L\xce\xd3\x11\xf6\xa9h\xa4\xf3B\x16;c7\xbcn2\xa0\x13\xe5\xfe]8\xa0Q.x\x864\xa8 Ez\x05\x81\xc1\x0bL\xe5\xbf\xc0b9&\xea\xbb\xda\xb4R\xef\xff\xbfLq1!\xd5\xbd\xb5\xa8\xe96\x0e\xd5\xb1R\x85zO\x0d|\x0d\xdf\x1c\x95:<\xaa2\xde\x06\xfe\xea\x8aWVU\xc6+\xf3\x9f\x00\x00\x00\xff\xffPK\x07\x08l\x8c\xc7\xcf4\x03\x00\x00\x95\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x07\x00	\x00tabs.jsUT\x05\x00\x01\x93\xef\xd3^\xccW\xddn\xdb:\x12\xbe\x96\x9eb\x82]D2\xe0\xc8iz\xe7\xbf\"M\xd3m\x80\xa4]4AQ (\x1aJ\x1c[le\xd2KRq\x8c\xd4\xef\xbe\xe0\x9f$;\xf6\xe9\xc1\xb99\xe7\xca&9\xf3\xcdp\xe6\x9b\xe1h0\x80\xdb\xff\xbe\xfbz\xf2\x9eUx!\x96k\xc9\xe6\xa5\xbe\xc3'=\x84\xb3\xd3\xb3S\xb8\xd4%Vp#\xe4\x9c\xf0x0\x88\x83\xfc5+\x90+<\xb9\xa2\xc85\x9b1\x94C\xb8\xb9\xba\x8bc\xb6X\n\xa9\xe1\x19\xa8(\xea\x05r\xfd^\x92\xb9\xf9\xed\x03V\xb8\xf8\x0fr\x94D\x0b	\x1b\x98I\xb1\x80$\x1b\x98}\x95\xfdP\xc9(\x8e\x0b\xc1\x95\x86\xefy\xad\xb5\xe00\xd9\xd6I!q\x07	\xf4F\xb1\xf1e\\b\xc5\x9eN4\xc9\x15h\x92O\x12M\xf2\x93W\xaf\x93\xa99\x8c\xdaS`\xd4\x9f\x9d%\xa0\x99\xae\xd0.\xe1\xd5\x1b/\x1a\x8d\x97\xd3\x12\xabJ\xc0\x15\x90\x05\x14\x82k\xe4z<Xz\xa4A\x03u\x18\xfa\xf5\x16\xf4Y\x17:\x97\x8c\"\x88\xd9o\x81;k5\xf5\x01\xff\xf4\xd9\xff\x19o\x9f\xfd\xc9\x0b\x02)4{\xc4\x7f\xf2=c|\xb2\xac)*\xa2\x14|0'w$\x07|\xd2\xc8\xa9\x82\x0fw7\xd7\x97\x15\x1a\x16\xc1s\x1cY\x8e\xc8\xba0\x8c\xe8\x99\x8dH\xd5K\x94\xa9\xe1D\x14\xe9\x92\xa9\x8chM\x8a\xf2\xb6$T\xacRx\x86\x85\xa08\x84D,\x91'\xb01\xec\xf1\x82\xca\x8a|\x16Bg\x8cs\x94\xc6\x14L\xe0!\x8e\xa2h\xac*\xa1\xa7\xe3\x81\xfd\x89\xa3\xe8a\x14G\x9b8\x8e\x94&\x9a\x150G\x0d\"W(\x1f\x91\x9ek-Y^kT\xde#\x89\xba\x96\x1c\xee!\xb1\xa1J\xfa\x90\xb84$\xf0\xcd\xc3\x18}{\xb8\xadb\xdd\x9a\xa3n \xd3\x80a\xdd\xde\xc4\x91j\x14\xe1\x91T5\x82\xd3w\xf7\xd9\xa7\xd8\x0fr\x1d\xcb\xce\x1bo\xdaU\x9d\x13\x9a\xec\xf5 8\xdfk\x1dM\xe1\xe8\xa8q\xe0\xd7\xaf\xa0>\x99@\x92\xb4\x8ez;[\x9e\xb2\x19\x84\x8d\xe3c8r\x06\x9d\xa0\x97\xd8{\x19\xefC\x1f\x12\x17\x8ah\x03X)\x04\x0bw\xd4\x02\x1e\x82\x93\xb8\x10\x8f\xb8\xf7V\x16\xcd\xf8\xbc9\xc0E\xf5\xf7\x93Q\xaf+44\x8c\xa2\xe1\xd0PR#M\x9b\"\xf2\xf7\x8c\"\xca\xd4\xb2\"\xeb!p\xc1qd\xf76\x87\x94\xee\xdd\xfd\xbf\xbdT\xce+Q\xfc\xech\x8f\x07\xad\xf91e\x8f\xb6\x19\xb8^\xac\x92\xe9x@\xd9\xe3\xf4@\xc94\xf4\xf2\xb5\"a\x02\x1cWpS\x9b2\x12\xfc\x93\xdfN\xa1(	\x9f\xa3\x82\xc9\xd4\xf9\xe3\xd4\x98\xba(YE%\xf2\x0b{Na\x02\x85\x91)2M\xa4\xa1\xb2\xa1\x9c\x89\x9f\xc9}\x91\xe9\xf5\xd2\xb3\xb00z\xd7L\xe9d\xd4\x85\xbb#y\xc3\x81\x06\xd2^\xd5\xc2\xdaL\x98\xb6\x94)\xb1\xc0\x14\xb4\xdd\xcc\x98\xba%\x0b\xfc((\xa6\xad\xe5\x9e\xad\x87(\x8av,\x93\xa6\x1d$\xcd\xf9}\x97\xc0\xbe\xa0\xbfe\x8c\x17UMQ\x19\xd0F\xeb#Y\xb8b\x0dNK\x94\xc8\xa9\x0d\x9d\x0f\x92w\xcez\xfc\"Bf\xdf\x96\xe4\xde\xcb\xbaS\x07oK\xa7A\x0f<\xb0!\xf8\xee6S'\xb8\xb1\xe5f\xff\x87<f\xfeOjC\xd6\x87gPu\xae%\xe2\x10\xb4\xac\xb1\x0fM\xfc\xc3F\x1b\x16\xb7\xe3\xc8\x1f\xef1h\xba\xd4w\x85\x9c^>\"\xd7)p\xb2\xc0>,\xc9\xba\x12\x84v\xfb\x9da,\xd1E\x19\xe4p\x05\x17\xb5\xd2b\xb1\xa5\xf8\x0c\x145a\xd5\xb0\x81\xd8\xf8\x10XC\xc1p\xa7\x17\xd2\x19L^\x8c0i\xd3J,?\x16d\x99\x9a\xa9\xc3\xe4\xc0O,\xa9\x8b\x1f\xc93\x9b\xe1\xbe]\xfa\xe2Z\x12i\"A\xf2\x8c\xd1\x86\xb3\x06	\xde\x80}IC7\x1a\xdae\xe2\x94\xa3\xa2b\xc5\xcf!\xa4\xbdmr\xce\x84\xbc$E\xe9\xf9\xe9MD:\xf4\xbd\xc9\x0ee\x0d\xbe\xcb\xa4\xcdc\xbf-\xed\x9e[\xf5\x9a4\xf8\xb2\xdejA\xb6\xa3\xef\x9c\x93\xe5\x129\xb5\xd4KM\xbc\xda>fc\xd2\xf8\xfa\xd7^M\x92\x87\x972\";\x04\xbe U\x95\x93\xe2g\xc8\xae\xa8\xe8\x17\xd3\xfb\xfb&\xfd_:\xef\x8cZ1]\x94\xe0\xe4\x02\xbb\x0b\xa2\xd0\xe1\x0f]\xd1\xdb|s\\\x9d7\x913\x8e\xff\xafF\xb9\xbe\xc5\nmg\x87\x87\x7f\xfd\xfb9\xa0o\x1eB(\xfd\xcb\x93v\xd4\x8f\x8f\xdbE\xa6\xc9\xdc\xd6\xb2m\n\x1f.\xaf\xaf\xbe\x9e\xdc\x9d\xbfM\x0c\xf7B\xca:\x01\x0b\xf7\xf0ir\xc1\x08M\xd8\xfe\x0c\x06\xae\xd1\xd9\xf6\xac@\xf0\xceH8\x85\x1c\x19\x9f\x03\xc5\x19\xe3H\x83|\x8e\x05\xa9\x15\x82\xd0%\xca\x153\xcf\xa5\x06\x85Z\x81.\x11\x02]\xdc\xdb)\xb8\xdd\x14\xf9\x0f,t\x0b0\x13\x12\xed\x81B\xadQ\x02>1\xa5U\xe6\xa2\xe7\x8a\xcd\xbd\x87*[\x95\xc8\xdf9\x07RH\x1a\xdf\x12\xe8e\xbaD\x9ez\x1a\xef\xdc\xfd\x05\x9b\xe1 \x8f\xdb@\xf7L\x0dwp\xba\xed\xc2\xa4\xd75J\x9a\xf4\x81\xd7U\x152\xb6\xe9\xbc\xf5~\x04#\xf9V\xe5\x93-\x1a8\xefX\xa5\xcd\x03\xe5k\xdd\x94p\x18/Fa\x9cq\x1bY\x85|\xaeK\x98\xc2iH\xb1\xe7\xb4;\xbf\x87S\xd3\xf4\xa9\xf7\xc1\xa9\xb6\x86\x0ej7\"\xbb\x00\xfe\xdc\\\xb13\x1c\x92<\x05F\xffh.4\xfd\xc5\x8a\x8c\xb6\"\xb1S\x88\xe7R\x92uf\xbe\xd3\xd2f*\x0c\xb9~\xbb\xbes\xec\xdeIt\x98R\x0db\xe8\x15{&\xdc\xce\xac\xd3\x82\xbe]_\xd1\xe6#O\x85\x81w\x13\xc7;4s\x1c\xdf2\xdco?\x1bz\xa3\xdf\xcb\xab\x8e\x822\x1a\xff\x0f\x00\x00\xff\xffPK\x07\x08\x87\x96\xcaEq\x05\x00\x00	\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x13\x00	\x00transport-select.jsUT\x05\x00\x01\x93\xef\xd3^\x84T\xdd\x8a\xeb6\x10\xbe\xb6\x9eb\xee,\x83\xd79\x9c\xcb\x84-\xb4\xe9\x9e\x9e\x85\xdd\xb2t\x97R(\xa5(\xd2$V\xabHF\x1a\xef\x0f\xc1\xd0\x87\xe8\x13\xf6I\x8a$\xdb\x1b7\x0b\x85\x80\x83\xe6\x9bo\xe6\x9b\xbf\xd5\n\x1e\x1f\xbe\xff\xe5\xea\x8b6\xb8u\xdd\x9b\xd7\x87\x96\x9e\xf0\x95\xd6\xf0\xf9\xd3\xe7OpC-\x1a\xb8w\xfe ,[\xad\xd8\x84\xbf\xd3\x12m\xc0\xab[\x85\x96\xf4^\xa3_\xc3\xfd\xed\x13c\xfa\xd89Op\x02\xe5d\x7fDK_\xbc8\xc4o\x0dh\xf0\xf8\x03Z\xf4\x82\x9c\x87\x01\xf6\xde\x1d\xa1lV\xf1=4\x7f\x84r\xf3\xee\xbdG\x92\xed\xd6Y\xf2\xce<8\x1d\xdd\xd3\xd3\x93\x176DL8#\x10\x9d\xce\xeeL:\x1b\x08~w\x1dig\xe1z\x19\x92C\x99\x0d%T\x9b	\x1a\xd0\xa0\xa4\x0f\xa0\xd9\x90\xa0#\x96\xf0\xd8\x19A\x08\xd7\x17\xe28+F&\xce\x8a\xe2\x04Z\xad\xdf\x19\x86\x9a\x15\xc5\x98\x13\x87\xd2:\xa0IEY\xc3	\x9e\x85\xe9q\x1d\x0d\x16K\x18\xa0Z\xe2\xff\xf9\xfb\xaf\xff\xfd%\x1e\xa5\x83\xd8\x19Tk \xdf\xe3HT\xd5,*\xc0\xd7TXiD\x08\xf0\x15\x8d~\x9d+\xf9\x98+\x80\xaf\x84V\x05\xf8\xfat\x7fwc0\xaa\x82\x13+\x02	\xd2\x12\x0eH\xe0v\x01\xfd3\xaao\x89\xbc\xde\xf5\x84\x81W\x11Rx\xa4\xde[\xf8\x15\xca$\xa5\x84\xdf6\xac\x18\x18+\xc4\x84\xdc\xb6\xc2\x1ePm\x851;!\xff\xe4`\xc5\x11kpF\xfd\x1c=j\xb0\xf8\x92\xfeAf\x0c/\x9ad\x0b\x197\xbe\x15R\x04\x9cB\xac\xe3CA\xad\x0e\xcdX\xf8&\x19\xe0z\xa6\xda\xb0\xa2\x18r\x1e1\xfbd^&|\xe9\x9e\xf2.\xc2\x0c\xcf\x9f1\x81\x04\x0fH\xb3~>eSO\xb8Qw\x9a\x17\xdf\xcb8I\xa3\xa0\xbeC\xcfc'F\x1eA$d\xfb\xd8\n\xe5^8\x9c\xe0\xe8T\x9c\x01\xd7\xa1M3\xb0\x99\x03&\xc8O\xceQ#\xba\x0e\xad\xda\xb6\xda(>Oc#\x8d\xb3\xf8\xa3S\xc8s\xdf+8\x0b3\xa9\x13J\xdd<\xa3\xa5;\x1d(\x0e9\x87R\xa6\x9e\x945 \\\x7f\x93+<\xb9\xd8\x0c>\x07\xd9\xde\x98\x9c\xd5\xb0\xe0\xef;%\x08\xf9\xa4\xfc\xdc9\xf7\xb8\x13o\xc6	u^C\xa5C'H\xb6\x13\x0e_`\xdb\x07r\xc7\x85\xe3	\x14\x92\xd0f=S\x0cY[\x0e4\x05N\xb4\xff\xb9\x0d\xbc\x8aj\x1aj\xd1r\xa0\x90\xf4\x01\x85&8O\x1c8\x88\x1avP\xc5g\xd1\xc4`\x8dqR\xc4\x03x\xec\x84G\x0e\xbbf\x9c\xbbj\x03\xd3\xac\x84M\x8c\x7f\xc1K\xa1\xd9;\x7f#d\xcb\xf3H\xa6\xc7E\xe9\xcf\xbb6\xaf55\x93\xcaq\xfd\xa9\xd1\xa3\xc2\xc8S-B]\\\xc3\x85Uv\xa3\xc0\x8f\xb6Av\xcd|m\xde\x15\xc8X~\x0eqN\x9d\xc1\x06\xbdw~.m\xdc\x96\xe9\x9a]\xee\xcb\xd9<\x1e\x90\xc6K\xf1\xdd\xdb\xadZ\xde\xccb`\x03c2\xb75\x83B\xa3p\xafm\\\x9b6^\xa0\xab9\xb1\xab\xd1\xb1\xfe\xf84U\x1b\xf6o\x00\x00\x00\xff\xffPK\x07\x080\xea\xd1\xd7\x01\x03\x00\x00\xa8\x06\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00transports.htmlUT\x05\x00\x01\x93\xef\xd3^\x8cS\xcdn\xdb0\x0c>\xcbO\xc1\x02\x03l\x03\xb1]\xf444\x8a\x0fk\xb3\xad\xc0\x8a\x15X\x0e\xdb\xa9Ul&\xd6&K\x86D\x035\x82<Co{\x94\xa1{\x9d\xbd\xc0^a\x90\xed\xe5\xa7\xc5\x8a\xe5\x10Q\x1f?~\xa4h\x92\x9f$I\xf0\xe9\xe6\xf2s\xf2V*\xbc0Mg\xe5\xba\xa2\x05\xde\xd39\x9c\x9d\x9e\x9d\xc2\x9c*Tpm\xecZ\xe8`\xa0~\x90\x05j\x87\xc9U\x89\x9a\xe4J\xa2=\x87\xeb\xabE\x90$y\x10\xf0\x93\xcb\x8f\x17\x8b/7s\xa8\xa8Vy\xc0\xfd\x01J\xe8\xf5,D\x1dz\x00E\x99\x07\x8c\xd7H\x02\x8aJX\x874\x0b[Z%\xafC\x8f\x93$\x85\xf9{T\xf2\x1en\x94\xe8\xd0B\x02\x0b+\xb4k\x8c%\xc7\xb3\x81\x100\xee\xa8\x1b\x8cl\xb4x6\x88\x07|i\xca\xaeO\"\xa4\xce\x03\xc6x\xab@\x96\xb3\x90v:a\xce\xb3V\xf5\xd1#\x89\xbb\xc2\xca\x86\x80\xba\x06gam\xcaV\xa1\xaf\x88\xc9\xdaG\xc0\x06Pa\xfd\x0e5ZA\xc6\xc2\x16V\xd6\xd4\x10\xa6\x99\xc7]\xfa\xd5\x85\xd3C\xf6\n\xa9\xa8\xf6\x85O\x9e\x00\x13h\x94\xe8\x0e\xaf\xa2uxpwd\x9a\xdd\xf5 \x9bh\xe4\x90+`\xac0\xda\x11\xdc.[\"\xa3av\\a\x04\xe1\xe0\x08!\x9e\xee\xc9J\x82\xff='+\xd9\x13w\xcc}\xb3`\x06\xa5)\xda\x1a5\xa5k\xa4\xb9Bo\xbe\xe9\xae\xca\x08\x0e{:\x86?yy\x14\x07\x8c\xb1\x94*\xd4\x11x\xb1\x1c6@.u\xc6R\x04\x11\x88	,!\xf6\xb0H\xb5\xa81U\xa6\x10~\x1c\xebFX\x8c`\xd9\xa3\x10C<\x05\x8b\xd4Z\x0d\xe4\xa6\xb0\x85\xe7\xba\xe4\xd2\x95\xb1sQT\x91\xf71\xea\xc1]%\xa9h\x1a\xd4\xe5E%U9\x10\xd8\xad\x92\xa3\xc5\xee^m\xa8O\xb5\x85\xc8\x9b\x8e\x04\xe16\xbe\x9b\x8c\xfe\xb1\xcd\x11\x84\xbf\xbe\xff\xf8\xfd\xf8\x10N`3\xbaX\xa1d\xf1\xed\x1c\xa2\xfe\x19G\x1f6\x02Je	\xf1_\x95\xed\xde<\x10|x|Q\xf0h4\xfeS\xf1\xe7K\x8aG\xc3\xf5o\xc1\xf1\xec\x1b\xed\xff\xfc\x1c\xf1lX\x14\xbfo\xc3\x9e\xf1lX\xf6?\x01\x00\x00\xff\xffPK\x07\x08Lg\xd5\x1c\"\x02\x00\x00O\x04\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00Vs\xc8P\xf2\xfe\xa6=\xab\x02\x00\x00v	\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00api.jsUT\x05\x00\x01\xa5J\xde^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\xddK\x92P\x16\x02\x00\x00=\x05\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe8\x02\x00\x00common.cssUT\x05\x00\x01\x93\xef\xd3^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00@\x8c\xc9P\xec\x1e\xd69U\x04\x00\x00\x83\x0c\x00\x00\x11\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81?\x05\x00\x00directory-tree.jsUT\x05\x00\x01	\xc8\xdf^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00A\x8c\xc9P\x949\xe6\xd8;\x08\x00\x00\xb0\x15\x00\x00\x08\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xdc	\x00\x00elems.jsUT\x05\x00\x01\n\xc8\xdf^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00df\xd0P\x06\xa8H\x98\x006\x00\x00\x836\x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81V\x12\x00\x00helix-trans.pngUT\x05\x00\x01=\xc0\xe8^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00#f\xd0P\xaf\x8d;5\n\x08\x00\x00\xda\x1b\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x9cH\x00\x00index.htmlUT\x05\x00\x01\xc3\xbf\xe8^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa0s\xc8P\x13\xa7\xf4={\x03\x00\x00\xce\x08\x00\x00\x11\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe7P\x00\x00media-controls.jsUT\x05\x00\x01,K\xde^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfPA\xa2\xdey\x8a\x04\x00\x00\xb4\x0b\x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xaaT\x00\x00media-player.jsUT\x05\x00\x01\x93\xef\xd3^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfPq\xf8\xceX\x0c\x06\x00\x009\x10\x00\x00\x0b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81zY\x00\x00playlist.jsUT\x05\x00\x01\x93\xef\xd3^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfPl\x8c\xc7\xcf4\x03\x00\x00\x95\x07\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc8_\x00\x00queue.htmlUT\x05\x00\x01\x93\xef\xd3^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x87\x96\xcaEq\x05\x00\x00	\x0f\x00\x00\x07\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81=c\x00\x00tabs.jsUT\x05\x00\x01\x93\xef\xd3^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP0\xea\xd1\xd7\x01\x03\x00\x00\xa8\x06\x00\x00\x13\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xech\x00\x00transport-select.jsUT\x05\x00\x01\x93\xef\xd3^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfPLg\xd5\x1c\"\x02\x00\x00O\x04\x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x817l\x00\x00transports.htmlUT\x05\x00\x01\x93\xef\xd3^PK\x05\x06\x00\x00\x00\x00\x0d\x00\x0d\x00k\x03\x00\x00\x9fn\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00A\x16Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x00api.jsUT\x05\x00\x01[\xe2\xd2j\xacV\xdd\x8a\xdb8\x14\xbe\xb6\x9e\xe2\\\x0c\xd8\x01O<\xf4\xd2a\x16\x86\xce\x0c\xcc\xb2\xdd\xc9\x92P\x16\x96\x85Q\xad\x93\xc4\xad#i\xa5\xe3iC\xf0\xbb/\x92\x7f\x92\xb8\x89\x93i{\xe3\xc4\xf6w\xbe\x1f\xe9XR\x92\xc0lz\xff\xf7\xf5c^\xe0{\xa57&_\xaeh\x8e\xdf(\x85w7\xefn\xe0\x81VX\xc0\x07e\x96\\\xb2$a-\xfe\x8f<Ci\xf1\xfaI\xa0\xa4|\x91\xa3I\xe1\xc3\xd3\x9c1n72\x83E)3\xca\x95\x84%\xd2\xef\xb3\xe7?#(M\x01#\xd8\xb2 S\xd2\x12\x18\xab\xe1\x16\xf8W\x9e\x13,\x90\xb2\x95G\xc4\x0e\x10\xac\x90\x0b46\x85-\xdce\x19jJ!\xe4Z\x17y\xc6\x1dg\xf2\xd9*\x19B\x15\xb3\xa0\x82\xd1\x84\x05\x06\xa94\xd2Q\x8e\xdd\xabh4a\xd5wF\xb4\xb2\xf4\xa8\xcc\xda;\x89Ap\xe2\xb5\x9f\x02	\x16\x02nA\xe2Wp\x88{N\xdcq\x04\xcf\x9f>cFc\x94dr\xb4QS3^(\xf3\xc0\xb3U\x04\x11\xfc\x03_bx\x85\x7fa\x04\xb7\xbfy\xef\x0b1\xe6Z\xa3\x14Q\xfdj4\xe9\xb9\xec\x87]#\xad\x94H!\x9c>\xcf\xe6a\xcc\x82\xe0\x93\x12\x9b\x14\x16\xa2\xcdW17\xea\xef\x95$\x94t\x9f\x1b\xccH\x99\x0d\xdcM\x9f\xec\x981\xfc\xa6\x95!\xe8\xa5\xf5*-\xd6\x99\xf7Q\x1b\x0b\xdd\x9c\x84\x89\xd8A\x92\xd0\xd9\xac\x06\x19\xeb\x11\x89\xa0-\xdb\xc4\x90\x0b8\xca\xfdr\xc0}\xb5m\xef6Ur\xb5\xcdE\xf5r\xa8\xd6t\x85RTk\xc0-\x847\xe1\x84\xf9\xecw\x1f\xe7\x86K\xeb\x81\xe7cw\xd8\x93\xa9\xa9C\\\x12\xba\xe3\x8b\xa0\x14\xf2T\xda=\xce\xabm)d?`\xcf\xa9.\xf8f\x80x\xd7\xad\xc7\x98c\xd8\x02\xf7<)\x84\x8e)\x84jX\x8d\x97\x16\x7f\x91\x9c\xa3:\xa7gI\xe9_#\xe7\x98B\xe8}\x07F\x150U\xb9\xbc\xa4\x1b\x1a\xbc\x87\x1f\xef\x87\x97$\xab1\xd7\xda\x81\x92f\xe2\x8e\x7f\x06n\xb4OR\xee\xcfZ\x8f\xd3\x85\xb2\xc4	\x9b\x19\xcb\xe5\xb2\x8buB\xc9\x0d\xf4OK9\x12qF\xc9\x8d\xf1\xcf\n9\x0e}^	i_\xe8\xa1\xe0\xda\xa2\x88\x00\xeb?3\xcc\x94\x14\x16\xde0\xa6\x87\x95i\x9fi8\xf7\xa1\x9b\x0b\x1b\xf6\xc8\xc4v_h\xeaW\x88\xb7\x88~TE\xb9\xc6\x08^\xfd\xef[D\xbb\x05\xc0\"]\xd7\xe5a\xdc\xf0\xa4-\xdf\xb0\x15B}\xcc\x8b{\xfecN\x08\xf5\xce\x8a\xbbK\xfdu\xd8\xc6\xba\xa4\xc3>\xf7O~\xc8\x80+\x0cc_\x9f\xfak\xab\xecv\x91\xbfJ,\xf1\x82\x15\xc3\xe3N-\x15\xff\xb9\x97\xc3KD\xbd\xff\xcfU\xcd3\xb0W\xee/\x17\x0d\xaf\xeb\xa6\xae \xdd\xafU~[L\xdd~[\xc1\x88\x05\xc1\x98V(#w\xeaqg\x8f\xdd\xe1g\xc8\x9a\xeb\xf8\xd2\x18\x94\xe4\xcd\xcd\x0d\xcf\xbeD\x17\xda\xca\xea\xc2\xd6\xc1i\x11\x83k\xf5\x8awE\xf1h\xd4\xfa\xfb\xc1<\x91\xba\xaer'\xbd\xa28\xb3\x8a\xd4Po~'qa\x8aV&\x17P\xc1h\xc2*\xf6\xff\x00PK\x07\x08g\x04\x8e\xd7\x00\x03\x00\x00\x02\x0b\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00common.cssUT\x05\x00\x01\x93\xef\xd3^\xa4\x93]k\xdc<\x10\x85\xafW\xbfbn\x02\x9b`%\x8e\xb3\x0eo\xbc7/\xf4\x03\x02\x0d\x14\x9a\x8b\xf6rl\x8d\xd7\"\xb2d\xa4\xd9\xc4\xdb\xb0\xff\xbd\xc8\xde\xaf\xa4I\x17\xda\x85\x053<sf\xce\x91tq&\xe0\x0c\xbe}\xfd\xf8]~\xd6\x86>\xb8n\xe5\xf5\xa2\xe1{\xea\xb9\x80,\xcdR\xf8\xc4\x0d\x19\xb8s~\x81V\xc0\x9e\xff\xa2+\xb2\x81\xe4\xad\"\xcb\xba\xd6\xe4\x0b\xb8\xbb\xbd\x17pv!D\xe1\x9dcx\x16\x13)K\xac\x1e\x16\xde-\xad\x92\x953\xce\x17\x00M0\xd34\x81\xf4$\x81\xcb4=9\x9dG\xaeBc\xdc\x92w\x10\xbc\xe0n\x06,r\xb5\xf3\xf4\xbe\xdeV\xad\xd1\x8b\xc6D'{\xbdHei\x9e\xc0\xf5\xcdI\x02\xf9@\xae\xc5\xff-)\x8d0\xed<\xd5\xe4\xc3\x88\xcbP5\xd4R\x01\n\xfd\xc3i\xf4\xb13t\xdcQ\x16\x85'G\x1de\xb3\xc8\x89\xc9QK\xff\xed\x04\xdf3\x95\xdf$0\x8b\xa6\xae\xf3\x01]\x8b\xb5\x10\xa5S\xab\xb8\xf9\x1b\xf9?\xa2\x9f\xfen#vn\x89\x83\xdf\x08\xbfN}X\xbdv\x96e\xd0?)n\x02\x97\xb3\x8e\xe7\x9bb\x8d\xad6\xab\x02 \xa0\x0d2\x90\xd7ulh\xb5\x95OZq36d\xd4\xce\xc5\xa4C\xa5\xb4]l\xa6\xa6\xe79\xb5\x07\x07\x13*Od\x01\xad\x82)\x1c\xf4\xcfRja8\x9a\xad\xd1I\x8b~\xa1\xed\xa8\x93\x02.\xd9\xc5\xd8Z\xecw3\xafRjw\xf9,\x99\x9dM \x90\xa1\x8a\x13P\xc4\xa8M\xf8cd/\xee\xe8?\xe7\x95\x1d\xcd\xabt^\x91\x97\x1e\x95^\x86\x02 \xef\xfa\xf9\xb6\xba?\xa5\xe0\x8cVp\xd5\xf5\xefmYk\xc3\x87\x0d\xca\xbbN\x86\x06\x95{\x9a^v=l\xff\xa5\xc1\xea!\xda\x1a\x93\x94\xec\xbaq\xcaU\xd7\xef\xab\xa5cvmL3V\xd7\x9b \x0b\xacX?\x12<\xbf\x1c\xf7\xd6\xa8\xf4<?\x1c\xc6\x1em\xa8\x9d\x8f\x92\xc3\xb7A\xa6\x1fq\xb1\xe1\x81\x8a\x86\x8c\xeeegpet\xe0\xa2\xe8\xd0\xf3\xb4ZzO\x96\x87\x0b\xb0\xb9\xb4\xa3\xf9Wod\x90\x18\x15\x18\xcb\xb0\xe9\x1ew\xfd\xcbf\xc6\xf2\x14\x9e\xc5Z\xfc\x1a\x00PK\x07\x08\xddK\x92P\x1d\x02\x00\x00=\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00@\x8c\xc9P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00	\x00directory-tree.jsUT\x05\x00\x01	\xc8\xdf^\xd4V\xcdn\xdc6\x10>SO1\x87\x02\xa2\x805\x1d\xe4(\xc3	\n'i\x02$m\x91\xb8h\x81 \xb0\xb9\xe2\xac\xc5\x84\"\x15r\x94xal\x9f\xa2@\x8f}\xc5>B1\xfa\xd9\xd5\xda\xeb4@\x0b\x14\xd5e\xb5\x9c\x99o\xc8\x99\xef\x1b\xf1\xf8\x18\xde\xfc\xf8\xe4\x97\xa3g\xd6\xe1Yh\xd7\xd1^\xd5t\x8e\xd7T\xc2\xc3\x07\x0f\x1f\xc0S\xaa\xd1\xc1\xab\x10\xaf\xb4\xcf\x8e\x8f\xb3\xc9\xff\xa5\xad\xd0'<za\xd0\x93]Y\x8c%\xbczq\x9ee\xb6iC$\xb8\x01\x13\xaa\xaeAO\xcf\xa2\xbe\xe2\xdf\x05\xa0\xc3\xe6;\xf4\x185\x85\x08\x1bX\xc5\xd0@\xae\x8ey=\xa9\xf7)?\xd9E\xaf\x90\xaa\xfa\x89\x8dXQ\x88\x16\xd3bX\xf9a\xf9\x1e+Z@\x0c\x81\x86\xf7\x19\x8en\xed\x80\x92U\xc1'\x82\x0b\x0d\xfds\xba\x9fYB\xaes(N&\xafeG\x14\xfc\x01\xaf\xc10w\xb5\xbe\xed\xe8\x10`o\x98{:\xbdDw\xc8\xb37\xecy\xda{6\xe9\xec\xdc-\xd1\xda\xe1!\xc0\xde0\xf7\xec\xdc=\x80\xdd\x90w<7a\xd3:M\x08\xa7wZ%31\xe4\x93p\x99	1\xd4\xa146\xe9\xa5C\x037\x99\x10\x82\xf0\x9a\x8e\x0cV!j\xb2\xc1\x97\xe0\xac\xc7#\xaac\xe8\xae\xea\x93L\x88M&D_\x96\xb7\xb4n\xf1\xb4\xaa\xb1\xfa\xb0\x0c\xd7\xef\xe0W\xe8\xdc\x80\xf1\xc9&\xbb\xb4\xce\xd2\xba\x84\xda\x1a\x83\xfeK\x81e\x0f\x81\xe6\x1e\x80\x1e\xcc\xe1\x88p	\xc5\"\xe3\xc3\xe2u\xcf\xa8\xca\xe9\x94\xe09:{=\xb1j}\x1e\x11\x01\xaf	\xbdI\xf0\xfc\xfc\xd5\xcb\xa7\x0e\x99\xa9\xbc\xb9\xbeF\xb1c\xf6\xc9\x82\x17D\xeaZ\x8c\x92!\x85\xa0\xda&\xa5\x89tU\xbf\xa9\xb5	\x9f%\xdc@\x13\x0c\x96\x90\x87\x16}\x0e\x1bn\xc8\xe8\x98z\x97\xd7!\x90\xd2m\x8b\xde\x9c\xd5\xd6\x19\xb9\xed\x80\xaa\\\xf0\xf8}0(\x81b\x87Ppp&\xc4m\x11\xc8\x82+\xaf\xa8F/\xc1$8}\xc4:K*\x85H\x12$\xe8\x05,\xa1\xe0e\xad\xbcnP\xb9Pi\xd6u\xd3\xea\x88\x12\x96\xfdj\x0f\x0f\x11\xa9\x8b\x1eL:\xe1\xbd\xde\xc1\xbd\xe8\x1c\xbf\xaaF\xb7\x12\xfa\xe3z\xfc\xbc\xad\x9cZZ\xcf\x07\xa8mb4(\xf6\x10:\xc7[\xf8\xe2\xd1;\xc71'\x99\xd8d\xcc5\xf4\xe6\xe9'\xf4$\x817\xb8\x80V\xaf]\xd0\x06\x86\xca\xf7\xbd\x00&\xaa\xc7\xcfp\xd6%\n\xcd\x9e\xfb\x0d\x18$m]\xb9\x0d\x9c\x97\xdf\xd8\xd4j\xaa\xea1\x04\xb7y\xe7'\x920e\x1b\x0bs\xe1\xac\xe4\xaa\x0c\x9a\x97\xbdI\x08kJ0\xaa3~\xc16\xc1\xcc.!\x9f\xb8\x9d\x0f\xabU\xad\xfd\x15\x96\xbc\xe3Gc\x9c\xb0+\x90\x80\x8at\xbcBR\x13\x93\x8b\xc9<\x9eq0\xc3\xe9\xd6\xf3d4\xcf\x86\x9f\x1c70\x9f\x81\xc5\xe86u \xf4\x0d\xe8\xc1\x14\xb7\xde\xd3H\xed=\x02NA\xc2\xa6\xb3\xe0I[\x8f\x91c\x0bx\xbc5\x89\x9e\x08AUL\xd9\x88~\x9f\x10\xc3\x08\xbe\xcb\x86\xf2V\xfcD\xa0\xe9\x04a\xc7\x19~v\xdb\xaf\xb8Q\x12\xb8\x1a\xc1\xa1\xc2\x18C\xec\xfb\xc5\xcf\x06\xd0%\xbcU2&\xdb\xae\xb0\xfb\x87\xfd\xd8a\\\xbfA\x87\xd5\xde\x00\x1c\xc2\xef\x89\x89\xd8\x84O8*\xb4s[\x7f\x1egBl\xfa\x0eox\xb807\xfaa\xce\x0d\x99x\xb8\nq$\x08LNF\xb5\x11\x13z\xea\xe7\xe4O\xaf_\xc2cx\x0b9\xe4\x0b\xb8\xd0\x12\xf2?\xff\xf8\xed\xf7|\x017PG\\\x95p\xd7}\x03\x05\xbc\x83\x12|\xe7\x1c#\xce\xe8;\xaf\xe7\x8c\xbbw\xfb\xb9\xed\xc0-C\xb95\xbc l\xfa\xb5\x1d\xfa-\xdf\xaf\x12\xc7\xe577A\x99iNl\x8e\xf9\xaf5\x9b\xcb\xffR/\xb3\x0d- (k\xfe\xa9^X\x13\x13\x82\x103q\xa4\xd0\xa0\x04\x9b\xb8\x98\xbb\xb2kc\xbeu\x8e\xb3\x14PB\x9e/\x0e\xc6\xfe\xbd\xb0vq\xbb\xd7\x02\xfe\xef\xe2	\x8a,\xb9\x9dz\xeec\xd0$\xa8\x19A\xb7\x9c=\xc8\xcd\xe1\xda2O\xc0iE^9[}\xc8K\x90\xc5\xf635\xff\x02\xe5\xe8?v\xd8a\xbe\xe0\x86\xcd\xf5>e\x9e\xf7\xf3K\x89\xf3\xb7\xa0\x8d\x01\xed\x1c\xbc\xcb\xff\xad\xf4\x9b\xe9\xfe6\x139\x9c\x0e\x13?(K\xd8\x9c\xf1UG%\xd2\x91\xd2\xcf\x96j	y\xe8\x95\xa0\xaaI\xd0\xb3\xeb\xe2H\xd6\xf1\xca\xf8U0\x9cd\xbcI\x0e\xdf\xe2\x811I\x19\\Y\x8f\x12\xf2\x9a\xefYG\xdb&\x1eQD\xae\xe7\x81\xebWq\x92\xfd5\x00PK\x07\x08\xec\x1e\xd69g\x04\x00\x00\x83\x0c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00A\x8c\xc9P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x08\x00	\x00elems.jsUT\x05\x00\x01\n\xc8\xdf^\x8cX]o\x1b\xb7\xd2\xbe\xd6\xfe\x8a\xa7}/VJ\xd7\x92\xec\xde\x04r\x9d\x17\x8e\x9d6\x01\x92&\xa8\x83\xe2\x009AL\xed\x8e\xb4\xac)rKr%\x0b\x86\xff\xfb\xc1\x90\xfb!\xc9Jk\x03\xb6W\xdc\xe1|<3\x9cy\xa8\xc9\x047\x9f\xae\xffs\xf2\xabTte\xaa\xad\x95\xcb\xd2\x7f\xa6{?\xc3\xd9\xf4l\x8a\xd7\xa4\xa9\x90\xb9\xc7[asS[\x9fL&I\xbb\xe9\xbd\xccI;:yW\x90\xf6r!\xc9\xce\xf0\xfa\xe6\xfa\xe4\xec\xe4J\x89\xdaQ\x92\xa4\xb5#8oe\xee\xd3\xf3$\x99\xbcx\x91\xe0\x05H\xd1\xea7\xd2d\x857\x16\xd2A`Q\xeb\xdcK\xa3O\x96\xdd\xfa\xa6\x94y	S\xfb\xaa\xf6\xae\x13p\xac`a,\x1aA\xa9\x97\xb8\xfe\xf8!\xe8$\xed\x1d\xd6R@`e,!7:\x97\x8e \xb5'\xbb\x109\x8d\x13\x04\x07\xae\x84R\x0e\xde\x1cx\xe2*\xca\xe5b\x0b\x01/\x96\xd0bE\x10\xba\xc8`*/\x8d\x16Jm3\x88\xb0\xee*\x91\x13k\x12\xba\xc0V\x92*vb\x80/\x85\xc7F*\xd5:I\xfb.\x9aE\x14\xf1\xdb\xaas\xe9sI\xb0\xe4k\xab\xa9\xd8\xd1$\xee\xc8A\xe8-t\xbd\x9a\x93\xe5\xad\xc2.\xebNO!\x17\x0b\xb2\xa4=;\xc3\xfa\\\x16|\x9a\xd7R\x15\x0eu\x05_R\x0b\x0eD\x9e\x1b[0d\xde\xf0\x8bUk\xfd\xd2Z\xb1u3V\x02\\\xea-DX\x80\xab\xabJI* 9*\xealCI\xe7!,\xc1[\x12\x9e\n\x08\x07Q\x142\x02\x15\xf5\xb4\xc2n\x8c\xcf\xa5t(Y\x06+q/W\xf5\n\x9a\x1c\xef\x0bXX\"(\xb1%\xebP\x10U\xadW7\xdeJ\xbdl\xddj>\x05\xab\xb9\xd1k\xb2\xbc\x9f\x03\xa1{\x0fm\nr\xad\x9b\x85\xc9\x83\xe5\x88\x85(\x8a \x18\xbd\xda\xc1\xa3\xb5\xf3;\xef\x9d\xbci*hr\xe9\xbdm\x8d~\xf4%\xd9\x90\xbd \x94A\xea\\\xd5\x01\xc2\x06TNO\x01\xe1\xbd\x95\xf3\xdaS\xd6c\xc6\xae\x952\x14,\xfa\x94\x86\xba\x98S\xe7\xd5^\x82B\xf1V\x15\xe9\xe2\xaa\x94\xaa\x988\xf2\x97\xad\xe6\x06T\xc7\x02\xd6TVr]\x05\x1b\xb4WI\x1f\xe7\x7fQ\xee\xbb\x00\xe2'l\xa4/!=Y1W\x84;\xda\xba\xce\x93\xdd\x14\xb6\xc6BP\xb4f\x9fV\xa2r\xadn\xe0O\xa1jr\xcd\xe1\xe4T\xb4\x81\xb9\xc3r\x88\xbbK\xa1\x0bE\xb6\xad\x81\xdaQ\x03\xc8\xad(\x8a7,\xf2^:\xcfG\xf06\x83\x8b\xd1\xdc\x1a}\x8bJX\x1f\xab\x83\x1aU|\xf0\xe0JS\xab\x02\xdax\xcc[H\xf6d\xefh\xdb{\x1b\xd3\xb7\x8e>\xb3\x7f\xfc\x9b\x1b\xb2y\xa8\x88\xd0\x9a\xf42\xa6\xf0\x18\x0c.\x0b\xc0\xb5v\x16\xb4\x01\xdd\xe7\x14\xfaA\x8b0Np\xabk\xa5n\x91s\xd3s\xd0\x06\"@\xc2\xd9)C:\xc7\x9d\xa8\xc0\xdc\x18EB\xb7\xd2\xa1\x13\x04\x0fY|Np\xc4\xcd\xc3\x97\xa6\xf6\x10\xcd\x9b\xa1\xb75\x8d`l\xd6(B\xc0\xa5OW\xdcjie\xd6T`\xb8\x10\xca\xd1\xa8\xb7j\x9a*\xe0\xac\x86\xa3\x1d\xadC\xe8>\x9e\x16\xb5O\xd6\xace\xa8p\xee;& \xc8\xf5\xc5\x87K\xe8\xbe\x05\x84\xf2\xb1\xe4j\xe5\x9bW\x9d*\xcc\x89\xf7\xfb\xd2\x9aM\xa7\xf7\xcd\xbdXU\x8ap\x8a\x13\\\xe2\xefZ\xe6w\xa1\x8d\xb0\x93\x17\xdf\xf9i\xb6\xbe\xe3\xee#\x1d(\xaa\xc8\xb0\xa1\xd4\x12\x96\xa6ieyH\x1d\x04~\xa9\xd5\xab\x00\x1e\x16\xa6\xb6\\\xef+Na\x98$\xa5X\x87\x82i\x94\x84\xda\xa2{\x9f\xed\x16\xaa\xd4\xcb\x0c\xceo\xe3\x03\xa3\xc5mJ\xeae\x1bDn\xb4\xf3\xa8\x15.\xf6g\xc70\xadU:\xea%\x94|*\xa1d\x90`\xa1\xb6A\x8d\xe7\xa6\xd8\x8ewN\xfc\x10\xb5\x1a\xb2\x04\xa0\xe4\x10\xe9[R\xca\xa4\x185y\x0fkW\x8a\xa1\xfb@i\x86\x07\xe4\xfca\x06\xc2\xc5+\x08E\xd6\x0f\xd3\xb72\x1d\xe1q\x7f\xcf\xb5\xd1\xffM=\xfem\xeb;8!\xf9t\xa5\xa3\x88\x03\xcd\x90\xe6F\x19;\x83\xa5\xe2<=\xd4{S\xcfqC>\xcd\x18\x93\xc6q\xb4/?\xea\xe8cZ\x08/N\x16\xc6\xa43\xa4\xa7\xbb::\xd1\xcf\x1b\x93\x1eY\xe5\xc9\x90\x82Q\x030\x8a\x0f#\x8c\xce\x13\xbc\x98$t_\x19\xeb\xfb\xce\xba\x9f\x10/\x96\x19\xb4\x1b%\x0f\xc9 \x0eV\x0c1\x1e\x8f\x85]:\x8cp\xf1*\x19<$\x83AL(o\xc5\x05\xb4\xc3\xff\xf7\xb9\x89U\xd5\x8c\x85\xdfo\x86\xd0.\x0b\xcc`\x84\xd9w\xa4\x86\xf1\xfdy\x92\x0c\x06r\x81!~\xc0\x90O\x0cO&\xe7\x85\xce\xc9,\xe2\xb0\xe50\x92Apa\x10\x04.\xf0%J~=O\x06\x83\xc7d0 \xe5\xe8@\x84\x05\xc6\x0b%\xfc\x10?3\x0c,\x98\xc4\xb7\xe3\x85\xb1oD^\x06{!\xbc\xa8<\xb8\x11\x96..\xc0}*\xd8\x8d\xef\x1a`\xd8`\xb0\x18L\xa2\xdb\xb1\xeb\xb3\xf7vw#\x036\xde\x9dNa\xc7\x98[t\x16\x9eb\xdf\x1a=C5\x0f\xd6'\xaa\xf7\x8e\x04{\x7fT\x13\xb7\xa5\xc8\x88Btil\xe7\xe9?k;\xc8\x1c\x93]v\xa11\xf3<C\xb1\x9f\xee\x19\x8a\x83v\xcc\x93\xb5Q\xd5g\xe4\x8e\xb61#\xad\xf0\xe0@\xeb\x97;\xda~\x8d1\xb4\xe5\xdc(\xefv4\x91\x1c\x0c\xcd\xa0;\xebUD\xef\x1b\xff\x0f@\xef\x8d\xf049\xaa~?\xa3Au\x9a>Si\x989G\xb5\xc6\xb1\xd4\x11\x99\xa0\xf8\x99J\xfb\x82\xed\x81\xd8)\xda#\x81\x1e\x82\xfa\xc3\x93|\xfd{\xcc\x9d\x0bG\x9c\xdc\x8f/\x8c8\xa4\xef\xf4Z(Y\x04\xe3\xe1v\xd2O\xe6\x14?\x85x\x7fB\xcac\xb2ez\xbc\xec\xc5rO\xff\xe3\xe1y\xe9\xcb\xf8\x98\x1d\xd9\x0fc\x17\x8c\x1e\xe8f\x93\xa14\xb0\x11.\x1a\xec\xb0\xe1w\xb3\xb0&\xec\xb23\x1a\x1cHZ\x84\x83\xbe\xf3d\xf0x\x9e<\xee\xdd\xdd\xfe\xa0%s\xb6\xe6\xeaV\x92\xaa\xc8\xf6m\xd8\x1b\xd8V`\xaf%w\"\xdc\x0d\xb9\xa53\x85Y*3\x17\n.7\x15\xcfu\xe9Kf\x12\xed\x8d\x0b\x95\xa5\x85\xbc?\xa0\x103\\\xc2[\xb9\x96B\xb5\x0c\x82\xd5\xbd%K\xd80E\x14J\xf5>4\x16Z\xe3\xec_w-c\xbe\x10\xd9\xdf/J\xbe\xda\xa1\xb9\xbba\x0e\x91~K\xb3P\x8a\x19x\xceg\xe0Y\xceX\xb5<\xf3[7\xb5\xf1pdn\xa6x\xecF\xdb\xb7cs\xbd]~\xc6hG\x98\xed\xcdD<\xc4%\xc2u\xa2\xc89\xdc\xfc\xf9[W\x11M\xc0\xc6\xbaf\xc7\x93\x089\xa8\xd2\xfbj6\x99l6\x9b\xf1\xe6\xe7\xb1\xb1\xcb\xc9\xd9t:\x9d\xb8\xf5\x92\xdf6\xff*\xe1K\xfe\xcf\xdci\x0f\x03\xb7^v\x04\xe0\x01kI\x9b\xd7\xe6~\x86\x1f\xa7\x98\xe2l\x1a~\x7f\xdc\xc1\x81\xf5\x0c\xf1\x80b\x86\xf4\xc3\xe94;\x9d\x96\xa7/\xa7\xeb\xd3\x97\xd3\xf2\x84\x1f\xf8O\xca$\xc4\x9a;f!\x96\x8a}\xe6\xc0\x0e\xb0\x82{&\x15S\x96\xdd\xce\x90\xbe\x9c2\xd8=q\xea\x81:N\x18\xdaZ\x1e6\xd81g\xc8\x98'x\xc1<\x81\xd9\x03?\xf5\xbd\x9c\x0f\xd6\x05\x93L]\x98\xcd\x97\xb8)\x9e\xb7\xafO(\x1f\x1a\x12\xc2\xc3~\xf7\x10\xb5#\xe8W+\x96\xe1\xc4~\xef u\x85z\xdd\xec@\xbb\xa5\xbd\xeb\xb6g\xd2\x8d\xffr\xb1\xf2\x8e\x1c\x96\xab\xday\xb3BCe\xe0iU)\x11n\x94\xbc\x1d\x0d\xab\xfdV\x9e>\x8d -O\x9b,\xf7rN\x19\x7fD\x92\x97\xf7*\"\x8a\xb7\xd6p\xf1$\xee\xae^\xbe\x95\xa7C\xa4\xdc\x0f\xbc\xf4\xaa\xa7|\x88\xc6\x86-3\xdcU\xae\x84s\xf8\xb0\xfd$\xacXZQ\x95\xa0{O\xbapx\xfb\xf9\xc3\xfb6\xd4\x87\xd6B\xf0\xc5\xd6\xb97v8\xea\x97\xc1\xd7u\xb2\xc36B\xfe\xe1K\xfbXx/\xf2\xf2\xa6\x14\x85\xd9p\x95\xadL\xc1Uh*\xd2\xa1\x0c\x0f\xe5]\x90\xfc\xc3\x18\xbf\xcf]\xda\xe8\xc7\xb92\x9a\"\xd1\x88\xe3\xb7C\x15x\x8c\x0f\x8f\xe7OR\x17\x88\x0b\xdfrV\xb5\xf2\x92\xafO\xf1\x8b\x0e\xfe\x1a\x00N\xea\xa5\x8a\xdf-\xc5\x0bg\x0f\x0eG\xcb=\xe5H\x9a\xba\xd6\xd5%4\\\x93\xfe)=_p\x9a\xe1,\xc3\xcf\xf8:^\x89j\x88\x82\x8f@hY\x05\xd7\xf6\xd3\xec(j\xbe#\xea\xb5\x8e\xff\xae\xc9noHQH\x02\xd2\xff\xe3\xde\xdd\xfb\xc2\x9f\xf6\xb1\x8b~}\x87\xed?\xa9\xa5\x9e\xdc\xf3\xa1\x8d\x91-\x9a\xd3\xc2\xdc\x9e6\xb8>\xdc\x13\x98\xfas\x88\xfaCr\x8c\xa6?&\xcd\x98~H\xbeO\xd1\x1f\x93\xe4(A\xef\x9c\xdb\x0f\xbac\xa1\xdd\xb5\xa5\x95;O\x1e\x93\xff\x0d\x00PK\x07\x08\x949\xe6\xd8Y\x08\x00\x00\xb0\x15\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00df\xd0P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00helix-trans.pngUT\x05\x00\x01=\xc0\xe8^\x00\x836|\xc9\x89PNG\x0d\n\x1a\n\x00\x00\x00\x0dIHDR\x00\x00\x00\x8c\x00\x00\x00\xe6\x10\x06\x00\x00\x00W?\xb1A\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\x0b\xfca\x05\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\xff\xff\xff\xff\xff\xff	X\xf7\xdc\x00\x00\x00	pHYs\x00\x00\x00`\x00\x00\x00`\x00\xf0kB\xcf\x00\x00\x00\x07tIME\x07\xb2\x01\x01\x00\x00\x01i\x0df\x9d\x00\x005rIDATx\xda\xed\x9dytdW}\xe7\xbf\xf7\xbe\xf7jQ\x95\x96\xd6\xbe/\xdd\xea]\xbd[\xed\xad\xdd\xb6\x1b;\xb8C \x9c\x93!$\xc1\xc0\x0cs\xf0\x90v\x02'!q\x1c\x98\x19\x08\x8b3\x9c\x840\xb6\xe7\xe40I \x80m2\xc3\x01C\xc0`{\xb0\xd3\xc6t\xb7C\xbb\xf7U\xad^\xa5\xd6ZRI%\xa9T%U\xbdw\x7f\xf3\xc7\xd5\xb3\x9an\xa9\xb5U\xd5{U\xba\x9f?\x90i\xa9\xeb\xde\xaa\xae\xfa\xeaw\x7f\xf7\xf7\xfb\xfe\x18\x11\x11\x11\x14\n\x85\"\xe5p\xa77\xa0P(r\x17}\xb1\x7f\x91(\x91H&\x89\x84\x08\x85\x86\x86\x00\xa2h4\x1e\x9f\xfe>c\xc1\xa0\xdf\x0fp^^^\\\x0c0\xe6\xf1\x18\x06cN?a\x85B\x01\x8c\x8c\x98&@t\xfcx4J\x04twON\xde\xf8\xfd\x9a\x1a\xaf\x17\x00\xb6m\x0b\x06\x19\x03\n\x0bu\x1dX\xf8\xe7w\xde\x02C49\x99H\x10\x99\xc9S\xa7.^\x04,\xeb\xda\xb5\x9e\x1e\x00L\x08!n\xf7\x179\xe7\x1c\xd0\xb4\xc6\xc6\xeaj\"\xdd\xd8\xbcy\xf5j\x801\xaf\xd7\xe3Q\x82\xa3Pd\x82HD\n\xca\xdf\xff}O\x8f\x10\xc0\xcb/\x87\x07\x89\x80d\xf2\xf6\xf9\x11\xc3\x90\x1f\xd1\xbd{KJ\x19#\xda\xb7\xaf\xba\x9as\xa0\xa8h~\x82\xc3\xe6\xca\xc1\x10\x8d\x8cD\xa3D\x89\xc4\x9bo\x1e=\x02\x10\xc5b\x13\x93s=\xecm\x16dyy>/\xe0\xf1\xec\xde\xbd\xe3\x0e\x80\xb1\xc2B\xa9\x91\n\x85\"\xd5\\\xbe\x1c\x8f\x13\x11\xfd\xc9\x9f\\\xba$, \x14J&\xb1\x84O[y\xb9a\x80\x80\xaf}\xad\xb9\x99k\xc0\xaaU~?c\xb3\x7f~g\xcd\xc1\xd8\x11K\xaa\x84e\xfaq\xe5\xe3L?\xae\\'\xbd/\xb3B\xb1\xbc\xb0#\x96T	\x8b\x8d\xfd8\xf6\xe3\xda\xeb\xcc\xf6\xf3\xb3\n\x8c}\x14J\x95\xb0\xdc\x8c\xfd\xb8\xf6:\n\x85\"u\xd8G\xa1T	\xcb\xcd\xd8\x8fk\xaf3\x1b\xb7\x08\x8c\x9d\xbc}'\xc7\x92f\xecu\xecu\xd3\xbf\xa2B\x91\xbb\xd8\xc9[;\xc7\x92n\xecu\xecuo\xfe\xfe-\x02c\xdf\n\xcd\x99\xbcM\x15S\xeb\xbc\xb3\xaeB\xa1X4\xc7\x8e\xc9[\xa1\xb9\x92\xb7\xa9\xc2^\xc7^\xf7ff\x88`\xc6\xc6b\xb1\xcc\xbf0D\xd1\xa8\x13\xeb*\x14\xb9DW\xd7d\x1a\x12\x1a\x8b_w\x86\x1c\x8cS\x95\xbd\xc9\xa4i:\xb1\xaeB\x91;\x8c\x8f[\x96\x13\x9f\xdf\xd9V\x9dA`t]\xd32\xbfA\xc0LZ\x96\x13\xeb*\x14\xb9C<\x9e\x91\xc4\xc6-\xf8\xfd|\xc6\x0b\xa3[\xfe\x901g\x04\x86\xc8\xb4\x94\xc0(\x14K\xc3\xf5\x02\x03h\x9a3\x11\x8ce*\x81Q(\x96\xc6\xc4\x84\xe5H\xa2\xc1\xe7\x9bY5\xdc\x14\xc1(\x81Q(\x96H<.\x1c\xc9\xa0\xfa|\xf3\x8e`\x1c\xca\xc1P<\xeeL\xfe[\xa1\xc8\x1d\xc2\x83\xc9\x84\x13\xeb.\xe0\x88\xe4\xf1\x18F\xe67H\x88\x8e\xabkj\x85bit\xf7$\x92\xe4@\x16\xa6\xb0p\x9eG$\xce\x83\xc1\xbc\xbc\xccoPV\xf2\x02\xf2\xbaZU\xf4*\x14\x0b!\x1a\xb5,\xd9\x9al\x9a\xe9h\x0d\x98\x8b\xaa*\xafw\xa6\x96\xc7\x19\"\x18\xc3\xd0u\xc6\xa4\x7fK\xe67*\xc4\xf8\xf8\x8d\xbe2\n\x85bnzz&'\x9d\xf8\xb5\\T\xa4k\x00\x90\x9f\xafi3\xd97\xcc\xda\xec\xc8X0\x98\xe7\xcf\xfc\x86\x89\xc6\xc6\xc6\xc73\xbf\xaeB\x91\xcd\\\xbf\xeeL\x06\xb3\xba\xea\xf6\xaeN\xb7\x13\x98\x80\xdf\x11\x81\x19\x1c\x88D2\xbf\xaeB\x91\xcd\x9c:5>\xeeD\x04SS+\x9d\xeff\xe36\x02\x13\x08\xe6\x052\xbfa!\x06\xc3\xc3\xc3\x99_W\xa1\xc8fN\x9e\x8cF\x9dX\xb7\xa6\xc6\xeb\xbb\xdd\xf7o#0\x85\x85\xc1`\xe67,\xc4\xf0\xd0\xd8\x18\x00\x98\xa63]\x15\nE\xf6 +w\x89.^\x8c\xc7\x9d\xb8=jj\xf2\xf9\x16uD\xe2\xbc\xb4\xb4\xa8(\xf3\x1b\x06\x08D\x80\x10\xe1\xb0:*)\x14\xb7\xe7\xcc\x19y4\xb2,\"'n\x8f\xb6l\xb9\xbd\xe1\xed\xed\x8eH\x01\xe9\xb6\x99\xe7\xf7\xdd6\x08J\x0f\x96\xd5\xdd\x1d\ne~]\x85\"\x9bx\xf3\xcdH\xc4\x898\xbf\xacLz\xf3VVz<\xb73\xff\x9es.\x12g\xceD2B\\\xef\xec\xef\x07\xa4}\x84:*)\x147\"[\x1a\x89\xf6\xff[d\xd8\x89O\xc7\xb6m\xc1\x00\x9b\xc7T\xb5\xb9\x05F+-[\xb1\"\xf3O\x80hbrr\x12\x10b`@%}\x15\x8a_\xe7\xd8\xb1\xb11\"`0\x9ct\xa4\xb9q\xf3\xe6`\xfe|f\x81\xcc)0\x8c\x959\"06\x96\xd5\xd9\xd9\xdb\xeb\xdc\xfa\n\x85\x1by\xed\xb5aG\"\x17\x9b\xcd\x9b\xe7w\x054w\x04\xc3\x8b\x8a\xf2\xf3\x19c,\x90\xe7w$\x17\xd3\xd1\xd1\xdb\xabL\xc1\x15\n\x00\x18\x1b\x93-\x01\xaf\xbe2\x14v\xe2\xd6\xa8\xa2\xdcc\x00\xc0\xea\xd5~\x7fJ\"\x18\x1bM\xab\xad\xab\xa8\xcc\xfc\x13\x92\xd7\xd5\x80e]\xbat\xfd\xba\x13\xeb+\x14\xee\xe1\xfb\xdf\x1f\x18 \x02\xe2\x13\xc2\x91[\xa3\x87\x1e^Q\xcc\x98\x9d\xd6\x9d[b\xe6-0\x9c\xd7\xd7W:\"0\x12\xcb\xba\xd8\xdeqM\xfe\x97\xaa\x8fQ,7d\xf4N\xf4\xfd\xef\x0f\x0c\x08\x07}\x93\x1ezh\xc5\x8a\x85\xcca]\x80\xc0\x14\x17\x17\x162\xc6\xe0T\x8f\xd2\xc4d\"y\xc3Ll\x85b\x19\xf1\x93\x9f\x84\xc3D@8\x9c\x9eAjsQS\xed\xf5\x00\xc0\xbauyyi\x11\x18\x1bM\xaf\xab\xab\xac\xca\xfc\x13\xb41\xcd3\xa7/]\x04\x88\x94\xad\x83\"\xf7\x19\x1f\x979\x97o~\xa3\xb7\xc7\x19\xb7]\xc9\xc3\xbf\xb1\xb0\xa3\x91\xcd\xc2\x05Fkh\xa8\xaa\x02@p\xe4\xc3mG2\xa6y\xe6\xf4\xa5KN\xec@\xa1\xc8\x1c\xff\xf0\x0f\xbd\xbdB8w\x1d\xcd\x00\x80\x80\xdf\xf8\x8d\xe2\xe2\x85D.6\x0b\x16\x18\xd9\xa3\xc4\x18\xd7\xaa\xaa\xcb\xca\x9cx\xca\x12\xcb\xbcx\xb1\xb3\x03 \x8aDdE\x80B\x91;\\\xb921AD\xf4\x83\xef\x0f\x0c8q[ds\xcf\xbd\x85\x85\x8c\x01+W\xfa|\x8c-\\b\x16,06\xba\xben]c\xa3sO\x1cL\x86P\xc9\xe4\xd1\xa3\xe7\xce\x01\xaa\xe2W\x91\x0b\xc8\x0b\x0c\xa2\xbf~\xaa\xa3\x83\x04`:\xd4cd\xf3\xa1\x0f\x95\x97\xcf\xa7bw6\x16\xfdW9///.f\x8c\xf3\x92\x12g\x9a\"%B\x0c\x0eF\"\xf2\xc8t\xf9\xb2s\xfbP(R\xc1?\xfe\xa3<\x12\x9d>3>\xee\xe4o\xcb\x0d\xeb\xf3\xfc\x8c\x01\xdb\xb7\xcb*\xb8\xc5>\xce\x12\xb4I\xa2i\x0eG2S\x98\xc9\xf3mW.\x03B\xf4\xf5\xc9|\xbbB\x91=\x1c=:6FD\xf4\x9d\xef\xf4\xf7;y$\xb2\xf9\xc8G+\xab\x16/+\xd3\xa4@`jj\xca\xcb\x01\xc6\xf2\xf3\x03\x0e\x98\x85\xbf\x03\x93\xe7\xa3d\xe2W\xbf:u\x12 \x8a\xc7\x9dq)U(\xe6\xcf\xe0`2	\x10\xfd\xb7\xffz\xed\x9a\xb0\x00!\x9c=\x125\xd4\xfb| `\xf7\xee\xa2\"W\x08\x0c\xc0\x18c\x8c\x19\xc6\xf6\xed\xeb\xd6;\xf7\xc2\xd8\x10&&\x12I \x91x\xf3\x17G\x8f\xaa\xebl\x85;\x89\xc5\xe4\xf5\xf3\x9f\xfd\xd9\xe5\xcbB\x00C\xc3\xce\xd4\xb7\xdc\xcc'?US\xc35@N9Z\xba\xc4\xa4@`\xa6\x1e\x88WV\x96\x962\xc6yu\x95\x93\xb7K6D\x91\x91\xb11 \x99<p\xe0\xf8q\x00\x10B\x08%4\ng\xb1+r\xff\xf2\xc9\xabW\x85\x00\xda\xdab17\xbc+w\xed*,`\x0c\xb8\xf7\xde\xc2\xc2\xa5\xe4\\n&e\x02cc\x18\xdb\xb6\xaf[\x07\x00\x1a\xe7.Pd!B\xa1\xa1! \x99<t\xf0\xe4I@\xdd6)\x9c\xc0\xf6o\xf9\xfc\xe7\xaf]\x13\x02\xf8\xd5\xe1\xd1Q7\xbc\x0b\x0dC~H?\xf9\xc9\xda:\x9er5H\x83\xc00\x16\x0c\xe6\xe51\xa6\xebk\xd76\xadL\xfb\xeb3o,\xab\xbb'\x14\x02\x92\xc9\xc3\x87\xcf\x9c\x01\x94\xd0(2\x81}\xed\xfc\xe5/wt\x08\x0bx\xfdugm\x16n\xe6\xd1G+*\x19\x03\xea\xeb\xbd\xdeT\x1c\x89n&\x0d\x9a%\xd1\xf5\x0d\x1b\x9b\x9a\x00\x86@\x9e\x13\xe3Of\xc3\xeeeJL\xdaG'\xd5<\xa9H=\x93\x93\xd2\x8c\xfb/\xfe\xe2\xcae!\x80\x9f\xfe4\x1cv\xd3\x9b\xac\xba\xca\xe3\x01\x80\x8f~\xb4\xb22\x1d\x91\x8bM\x1a\x1fZ\xd34\x8d1\xc3s\xcf\xbd[\xb6\x00\x00gn82\xd9\x08\xea\xe9\x19\x18\x00\x12\x93\xfb\xf7\xbf\xfd\xb6\xf2\x9bQ\xa4\x06\xdb\xaf\xe5S\x9f\xbctI\x08\xe0\xc0\x81\x11W\x1c\x85l4\x8d1\x10\xf0\xf9\xbfjl\xe4\x1c\xf0\xf98OG\xe4b\x93F\x81\x99Z`\xaa\x0b[\xd77mn^\x9d\xee\xd5\x16\x8e\xa0pxd\x04HL\xfe\xdb\xbf\x1d>\x0c\x10E\xa3\xeeH\xbb)\xb2\x89\xae\xae\xc9I\x80\xe8\x13\x9fh\xbf ,\xe0\xc4\xc9h\xd4\x8d\xef\xa2O|\xa2\xba\x9ak\xd2\x91.\x95\xc9\xdc\xd9H\xbb\xc0\xd8\xe8\xfa\xbauMM\x8cq^S]^\x9e\xa9U\xe7\x0fad$\x1a\x05&'^}\xf5\xad\xb7\x00\xcb\xba~\xbd\xaf\xcf\x8do\x11\x85\x9b\xf8\xe5/GF\x88\x88\xfe\xd3\x7flk\xb3,\xe0\xf2\xe5\xf8\x84\x1b\xdf4w\xdf]P\xc0\x00<\xfahEE\xfaee\x1a\x96\xe9T\xa7}\x14I$^}\xf5\xd0!\x80(\x16\x9b\x98\xc8\xdc\xfa\x0bE\xd3V\xad\xaa\xad\x05\x0cc\xfb\xf6\xf5\xeb\x01\x80s\xce3\xf9O\xa4p\x13v\xd2\xf6\xeb_\xef\xe9\x11\x02x\xfe9YyK\x00\xdcP\xc7r3\xf6x\x91\xe7\x9e[\xbf^\xd3\x81\xa2\"]O\xe7\x91\xe8f2.06B\x0c\x0e\x0e\x0f\x13%\x12o\xec?\xf26\x00XB\xb8Q\xfa\xa7\xb0{\xae\x0c\xe3\xce;[Z\xa6*\x97\x03Jh\x96\x0b\x1d\x1d\x13\x13\x00\xd1\x17\xbf\xd0\xd1!\x04p\xe6\xac3\xb3\xa0\xe7\x8b\xc7#S\xb7\xcf>\xdb\xdc\xcc\xb9= -\xf3\xefW\xc7\x04\xc6F\x88\xee\xeeP\x88(\x918x\xe0\xc4	\xc0\x9e\xec\xe8ZH\xc6/\xba\xb1vmc#\xa0\xeb--\xcd\xcd\x80\x8alr\x0b;R\xf9\xeewC!\xa2\xe9&\xc4D\xc2I\xdb\xa7\xb9\xe1\\&q\xbf\xf4\xa5\xa6&\xae\x01{\xf6\x14\x159!,6\x8e\x0b\x8c\x8de]\xb9\xd2\xd5E\x94L\xbe\xfd\xf6\xd9\xb3N\xeff\xfe0VX\x90\x1f\x04\x0c\xa3u\xe7\xc6\x16\x19\xe9H\x07\x0dE6\xd2\xde\x1e\x8f\x13\x11=\xf5TG\x07\x91{*m\xe7\xcb\xa7?]W\xc79\xf0\x81\x0f\x94\x959),6\xae\x11\x18\x1b\xd3<{\xf6\xf2e\"\xd3<s&\xab\x1c\xeb\x88\x81\x01\xd0\xf4U\xcd\xb5u\x80\xaeo\xdc\xd8\xdc\x0c0\xe6\xf3y<\xce\xffC+ffhH6\x1b\xfe\xd3?\xf5\xf5\n\x01\xfc\xe8G\x83a\x12\xce7\x1d.\x94\xc7\x1e\xab\xaa\xe2\x1c\xf8\xd8\xc7\xaa\xaa\xdc ,6\xae\x13\x18\x9bd\xe2\xd8\xb1\xf3\xe7\x89,q\xf1bg\xa7\xd3\xbbY\x0c\xba\xa6q@\xd7\xd7\xadoZ	\xe8\xba<R\x01\xba\xaei\xeey\x03,7\xe2qY\x00\xf7\xc2\x0b\xfd\xfdD\xc0\x0b\xcf\xf7\xf7	\xcb\xb91 KEF*v\xe4\xe2\xbe\xf7\x95k\x05\xc6\xc64O\x9elo'2\xcd\xb6\xb6\xabW\x9d\xde\xcd\xe2a\xcc\xe7\xf5z\x01]\xdf\xd8\xb2j\x15\xa0i+W\xd6\xd6\x02\xf2\xd4\xec\xbe7F\xae ;\xe9\x89~\xfc\xe3\xc1A\"\xe0\x1b\xdf\xe8\xeb\x13\x96s\xee\xfc\xa9\xe2\xc3\x1f\x96\xd7\xcd\xfb\xf6\xd5\xd4L\x97\xca\xb9\xef}\xe4z\x81\xb1\xb1\xac\xf6\xf6\x8e\x0e\xa2d\xe2\xc4\x89\x0bmx\xc7\xff%[\xb1\x05G\xd3V5\xd7\xd6\x02\x9a&#\x1c\xc6\x0cC\xd7\xdd\xf7F\xc9\x16\xec\x08\xc5\x16\x94\x7f\xf9\x97PH\x08\xa0\xaf/\x91pzoK\xc16\xdf\xde\xf7\xb8\xb4S\x90\x02\xe3\xfe\xf7I\xd6\x08\x8c\x8deut\xf4\xf4\x10%\x13SM\x8b,GL\x18\xc8\xd0u\x0d\xd0\xf4\xa6\x955\xb5\xd3\x9e\xc7\x8c\xf9\xfd>\x9f\xfb\xdfHN1<l\x9a\x00\xd1\x0f~00 \x04\xf0\xbd\xef\xc9\xc9\x87\xa3\xa3\xa6#.\xfc\xa9\xc6\xbe\x15z\xf2\xc9\xfaz\xae\x01\xef{_II6\x08\x8bM\xd6	\x8c\x8d\x10\xbd\xbd\x03\x03D\x89\xc4\xa1C\xd2\x86A\x8e\x98\xcd\x1d\xa4\xdd\x85\xc6\x1b\x1a\xabk\x00M\x97\x05\x7fv\xeb\x85\xd3\xbbs\x8as\xe7b1\"\xa2\x1f\xfdHF(\xaf\xbc24D\xe4\xfe\xeb\xe3\x85\xe2\xf7\xcb\xce\xbd/\x7f\xb9i%\xe7\xc0=\xf7\xa4\xd6\xa7%Sd\xad\xc0\xd8\x10\x8d\x8e\x8e\x8f\x13%\x12\x87\x0e\x9e8\x01\x10\x8d\x8cF\xa3N\xef*}0V\x90\x1f\xc8\x034\xad\xb1\xa9\xa6V\xe6rjj\x00\xc6\xbc\xde\\\xba\xad\xb2\x9b\x06m{\x83\x17\x7f08@\x02h\xbf\x18\x8bg\xf1\xdbuN\xea\xeb\xbd\x1e\x10\xf0\xd4S+Wq\x0dhn\xf6\xfb\xb3QXl\xb2^`\xa61M\xd3$J&\x0f\x1f>{\xd6\xee%rzO\x19\x808\xe7\x1c\xd0\xb4\xda\x9a\xf2ry\xc4\x92\x91NEEq1`[\x9a:\xbd\xcd\xd9\xb0\x0b\xda\x8e\x1c\x91\xd3\xad^z)<H\x04\xbc\xf1F$B\x04$\x939\xf1\xe6\x9c\x93w\xbdK\xce|\xfe\xecg\xeb\xeb9\x07\xf2\xf24\xcd\x8dI\xdb\x85\x92C\x02\xf3\xebX\xd6\xe5\xcb\xb2p\xef\xd8\xd1\xf3\xe7\x00@\x90\x9b[\x11R\x0dc\x1e\x8fa\x00\x9cWW\x97\x95\x02\x9a&G\xfer^YYR\x02d\xba\xf2Xf\xca\x88N\x9f\x96%\xf6\xaf\xbf\x16\x19&\x02^{m8B.\xf2\xa4\xcd\x14\xb6m\x82\xdd\xdd\x9c-I\xdb\x85\x92\xb3\x02c#\xc4\xd0\xd0\xc8\x08Q2\xf9\xd6\xa1\x93'\x00\xa2\xe8x\xdc\xc5\xcd\x95\xe9\xc70t}z\x1a\x84\xa6\xd5\xd6VT\xdc(<\xd2\xc7g\xb1\x8f>1!oq\xde~[F$\xfb\xf7\x0f\x0f\x93\x00~\xf9\xe6\xc8\x08\x01\x18\x8b\xe6V\xa6l\xa1\xd8C\xe4\xbf\xf8\xa5\xc6&\xce\x81\x0d\x1b\x02\x81\\\x14\x16\x9b\x9c\x17\x98i\xa4s\x9d\xac\x14\x06\xccd\xdb\xf9kW\xf1\xce\x84H\x05\x03c\x00g%%\x85\x85\x00\xd7\xaak\xca\xcb\xa7\x85\x87\xf3\xa2\xa2\xfc|\xc0>ruwK\xff\x13[H\x0e\x1c\x18\x89\x90\x00\x0e\x1f\x1e\x8b\x12r/\xe9\xbaX8\x03@\xc0\xfb~\xbb\xb4\x94q\xe0S\x9f\xaa\xad\xe5\x1c\xf0\xfb\xd3k\xf4\xe4\x16\x96\x91\xc0\xfc:\xd3\x91\xcd\xdb\x87\xcf\x9e\x9d\x9eB\xa0\x98\x99\xf1\xa8ax\x0c\xe0\xc8Q\xbf\xbf\xa4\x148x\xd0\xef/.\x06\x8e\x1e\xcd\xcb+.\x06\xc2a]\xf7z\x9d\xde\xa5{hn\xf6\xfb\x18\x80\xcf|\xa6\xa1\x91q`\xc3\x86\xbc\xbc\\\x8eTfc\xd9\n\xcc4\xf2\x150\xcd\xb6\xb6k\xd7\x00\xd3<{\xe6\xd2E\xc0\xed\xf6\x11n\xa3\xa7\xc70\xfc\xfei\xc1\xb1\xbf\x1e9\x92\x97WR\x02D\xa3\x9c\xeb\xba\xd3\xbbL\x1f\xfaTN\xe5\xf7\xff@\xcer~\xec\xb1\xeaj\xce\x01\xc3`l9D*\xb3\xa1\x04\xe6&\x88\xc6\xc6b1\"3y\xfaT{\xbb\xbc\x8d\xea\xef\x07\xc0\x96S\n2u\x98\xa6\xfc\xbd}\xfe\xbc\xd7[X\x08\x1c=\x1a\x08\x14\x17\x03\x87\x0fK\xe1ik\xf3z\x0b\n\x00!\xb2\xeb\xf7\xbb]Y\xfb\xe0\x9e\xa2\"\xc6\x81?\xfcCY\xb2_W\x97\x1ew\xfelE	\xcc\x1c\xd8G)\xd3<y\xb2\xfd\xc2\xd4\x9c\xa5a\xa7w\x95;\x8c\x8ern\x18\xc0\xb1c2\xe2y\xeb\xad@\xa0\xb4\x148p \x18,+\x03\xc6\xc7\xdd\x15\xf9\xb4l\x946c\x7f\xf4\xc755\x8c\x01[\xb7:c\xe4\x94-(\x81Y B\xf4\xf4\x0c\x0c\x10%\x93'O\xb4\xb7\x03D\xa3c\xb9\\\xd8\xe7\x14\xc9$c\x9c\x03\xc7\x8f\xe7\xe5\xadX\x01\x1c<\x18\x08\x94\x95\x01\x87\x0e\xe5\xe5\x95\x96\x02\x03\x03\x86\xe1\xf3\xa5\x7f\x1fMM>/\x08x\xfc\xf1\x9aZ\xae\x01\xbbvegE\xadS(\x81Y4\xf2\x95\xb3\xac\x8e\x8e\xde^\xc04\xcf\x9f\xbbzU	N\xba\xb1\x8fRg\xcf\xca#\xd7\xfe\xfd\xf9\xf9\x15\x15\xd3_\x87\x875MN\xfcY\x1c\xb6\xa0<\xfahE%\xd7\x80\xbd{\x8b\x8b\x19\x9b\xea	RG\x9f\x05\xa3\x04&\xc5\x08\xd1\xdf\x1f\x0e\x13\x99f\xfb\x85\x8e\x0e@\x88\x9e\xde\x81\x01\xa7w\x95\xfb\xd8\xc2s\xee\x9c\xcc\xe9\xbc\xf1\x86\x14\x9c\xd7^\x0b\x06++\x81HD\xd7g\x12\x1e9\xbe\x03\xf8\xe0\x07\xa5\xaf\xca\x83\x0f\x16\x15)AI\x1dJ`\xd2\x8ce\x85\xc3\x91\x08\xd1\xc0\xc0\xe9\xd3\xd7\xae\x01\x85\x05\xa1\xfeP\x08`\xdc\xe5\xde\xc39\x82\x9dd\xfe\xf7\x7f\x97G\xac\xce\x8e\xaa\xca\x9a\x1a\xe0\xce\xbb\xd6\xac--\x056n\x0c\x06\xddh\xd4\x94+(\x81I1==\x89\x04@\xf4\xd2K\xe1\xb0\x10\xc0K/\x0d\x0e\x92\x00B!Y\n_[\x9bH\xc4b\xc0o\xfd\xd6\xe8hO\x0f\xf0\xeew\xcb\xaf+VXVv;\x96d\x17\x8c\xe5\xe5\xf9\xbc\x80\xa65M5\x8d65\xc9\xa6\xd1@\xc0\xefW\x82\x93*\x94\xc0,\x12Y\xa7J\xf4\xe6\x9b\xb2)\xef\xc5\x17\x07\x07\x88\x80#o\x8f\x8e\x91\x00\x04a^srt]\xbe\xfew\xdf=>>0\x00\xbc\xe7=##==\xc0\xce\x9d\xb1X8\x0cp\x0e\x15\xe9d\x02\x92\x99[\xce+*\x8aK\xa4=F]\xddtK\x85\xdb\x9bF\xdd\x8a\x12\x98yb;\xa5\xfd\xe4'\xd2\x87\xe4\xff\xfe\x9f\x81\x90\x10@w\xcfdZ\xe2\x8e\xb2\xb2drb\x02\xd8\xbbwl\xac\xa7\x07x\xe4\x91\xd1\xd1\xde^\xa0\xba:\x99\x8c\xc7\x9d~5\x96\x0f\x8c\x05\x03~\x1f\xa0\xeb\xab\xd744\xdehu\xaa\xbc\x95\xe7\x83\x12\x98Y\x08\x87\xa5\xdb\xfc\x0f\x7f88\xe8&\xa7\xb4\xa6\xa6\xc9\xc9\xf1q\xe0\xfe\xfb\xa3\xd1\xfe~`\xcf\x1e\xf9\xb5\xbe>\x91\x18\x1fw\xfaU[\x06\xdc\xe2<h[\x9d\xe6\xe5)\xe7\xc1[Q\x023\x85\xf4l%\xfa\xe67\xfb\xfa\x84\x00~\xf6\xd3p\x98\x04`Z\xd91\xbe\xa2\xb9yr2\x1a\x05v\xef\x8eFC\xa1i\x01jhP\xc2\x93V\xa6\x06\xf1iZccM\x0d\xa0\x1b\x1b6\xac\\\xa9r96\xcbV`\x06\x07e\x84\xf2\xadoIA\xf9\xd7\x7f\x959\x94\\38\xb2\x05f\xe7\xce\xf1\xf1p\x18hm\x8d\xc7\xc3a`\xf3\xe6X,\x12\x01|>\xa2\xe5m\xa0\x90j8\x93V\xa7S\xf3\xb1\x8c\xf5\xeb\xa5\xe0\xf8\xfd^\xef\xf2\x13\x9ce#0\x91\x884\x87\xfe\xf6\xb7\xe4\xd8\x8a\x17\x7f(\x05er2;\xe7\xe1,\x15\x8f\x87H\x08`\xd3\xa6x<\x12\x01Z[c\xb1\xa1!\xe0\x8e;b\xb1\xc1A`\xd5*\x19\x11-\xbf\x8fD\x8a!mj>Vssm\x1d\xa0\xe9\xb6\xe0\xe4\x96\xc5\xe9l\xe4\xac\xc0\xd8V\x8c/\xbe(\x93\xb2\xff\xf0\xbf{z\x84P\x86G\xf3\xa5\xb8\xd8\xb2&'\x81\x1d;\xa4\xf0\xdc{O\\\nPk|bx\x18\x08\x06\x13\x89\x89em\xdc\xb5X\xa4\xe1\x97al\xda\xb4z5\xa0i\xcd\xcduu@\xae\xdeR\xe5\x9c\xc0\x1c?\x1e\x8d\x12\x11}\xf5\xab\xd7;I\x00\x97.\xc5'r\xe8\xe9\xa5\x0d\xdb\xc5\xbe\xa5%\x10d\x0c\xd8\xb9\xb3\xa0\x801\xa0\xb5U\xdaL\xad]\x9b\x977]\xdb\xca\x18Q4\x1a\x8f\x13\xc9\xcae@\x88\xfe\xbe\xa9\xaf\xa1p\x18 J$\x92I\xa7\x9f\x95\xfba\xac\xa0 \x10\x00\x0cc\xdb\xb6\xf5\xebm\x83\xaf\xdc\x11\x9a\xac\x17\x18\xfb\xb6\xe7\xe9\xa7\xbb\xae\x0b\x01\xfc\xfc\xffI\x8fW\x02\xe6U\x87\xb2\xdc\xb0{mv\xed*,b\xdcn\xde\x03ZZd\x97\xb0\xbcz]\xca\x1b\\\xbe\xa3\x84\x08\x87#\x11\xbb9\x14\xb0\xac\xee\xae\x81\x01\xd5\xab5+S\xc6\x8a\x9a^\xdfPU\x05\xe8\xfa\xb6mk\xd7\xca\xd9\xe6\xd9\x9c\xbb\xc9Z\x81\xd9\xbf?\x12!\"\xfa\xcaW:;\x84\x00\"\x11S\x1d}0=\xa8\xab\xa5%\x10`\x1c\xb8\xef>) \xf6\xd7\xc6F\x9f\xcf\xc9\xcc\n\xd1\xf8\xb8\x8c|\xfa\xfa\xc2a\xc0\xb2z\xbaC!@\x88\xbe\xbe\xf0 \xb0\xdc\xcc\xd9g\xc7>J\xed\xd8\xb1a\x03\xa0i\x0d\x0dUU\xd9'4Y#0\xd1\xa8\x9c\x93\xf3\xd5\xbf\xbd\xde),\xe0\xe5W\x86\x86\xb3`\xdbi\xc3v\xa5om\xcd\x0f2\x06<\xfc\xf0\x8ab\xc6\x81\xdd\xbbe\xb3^~~v\x8d\xbd J&M\x93H\x88\xae\xae\xfe~\xc0\xb2\xaew\xf6\xf5M	O\x18\x00\x96w\xef\x96\xa656VW\x01\x86\xb1}\xfb\xfa\x0d\x80\x14 \xf7\xff\xfb\xba^`\x8e\x1d\x1b\x1b#\"\xfa\xc2\x17::ra\xc6\xf0B\xb1#\x92m[\x83\x01\xc6\x81\x87\x1e^Q|c\xd7oQ\x91\xaeg\x93\x90,\x14\xa2\xc9\xc9D\xe2F\xe1\xe9\x94\xc2c\x0d\x84\x86\x87\xb0\xecL\xdbeA\x1f`\x18w\xde\xb9i\x13\xc0yy\xb94\x94p'\xae\x15\x98\xef}/\x14\"\"z\xfa\xe9\xeenaM\xdd\n\xb9\xf6eL\x1d\xf6d\xbf\xf7\xbe\xb7\xb4\xecF?\x92\xd2R\xc3\xc8e!Y(D\xf1\xf8\xe4\xa4\xf4\xe3\xe9\xe9\x01,\xf3\xca\xe5\xee.\x800\x16\x1d\x8f9\xbd\xbbL \xa7@\x18\xc6\xd6mk\xd7\x02\x9a\xb6fMC\x83\xfb\xde\x1f\xae\x11\x98dR^+\xff\xcd\xdftv\n\x01\xfc\xf8\xc7\xe1\xb0\x1b\xf6\x95.<\x1e\xce\x81\xa9\xdc\x08\x80\xf7\xbf_\x8e\xb5\xb8\xe3\x8e\xfc\xfc\x1bok\x9c\xdeg\xb6!\xc4\xf0\xf0\xe8(\x91e]\xbe|\xfd:`\x1b\x82\xe5\xde\xec\xf2_G\xe3\x0d\x0dUU\x80\xe1im\xdd\xb8\x11X\xea|\xabT\xe1\xb8\xc0\xd8\xb7@O\xfe\xc5\x95+\xc2\x02N\x9f\x19\x1f\xcfE]\xa9\xad\x91C=>\xf8{\xe5\xe5\x9cOG&\xc1`v\xe5J\xb2\x0f\x99\xdb\xb1\xack\xd7zz\x00\xd3lo\xef\xb8\x06\x10E\xa3\xb1\x1cl\x1a\xe5\xbc\xb4\xb4\xa8\x080\x8c{\xef\xdd\xba\xd5\xf9[(\xc7\x04\xc6\xee\xfdy|\xdf\xc5v\xcbJ_W\xb2S\xac['\xebF~\xf7w\xcb\xcb\x19\x03\x1eyD\xce\x1eVNiNc_\xa3\xf7\xf6\x0e\x0e\x02\xd3\xce\x83\xb2~'W`,\x90\xe7\xf7\x01\x1e\xcf\x03\x0f\xb6\xee\x04\x18\x0b\x06\x9d\xe8\x8d\xca\xb8\xc0\xd8\x13\x01\x1f\x7f\xfc\xe2E\xcb\xca\xfe\xa4\xad=\xb9\xef\xc1=+V0\x0e<\xfahE\x05c\xc0\xfa\xf5\xcbs\xd0V\xb6\"\xc4\xd0\x90<Z\xb5\xb5]\xbd\nXfWW\x7f\x1f\xe4'\xc4\xe9\xcd-\x01\xa7\x85&c\x02\xd3\xdb+#\x96}\xfb\xda\xdb-\xcb\xfe\xff\xd9\x87=\x0f\xe7\xde]\x85\x05\x8c\x01\x8f=VU\xcd8\xb0f\x8d\x12\x94\\\x82ht4\x1a%2\xcd\xf3\xe7\xaf^\x01,s*\x97\x93\xa5\x82c;\xf8y<\x0f< \x85&?_\xc6\xd8i^7\xdd\x02cG,\xff\xe5\xb1\xf6\x0b\x96\x05\x0c\x86\x93\x8e\xfa\xa9,\x96]\xbb\n\x0b\x18\x94\xa0,W\xec\xe4\xb1i\x9e9}\xe9R\xf6\x9a\xb93\xf8\xfd^/\xe0\xf1\xee\xd9s\xe7\x9d\xe9\x8fh\xd2&0\xb1\x98,\x8c\xfb\xf8\xc7\xdb\xdb\x85\x05\\\xbe\x1c\x8fg\x93\xf27\xd4\xfb| \xe0O\xfe\xb4\xb6\x96k\xc0]w\x15\x14(AQ\xd8\x081004D\x94L\x1e=\xd2v\x1e \x1a\x19\x1d\xcb\xa2\x16\x08\xc6\n\xf2\x03y\x80\xd7\xfb\xd0\xc3w\xdd\x0d\xa4\xabp/\xe5\x02c{\xd5>\xf1\xc4\xe5KB\x00\x07\x0e\x8c\x8cfCLi7\xfb}\xe8C\x15\x95\x8c\x01\x1f\xfdhe\xa5\x9a-\xac\x98\x1b{>\xd6\xc5\x8b\x9d\x9d@2y\xe6\xb4\x9cm\x9e4\xb3\xa1y\x85\xf3\xaa\xca\xd2R\xc0\xe3\xb9o\xf7\xf6\xed@\xaa\xbb\xbaS.0\xcf>\xdb\xdd-\x04\xd1\x0b/\xf4\xf7g\x83\xb0<\xf0\x80\xac\x88}\xe2\x89\xba:\xce\x81\xe2bU\xd0\xa6X<D\x13\x13\x89\x04\x91\x99<z\xe4\xdcY\xc0\x12]\xdd\xfd!\xa7w57\xba\xben]c#\xa0\xeb[\xb6\xac]\xebB\x81\xb1\x9b\x0f\xff\xf2/\xaf\\\x91Q\x8c;	\x065\x0d\x04\xfc\xe9\x9f\xd6\xd5q\x0d\xf8\xcd\xdf,.VG\x1fE\xba\x90\xf57D\xc9\xe4\xb1\xa3\xe7\xcf\x01n\x8fl\xec\xfa\x19M\xab\xad\x95\xf7\xa1Kc\xc9\x0232\"\x9d\xe2~\xff\xf7\xce\x9f\xb7L`hX\xce\xffq\x1b\xdb\xb7\xe5\x07\x19\x80\xff\xfe\xb9\x86F\xae\x01\x95\x95\x1e\x8f\x8aT\x14\x99\x82(\x16\x9b\x98 J&\x7f\xf5\xab\xd3\xa7\x00!B\xa1\xa1a\xa7wu+\x0c>\x9f\xc7\x00<\xde\xbd{w\xdd\x070\xe6\xf1\xc84\xc1\xe2\xe0K\xdd\xd0\xdf\xfd]W\x97\xb0\xdc+,\xef\x7f\x7fi)c\xc03\xcf6\xafV\xc2\xa2p\n{\xea\x80\xc7\xf3\xc0\x03w\xb4\x02\xba\xbe~}S\x93\xd3\xbb\xba\x15\xc2\xc4D\"	\x98\xe6\xb1\xa3mmK\x7f\xbcE\x0b\xcc\xa1C##DD\xaf\xbe:4\xe4\xa6T\x8baH\xed\xf8\xecg\x1b\x1a8\x07\x9e|\xb2\xbe\x9es\xc6d\x86\\	\x8b\xc2id\x12U\xd77o^\xb3\x861\xc3\xb8\xeb\xae\xcd\x9b\xf1\x8ew\xaf[\xb0\xac\x8e\xce\x9e\x1e@\x88\xee\xeePh\xf1g\x9c\x05?\xa5\xe9\xa6\xc4\xeb\xd7\xdd\x94k\xc9\xf3\xcb\xdc\xca3\xcf\xac^\xc39\xf0\xde\xf7\x96\x94\xa8\xdc\x8a\xc2\xed\xd8FR\x1e\xef\xfd\xf7\xef\xb8\x03\x00\xe9\xba\xae;\xbd\xabi\x92\x89\xe3\xc7e$#\x84\x10\x0b\x17\x9a\x05\x0b\xcc\xcf~&\xbb\x9c\xddR\x89k'm\x9f~\xa6\xb9\x99k\xc0\xb6m\xc1\xa0\x12\x16E\xb6\xc1yY\xd9\x8a\x15\x8cy\xbc\x0f>\xd8\xdaj\xe7>\x9c\xde\x15@\x18\x1f\x8f\xc7\xedd\xf5\"\x9e\xd7|\x7f\xd0v\xe9\xff\xce\xb7\xfb\xfb\xdc\x10\xb9\x04\x02\x9a\x06\x00\xcfL	\xcb\xa6M\x81\x80\x12\x16E\xb6\xc3yqqA\x01c\x86q\xff\xfd;v\xe0\x9dI\x92Nc\x9a\xe7\xcf]\xb9\x0c\xd8u?\xf3~>\xf3\xfd\xc1W^\x19\x1a\"r\xbe\xeb\xd9n.\xfc\xab\xbfjl\xe4\x1c\xd8\xb0A	\x8b\"\xf7\xe0\xbc\xb8\xb8\xb0\x901\x8f\xf7\x9e{\xb7l\x05@\xcc\xd1;\x14\xa2\xe8x|\xe2F\x7f\x9dy>\x8f\xf9\xfe\xe0s\xdfqG\xe4\xf2G\x7f\\S\xc35\xdb\x0d_	\x8b\"\xb7\xe1\xbc\xb2\xb2\xb4\x941\xdd\xd8\xb2u\xcdZ\xa7wsc$3\xcf\xfd\xcf\xf5\x03\xed\xed\xf18\x11\xd1\xb5\x8e\x89I'\x9f\x98]q\xfb\x07\x7fPQ\xa1\x84E\xb1\xdc\xd0\xf5\xb5k\x1b\x1b\x19\xd3xmME\xb9s\xfb \x1a\x1d\x1b\x8f\x01BD\"ccs\x1f\x95\xe6\x14\x98\x9f\xff\\\x1e\x8d\x9c\xa2\xa0@\xe6\xd4\xedR~\x85b9\xa3\x1bw\xb4n\xd8\x080x=\x1e\x07\x93\xc0Btv\xce\xe7\xa84\xebGV\x8a\n\xd1\xbf\xbd\x1e\x19vR`>\xf5\xa9\x9a\x1a\xd5#\xa4PH\xec\x99\xd6N\x1f\x99,\xebzg\xdfR\x04\xa6\xad-\x16s2\xa9\xdb\xd8\xe0\xf3\x02\xc0\xde\xbd\xb94HS\xa1H\x0d\x9a\xd6\xd8X]=m\xbb\x90i\xec\xa4\xaf\xed\x048\xdb\xcf\xcd*0\xc7\x8f\x8f\x8de~\xdb\xd3\xfc\xa7\x8fUVq\x0e\xc8c\x91\x92\x18\x85\xe2\xd7\xb1+\x827l\\\xb9\xca\xb9]H_\x9c\xd9\xbf?\xab\xc0\\\xb8\x10\x8f\x93\x03\xb7F%%\x86\x01\x02\x1e~X\x9ad+\x14\x8a\xd9\xd1\xb4\xfa\xfa\xaa*\x801\x9fW\xce\xad\xc8,$\x86\x87GGg\xff\xfe\xed\x8eH\x8e\x8c\x0f\xd9\xb3\xa7\xa8\x88q\xe5\xbe\xafP\xcc\x0f\x19\xc9pVW_Q\x91\xf9\xd5\x05\x0d\x0f\x8d\x8e\xcc\xfe\xfd[\x04&\x1e\x17\x02 \xba\xde91\xe9\xc4L\xce={T\xe4\xa2P,\x14M\xaf\xabsB`H\x8cEc1@\x0e\xb6\xbb5\x17s\x8b\xc0\\\xbb61A\x04\x08\x022Y:h\x0fs\xdf\xb0!\x13^\xe7\nEn!+\x7f\x01{\xa4l\xc6\x98\x9a\xb2 \xc4\xe8\xe8\xf8\xf8\x0c\xfb\xba\xf9\x0f\xa4\x81T\xe6ij\xf2y\x19\x03\xbc^\xce\xd5\xd1H\xa1X(rT,c\x05\x05\xc1\x80\x13\xeb'\x123\xb5?\xdf\"0\xa1\x903]\xd2\xd5U^\x1fS\x85t\n\xc5\x92\xe0<\xbf \xcf\x01\x81\x11\"\x16\x9b\x98\x98a?7\xff\xc1\xb9s\xf1\xb8\x13\x85u\x89\x84\xb33\xb2\x15\x8a\\@\x08\xd3\xb4\x1c\xf0\xfc%\x9a\xf96\xe9\x16\x81\xe9\xeb\x9d\x9c\x98\xcf\x03\xa6\x9adR\xb8\xd8\nY\xa1\xc8\x0e\x18\xb3,'>ID\xe3\xd1\x89\xf8\xad\x7f~\x8b\xc00\xae\x12 \nE\xf6\xe2\xd8\xc7wF?\x89[\x04\xa6\xb2\xc2\x89r\x1d@\xd7\xc1\xd4\x11I\xa1X\x1aD\x8c9\x91\xcb\x94\xa6\xe6\xb7\xfe\xf9-[Y\xbf\xc1\xefw\xc2\xd9\xc6\xeb\xd54\x15:)\x14K\x83s]s\xc2\xd3\x97\xb1\xe2\xe2\x82\x82\x19\xf6s\xf3\x1f\x94\x97{<N\xbc0\xdd=\x93\x8e\x14\xf6)\x14\xb9\x84\xb0\xa2\xe33\xd5\xa3\xa4\x1b\xce\xf3\xf2\xfc\xfe\x19\xfe\xfc\xe6?(,t\xc6\xd3\xdc.\xf0\x9b\x9c\x94\x95\xc4N\xecA\xa1\xc8^,\xcb\xb2\x88\x08\xa3#N\x08\x0c0\xb3I\xf9-\x02\xd3\xd8\xe8\xf316\xed}\x9b\xb9\x97\x87\x08\x0c8wN\xdaD(\x14\x8a\xf9#\xc4\xd0\xd0\xc8\x08\x00Pf\x7f=\x93\xb4\x97\xe4\xbc\xa0 0C\xfd\xcd-\x02\xe3\xf7\xcbJ\xda\xfaz\x9f\xd7\x89\\\xcc\xeb\xaf\x0f;jp\xa5Pd#\x96\xd5\xd9\xd9\xdf\x97\xf9u\x19\xcf\x0f\x06\x82\x00\xa0\xeb3eQg\xcd7\xaf]\x97\x17p\"\xe7\xba\x7f\x7f$B\x02\x90C\x9e\x94\xd4(\x14\xb7G\x8e\x11\x11\xa2\xebz\x7f(\xf3\xabs\xb6\xa28?\xff6\xdf\x9f\xed\x1b\xeb\xd6\xe5\xe59q\xdd\x15\x0e\xcb\x19\xd7?\xff\xb9\x8ad\x14\x8a\xb9\xb0,\xe9\x8dK419\xe9\x80-?\xe3+V\xc8&\xcb\x99\x99UB\xb6n\x0d\x063\xbf\xddi\xfe\xf9\x9b}\xbdB\x00rT\x8a\x92\x1a\x85\xe2\xd7\x91\x91\x8bi\x9e;\xbb\x901\"\xa9FN\xa4\xbc\xcd\xf7g\xfb\xc6\xbau\xd26\xa1\xa6\xda\xeb\xc8\xb5\xb5=&\xe5\xe5\x97\xe5\xa8Z\x85B1\x8d=\xca\xd5\x1e#\x92i\x18\x0b\x06\xfc\xbe\xe9I\x94\xb3\xfd\x1c\x9f\xfd\x01\xe4\xff\xbe\xeb\xa1\x15\xc5N\x16\xc0\xfd\xcf\xafuu	\x01\x0c\x0c$\x93*\x92Q,w\x88&&&'\x89L\xf3\xc4\xf1\x0bm\xce\xedC\xd3\xea\xea\xab\xaa\xe7\xfe\xb99\xb3,\x0f=\xe4\xac\xc3\xdcXT\xf6\x86~\xf5\xab\xd7\xaf\xbba\xb2\xa4B\xe1$f\xf2\xe8\x91\xf3\xe7\x00\xa2D2\xe9\x88s\x93\x84\xf3\xfa\xfa\xca\xcay\xfc\xdc\\?\xb0f\x8d\xdf\xcf\x18c\xf6\x18\x11\xa7x\xe3\x8dH\x84\x08\xf8\xeew\xfb\xfb\x95\xb1\x83b\xb9a\x9a\x17.\\\xbbFd\x89\xaen'n\x8bl\xec1)\x9c\x17\x15\xe5\xe7\xcf\x1dz\xcc\xfb\x9e\xe8\xc3\x1f\xa9\xa8t\xc3d\xc5\xff\xf5lw\xb7\xb0\x80\x03\x07FF\x94\xd0(r\x1d!\xfa\xfa\x06\x07\x89\xcc\xe4\xc9\x13\xed\x17\x9c\xde\x0d\xa0\xeb\xeb7,dL\xca\xbc%\xe3\x91G\x8a\x8b\x9dL\xfa\xda\xd8^\xc1\x9f\xfb\xdc\xb5kB\x00\xe7\xce\x8d\x8f+\xa1Q\xe4\x1a\xb22\x97(1y\xe8\xe0\xc9\x13\x00\x189\xda\xaag'u5\xad\xa1\xa1\xaaj\xfe\x7fo\xde\x02#\xab\xf4\x18\xfb\xc8G\xdd\x11\xc9\x8c\x8f\xcb\xdc\xcc\xe3\xfb.\xb6\x0b\x0b8vllL	\x8d\"\xdb\x11bp0\x12!JL\xbe\xb1\xff\xc8\xdb\x00X\xd24]`\xc56\x1d\xb9\xc81)\xf3\xfd{\x0b\x96\x8a\xdf\xfcM9\xca\xb5\xaa\xca\x99\xae\xeb\x9b\x89O\x08\x02\x03\xfe\xec\xd3W\xae\x08\x0b8~<\x1aUB\xa3\xc86\x84\x18\x18\x18\x1e&JL\xfe\xe2\x17G\x8f\xc25\xc2\xc2\x10\x08\xf8\xfd\xd3\xa3j\x17\xca\x82\x05\xc60d$\xf3\xe7\x7f^W\xe7\x86H\xc6&\x16\xb7,0\xe0\x93\x9f\xbc\xd8.\x04\xf0\x93\x9f\x84\xc3Jh\x14n\xc7\xb2::z{\xa7\x84\xe5\x08\x00f\x9a\xce\xcc\xf5\x98\x19\xc3\xb3m\xdb\xbau\x00\xc0\xb9\x1c\x86\xb80\x16-\x11\xf7\xdcSX\xc8\x18c\x8f\xbc\xbb\xb8\xd8M>Q\xc9\xa4\xd4\x94/\x7f\xb9\xa3C\x08\xe0\x7f\xfc\x8f\xceN!\x88LS\xf56)\xdc\x80]\x81{\xeaT{;Q2\xf9\xef\xff~\xea\x14\x00fY\x96\x8b\xca04\xad\xb1\xb1\xba\n\xe0\xbc\xa6\xa6\xbc|\xf1\x85*L>\xdd\xc5oD\xceQ\"\xfa\xfd\xdf;\x7f\xde2\x81\xa1a\xd9K\xe46\xb6o\xcb\x0f2\x00\xff\xfds\x0d\x8d\\\x03*+=\x1e5\x7fI\x91)\x88b\xb1\x89	\xa2d\xf2W\xbf:}\n\x10\"\x14\x1a\x1avzW\xb7\xc2\xe0\xf3y\x0c\xc0\xe3\xdd\xbbw\xd7}\x00c\x1e\x8f<\xb5,\x8e%\x1fr\xa4A\x15c\x7f\xfeD]\x1d\xd7\x9c~yf\xe7\xd8\xf1\xb1(\x01x\xf4Q)\x84?\xfb\xd9\xd0\x90:B)\xd2\x8d,\xe9'\x9a\x9c|\xe5\xe5\x83\x07\xdc+,6\xba\xb1c\xc7\x86\x8dK\x17\x16\x9b%G07\xf3\xec\xb3\xdd\xddB\x10\xbd\xf0B\x7f\x7f6||\x1fx\xa0\xa8\x881\xe0\x89'dN\xa9\xb8\xd80Td\xa3X,D\x13\x13\x89\x04\x91\x99<z\xe4\xdcY\xc0\xe9\xc2\xb8\xf9\xa2\xeb\xeb\xd656\x02\xba\xbee\xcb\xda\xb5\xa9{\xff\xa7\\`\xec\xee\xe7'\x9e\xb8|I\x08\xe0\xc0\x81\x91\xd1l\x10\x1a\xbf\x9f3\x00\xf8\xd0\x87**\x19\x03>\xfa\xd1\xcaJ\xce\xa7\x93\xdaN\xefO\xe1V\xe4'\xc8\xb2.^\xec\xec\x04\x92\xc93\xa7/]\x04\x00w\xdc\x02\xcd\x05\xe7U\x95\xa5\xa5\x80\xc7s\xdf\xee\xed\xdb\x81\x85^C\xcfE\xca\x05\xc6&\x16\xb3,\x80\xe8\xe3\x1foo\x17\x16p\xf9r<\x9e\x05:\xf3\x0e\x0d\xf5>\x1f\x08\xf8\x93?\xad\xad\xe5\x1ap\xd7]\x05\x05\xa9|\xe1\x15\xd9\x8d\x10\x03\x03CCD\xc9\xe4\xd1#m\xe7\x01\xa2\x91\xd1\xb1\xa8\xd3\xbb\x9a?v\xc9\xbf\xd7\xfb\xd0\xc3w\xdd\x0d\x00\x86\xa1\xeb\xa9\x7f\x7f\xa7M`l\xba\xbb''\x01\xa2\xff\xf2X\xfb\x05\xcb\x02\x06\xc3N\xb6h-\x9e]\xbb\n\x0b\x18\x80\xc7\x1e\xab\xaaf\x1cX\xb3&/O	\xce\xf2A\x88\xe1\xe1\xd1Q\"\xd3<s\xfa\xd2%@\x88\x9e\xde\x81\x01\xa7w\xb5p\x18\xfc~\xaf\x17\xf0x\xf7\xec\xb9\xf3N\x80\xb1`\xd0\xefO\xdf\xfb8\xed\x02c\xd3\xdb\x9bH\x00D\xfb\xf6\xb5\xb7[\x96\xfd\xff\xb3\x97\xd6V\xd9\xea\xf5\xc7\x7f\\S\xc3\x98\x12\x9c\\\x83ht4\x1a\x95\x82r\xf92`\x99\xd7\xaf\xf7\xf5a\x96\xf9\x85\xee\x87\xb1<\xbf\xcf\x07x<\x0f\xeeim\x95\xc2\"\x1d\x9f\xd2\xbcn\xa6\x04\xc6\xc6\x8eh\x1e\x7f\xfc\xe2E\xcb\x02\xfa\xfa\xb2[h\xec\xe9\x0b\x0f\xeeY\xb1\x82q\xe0\xd1G+*\x18\x03\xd6\xafW\x82\x93M\x08144:JdYmmW\xaf\x02\x96\xd9\xd5\xd5\xdf\x07\xf9	qzsK\x80\xb1@\x9e\xdf\x07x<\x0f<\xd8\xba3\xfd\x11\xcb-\xebgZ`l\xa4\xb0\x10=\xbe\xefb\xbbe\x01\xdd=\x93Y-47c;\x02\xfe\xee\xef\xca2\xa5G\x1e\x91\xbe:\xb2\x1aR	\x8fs\xd8&\xd9\xbd\xbd\x83\x83\x80i\xb6_\xe8\xe8\x00\x84\xe8\x0f\x85\xc3N\xef-u8-,\xef\xec\xc3)\x81\xb1	\x87\xa5S\xdd\x93\x7f!{\x89N\x9f\x19\x1f\xcf\xe6\xdf\x18\xb3Q[#g~\x7f\xf0\xf7\xca\xcb9\x07\xf6\xee\x95\xdd\xe9\xc1\xa0\xa6)\xc1I'\xc9\xa4i\x12\xd9\x16\x93\xa6\xd9\xde\xdeq\x0d \x8aFcq\xa7\xf7\x96z8/--*\x02\x0c\xe3\xde{\xb7n\x05\x18\xf3\xf9\xbc^\xe7\xde_\x8e\x0b\x8cM2)K\xf9\xff\xe6o:;\x85\x00~\xfc\xe3\xdc\xf6\xe2\xf5xd'\xd7}\xf7\x15\x162\x00\xef\x7f\x7fi)\xe3\xc0\x1dw\xc8\xdc\x8emY\xea\xf4>\xb3\x0d;\x19kY\x97/_\xbfn\xf7\xfa\x00\x80iZYpm\xbcX4.m\x14\x0cOk\xeb\xc6\x8d\x00\xa0in\x98\xf6\xee\x1a\x81\xb9\x99\xef}/\x14\"\"z\xfaii0eO~\xccu\xea\xeb\xbd\x1e\x10\xf0\xde\xf7\x96\x96qm:\xd2)-U\x05\x807B\x14\x8fON\x12YVGGO\x0f`\x99W.ww\x01\x84\xb1\xa8\x13&\xd8\x99\x87\x811\xc00\xb6n[\xbb\x16\xd0\xb45k\x1a\x1a\xdc\xf7\xfep\xad\xc0\xd8\xd8>/_\xf8\x82l^\xcc\xf6\xa4\xf0B\xe1\x9c1\x10\xb0mk0\xc08\xf0\xd0\xc3\xd2\x84\xfd\xc1\x07e\x05rQ\x91l\xd5pz\x9f\xe9\x82hr2\x91 \x12\xa2\xab\xab\xbf_\xce\x01\xea\xeb\x03\x845\x10\x1a\x1e\x82\xe3FL\x99\x86\xb1\xbc<\x9f\x0f0\x8c;\xef\xdc\xb4	\xe0\xbc\xbc\xbc\xd8Q[\xfe9\xf6\xebv\x81\xb1\x89Fe\xe1\xdeW\xff\xf6z\xa7\xb0\x80\x97_\x19\x1a\xce\x82m\xa7\x0dM\x93\xc2\xd3\xda\x9a\x1fd\x0cx\xf8\xe1\x15\xc5\x8c\x03\xbbwK\xe1\xc9\xcf\xcf\xae\xdc\x0e\x91\xcc\x95L\x0b\xc9u)$\xa2\xafO&_3<s\xd9e\xd8\xdd\xcd\x86\xb1}\xfb\xfa\x0d@\xba\n\xe3RM\xd6\x08\xcc\xcd\xec\xdf\x1f\x89\x10\x11}\xe5+\x9d\x1dB\x00\x91H6\x14f\xa7\x1f;\xe2ii	\x04\x18\x9f\xca\xf1\xb0\xe9\xaf\x8d\x8d>\x9f\x93\xc2C4>\x1e\x8f\x13\xd9\xc2aY=\xdd\xa1\xd0\x94\x90\x0c\x02\x80 \x91\x85\xef\xc7\xd4c\x18\xba\x0e\x18\xc6\x8e\x1d\x1b6\xd8V\x95\xee\x17\x94\x9b\xc9Z\x81\xb1\xb1o\xa1\x9e~\xba\xeb\xba\x10\xc0\xcf\xff\xdfp\x84\x04d\xd8\x9cu\xff\x1c\xe9\xa7\xa9\xc9\xe7\x05\x01\xbbv\x15\x161\x0e\xec\xda%\x85\xa7\xa5%\x10`l\xda\x1au\xf1+\xd8\xd7\xc0\xe1p$\x02\x08\xd1\xd330\x00XVw\xd7\xc0\x80\x1c\x14\x16\xcd\xa2\x92\xfa\x8c1u\xd2\xd3\xf4\xfa\x86\xaa*@\xd7\xb7m[\xbb\xd6\xf9[\xa0\xa5\x92\xf5\x02s3\xb6e\xe6W\xbfz\xbd\x93\x04p\xe9R|\"\x87\x9e^\xda\xb0\x9b=[Z\x02A\xc6\x80\x9d;\xe5\xbc\xbe\xd6V9\xda|\xedZY\xd7c\xdfn\x11E\xa32\x12\xe9\xef\x0f\x87\x01!\xfa\xfb\xa6\xbe\x86\xc2a\x80(\x91H&\x9d~V\xee\x87\xb1\x82\x82@\x000\x8cm\xdb\xd6\xaf\x078\xaf\xac\x94\xa6\xb4\xb9A\xce	\x8c\x8de\xc9k\xef\x17_\x1c\x1c$\x02\xfe\xe1\x7f\xf7\xf4\x081=\xc8M\xb10JK\x88\x92I\xe0\x8e\xd6xlh\x08\xd8\xb1ctlp\x10\xd8\xb1#\x16\x1b\x1a\x02\x8a\x8b-\xcb\x89\xe1\xeb\xd9\x87}\xf4\xd9\xb4i\xf5j@\xd3\x9a\x9b\xeb\xea\x80Tw1\xbb\x85\x9c\x15\x98\x9b\x89D\xa4\xf3\xde\xb7\xbf\xd5\xd7',\xe0\xc5\x1f\x0e\x0e\x10\x01\x93\x93bY\\\x7f\xa7\x0b\xfb#\xb1j\xd5\xe4d4\n\xdcqG,68\x08\xb4\xb6J\xe1\xd9\xb4)\x1e\x8fD\x00\x8f\x87hYN\xe6$M\xd38\xa0\xeb\xcd\xcd\xb5u\x80\xa6\xaf_\xbfr%\xc0\x98\xd7\xeb\xf1\xe4\x9e\xa0\xdc\xcc\xb2\x11\x98\x9b\x19\x1c\x94\xb9\x9bo}\xab\xafO\x08\xe0_\xffU\n\x8e\xed\xe9\xabH\x0d>\x1f\x91e\x01\x9b7\xc7b\x91\x08\xd0\xda\x1a\x8f\x87\xc3\xc0\xce\x9d\xe3\xe3\xe10\xd0\xd0\x90H\x8c\x8f;\xbd\xcbT\xc2\x19g\x80\xc6W5\xd7\xd6\x01\xbaa\x0b\x8a\xdf\x9f\xcd\xb9\x94\xc5\xb2l\x05\xe6f\xec\xde\xa8o~S\n\xce\xcf~\x1a\x0e\x93\x00\xcceR\xe0\xe7\x14\xb6\xc0\xdc\x7f\x7f4\xda\xdf\x0f\xec\xde\x1d\x8d\x86B@s\xb3\x8c\x88\\\x0f\xc9\xee2Mkl\xac\xa9\x01tc\xc3\x06)(\x81\x80\x13\xbd?nC	\xcc,\xd8\xb7S?\xfc\xe1\xe0\xa0\x10\xc0\xf7\xbe70@\x04\x8c\x8e\xbai\xa8D\xeeRY\x99L\xc6\xe3\xc0\xbd\xf7\x8e\x8f\x0f\x0c\x00\x0f<06\x16\n\x01--\x13\x13\x91\x88\x83\x17\xedd\xe8\xba\x06hz\xd3\xca\x9aZ@\xd7\xd7\xaeml\xb4\x0b\xe0\x94\xa0\xdc\x8c\x12\x98y\x12\x8f\x0b\x01\x10\xfd\xe4'2i\xfc\x7f\xff\xcf@H\x88\xdc\xeb\x02w;\xd5\xd5Rx\x1eydt\xb4\xb7\x17\xd8\xbbwl\xac\xa7\x07(+K&'&R\xbf\x9e=2U\xd7W\xafih\x044m\xe5\xca\xdaZ\x00\xd0u7\xf4\xfa\xb8\x1d%0\x8b\xc4\xf6\x1e~\xf3\xcdH\x84\x08x\xf1E\x99\xc39\xf2\xf6\xe8\x18\x89\xe9\x19\xda\x8a\xf4\xc2\xb9\x9cv\xb5sg,\x16\x0e\x03\xefy\xcf\xc8HO\x0fp\xf7\xdd2\xf2\xd1\xf5y\xbe\xbfI^\xe1p^QQ\\\x02h\xfa\xaaUuu\x80\xa6\xd5\xd4\x94\x97\x03\xb9z\xcb\x93n\x94\xc0\xa4\x98\x9e\x1e\x99\xcby\xe9\xa5pX\x08\xe0\xa5\x97\x06\x07I\x00\xa1\x90;\xe7E\xe5*+VXV\"\x01\xbc\xfb\xdd\xa3\xa3==\xc0o\xfd\x96\xfcZ[\x9bH\xc4bSG\x1a/\xa0iMM5\xb5S_kT\xee$\xd5(\x81I3\xa6\x19\x0e\x8f\x8c\x10\x1d:\xd8\xde>8\x00\xbc\xf4S\xd3\x0c\x04\x80C\x87\xf2\xf2J\xcb\x00\xd3T\xbf\x173\x81\xed<x\xdf}>_\"	|\xf8#\x0d\x0d>\x9f]\xc1\xac\xfe\x05\xd2\x85\x12\x98\x14#+[\x89\xa6\x9d\xd2f6\x87\x1e\x1b\xe3\\\xd7\x81C\x87\x82\xc1\xb22\xe0\x17\xbf\x08\x06+*\x80\xc3\x87\xf3\xf2JJ\x94\xf0d\x8a\xcd\x9b\x83A\xc6\x80\x0f~\xb0\xac\xec\xc6.u\xe5<\x98\x1a\x94\xc0,\x1a{\x1e\x8e442\xcd\xf3\xe7\xae^]z\xaf\xcd\xf0\xb0\xa6y<\xc0\xfe\xfd\xf9\xf9\x15\x15\xd3_\xcf\x9e\xf5z\x0b\x0b\x01!\x94\xf0\xa4\x13\xbbW\xeb\xd1G+*o\xf4\xe3Q\x82\xb38\x94\xc0,\x10\xd9\xbcG\x94L\x9e<\xd1\xde\x9e\xb9\xe6\xbd\x81\x01\xc3\xf0\xf9\xa6\x8eV\xa5\xc0\xc1\x83\x81@Y\x19p\xfcx^\xde\x8a\x15@2\xc9\x18_\xf2 `\xc5\xcd\xd8\x82\xf3\xf8\xe35\xb5\\\xb3\x9bC\x95\xd0\xcc\x17%0s \xc4\xd0\xd0\xc8\x08\x91i\x9e<\xd9~\xc1}\xb3\x85'&\x18\xd34\xe0\xd81)4o\xbd%\x85\xe7\xd0\xa1@\xa0\xb4\x14\x08\x87u]\xba\x01+RA\xcbF\xd9u\xfeGS\xe3j\xb6n\x0d\x06\x95\xe0\xcc\x8e\x12\x98\x9b \x1a\x1b\x8b\xc5\x88\xcc\xe4\xe9S\xed\xed\x80e]\xbf\xde\xdf\x8f\xac\x9b\x87c\x1f\xa5\xda\xda\xbc\xde\x82\x82\xe9\xdc\xce\xd1\xa3\x81@q1p\xfe\xbc<r\xa9\\\xcf\xe2`\xc0\xd4\xb8\x9a\xa2\"\xc6\x81?\xfc\xc3\x9a\x1a\xce\x81\xba:\xafW\x1d\xa5\xa6Q\x023\x95K1\xcd\xb6\xb6k\xd7\x00\xd3<{F\xce\x16\xb6D.\x1b\x1f\xd9\x91\xcf\xd9\xb3~\x7fa!p\xe4\x88\xdf_\\,\x05\xa8\xa4\x04\xb8x\xd1\xeb\xcd\xcf\xc7\xb2v\x91[\x08\xfa\x94\xc3\xe0\xef\xffAy9\xe3\xc0c\x8fUW\xab\xd9\xe6\xcbX`\xec\xa3O2\xf9\xf6\xe1\xb3g\x01\xa2\xc8\xc8\xd8\x98\xd3\xbbr\x0f\xf6\xd1\xea\xe8\xd1\xbc\xbc\xe2b\xe0\xed\xc3\xc1`i	p\xe4H \xaf\xa4\x04\x18\x8e\x80\xe9\x86\xd3\xbbt/\xcd\xcd~\x1f\x03\xf0\x99\xcf442\x0el\xd8\xb0<\x07\xf1-#\x81\xb1,\xcb\"2\xcd\xb3g/_\x06\xccd\xdb\xf9kW\xb1\xecL\xa3gG\xba\xd4sVRRX\x08p\xad\xba\xa6\xbc\xdc6@\x028/*\x92\xd6S\xb2\xa2\xd5\x9e\xd0\xf9\xf6\xdbccD\xc0\x81\x03#\x11\x12\xc0\xe1\xc3cQ\x02\x90H,Ks\x86[\xb0\xebo\xde\xf7\xdbr,\xcd\xa7>U[\xcb9\xe0\xf7s\xbe\x1c\"\x9b\x9c\x17\x98\xe9H\xe5\xadC'O\x00D\xd1\xf1x\x1azV\xb2\x07ixd\x97\xc0kZmmE\xc5\xb4\x90,u\x9e\xce\xc4\x84\xec\xd9\xb2\x85g\xff\xfe\xe1a\x12\xc0/\xdf\x1c\x19!(\xc3\xaf\x9aj\xaf\x07\x00\xbe\xf8\xa5\xc6&\xce\x81\x0d\x1br\xbb\xd0/g\x05\xc6\xb2._\xee\xea\"J&\x8f\x1d=\x7f\x0eXnf\xd2\x8cy<\x86\x01p^]]V\nhZ]]e\xd5\x8dB\xc2\xb9\xac\xed\xc8\x0cBH\x87\xc1\xd3\xa7\xc7\xc7\x89\x80\xd7_\x8b\x0c\x13\x01\xaf\xbd&=\x94\x87\x86\x97W+\x85=\x15\xe2\x13\x9f\xa8\xae\xe6\x1a\xf0\xe1\x0fWT\xe4\xa2\xd0\xe4\x90\xc0\x98\xa6i\x12%\x93\x87\x0f\x9f=+o\x7f\xfa\xfa\x9c\xdeS\x06 \xce9\x074\xadVF$z\xd3\xca\xda\xda\xa9\xa6\xbdb\xc0\xedMz\xb6\xb5\xe9\x91#2\xe2y\xe9\xa5\xf0 \x11\xf0\xc6\x1b\xb2\x89t\xb9\x18\x80\xbd\xeb]rv\xf9g?[_\xcf9\x90\x97\x97]cgf#\xeb\x05\x86htt|\x9c(\x918t\xf0\xc4	\x80hd4+\x8c\x8a\x16	c\x05\xf9\x81<@\xd3\x1a\xa7\x9a\xf4V\xae\x94Mz\xb9e\xc186&\xe7`\xbd\xfe\xfa\xf00\x11\xf0\xe2\x0f\x06\x07H\x00\xed\x17c\xf1,~\xbb\xce\x89=\xd9\xf3\xa9\xa7V\xae\xe2\x1a\xd0\xdc\xec\xf7\xbb\xf9\x17\xc4\\d\xad\xc0\x08\xd1\xdb;0@\x94H\x1c:t\xf2$\x90{\xb3\x875.\xad\x17\x1b\x1a\xabk\xa4}\x80\x8cL\x8a\x8b\xe5\xa0\x91\xe5\xc9\xb9s\xb1\x18\x11\xd1\x8f~$}y^yeh\x88(\xf7\x92\xca\xf6\x94\x87/\x7f\xb9i%\xe7\xc0=\xf7dg\x05q\xd6	\x8c\x9cEL\x94L\x1c>|\xe6\x0c\x00&O\xf7Y\xcf-Ni\xeb\xd6I\xa74\xbf_9\xa5\xcd\xce\xf0\xb04s\xff\xc1\x0f\x06\x06r\xd1y\xd0\x1e\xa4\xf7\xe4\x93\xf5\xf5\\\x03\xde\xf7\xbe\x92\x92l\x12\x9a\xac\x11\x18\xcbjo\xef\xe8 J&N\x9c\xb8\xd06\xb5s\xa77\xb5\x04\x18\xf3y\xbd^@\xd3V5\xd7\xde`\xbd\x98-#A\xdd\x8a\xed<\xf8\xe3\x1f\xcb\x08\xe7_\xfe%\x14\xca\x85\x99\xe6v\xe5\xf0\xbe\xc7kj\xb2))\xecz\x811\xcd\x93'\xdb\xdbe\xa5\xed\xd5\xabN\xeff\xf1\xd8\x82\xa2\xeb\x1b[V\xad\xba\xd1z\x91\xf3lx\xa3d+\xa6)\x93\xc8\xb6\xe0|\xe3\x1brlM8\x9c\xdd\xb7VR`\x80}\xfbd\x8b\x82=\x10\xcf\xe9}\xdd\x8ck\x05&\x998v\xec\xfcy\"K\\\xbc\xd8\xd9\xe9\xf4n\x16\x83>5\x0fg\xdd\xfa\xa6\x957F(\xca\xcb\xd5I\xec\x08\xe7\x85\x17\xfa\xfb\x89\x80\x17\x9e\xef\xef\x13\x16\x10\x9f\xc8\xce\xf9X\x1f\xf8\x80\xf4\xb1\xf9\xf4\xa7\xeb\xea2Yv0_\\'0\xb2\xd2\x96\xc84\xcf\x9c\xb9t\xc9\xe9\xdd,\x00\x92\xdd\x90\x9a>5\x0fG\xdf\xb8\xb1\xb9Y\xce\x16\xce\xa5\xdb\x9d\\chHN\x8f\xf8\xa7\x7f\xea\xeb\x15\x02\xf8\xd1\x8f\x06\xc3$\xa6\xeav\xb2\xe8_\xed\xb1\xc7\xaa\xaa8\x07>\xf6\xb1\xaa*7E\xc4\xae\x11\x98\xe9\xc2\xb8#G\xce\x9euz7\xf3\x87\xb1\xc2\x82\xfc `\x18\xad;7\xb6\x00\x9c\x97\x94,\xe7[\x9el\xa7\xbd=\x1e'\"z\xea\xa9\x8e\x0e\"\xa0\xad-\x16s\xc3\xe7c\xbe\xc8H\xc6\x8el\x9c\x7f\x1f:.0Btw\x87BD\x89\xc4\xc1\x03'N\x00\x00\xb9\xbb\x83wj\xd0\x96n\xc8#\x8f\xae\xb7\xb447\x03\x99\xae\x8cU\xa4\x17\xbb\x00\xf0\xbb\xdf\x0d\x85\x88\x80\x7f\xfc\xc7\xde^!\xdc\x7f\x1dn\xdf:}\xe9KMM\\\x03\xf6\xec)*rRh\x1c\x13\x18!\x06\x07\x87\x87\x89\x12\x897\xf6\x1fy\x1bp\xbb=\x02\xe7%%EE\x80a\xdcygK\x0b\xc0X~\xbe\xb4\x1eR,\x07::&&\x00\xa2/~\xa1\xa3C\x08\xe0\xccY\xd9\xf2\xe0V<\x1e\xe9o\xf8\xec\xb3\xcd\xcd\x9c\x03[\xb68c\x8c\x95q\x81!J$\x92I\xa2D\xe2\xd5W\x0f\x1d\x02\x88b\xb1t\x0c\xccJ\x15\x9a&\x0b\xdc\x0cc\xfb\xf6\xf5\xeb\x01\x15\xa9,o\xec\xc8\xe6\xeb_\xef\xe9\x11\x02x\xfe\xb9\xfe~\x12\x90\x1d\xf9.|W\x94\x95\x19\x06\x08x\xee\xb9\xf5\xeb5\x1d(*\xd2\xf5L\xde6e\\`\x12\x89\x03\xbf<~\x9cH\x88\xee\x9eP(s\xeb\xce\x1b\xd2u]\x07\x0c\xcf\xce\x9d\x1b7N5	V*AQ\xcc\xcc/\x7f92BD\xf4\x85\xbf\xbavM\x08\xf7v\x8b\xdf}wA\x01\x03\xf0w\x7f\xd7\xdc\xcc\xb5\xcc]kg\xcc&Z\xd6\xb1\xb8WX\x18\n\x0b\x83A\xc0\xeb{\xf7\xbb\xef\xbe[	\x8bb~\xdcw\x9f,\xe1\xff\xe7o\xad[\xa7i\xc0\xaaU\xd2h\xcam\xbc\xf5\xd6\xe8(\x01x\xfeyy=\x9f)\xd2.0\xd3\xa6\xd9\xa7OI+Jwa\x1b,y\xbc{\xf6\xec\xdc	0\x16\x0c\xe6\xe5)aQ,\x8c\xdaZ\xe9\xc5\xfb\xf5\xaf\xafY\xcb5`\xeb\x169o\xc9m|\xfd\xeb==\xc2\x02N\x9d\x8aF3qvI\xa3\xc0H\x07\xb9d\xe2\xd0A\xd9\x8c\xe8.?\x16\xce\xaa\xab\xcb\xca\x00\x8f\xf7\xc1\x07[[m\xff\x147\xbe%\x14\xd9D~\xbe\xb4Yx\xfa\x19\x99\\\xdd\xb5\xab\xb0\xc0M\xef*\xcb\x92\xf5=\x9f\xff\x9c<\xd2\xd9\x06a\xe9Z/m\x02c\x9a\xe7\xce^\xbd\n\x10\xc6c\xf1x\xbaVY8\x9a\xd6\xd8X]\x0dx\xbc\xbbvm\xdb&\xffDU\xd6*R\x8d\xd7+\x0b\xf8\xbf\xf2\x95\x95\xab8\x07\xde\xf3\x9e\x92\x127\xbd\xc9zzeo\xd6\xb7\xbf\xdd\xd7\x97\xce\x8b\xf7\x94\x0b\x0cQ4\x1a\x8b\x11\x99\xe6\x85\x0bW\xaf\xa4o\xe3\x0bE\xd3j\xaa\xcb\xcb\x01\xc3\xd8\xb9\xb3\xa5\x05p\xbb\x11\x93\"7\x90\xbf\xbc\x18\xfb\xecg\x1b\x1a\xb86m,\xe5\x16\x9e\x7f\xbe\xbf\x8f\x08\xe8\xec\x94\x1e\xcb\xa9~\xfc\x94\x0bL2y\xfcX[\x1b\xe0\x96\xba\x16\xce\xcb\xcb\x8b\x8b\x01\xc3\xb8\xe7\xde-[\x00%,\n'\x90U)\x8c}\xfe\xf3\x8d\x8d\x9c\x03w\xddY\x90\xef\x86w\xa1\xed\x18\xf8\xcc3]\xd7\xd3\x11\xc9\xa4L`\x84\xe8\xeb\x1b\x1c$\x9am\xd8{\xa6a\xac\xa80?\x1f0\x0c\xfb(\xa4\xeaW\x14\xcec\xcfIz\xea\xaf\xa5\x91\xd4\xbau\xee\xb8R8p`d\x94\x088xP^\xbb\xa7\xeaqS 0\xb2\x92&\x99<v\xac\xed\xbc\x93/\x91\x84\xc1\xe7\xf3\x18\x80\xc7\xb3\xfb\xfe\x1d;\x00\xc6\x94\xbf\x8a\xc2}\xd8\x9e\xbb\x7f\xfb\xb7\xabVq\x0e\x14\xaf\x90\x05qN\xf3\xcc\xd3\xdd\xdd\xc2\x02d4\xb3t\xa1Y\xb2\xc0XVWW($G\xae\x8e\xc7\x1c|eH\x9e{\x0c\xcf\x9dwn\xde\"\x9d\xe0\xbc^%,\nwSZj\x18\x00c_\xfcRc#\xd7\xa6{\x89\x9c\xa2\xa3sb\x02\x0c\xf8\xc5/\xa4\xe9\xfaRI\x81\xc0\\\xb8p\xed\x9as/\x88\x8dn\xac_\xb7r\x95=\x96C	\x8b\"\xbb\xd8\xb1#?\x9f1\xc6>\xf2\x91\x8a\n\x96\xb1\xf2\xd7\xd9y\xee;}\xbd\x8e\n\x8c\x10\xa1\xd0\xd0\x10\x91\x10\xe1p$\xe2\xdc\x0b\xc1yiiQ\x11\xa0\xeb-\x9bV\xadrn\x1f\nE*\xf8\xf8\xc7\xa5\xaf\xcb\xa6\x96@\xc0\xc9\xdf\x92\xe7\xce\xc7\xe2D\xc0\xb1cccK\xc9\xc9,Z`L\xb3\xed\xbc\xa3\xd7\xd0S\x06O\x86\xb1c\xc7\x86\x0d\x80\xba\x1dR\xe4\x02\xf6\xb5\xf6_~\xa6\xa1\x81q@\xd7\x9c=2=\xff\\(DK\xb8]Z\xb0\xc0\x10\x8d\x8cD\xa3D\xc2\xea\xed\x1d\x0c;\xf7\xc45}\xf5\xea\xfa\x06\x80\xb1\xa2\xa2|W\\\xf8)\x14\xa9c\xe5J\x9f\x8f1\xc6~\xe7?\x94\x959ydz\xeb\xad\x91\x11\"\xe0\xca\x95\x89\x89\xc5D2\x0b\xde\xba\x1c\x1b\x02\x809\xd3\x9c\xce\x98\xcf\xeb1\xe4\x91H\x1a=)\x14\xb9\x8bm\x85YZb\xe8N\xaco\xdbP\xbc\xfa\xaa\x9c?\xb5P\x16.0\xe6\xf5\xeb\xfd\x0e\x8ed\xd5\xf5\x96M\xcd\xab\xd5\xf5\xb3by\x10\x08\xc8\xeb\xec\x8f\xfd\xe7\xaaj\xee`$\xf3\xda\xcf\x87\x87\xa4\x13\x0e\xb0\x90\xeb\xebyo\xd9\xee\x8a&D\xa31\x07z\x8b\xec\xc8\xc5\xee%R(\x96\x13\xef}\xaf\xbc\x1bu*\x92\xe9\xee\x99L\x00\xc0\xf9\xf3\x0b\xf3(^\x80\xc0tv:9L^\xd3V\xafih\x94\xff\xa5\x9a\x13\x15\xcb\x0d\xbb\x02\xf8?|\xa0\xac\xdc\xd1H\xe659+|\xbe\xcc{\xab\x96\xd5\xe5\xd0\xd1H\xd75\x0d\xd0\xb4\xe6\xe6\xba:'\xd6W(\xdc\xc3\xef\xfc\x8e\x9c\x83\x94\xe7\xd74'n\x97^\x7fmaG\xa59\x05F\x88HD\xde\x84\x8f\xc7\xe2\x0ex\xe7jZCCU\x95\xf2kQ(\x80i\xbf\x99\xdfx\xf7\x8a\x15N\xdc.\xf5\x87\x12I\x00\xb8x1\x1e\x9fO$3\xe7\x16\x89\x06\x06\x86\x873\xffDl4\xad\xbe\xbe\xaa\xca\xb9\xf5\x15\n7\xf2\xf0\xc3\xce\xda>\x9c<\x19\x8d\xce\xe7\xe7\xe6\x11\xc1\x0c\x0e\x0c\x0fe\xfe	\xd8\xb3\x9c9/+[\xb1\"\xf3\xeb+\x14nf\xdb6Y\xfd\xe5T\xd2\xf7\xd4\xa9\xe8XJ\"\x18!\x06\x07##\x99\x7f\x02\x9c\xd77TV\x02\xaaBW\xa1\xb8\x15\xdb_\xe6\xc1\x07\x8b\x1c\x89dN\x9c\x88\x8e\xcf\xa7\xc2wV\x81!\x1a\x1f\x97C4cq'\xe6\x16i\x9a\xf4\xccU(\x14\xb3\xb3\xfb\xfe\xa2\"'\x04f` \x99\x04\x03\xfa\xfa\x12\x89\xdb%{g\x15\x18!\x06\x07\x9dibd`lz\x92\xa2B\xa1\x98\x9d\x96\x169_Ts\xa8g\xe9\xe4\xc9h\xf4vG\xa5\xdbD0##\xf3K\xe3\xa4\x16\xceW\x14\x17\xe4\x03\xf2zZ\x1d\x8d\x14\x8a\xdb\xe1\xf7Ks\xf15\xab\xfd>'n\x95\xae^\x9d\x98X\xa4\xc0\x8cGc\xe3\x99\xdf0geeE*\xa9\xabP,\x88-[\x83A'\xd6\xed\xee\x9e\xbcm\x02\xe56\x02\x13\x1dwb\xdc\x08SG#\x85b\xc1l\xda\x14pd\xd0[w\xd7\xe4\xe4\xed\xbe\x7f;\x81q\xa8\xe7(??\x10\xc8\xfc\xba\nE6SW\xe7\xf5:\xb1nOo\"\xb1\xc0#R2i\x9aDD\x89D2\x99\xf9\x0ds\x1e\x08\xf8\xfd\x99_W\xa1\xc8f\xaa\xab\x9dq\xa0\x8eDL\x0b\x00\xc6\xc6,k\xa6\xdb\xa4[\x04F\x88h4\xe6\x80y\xb7l\x05\x00\x00e\xc3\xa0P,\x94`P\xb6\x10\x14\x16\xea\xba\x13\xb7I\xbd\xbd\x93\x933E23D0\xceD.\x0c\xc1@^^\xe6\xd7U(r\x89\x9aj\x8f\xe1\xc4m\xd2\xc8\x88e\xcd\xf4\xe73l\xc54g\xfe\xd14\xc3\xfc~gN\x91\nE\xeePRjx\x9cX7\x1e\x9fy.\xe4-\x02C\xe4\x8c\xc00&m\x19\x14\n\xc5\xe2\xf1\xfb\xb9#\xe9\x85\x89\x89y\n\x0c`Y\x8eD0\xd0\x94\xc0(\x14K\xc4\xe7\xd3\x1ci~\x9c\x98\x98\xe7\x11\xc9\xc1\x08FS\x02\xa3P,\x0dY\xd9\x9by\xe6}Dr,\x07\x03\xddP\x02\xa3P,\x8d,\x10\x18\xce\x9d\xb9$6\x0c\xdd\x91\xe0N\xa1\xc8\x1d\x02\x01g:\xf8f+-\xb9E`\x18\x0b\x06\x9d\xb8.vj]\x85\"\x97\xa8\xadu\xe6.\xb6\xa6f\xe6uo\x11\x18\xce\xcb\xcb\x8b\x8b\x01\x10\xe7\x19	\xb6\xa6\xd6yg]\x85B\xb1h\xb6o\x0f\x06\x19\x03\x0c#3a\x8c\xbd\x8e\xbd\xee\xcd\xcc\x10\xc1Hs\xedL\xcd\x1f\xb2\xd7Q\xa6\xde\n\xc5\xd2),\xd4u\x80\xb1\xbd{KJ3\xf1i\xb2\xd7\xb1\xd7\xbd\xf9\xfb\xb3\xc6(\xba\xb1y\xf3\xea\xd5\x00cyy\xbe4\x04]\xf6\xe3\xda\xeb(\x14\x8a\xd4\xb1o_u5\xe7@y\xb9a\xa4\xa3u\xc0~\\{\x9d\xd9\x98\xf5[\x8cy\xbd\x1e\x0fc\x1e\xcf\xee\xdd;\xeeH\x9d\xd0\xd8\x8f3\xfd\xb8r\x9d\xd4\xbf\x04\n\xc5\xf2\xa5\xa8HF\x14_\xfbZs3\xd7R'4\xf6\xe3\xd8\x8fk\xaf3\xdb\xcf3\"\xa2\xf9\xb8\x83\x13MN&\x12Df\xf2\xd4\xa9\x8b\x17\x01\xcb\xbav\xad\xa7\x07\x00\x13B\xdc\xce\xfcw*\xc7b\x1f\x85\xa6##%,\nE\xa6\x88DL\x13 \xfa\xfb\xbf\xef\xe9\x11\x02x\xf9\xe5\xf0 \x11\x90L\xde\xfe\xc3o\xe7X\xec\xa3\x90\x1d\xb1\xcc%,6\xf3\x16\x98\x9b\x91v\x0eDB\x84BCC\xd2?\xe6F\x83*\xc6\x82A\xbf\x7f:y\xabr,\n\x85{\x18\x19\x91\x82s\xfc\xb8\xf4\xd4\xed\xee\xfeu\xe3(\xfbVh\xdb6\x99\xbc\x9d-\xc72\x17\x8b\x16\x18\x85B\xa1\x98\x0b\x07\xc7h+\x14\x8a\\\xe7\xff\x03\xdb*\xe3!=\xb9\xad\xe1\x00\x00\x00%tEXtdate:create\x002020-06-16T12:50:13+00:0041-:\x00\x00\x00%tEXtdate:modify\x002020-06-16T12:50:13+00:00El\x95\x86\x00\x00\x00\x00IEND\xaeB`\x82\x03\x00PK\x07\x08\x06\xa8H\x98\x8a6\x00\x00\x836\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00A\x16Q]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00index.htmlUT\x05\x00\x01[\xe2\xd2j\xbcY\xddo\xe3\xc6\x11\x7f&\xff\x8a\xb9\xcb\x15\xa4Z\x8b\xf2\x05\x08P\xc8\xa2\xd3\xf6ri\x83&\xed5g\xf4\x03\x87C\xbc&G\xd2\xc6\xcb\x8f\xdb]\xca'\x18zN\xdb\xe7\xbc\xe7_\xcc\x9fP\xcc~P$E\xd9\xba&\x88\xfc`rv\xf67\x1f;3;\xbb\\<\x99N\xc3\xd7\xaf>\xfb\xe7\xf4s.\xf0EUo%_\xad\xf5\x15\xbe\xd7s\xf8\xf8\xfc\xe3sx\xa9\xd7(\xe0\xabJ\xaeX\x19Z\xd6/y\x86\xa5\xc2\xe9\x179\x96\x9a/9\xca9|\xf5\xc5U8\x9d^\x86\xe1\xe2\xc9g\x7f}q\xf5\xafW/a\xad\x0bq\x19.\xe8\x1f\x08V\xae\xd2\x08\xcb\x88\x08\xc8\xf2\xcb0X\x14\xa8\x19dk&\x15\xea4j\xf4r\xfa\xdb\xa8\xa5\x97\xac\xc04\xdap\xbc\xab+\xa9#\xc8\xaaRc\xa9\xd3\xe8\x8e\xe7z\x9d\xe6\xb8\xe1\x19N\xcd\xcb\x19\xf0\x92k\xce\xc4TeL`\xfa<978\x82\x97\xb7 Q\xa4\x11\xcf\xaa2\x82\xb5\xc4e\x1a%\xb35\n\xfe~\xaa%+UR\x97+\xc3\xab\xb9\x16x\xf9'\x1a\x81W\x82mQ.f\x96\x16\x06\x0b\xa5\xb7\xf4\xf0;^\x90.\xd0H\x11\xc3\xd3\xac*\x8a\xaaL2\xa5\x9e\xc2\xe4\"\x0c\x0b\xc6K\xb8\x0f\x835\x92\x03\xe7\xf0\xfc\xfc|\xb3\xbe\x08\xc3 \xe7\xaa\x16l;\x87\x95\xe4\xf9E\x18\xd0\xbf\xa9\xc6\xa2\x16L\xe3\x94Idj\x0eO\x89\x05\xe5Sx\x9as\x89\x99\xae\xe4\xf6\xe9\x01\xaf\xac\xee\xd4\x1cX\xa3+x\xbe\x94\x17\xe1.\xfc\xc8N#\xb9\x86\x95\xd0\xe6`\x89f\\bQi\x9cn*\xd1\x14\x08\xf7\x1dm\x96\x02\xdf\x8f\xb0\xfc\x9a\x98\n&W\xbc\x9c\xc3'\xf5\x18\x0b/\xebF\xbf\xd1\xdb\x1aS\xc9\xca\x15\xbe\xa5)f%\x8c\xd5\xbf\xa2)\xa1urk\xccTK\xc4\x81\x9a\xed\xe0E\x18T\x1b\x94KQ\xddM\xb7sP\x99\xac\x84 \x94`1s\xbe_\xccl\xd4\x84\x8b\x9b*\xdf\xd2\xa2\x90\xbf/\xc3 X\xb8\xe5d7\nx\x9eF\xd6xZ\xd4\xee\x98\x19\x12U\xc6D\x04f]\xdb7\x96i\xbeA\xc3\xee\xf9\x0b\xcc9\x9bZ\xa0\xcb\xc5l\x84x\xc8M\xf1)+\xa1 o$\xd3\xbc*\xd3\xe8\xf9\xf9y4\x98\xee\xb9\x1c@\x8e\x9aq\xff\x16,TS\x14Ln/I\x88\xe0J/f\x9e\xe2\x18,\x94\x1fn\xb1[\x82a[\xcc\xba\xb0\x9eG\xb3\x9b1\x97\xd8\x95m}\xe2^\x1d\x90\x9bI\x89B\x81?U(0\xdb\x8b=\x18\xb0\xb3T\xcd\xca\x0e\xf6Ti\xa6\x91\x1cA\x03=\xe4\x9f\xe29\xbe\xe9\xca\xb0\xc1\xeb\xf4\x0e\x167\x8d\xd6UO\x89\xa2!\x1d~\xfc\xe1\xfb\xef\x163;z\x9c\xd7\x82M\xf3\xea\xae4S\xfe3\x9cb\x12`d\x86\x12<G\x19\x81I\x8d\xc8\xe4F\x04\x05/\xd3\xe8<\x82\x82\xbd\xb7!\x01\x1b&\x1a4\xb4\x9c+v#0\x7fT\x97\xa66\x9a\xfc\xb7\xaf\xc9b\x96\xf3\x8d{\xfc\xe5C\xa9\x13Y\xea2\xdcgb?\xe7[\xe4\x01\x99r\xdb\xa60\x15\xd8L\xf2Z;\xbf\x15U\xde\x08\xbb\x96\xae\xe0F\xc9\xac?;\xf9VE\x17\xbd\xf1~\x90\x1c\x1b'sQ\x1e\x8ez\xab\x0fG\xa8\xac\x8cP\x07\x91o9\xf6,\xf7\x80\x02\x8b?b\x89\x92\xe9J\xc2\x0e\x96\xb2*HO\xa2\xb7\x80YU*\x0d\xdf\x08\x0e\xf4K\xfb\x93b\x88\x04\x8fhki\x19U\xd5\xc8\x0cG\x18\xed\x80a\xee(A\x81\xb1D\x9d\xad_\xd8\xdcyU\xf1R\x9f\x11\x95\xec=$\xb2F\xe1\x01U\xe9\xaa>$\xa2\xee\xd2^\nV+\xcc\xc7\x86\xae\xbc\xa7\xc6\x06\xffn\x82\xdb\x8eh\xac\x8f\x0cQ\xeav\x87\xce\xc2\xd6\xb0\xbf5\xd8\xd8\xf9\xac\xae\xb1\xcc\xaf\xaa=\x85tl\xa4\xc4R\x1b\xda\x95d\xd9\xada\xa5\x8a\xb0\xb1\xef\x9f\xcb\xaahg\xec\x17\x89\xd5\xbc]\xd1\x17\x8d\xd2U\xf1R`\x81\xa5\xfe\x1aW\\i\xb9MjY\xe9\x8a\xc25\xb9[c\xf9{!>\xc3%/1\x87\x14\x96M\x99Q\xfd\x8f\xe1\x96\x97\xb9\x82\x89]\x08\x89\xba\x91%\xbc\x92U\xc1\x15&L\x08\xc7\x90\x14\xac\x8e\xe1\x16\xd2K\xd0k\xae\x0c\xa0C#\xf2\x84\xfe(\xd6wa\x1b\x08\xb4\xfcX\xea?\xd3tH\xe1\x0dY\x15\x8dm\xb8\xd1Yg\xa8\x9f\"#Cn\xe7\xec\x0e\xf8\xc4\xe8\x115\xbb\x19\xbe[\xb8\xb7&\xfc\xbc\x03\xc0l\xb7\xff\xe0\x92\x97\xab\xd8y\xc1\xea\xdf&\xf3\x95D\n\xe7\xbc\xca\x1a2(y\xd7\xa0\xdc\xbe6\xbb\x8cI\x80Q\x9b\\\x94;,\xbf/<\x04\xf3\x91\xd1\x04F\xdd@h-\x98\xeb\xa6\xe0\x03\xc1\x9c\xe3\x0e\xa0\xa8\x90\x9e\x0c\xd5\xba\xbac\x9fQ1ay\xfer\x83\xa5\xfe\x92+M\xd5!\x86\x88\xb22:\x03\xa4\xa81\xe1\x05N\xf7\xc4dq<\xb9\xe8\x11m} \x1f\xbdy\xdb\x19!\xfd\x92\xcc&\xc9\x17\x1a\x0bH\xe1\xb9\x19\xde\xedM9\xaa\x02A\x1ba==\x02\xbe\x84\xb8\xa7K\xee3 \x08<Y\xb0\xad\xd30\xd8\x01\n\x85C\x86\x8e\x0d\xc1\xeeT\x85\xd4-\xef\xfb$\xf0.Mh(\x9e\x9cl\x9aB\xbc=DB\xe9}u\xc5\x0b\n[L\xec\xee\xb8w\x99\xaf\xad(\xc7\x14\xf4\x9da\xb66\x9dAO@\xbb\xd8\x9e\xc9\xe0k&W\xa8[Zom\x8e\xcb\xd1\xbc\xc0\xa6\xce\x99>&ch\x86\x13\xd3!\x9f(	\xcb\x1c\xf3\x03!\xaa\x12\x98\x88j\x15C\xa4\xa9\xea\x82esKx|U\xbc\xf7LX\x8eH3`\xd6y'\x08\xf5\x8c]\xb1\xbdT\xf0\xcb\x97\xacP\xbb\xfa\xae\xfe\xb0\xbdb\xab\xbf\xb0\x02\xfb\x9b\xaa\x0f\xa6~\xf0:\xff\xec\xba5\x8f6\x17\x8d\xbfX\xd1s\xbf\x87j\x8c\xd5\xe8\x84\xe2g\xfat\x8f\xf8H\x0dt}\xaf\x99\xe2=<\xa8{\x1f\xaa\x9a\x0f\x8b>\\\xdbi\xd9]\xe1tK\x87-Z\x1f\xd6\x9d\x87\xdb\xdf\xe3\xb0\xc3\x1e\xbf\x8bF\x1d\n\xc0\x07\xa1\xd1\x94\xb6\xd2\x0f\x8c\x1c\x8b\xfd#5c\x9fj\xd7\n\xb5\xe6\xe5\xca\xc7\xc6\xb4\xa6no\xef?\xd0\x15<\xbbos\xdd\x9cAv\xd7\xce\x8c\xa3][\xbc/Bf\x06L\x0e\x8b\xdd\x83\xe9J\x81\xb2\x19KW:?ujh\x923\xcd\x14\xea\xc4\\\x91\x1c\x18g`\xc8:\x03\n\xcf\xeey\xee\xda\xb5w\xd4\xbe\xb5v\x8c\xf5v1I\xeae\xec\xe3j\x1f\xad2\x1f\xa6v\xbb&\xb6\xb2Ze\x9d\x0df=x\xde_\x83a\xc3:\xd4\xfd\xb1M\xf9\xa0/8\xe8\xdf\xe3I\xa2\xd7X\xc6 q)Q\xad\x07\x9ey\x08\xfc\xa1\x1d\xdfT\x82D\xe3{\x13Gdk\x9a\xa6v\n/WQ\xa7\x07\x18\x9e2\x8e(4\xd2\x19\x9c`\xc6O\xe9\x16\xc6\x0e\x0c1D%\xbeo\x8b\xc7I\xc0\x07\xcd\xc3\xf8i\xa9\x93Z\x9d\x8d\x17\xc6\xbd\xd1.\xbe\xadB\xa7V\x88\x81d{\xa8:\xcc\xe9\xe3\"\x83 8\xb50\x9a\xeb\x12\x98\x8c\xaa&x6\xf4\xc9\xe8y/\x86\xe9'?\x93:M\xfd\xd3\x95yL\x17*\xe2'\xda;<\xc4\xc6f\xd3h\xab\x1e\xbd\xe4\xf0\x84RF\xcb\x06\xa3\x87\x9c\x10\x06\x9d3\x96\x0b\x13\x7f\xbe:<\xef\xb7\xe9\x95\xd5{m\x82\x91t\x85\xacN\x0c\xd9Hr\x8d\xbc\xa7\xd9l\xa6ZR\x9bN\xceI\xeb\x14\xa3n\x0c\xa7p\xee@L:\x1enpv/1\"\xdb\xa1\x8b\xb0/\xd77\xbd\xaf1\xab:\xe7h:\xbb\xb4B=\x8f\x85\x1a\xcc\xe8\xab\xe0\xcdA{W\xf1\x10j\xdf\x94\x839-\xae\xfd\xef2\xd2_\xe5YU\\\x7fA^k\xca\xdc\x9e\xe6/\xfa\x8a8\x96']\x96}\x99\xf4\xb0\x1dOY\x8aC	F\xc2\xc7p\x11\xbd\xc7\xd3\xab\xc9\x9e\x03>\x85\xe8\xc7\x1f\xbe\xffw\x04s\xf3\xf0]\xe4\xe6\xd8\x05k\xe3\xcc\xc6\x93\xd9\x8f\xda@z\xd7\x89\xa3\xb6I\xcbn;\xa7K\xb3\xe0\xd9\xadJ\xeaF\xadcH\x92\xe4]\xb2\xe6\x8a\xbaY\x98\x1c\xe5h\xea\xac*h\x8f\xf7\xb2\xf7\xdb4\xb5\x18t@%\x19\x0e\xda\xdc\x9ahR\xe5\x1b\xc1c\xd0\x89\xb9\xd5?\x83{\x88(\xa9\xa6<\x8f\xe6@\xed\x04\xec\xfc\x0dJw\xe3\xef\x9fz[\xf5\x12\x81\xe5J\xaf\xe17\xee \xdc\xa6\xfa\xaes\x87\xe3rn\x7f)\xd3\xbd\"R\x83\x1b\xa1\xb8\x7f[\xe3\x9d\x18OZ/\xf6\xaeI:]\xa5\xadg_\xbb\xca\x93\x0e\x8e\x16\x17\xe1\xcfy\xb4h\xa1\x8c2\xaf\x9c\xdf\xff\xbf\xbb\x8b\x16\x8b\xeeN\xdb\x9e\xf8A\xac\xce\x05J\xe8s\xd5h\xf2ZW\x92\xad\x90\x8eh\xb4\xfe1\xb8\xcf;t\xe7DM\x85\xcf\x17\x12\x95hv\x03\xe9I\xf3\xf6Kj&\x8e\x94p\xcdnF{\xc0\x1e\xba\x1a\xd3\xeal\xbf\xbd\x92B\x83\xc2\xdd;\x05\x8e	\xc6\xd24\x89\xfd\xdd\xd2\xfa\x93\xdb+\x9a\xfd\xb5\x83\x19Sw\\gk\x88\xa1\xf5A[D2\xa6\x10\xdcw\xae\xb9\xab	\xfd\x06\xd5^\x9b\xee\x1b\xeb\xf8\xd9=IIZ5wg\xe0H<\xdfM\xa8i5p\x9d\xb6\xdb\xe1\xf6\x02'\xb1\xb8/\xd6\\\xe4\xb1IP\xc7\x15\x18t\x9b\xaa=R\xc1\x0b\xa4\xfb\xd4Nb\xfb\xf1\xc0\xdd{\xc7\xde,\xfa)\x99\xcd\xe1\xba\xfd,\xc0Q\xcd\x0eT\xf7\x14\x9e\xef>eY\x86\xb5N\x9f\xdd\xeb\xddu+9\x08H\xe4\x1c\xf4\x9e\xb2\x83\x89\x7f\x9ex\xead_\x91\x82\x1b\x89\xec\xd6\xbfY\xff\xbaof\x8f9\xb8\xba\xf9\x96\x0e\xb0'x\xd8\x9dc\x0f\\\xdc\xbb\xe3\x8e\xa1o\xed\x19\xb8U\xda\x1b\xe0J\x8dTf\xe7\x1f\xd3\x0bs\xaf\xd6\x9a\xd1\xd7Sxv/UM\x0e\xbb\x86\xc9!P\xaf \x1d\xf1I\x8eK\xd6\x08=\xf4\x06JI]\xda\xb5\xbba\xa5\xcc\xe0\n\x9e\xdd\xfb\xa0\xdd\x9d\x81ZW\x8d\xc8\xe1\x86>0\xc3\x1b\x1f\xb7g\xad\x83\xe1\xed5L\x06M\xbe\xbf\xcfo\xd5r\x15\x942\xb3\xd4(7L\x0c\xf4>\x83O\xce\xcf\xcf\xdd\\\x93\x96\xf4\x91\xd9|\x7f\xba\x0c\x173\xfbuy1[\xebB\\\x86\xff\x1b\x00PK\x07\x08,\xcb\"\xfa\x91	\x00\x00\x1e!\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa0s\xc8P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00	\x00media-controls.jsUT\x05\x00\x01,K\xde^\x9cUQn\xe36\x10\xfd&O1?\x85\xe4B\xb6\xd3\x05\xfa##\x05Z7\xdb\x0d\x90\x14E7(\n,\x16YF\x9cX\xac)R G\x8e\x0d\xc3g\xd8s\xf4\xab\xbf\xeduz\x81^\xa1 E;r\xe2n\xb1\xf9\x92@\xbe\x99\xf78|3\x9cN\xe1\xedO\xdf\xff:~\xad4\xcem\xbbqjQ\xd3\x0d\xae\xa9\x84Wg\xaf\xce\xe0\x82j\xd4pm\xddB\x18>\x9d\xf2=\xfeJUh<\x8e/%\x1aR\xf7\n]	\xd7\x977\x9c\xab\xa6\xb5\x8e`\x0b\xd2V]\x83\x86^;\xb1\x08\xdf\x02Pc\xf3\x03\x1at\x82\xac\x83\x1d\xdc;\xdb@6\x99\x86u?\xf9\xcdg3\xce+k<\xc1\xed]Gd\x0d\x00\x9c\x1fG\xe5\x90\xf5[\x19\x8cf{\xb02mGp\x12\x1c\xb7\x86XO\x1b\x8d\xa7\xb1q+bSb\xc2\xa6\xd5\x82\x10\xce\x9f\x1d&\xe7\xacO\x95\xc3\x07\xceXY[O\xb0\xe5\x8c1\xa9|\xab\xc5\xa6\x84{\x8d\xeb\x19gl\xc7\x19\xfb\xb2\xdfk\x84[(S\xc2\xd7\xeda'\n|G\x9b\x16\xcf\x9d0\x0b|\xdf#\x1f\x94\xa4\xba\x84\xaf\xce\xce\xbeH\xc8\x0f0*8K\x85\xc9!\xfb\xfb\xe3_\xff\xfc\xf91+`\x0bJ\x96\x90y\xb2m\x06\xbbg\xa8?\x8ePAZ+:\x8f\xa7\xa0\xbf\x0f\xb2-\xd5c\xb6\xa81\x8f\xc2z*\xad$\xba\xac\xe0\x8c\x05\xe1%dQz\\X	\xdda	g\x05g1z4\xe3\x1c\xd7\xd1\x12\x95\x16\xde\xc3\x1b\xd4j}\x8dR\x89\xb95\xe4\xac\xf6\x80kB#=\xbc\xb9\xb9\xbe\xba\xd0\x18\xcc\x12\xc8<	R\x15,\x90\xc0\xdeyt+\x94\xdf\x129u\xd7\x11\xfa|\x14 \xcc!u\xce\xc0;\xc8d\xe7\x04)k\xb2\x02\xb2\xaas\x0e\x0d\x8dI5\x98\xc1\xfb\x19g;\xce\x99\xd8\x07\xcf\xeb W\xce\x85\xd6w\xa2Z\xe6\x10v\n\xb0Z\xfe\x12\xd4\x17`\xf0!\xfeAO\xe2\x1f\x14U5\xf4\xb8\xb4\xc6*\xe1q\xc0Z\x86\xbbgT+?\xb9\xed\xeb3i\xc4\x1a\xce\x0f\xb9f\x8fAG\xf2N\x04\xc6\x1a>	\xdd\xf5g\x08\xc5\xd8s\x1e\x97\xe0)u<4\xf3C<\xac\x92\xf8\x88\xf5H\x87r\xe6G\xf5[\x85\x1e8\xd0%\xb57\xaa\xc1O0\xae\xd2\x19\x13\xe70\xe8\x93\xb4	\xd8_\xd5\x90:\xb6\xa0\xeb\xaa\xd0\xc7\xe9\x1a\xba\x16]\x1e\x0c\x95N \x88DU\xbf\xad\x85\xb4\x0f9l\xa1\xb12\x98\xd1\xb6h\xa2wg{\xa0\x8f\x90\x9f\xad\xa5\x89h[4r^+-\xf3C\x83O*m\x0d\xfeh%\xe6@.\xde\xfb\x80\xe66\xb4\xd6DHy\xb1BCW\xcaS\x98F9d\x95V\xd52+\x00\xe1\xfc\x9b\xa8p\x8fG\xd3c\xf3\xd4\x96\x05\x98N\xeb\x90\x93\xb1\xa1\xae\xdbC;\xbe4\xfdc?\xff'Gh\xe5\x17\xab\x0fc\xe0Y\xe6}Y\xf7n;\x91<\x8e\x8c\xff+\x0db\xe4\x9f\x90p\x0b\xa4d\xfbA\x91\x82\x03\xa7S\xc0\x80\xf7e\xfc\x1f\xc3\xe1\xc4\x05\x84{)\xd2rPZ@h\xe7\xfd\x02\xe2\x92\xb3!\xa1\x11\x0d\x16\xd0\x8a\x8d\xb6B&KF\x97Aj6\x98w\x9els\x04\xdf\x82D\x12J\x97\x87\xc0\xe1\x05\x86a/\xa8\xaaS\x08\x1e5N\xf4M>\n\x8f\x0d\xc0\x16\xd2\xa8zj\xc8\x05R\x9ay\xdfm.\xe5\xde11\x06F3\xd8\xf5M\xf8\xe8\x94|\xf4\x19\xb9\x0eQ\xd90W\xa8\xd5\x0bt-\xd5	]\xbd\x05\xf2\xd1g\xe6\x8aQ\xd9\xe1\x8c;\xce\xab\xbe\xf6=\xccO$\xde+\x13\xe6R\x1d\xde\x8bq\x13\x1e\x8cq\x95^\x8c\xac8\xf5\x8c\x8cf\xfc\xdf\x01\x00PK\x07\x08\x13\xa7\xf4=\x81\x03\x00\x00\xce\x08\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00media-player.jsUT\x05\x00\x01\x93\xef\xd3^\xa4V\xdbn\xdb8\x13\xbe\xa6\x9eb\xfe\x8b\x1f\x92\x80D\xce\xf6\xd2\x8eS\xb4i\xba-\xd0\xb4\xc1\xd6\xc0\xee]\xc1\x92c\x8b\xadD\n\xe4(\x8e\x11\x18\xd8\xa7\xd9\x07\xdb'Y\xf0 \xcb\x8e\x9dn\x81\xbd\xf1A\xfc\xe6\xe3\x1c\xbe\x99\xd1d\x02\x9f\xef\xde\xfcq\xfeV5xm\xba\x8dU\xab\x9a\x16\xf8@Sxq\xf1\xe2\x02n\xa8\xc6\x06n\x8d]q\x9dM&\xd9\x80\xff\xa0\x04j\x87\xe7\xef%jRK\x85v\n\xb7\xef\x17Y\xa6\xda\xceX\x82G\x90F\xf4-jzk\xf9\xca\x7f\x9f\x016\xd8\xfe\x8a\x1a-'ca\x0bKkZ\xc8\xab\x89\x7f\xee\xaao.\x9fe\x990\xda\x11|\xe1\xbdT\x06`~hS@\x1e\x0er(g\x03\xd2\x99\xde\n<\x81\x8c\x07\xfb\xd0{%\xf1$i8\x08H\x1f\xdfe\x8d\x8dz8oQ*~\xde5|\x83\xf6\xca?g\x97\xe9.g\xc5<\xff\xfb\xcf\xbf\xf2\xf0\xf8rr\n\x9f\xe1C\xc8\x83h\xb8s\xf0\xce#n=\xe1]\xe0\x03| \xd4\xd2\xc1\xbb\xc5\xed\x87\x9b\x06}~\xe01\xcbX\x08\xca\xf6\xc2\xfbU\xc2c\xc6\x98\xeb;\xb4E9\xcb2\xc6\xa8V\xae\xe2D\\\xd4\x9fk.\xcd\xba\x80Gh\x8d\xc4)\xe4\xa6C\x9d\xc3\xd6\x07\x91\x80.@~3\x86*\xdeu\xa8\xe5u\xad\x1aY\x1c\x15\xa6\xc8\x18c1\xe3\x9eO\xc9)\xe41\x8c\xc0w\x961Vz\xda\x8c\xb1\xc9\x04\x16\x9f\xde|\x9aB\xcb7_\x11\xba\x9e\xc0|uh\xef\xb1(Ai\x10Fk\x14\x84\xf2\x9a7\xcdW.\xbe\x17%p-A*\x97\x8e\"n\xfc\xbf\x0f}\x99\xb1\x98\x80\x81\xd4\xc2\x1c4\xae\xe1\xb6'N\xca\xe8O\xe9q\x01\xa2\xe6z\x85\x0e\xe6W!I\xde\xb1o\xbd#X\xaa\x07\xe8;\xe0M\x03\xb1X\x0e\xd6\x8ajhU\x8b\xb4\xe9\xd0U>\xd8;kZ\xe5\xb0\xe2MS@Hj\x02W-\xef\n\xe0n\xa3\x05\x8c\xe4L-\xa1\x80\xff\x81\xab\x9c\x15\x10\xcb\xc2\x18\xb3H\xbd\xd53\x80\xc9\x04\xd6\xb4\xf4\xde3\xb6\x0d\x9f\xc1\xc0U\xfe\xc6#|\x82\x85\xaf\x18\xadu\x1d\xcc\x81\xaf\xb9\"X\"\x89\xda\xdb:+\xce|u\x91j\xe3+\xf2\xee\xe6\xd5\x9b]}\x19K\xe4so\\\xd5\xc8%ZW\xad\x90\n\xc8\xaf\x8d&\xd4t\xbe\xd8tQ\xfe\xde-(\xa1\xac\xa8F]@Q\xfa\xc8B\xd8_,j\xe9\xe5\x15i\x13\xfb\x90\xfd*\xfd\x889\xf2\xde\x08\xaf\xa0\x0f\xca\xd1\x14\xc8\xf6\x98\xdc\xd9f\x19\x1b\x99\x1ewU\xc4$\xecy\xb0\xafB\x97-,\x17\xdf]\xd5\xa0^Q\x0d/\x87\xa6\x9c\xa6\x96\x9f\xed\x8c\xa3\x02S\xb3\xa2\xa6\"\xa5q_\x9e^\x9a\xcc\xd1\xa6\xf1\x0d\xb0V\x92\xea)\xfcrq\xf1\xffY<a\xb2\xb7A7Q-\xd3\x83\xc8\x1djys\x1f\x88\xf3C\\\x1e5\xcf\x18\xa9\x16\xfbNr\xfa\x81\xe9\x88\xd9\x99\xf9\x94\xca\xe7-\xc2\xf1\x08\xb6\xd6\xd8)\xe0i\xf6p:`\xb7\xe1\xf3\x95\xb5|S\xf9\xd1\x99\xa4\x1bjbQC\x19\xd5\x8b\x8d\xe7\xc2\xa6\x12\x8d\xd1\xf8\xd1H_?_\xac2\xf2\xc4N\x8eI^sw\xc7{\x87r\xa8Q\x17\xfe\x9d\x1a!Jk\xb4~\\\xc1\x1c\xf2\xfc_\x87L*\x9f\x97\xc7\xd0>\xe3e\xa9%\xe2\x8d\x0d\xdf\xf8\xf1\xe6{\"*i/Y\x9a\xb7x\x06\x1d\xdf4\x86\x0ff\xd1sL\xa3\xe1\xbawd\xda\x94\xaf\x08\x7f\x04\x89\xc4U3\xdd\x19&]\x87\xfb\xa4r\x1d'Q'\x13\xdc)x\x854\xcc\x0c?z!\xb67\x1c\xe5{\x85\x94&\xb6{\xbdY\xf0\xd5G\xde\xe2\xfe\xba\x81r\x06\xdb\x8c\xb9=:\xbf1\xdc\x81\xfbr	\xf3\xe3A|pY\xb2\x19=\x7f\xa6\x02\x87\xb3}y\x10O\x18\xea\xb1\xe5\xd2:IQ\x1d\x0c\xbd\xa5j\x08m\x11'^\x1c,\x95#n\xc9\xfd\xae\xa8\x1e\x96\xee$\xc6\xe6\xc9C\xae\xf6\xda\xf9\xbfp\x07\x9a\xa7\xdc\x84\x0f\xf4,\xf53\x05 \x8f\xcfS\xf81\xfdV\x14p\xcf\x9b~\x18\xc2?\x9d\xc6\xf4V\xe1\xd7\xa1\xb3b\x9aH\xb6;'Sz\xbfx\xf1*\xbdJz8\x95\x86q\x07\x8fn\xbf\xde\xbc\x97\xc5\xb8c\x87\x82y\xb2D\x11\x1c}\xc2>v\xca6c\xa1O\x7f\x08\x8e\x80D\xfddo[\xec\x1a.0\xaeF\xae\xe12\x14\xf8*\xac\xea\xcbP\x90+X\x1a\x0b\x84\x8e\x94^\xbd\xcc\x98\xe0\xda\xbf\xb9\xf8\x95R\x00\xc1\xb1\x96\x9e:\xfb\xd4`\xd4M\xf0L\x9e\x90\xe3Q\xbc\xc3,J\x82\x1b\xa6\xf4O\x98\x0e\xd0\xd1X\xf4\xd6\xa2\xa6\x85j\xf1D'\x1cy?\xa2G9\xedS\xc0\xfd\xcf\xe4`4\x809\xdc\x07\xa6m\x96\x898\xb2\xd2\x0c\xa9$.\x95\xf6\x13\xe4\xf852?;~s,g\xd9?\x03\x00PK\x07\x08A\xa2\xdey\x9c\x04\x00\x00\xb4\x0b\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0b\x00	\x00playlist.jsUT\x05\x00\x01\x93\xef\xd3^\xc4W\xcdn#\xb9\x11>w?\xc57\x97\xe9n\x8c\xd4\x9a\xd9\xa3\xec\x9e\xc5\xae\xd7\x9b50\xb3\xb3\x88\x8d \xc0\xc0\xf0R\xcd\x92\xc4\x98\xcd\xd6\x92l\xdb\x82  o\x90[\x0e\xb9\xe4\x96c\x8eA\x9eg_ y\x84\x80l\xf6\x8fdy\x92[.\x16],V\x15\xab\xbe\xaa\x8f=\x9b\xe1\xfa\xa7\xef~?\xfd^H\xba\xa87[-Vk{COv\x8e\xaf\xde~\xf5\x16\x97vM\x12\x1fk\xbdb*\x9e\xcd\xe2N\xff\x83(I\x19\x9a^qRV,\x05\xe99>^\xdd\xc4\xb1\xa86\xb5\xb6\xd8\x81\xd7eS\x91\xb2\xdfk\xb6r\xbf\x13\x90\xa4\xea7\xa4H3[k\xec\xb1\xd4u\x85$\x9f9\xb9\xc9\xff`\x92\xb38.ke,\xee\x16\x8d\xb5\xb5Bqx&Eb\xd9BR\x82\xec\xac\xd3\xf4\x02\xfcO\x9a\x1c\xc0IM~`P\xbf\xa4\xa6\xbd\x9aK\xc1\xf9\x9a\xa4x\x9an$\xdbJa\xec{'\x8b\xce\xa5\x00g\x96M\x99\\4U\x910\x05\xbfJ\xda\xed\x88\x0b\xe3\x0e\xc0\xd2\x93\x8dg\xb3(:7u\xa3K\x82\xd1e\x91\xfc\xfa\xc7\xbf%\xb0\xdb\x0d\xb5\xcb`r&\x85_\x9d\xcf\x8e=\xc6\xf4\xe4\x13]Jf\x0c~p\xbb?\x85p@O\x96\x147\xf8\xe1\xe6\xe3\x87KI.\xfb\xd8\xc5q\xe4S\xa6\x9b\xd2\xd6:\xcd\xb0\x8b\xa3\xc84\x1b\xd2iv\x16\xc7Qd\xd7\xc2\xe4we\xa3\xb5\xd3/\xe0\xff\xff\xa5!\xbd\xbd&I\xfe\x10\x12)|\x12\x82vP\xbe\xb2Tu\x07F\xa2\xc1*\xb3\x96\x95\xeb\xeb5\xe3\xf5c\x8a\x1d\xaa\x9a\xd3\x1cI\xbd!\x95`?2h\xbc\xcao\xeb\xda\xe6l\xb3!\xc5/\xd6B\xf2\xf4\x19\x98\x90\xc6Q\x14\x05\x98\xa4H>\xa3\x94\xc44\x98\x94\xb8M&\xd8\xa1\x94\xa2\xbc\x9f#\xcdP\xbco\xaf\"\x94\"\xedR\x82\x02\x89w;\xf1F<\x82\\T\x82\xcf]\x95Yy\xef\xb2\xd8kd.\xbe8\x8af\xb3\xd6\xce]\xb3\xe1\xcc\n\xb5\x820`XJ\xb6\x82!\x8b\xc5\x16?\xbb_w\xd8e\xc4\xa4\xd9\xcf`\x8a\xa3Qa\xdb\xae	Uc\x99\x15\xb5B\xbd0\xa4\x1fH\xe7]\x92\x06\xbb\x05\x96L\x1a\x1aUE\x93\xe2}\xa1f3\xdc|\xfa\xee\xd3\x1c\x15\xdb.\x08\x9b\xc6v\xc6\xd2\x0cB\xa1\xac\x95\xa2\xd2\x12\xbf`R.Xy\x9ff>\x0e.L\xd8j\xf5\x86\xff\xc7\xaa_\xc7Q\x0b\x94>B\x14P\xf4\x88\x8f!\xf2OA\x9c\xa2\\3\xb5\"\xe32\xec\xc0\x14\x89%\xd2\xe3\x1c\xb58{\xf9\x8aQ\x14E\x9al\xa3\x95_\xef\xdd\x9f`7_\xd6\xfa\x92\x95\xeb\x14e\xef\xa2\xf5Q\xe6\x96\xe9\x15Y\x14E\x0b;\xbc~\xed\x84\xdb\x0dyQR:\xd8|\xf0U\xec\x02\x88$Y\x04tv`\xed\xd0\xee]\x07\xdb\xdfh\xcd\xb6\xb9\x9bM)\xca\\SU?\x10\xff\xb1\xe6d\x90\xe5B\x95\xb2\xe1d\xd2\xc3\xf3\xc8\x067Q\xb0\x89\x02e\xae\xe8\xc9^\x8b\x85\x14j\x15|D\x8fk!\xc9\xdd!\xa8\xb9\xc8\xdben\xd9\xeaGV\x11^\x15\x05\x92\x0fW\xa3\xd8\x0f\xac\x06mg;t\xf7\x91\x0b\x9f\xc4\xfe\xc7g\xecUw\xcc\xfb\xcb\x19\xe7\xfd\xa5N\x06>(|\xc6[\xdc\xfe\xdf\x82\x7fi\xcc\x08\xc5\xe9\xe9\xd3r\x88$\xc3\x1b\xbc\xf3\x1d\xe3\xfc\x1d\xd4m\xc0\x91\x1ap\x14\xaa\xad\xfa\xa4\x17'\xe2\xf6\xde\xef\x0c)~\xf9@\xca\xa6a<\x04\xe3\xc9\x04\n\xd9q\xdc\x9dd\x0f\x92\x86p\x1a\xafc\xe4\xec\xe2\x17|\x05\x15\xef\xd2\xcf\x06\xef\xf2\x08x\xc1Y<r}<2\xfa\x9d\xae\xa1\xf3\xb0hQ\xec\xa6\xa5i\x16V\x13\xcdauC\x13\xf4\xed\xd3	\x98\xb5Z,\x1aK\xa6\x95\x84\xa1\xbd\x8f\xe3\xf14\xba'o\x10\xaa\xb6\xd0\xf4\xa8\x85u\x02\x82{\"h\xb7t\x03\x81\x1eHoaEEy\x1c\xf5Q\xfa,\xb4c\xc7\xcf\xe3\xaeCG\x84\xb0\xa2\x0e\xee\xdfn\xafxW\x0b7m\x03%\xb5\xc7\xf9\x12\xc53\xbe\xf0t1\xeelo\xbc\x9f\xd4\xc8\xf2\x8amR\xa4\x90b\x02\x01O\x1awV\xfbc\xd1\x0e\x1b\xa6\xed\x1c\xc2c$\xb4~(\x8e\xc7\xe4\x14\xef\x90\xe1\xeb\xbeb	\xe6P\x8d\x94\xd8{\x86\x89\xee,w\x96s\xc7\xfc\x17\xb5\xb2\xbe\xee\xa3\xad\x81\xc7~\xfd\xf3?\xfe\xf5\xcf?\xbd@`c\x97.\x0e\xe1 \x8f\xcc\xd5\xe2\x05s\xff\xfe\xeb_\xfe\xfe\x82\xb1\x16\xc3\x81^\x0f\xb3\xf1\x19\x02\xb7\x07v\xc3\xaf+y\xe4\xcbsD\xa6\x83\xfc\x90\xb5\x97c\x94\x90k!3\xf7\x88\x99\xc2\x17\xaf\x1d\xf4<\x88N\xe0}\xac\x1c\xba.\x8e\xc6\x0d\xa9XE\x13l\xd8V\xd6\x8c\x87y\xe9o\xe3^[\xcc\x96\xebN\x8f\x1eq\xd1\x18[W\x07\x07w\xe0d\x99\x90\xf3\xde\x84\xbft\x08\xda8\xc2+\xe1\x88&t\x0c\xff\xa6o\x84\xf0\x82j\xb9\x0b\x9f\xfb\xe2O\x85\xa5*\xf1#s\x1fG}\xe3\\\xb4W\xedy6D^K\xfe;&]\xcf)z\xf4\xabp	\xf3(l\xb9F\xab\x17dQ\xc9\x0c\x1d9\x9a\x0f\xac\xf8\n)\xde\xe1\xbc\x18L\xbd~=\xac\xcf\x8b\xa32\xe7\x92\xd4\xca\xae\xc7\xdc5\"\xe20\x80\x07J\x1f\xc1\xa3\xb7\xe9\x80\x7f\x9b\x0bs\xcd*r\x14\x99\x1eO\xa7\x97L{w\xe1\x95!\xf9i:>4U\xfc\x97(\xce\x86p_\x1dZ\xcb\x85\xb9\xfc\xa5a\xb2\x0d\xd0\xb9\xcb\xbe4u\xc7\xc0\xfc\xd2\xb8\xdd\xbb\xfa\xc6QOD\xaa\xe6\x84\x03L<\x9b7~\xacjR\xc8\\\xb0\xf9RHK:\x05I7nH\x9e\xa0\"\xafw\xe4\"\x80s5<g\\\xd7\x1e\xc21<x\xc3\xb9\x83ttL\xe9.`\x8el\xe0a\x84@\x7f\xca\x90\xed!?PR\x0b\xbdI\xa7>\x8a\xa8G\xc9\x89x\x86\x01n\xbe\xdd\xde\xb4\xcf\x85\xd1'\xc5~\xc4%.\xb0\xa5\xe7\x8c\x1a\xd5\x16\xf5\xa3\xc2\xban\x1c\x9d*lH1U\x12\x96\xb5\xf67\xc3\x9a\x95\xf7y\x1c\x1d\xbe\xbd\xdd2\x94\xe3\xd9\xd3\xd3\x91\xdcY\xcf7\x82?u\xe8\n\x9d\xe5\xf2\xd9\x7f\x92\x9c\x98t\xce\xde\xe1\xa0;\xa6\x9b\xe0}\xf8\xb0\x19YF\xe1\\\xf6;c\xa2vY4\xf7b\x13\x92w|\xf2\xcd\x1b\xaf\x13\x857\xfd.\x1e\xf7\xe7\xd8\xc3{\xbc\x0dw\x7ffc:u\x9e=x\xf7q\\\xb6#\xb1}E\x9a\x9c\xd3R(W\x93\xc3\xaf\xcddr\xf4\x81\x99\x9d\xc5\xff\x19\x00PK\x07\x08q\xf8\xceX.\x06\x00\x009\x10\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00queue.htmlUT\x05\x00\x01\x93\xef\xd3^\xb4UMo\xdc6\x10=S\xbfbr\xa2\xb6]IiN\x85\xa3\x15\x90\xda\x9b\xd6h\x02\xbb\xcd\x1eZ\x14EA\x8b\xb3+\xd6\x14)\x93\xa34\x82\xe1\xff^P_\xbb\xb2\x17\xed)\xf6a\xa9\x99\xc7y\x8fo\x86R\xfe*I\xa2O\xb7W\xbf%\xef\x95\xc6K\xdbtN\x1d*\xda\xe1\x17\xba\x807\xaf\xdf\xbc\x86-U\xa8\xe1\xa3u\x07a\xa2\x01\xfaA\x95h<&\xd7\x12\x0d\xa9\xbdBw\x01\x1f\xafwQ\x92\x14Q\x94\xbf\xba\xba\xb9\xdc\xfd~\xbb\x85\x8aj]Dy\xf8\x01-\xcca\xc3\xd1\xf0\x10@!\x8b\x88\xe55\x92\x80\xb2\x12\xce#mxK\xfb\xe4{\x1e\xe2\xa4Hc\xf1\x13j\xf5\x05n\xb5\xe8\xd0A\x02\xbf\xb4\xd8b\x9e\x0d\xb9\x88\xe5\x9e\xbaa\x91\x8d\xab<\x1b\xeaF\xf9\x9d\x95]\x80\xd4B\x99\"b,\xbfk\x89\xac\x01%7\xdcam?c\"\xb4\xe6\xc5\xb0\x06\xa1u\x9e\x0d\x90\x1e]\x05\xe2\xa4\xd1\xa2\xd3\xcaS\x91g\xcf\x02G\x8cT\x0eK\xb2\xaeK\xc8!\xce\xc8g\xe1\xa0q\x94\x92\xfb\xd2\xa9\x86\x80\xba\x067\xbc\xb6\xb2\xd5\x18\x8e\xccT\xddXG\xc0\xd3l\xb99\xfd\xdb\xf3\xb7\x8b\xfc\xa4k\xc8\x1cS\x8f\xb0G*\xab\xde\xa65\x88\xa6A#wv|\x1cN\xfaN\xeb\xf7\xce\xd6\x8b\xd8\xce\x89\xf2~\x8e\xc2\x13\xec\x9d\xad\x03\x8fh\xd4K\n\xd4X\xff\x88\x06\x9d \xebN\xc0!\xee'\xad\xa55\x9e\xe0/\xad \xfcm\x96\x9bb\xe0ZqX\x9d\x00\xbdm]\x89g\x80C\xa2\x07G\x8cI[\xb65\x1aJ\x0fH[\x8da\xf9Cw-c8\xed)\xacR!\xe5\xf63\x1a\xfa\xa0<\x05\xd6\x18x\xa9Uy\xcf\xd7\x80\xb0)\xe01b\x8c\xbd4$\xee%=\x8dd\x97\xad'[\x8f4\xbf\xe2Ayr]\xda8K6\xf4.\xfd\xa7B\xf3N\xeb+\xdc+\x83\x126\xb0oMI\xca\x9a\x18\xee\x95\x91\x1eV\x13\x0f\xb5\xce\xc0\xad\xb3\xb5\xf2\x98\n\xadG@Z\x8b&\x86\xfb \x88*\xe5\xfb\x82c\xb5\x10^\x85\xff^\xd0\xecSp\x07\x0d\xfd\x1cv\xc3\x06\xfe\x08\xc7\xe0\xe7\x06\x8e\xafOR\xd3\xb8\xf4\xc1?C\xc5\xf2\xf4h\xfe\xd9I\xe2%\xcd*\xa5\nM\x0c\xf1jvn\x103O\xe9\xceah\xdd\xdc\x9c\x87\x16]\xf7	u\x9f\x8d\xe1\xbc\xc0`\xf2\\jR\xf8\xffU\xe6\xb3\x8cMb\x0b\x15\xe7\x1a\x8f\xe6!\x0c\xfb\xa2\xf5#\xad\"\xac\xc3\xcc\xa5\x12I(\x1d\x9calqm\xe2\x1e\x93\xce$\xeb\xe1YIX\xf5`\xf6\xd2\x1c\xc6\xd8$2\x1dj]VJ\xcb8\xdc\x86x\x04\xb0\xbeJ\xff\x1e[O\xa1,\x83\xdd\xcd\xd5\xcd\x05\x08)\x8f\x86\x04 (\xb3\xb7 <\x08\x90\x82D\xf2\x0d\x08\"\xa7\xeeZ\xc2t\xdc=N\n\x1b\xa7w\xfa\x8d\x16b^zC\xe1\xe6\x0f\xf7@.\x0d:\xf7f\x88g\xa7\xd2\xa0\xc3#\xf5F,\xe9\x8eo\xa0x\x9a\x9c\x87yp\xd8CZ)\x1f\x86 \xdd[\xb7\x15e\x15\x03\x1d\xb3\xffa\x1c\xd0h\x17<\x02\x0f\xec\x89\x92\xfc\x02z\x05O\xd3E\xe9e\xacG\xa2\xb6)m\xad\xcc\xe1\xeb1\xbd\x8d\x16\x9a\xcb\xd694t\x1d:\xb6\x81\xe3I5\x9a\x03U\xf0-|wtj\xec\x0f\xcb\xb3\xe1kPDy6|\xb2\xf2\xac\xa2Z\x17\xd1\xbf\x03\x00PK\x07\x08l\x8c\xc7\xcf:\x03\x00\x00\x95\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x07\x00	\x00tabs.jsUT\x05\x00\x01\x93\xef\xd3^\xccW\xdfO\xdb\xc8\x13\x7f\xb6\xff\x8a\xa9\xbe_aG\n\x0e\xa5o!IE)\xbd\"A{*\xa8\xaa\x84\xaa\xb2\xb6'\xf1\xb6\xcennw\x0cD4\xff\xfbi\x7f\xd9N\x80\xab\xee^\xeex	\xbb;\xf3\x99\xd9\x99\xcf\xcc\x8eG#\xb8\xfc\xfd\xed\x97\xfdw\xbc\xc6\x13\xb9Z+\xbe\xa8\xe8\n\xefi\x0c\x87\x07\x87\x07pJ\x15\xd6p!\xd5\x82\x89x4\x8a\x83\xfc9/Ph\xdc?+Q\x10\x9fsTc\xb88\xbb\x8ac\xbe\\IE\xf0\x00\xa5,\x9a%\nz\xa7\xd8\xc2\xfc\x0e\x01k\\\xfe\x86\x02\x15#\xa9`\x03s%\x97\x90d#\xb3\xaf\xb3\xef:9\x8a\xe3B\nM\xf0-o\x88\xa4\x80\xe9\xb6N\n\x89;H`p\x14\x1b_&\x15\xd6\xfc~\x9fX\xae\x81X>M\x88\xe5\xfb/_%3s\x18u\xa7\xc0K\x7fv\x98\x00q\xaa\xd1.\xe1\xe5k/\x1aMV\xb3\n\xebZ\xc2\x19\xb0%\x14R\x10\n\x9a\x8cV\x1ei\xd4B=\x0f\xfdj\x0b\xfa\xb0\x0f\x9d+^\"\xc8\xf9/\x81{k=\xf3\x01\xff\xf8\xc9\xff\xd3\xbb\xed\xdf\xb8 \xb0\x82\xf8-\xfe\x97\xef\x19\xe3\xbdeMQ3\xad\xe1\xbd\x91\xbcb9\xe0=\xa1(5\xbc\xbf\xba8?\xad\xd1\xb0\x08\x1e\xe2\xc8rD5\x05I\x95\x0e\xccF\xa4\x9b\x15\xaa\xd4p\"\x8a\xa8\xe2:cD\xac\xa8.+V\xca\xbb\x14\x1e`)K\x1cC\"W(\x12\xd8\x18\xf6xAmE>II\x19\x17\x02\x951\x05S\xb8\x89\xa3(\x9a\xe8Z\xd2l2\xb2?q\x14\xdd\x1c\xc5\xd1&\x8e#M\x8cx\x01\x0b$\x90\xb9Fu\x8b\xe51\x91\xe2yC\xa8\xbdG\n\xa9Q\x02\xae!\xb1lK\x86\x90\xb84$\xf0\xd5\xc3\x18}{\xb8\xadb\xfd_ \xb5\x90i\xc0\xb0no\xe2H\xb7\x8ap\xcb\xea\x06\xc1\xe9[E\xfd\x94\xe20\xc8\xf5,;o\xbci\x1bQ/4}\xd2\x83\xe0\xfc\xa0s4\x85\x17/\x020\xfc\xfc\x19\xd4\xa7SH\x92\xceQo\xa7\x154\xe6\xf8\x1c\xc2\xc6\xde\x1e\xbcp\x06\x9d\xa0\xbf\xcb\x93\x97\xf1>\x0c!q\xa1\x886\x80\xb5F\xb0p\xc1\x95\xbd\xbdg\xe1\x14.\xe5->\x81\xe8\xd1\x8c\xcf\x9bg\xb8\xa8\xff}2\xd2\xbaFC\xc3(\x1a\x8f\x0d%	\xcb\xb4-*\x1f\xb6(*\xb9^\xd5l=\x06!\x05\x9a E\xd1\xe69\xa5kw\xff\xaf\x8f\x95\xf3Z\x16?z\xda\x93Qg~R\xf2[\xdbO]/\xd6\xc9l2*\xf9\xed\xec\x99\x92i\xe9\xe5kE\xc1\x14\x04\xde\xc1Ec\xcaH\x8a\x8f~;\x85\xa2bb\x81\x1a\xa63\xe7\x8fS\xe3\xfa\xa4\xe2u\xa9P\x9c\xd8\xf3\x12\xa6P\x18\x99\"#\xa6L\x11\x19\xca\x99@\x9b\xdc\x17\x19\xadW\x9e\x85\x85\xd1;\xe7\x9a\x0c\x1d\x83\x17\\_\xb1\xbc\xe5@\x0bi\x9c\x8f,\xacA\xcaL[\xca\xb4\\b\nd73\xae/\xd9\x12?\xc8\x12\xd3\xce\xf2\xc0\xd6C\x14E;\x96Y\xc0\xd7I{~\xddv\x80a[\xd0_3.\x8a\xba)Q\x1b\xd0V\xeb\x03[\"\x0czN+T(J\x1b:\x1f$\xef\x9c\xf5\xf8Q\x84R(\\I>yYw\xea\xe0m\xe9\xb4\xe8\x81\x076\x04\xdf\x9cI\xd3U=\x87|\xdf\x0cy\xcc\xfc?\xa9\x0d\xd9\x10\x1e@79)\xc41\x90jp\x08m\xfc\xc3F\x17\x16\xb7\xe3\xc8\x1f?a\xd0t\xa9o\x1aEyz\x8b\x82R\x10l\x89CX\xb1u-Y\xe9\xfd4V3\xc3XFE\x15\xe4\xf0\x0eN\x1aMr\xb9\xa5\xf8\x00%\x12\xe3\xf5\xb8\x85\xd8\xf8\x10XC\xc1p\xaf\x17\x96s\x98>\x1aa\xd28\x04\xc7\xf2c\xc9V\xa9\x99:L\x0e\xfc\xc4b%\"byf\x1b\xf4\xd0.}q\xad\x982\x91`y\xc6\xcb\x96\xb3\x06	^\x83\x1dF<=`l\x97\x89S\x8e\x8a\x9a\x17?\xc6\x90\x0e\xb6\xc99\x97\xea\x94\x15\x95\xe7\xa77\x11Q\xe8{\xd3\x1d\xca\x1a|s_\xf3\xb7\x81\xc1\xb0+\xed\x81[\x0d\xda4\xf8\xb2\xdejA\xb6\xa3\xef\x9c\xb3\xd5\nEi\x8b35\xf1\xea\x1eUc\xcc?#\xc4\xf2\x7f\xf6j\xb2<\xbc\x94\x11\xdb!\xf0	\xab\xeb\x9c\x15?Bve]~6\xbd\x7fh\xd2\xff\xb9\xf7\xce\xe8;NE\x05N.\xb0\xbb`\x1a\x1d\xfe8\x8e\xda\xb6 \xf0\xee\xb8\x8d\x9cq\xfc\x8f\x06\xd5\xfa\x12k\xb4c\x06\xdc\xfc\xef\xff\x0f\x01}s\x13B\xe9_\x9e\xb4\xa7\xbe\xb7\xd7-2b\x0b[\xcb&\xdb\xc9\xfb\xd3\xf3\xb3/\xfbW\xc7o\x12\xc3\xbd\x90\xb2^\xc0\xc2=|\x9a\xdc3\x1b\x9a\xb0\xfd\x19\x8dlT]{\xd6 Eo$\x9cA\x8e\\,\xa0\xc49\x17X\x06\xf9\x1c\x0b\xd6h\x04I\x15\xaa;n\x9eK\x02\x8d\xa4\x81*\x84@\x17\xf7:K3} \xc8\xfc;\x16\xd4\x01\xcc\xa5B{\xa0\x91\x08\x15\xe0=\xd7\xa43+P\xb8bs\xc3\x99\xce\xee*\x14o\x9d\x03)$\xado	\x0c2\xaaP\xa4\x9e\xc6;w\x7f\xc4fx\x96\xc7mla`j\xb8\x87\xd3o\x17&\xbd\xaeQ\x96\xc9\x10DS\xd7!c\xbe\x87m\xbaA\x88X\xbe5\x05u\x86;\xefxM\xa8\xdaZ7%\xec\xa5,\xaae\x81\xdb\xc8j\x14\x0b\xaa`\x06\x07!\xc5~\x12t\xe7\xd7p`\x9a~\xe9\xe7\x0d\xa7\xda\x19zV\xbb\x15\xd9\x05\xf0\xe8\xe6\x8a\xbd\xe1\x90\xe5)\xf0\xf2\xaf\xe6B\xd3_\xacHo$4\x99\xf0\xa1\xf0\xb0\xc7J\xb1uf\xbe\xd3\xd2v*\x0c\xb9~\xb3\xber\xec\xdeIt\x98RMlC/\xd9F\xdd\x9du:\xd07\xeb\xb3\xb2\xfd\xc8\xd3a\xe0\xdd\xc4\xf1\x0e\xcd\x1c\xc7\xb7\x0c\x0f\xbb\xcf\x86\xc1\xd1\xaf\xe5uOA\xc3\xe0(\xfes\x00PK\x07\x08\x87\x96\xcaE\x81\x05\x00\x00	\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x13\x00	\x00transport-select.jsUT\x05\x00\x01\x93\xef\xd3^\x84T\xedj\xec6\x10\xfd-=\xc5\xfc\xb3\x0c\x8e\xf7r\x7f\xaeI\xa1\xdd\xe6\xf6\x06\x92\x12\x9aP\n\xa5\x14\xad4\xbbV+KF\x1a\xe7\x83\xc5\xd0\x87\xe8\x13\xf6I\x8a\xfc\x15o\xb3P\x08d\xb1\xce\x9c9g\xbe6\x1bx|\xf8\xfe\x97\xab/\xc6\xe2\xce\xb7o\xc1\x1ckz\xc2W\xda\xc2\xe7O\x9f?\xc1\x0d\xd5h\xe1\xde\x87\xa3t|\xb3\xe13\xfe\xce(t\x11\xafn5:2\x07\x83a\x0b\xf7\xb7O\x9c\x9b\xa6\xf5\x81\xe0\x04\xda\xab\xaeAG_\x82<\xa6\xff\x05\xa0\xc5\xe6\x07t\x18$\xf9\x00=\x1c\x82o +7\xe9{,\xff\x88Y\xf5\x1e}@R\xf5\xce;\n\xde>x\xe3\xa8\x18?=\x05\xe9b\xc2\xc4\x15\x81l\xcd\x18\xce\x95w\x91\xe0w\xdf\x92\xf1\x0e\xae\xcfS\n\xc8\xc6\x87\x0c\xf2j\x86F\xb4\xa8\xe8\x02t|\x18\xa0\x13\x96\xb0i\xad$\x84\xeb\x0f\xe6\x04g\x13\x93\xe0\x8c\x9d\xc0\xe8\xed;C_p\xc6&M\x022\xe7\x81f\x17Y\x01'x\x96\xb6\xc3mzp\x98A\x0f\xf99\xfe\x9f\xbf\xff\xfa\xdf\xbf\x81G\x9b(\xf7\x16\xf5\x16(t8\x11\xe5\x05\xcf+\xce\xf15\x15\x0d\x94\x951\xc2W\xb4\xe6u\xa9\xe4\xe3 \x13\xf0\x95\xd0\xe9\x08_\x9f\xee\xefn,\xa6\x96\xc1\x89\xb3H\x92\x8c\x82#\x12\xf8}\xc4\xf0\x8c\xfa[\xa2`\xf6\x1da\x14y\x82\xb0\x80\xd4\x05\x07\xbfB6X\xc9\xe0\xb7\x8a\xb3\x9es&g\xe4\xae\x96\xee\x88z'\xad\xddK\xf5\xa7\x00'\x1b,\xc0[\xfds\x8a(\xc0\xe1\xcb\xf0\x0bF\xc6\xf8bH\xd50\xe2\xa6oL\xc9\x88s\x8a-g\x8c1\xaaM,\xa7\xc2\x97Cn\xb8^\xa8*\xceX?\xeaH\xea\x87\xe7s\xc1\x1f\xc3\x07\xdd,.\xf01j\x120\xc0#\xd2\xe2_\xccj\x8a\x197\xf9\x1e\xe6%t\x8a|\x982\xc6\xae\xc5 R'&\x1eI$U\xfdXK\xed_\x04\x9c\xa0\xf1:\xcd\x80o\xd1\x0d3P\xcd\xc08@~\xf2\x9eJ\xd9\xb6\xe8\xf4\xae6V\x8be\x1aKe\xbd\xc3\x1f\xbdF1\xf6=\x87U\x9a\xd9\x9d\xd4\xfa\xe6\x19\x1d\xdd\x99Hi\x1f\x04dj\xe8IV\x00\xc2\xf57C\xd5\x97\x107\x82\xd7 \xd7Y\x9b\x88\x19\xeb\xcf\xf8\xbbVKB1;_\x07\x8f=n\xe5\x9b\xf5R\xafk\xa8Ml%\xa9z\xc6\xe1\x0b\xec\xbaH\xbe9\x0b<\x81F\x92\xc6n\x17\x8a~\xf46&\x9a\x13\x0f\xca\xffs\x1bD\x9e\xc6\xa3\xa4\x1a\x9d\x00\x8a\x83?\xa0XF\x1fH\x80\x00Y\xc0\x1e\xf2\xf4Y\x96Iei\xbd\x92\xe9\x006\xad\x0c(`_Ns\x97W0\x0d7\xc5*\xe5\xff\xc0K\xb1<\xf8p#U\x9d6\x9f1J\xacs\x1d\xc7\xd2\xaf\xbb\xb6\x9c\x01\x1aR\xac\xd6\x9fJ\xa3S\x861G\x0ek\x0b\x1f\xae\xe1\xd9\xabj'\x83\x97\xb6A\xb5\xe5rm\xde\x1d\xa8T~\x01iN\xbd\xc5\x12C\xf0a)m\xda\x96I\xfc\x85}Y\xcd\xe3\x11i\xba\x14\xdf\xbd\xdd\xea\xf3\x9b\xc9z\xdes\xae\xc6\xb6\x8e\xa0Xj<\x18\x97\xd6\xa6N\x17\xe8j\x11v5\x9d\xca\xe2\xf2i\xca+\xfe\xef\x00PK\x07\x080\xea\xd1\xd7\x07\x03\x00\x00\xa8\x06\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00transports.htmlUT\x05\x00\x01\x93\xef\xd3^\x8c\x93\xcdn\xd40\x10\xc7\xcf\xf6SL%\xa4$\xd2&\xa9zB]o\x0e\xb4\x0bT\xa2\xa2\x12{\x80S\xebMf7\x06\xc7\x8e\xec\x89\xd4\xd5j\x9f\xa17\x1e\x05\x95\xd7\xe1\x05x\x05\xe4M\xd8\x0f\n\x159$\xe3\x99_\xfe3\x1e\x8f\xc5I\x9a\xf2\x0f7\x97\x1f\xd3\xd7J\xe3\x85mWN-k\x9a\xe1=\x9d\xc3\xd9\xe9\xd9)L\xa9F\x0d\xd7\xd6-\xa5\xe1=\xfaN\x95h<\xa6W\x15\x1aR\x0b\x85\xee\x1c\xae\xaff<M\x0b\xce\xc5\xc9\xe5\xfb\x8b\xd9\xa7\x9b)\xd4\xd4\xe8\x82\x8b\xf0\x01-\xcdr\x12\xa1\x89\x82\x03eUp&\x1a$	e-\x9dG\x9aD\x1d-\xd2\x97Q\xf0\x93\"\x8d\xc5[\xd4\xea\x1en\xb4\\\xa1\x83\x14fN\x1a\xdfZG^\xe4=\xc0\x99\xf0\xb4\xea\x8d|\xb0D\xde\x8bs1\xb7\xd5* \x8dT\xa6\xe0\x8c\x89N\x83\xaa&\x11\xedt\xa2B\xe4\x9d\x0eL>@\xc2\x97N\xb5\x04\xb4jq\x125\xb6\xea4\x86\x8a\x98jBfX\x03jl\xde\xa0A'\xc9:\xd8\xc0\xc2\xd9\x06\xa2,\x0f~\x9f}\xf6\xd1\xf8\x90^ \x95\xf5\xbe\xf0\xd1\x1f\x8e\x11\xb4Z\xaev\xf1\x11\xb4\xb2\xf3x\xb0\xf6d\xdb\xdd\xf2 \x9blU\x9f\x8b3VZ\xe3	n\xe7\x1d\x91509\xae0\x86\xa8\x0fD\x90\x8c\xf7\xb0V\x10\x9e\xa7\xb0V[pG\xee\x9b\x05\x13\xa8l\xd95h([\"M5\x06\xf3\xd5\xea\xaa\x8a\xe1\xb0\xa7\xc3\xef\xc7\x1b\xf5q\xc2\x19c\x19\xd5hb \x0f\x93\x02\xd6@>\xf3\xd6Q\x0c1\xc8\x11\xcc!	n\x99\x19\xd9`\xa6m)\xc386\xadt\x18\xc3|\xeb\x85\x04\x9218\xa4\xce\x19 ?\x86\x0d<\xd5%\x9f-\xac\x9b\xca\xb2\x8eC\x8cQP\xdd\x17\x98\xc9\xb6ES]\xd4JW=\xc0n\xb5\x1a,v\xf7bM\xdbT\x1b\x88\x83\xe9I\x12n\x92\xbb\xd1\x10\x1f\xda\x1cC\xf4\xe3\xeb\xb7\x9f\x8f\x0f\xd1\x08\xd6C\x88\x95Z\x95_\xce!\xden\xe3\xe8`c\xa0LU\x90\xfcV\xd9@\xf2\x17\xc1\x87\xc7g\x05\x8fF\xe3?\x15\xbf?\xa7x4\\\xff\x16\x1cJ\xdd6:\xbc\xc2\x1c\x89\xbc\xbf(\x05\x17y\x7f\xcfD^S\xa3\x0b\xfek\x00PK\x07\x08Lg\xd5\x1c&\x02\x00\x00O\x04\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00A\x16Q]g\x04\x8e\xd7\x00\x03\x00\x00\x02\x0b\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00api.jsUT\x05\x00\x01[\xe2\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\xddK\x92P\x1d\x02\x00\x00=\x05\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81=\x03\x00\x00common.cssUT\x05\x00\x01\x93\xef\xd3^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00@\x8c\xc9P\xec\x1e\xd69g\x04\x00\x00\x83\x0c\x00\x00\x11\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x9b\x05\x00\x00directory-tree.jsUT\x05\x00\x01	\xc8\xdf^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00A\x8c\xc9P\x949\xe6\xd8Y\x08\x00\x00\xb0\x15\x00\x00\x08\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81J\n\x00\x00elems.jsUT\x05\x00\x01\n\xc8\xdf^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00df\xd0P\x06\xa8H\x98\x8a6\x00\x00\x836\x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xe2\x12\x00\x00helix-trans.pngUT\x05\x00\x01=\xc0\xe8^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00A\x16Q],\xcb\"\xfa\x91	\x00\x00\x1e!\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xb2I\x00\x00index.htmlUT\x05\x00\x01[\xe2\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa0s\xc8P\x13\xa7\xf4=\x81\x03\x00\x00\xce\x08\x00\x00\x11\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x84S\x00\x00media-controls.jsUT\x05\x00\x01,K\xde^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfPA\xa2\xdey\x9c\x04\x00\x00\xb4\x0b\x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81MW\x00\x00media-player.jsUT\x05\x00\x01\x93\xef\xd3^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfPq\xf8\xceX.\x06\x00\x009\x10\x00\x00\x0b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81/\\\x00\x00playlist.jsUT\x05\x00\x01\x93\xef\xd3^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfPl\x8c\xc7\xcf:\x03\x00\x00\x95\x07\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x9fb\x00\x00queue.htmlUT\x05\x00\x01\x93\xef\xd3^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP\x87\x96\xcaE\x81\x05\x00\x00	\x0f\x00\x00\x07\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x1af\x00\x00tabs.jsUT\x05\x00\x01\x93\xef\xd3^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfP0\xea\xd1\xd7\x07\x03\x00\x00\xa8\x06\x00\x00\x13\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xd9k\x00\x00transport-select.jsUT\x05\x00\x01\x93\xef\xd3^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xef\x8e\xbfPLg\xd5\x1c&\x02\x00\x00O\x04\x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81*o\x00\x00transports.htmlUT\x05\x00\x01\x93\xef\xd3^PK\x05\x06\x00\x00\x00\x00\x0d\x00\x0d\x00k\x03\x00\x00\x96q\x00\x00\x00\x00"
 		fs.Register(data)
 	}
 	
\ No newline at end of file
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+// Binary helix-renderer is a UPnP AV MediaRenderer.
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/ethulhu/helix/flag"
+	"github.com/ethulhu/helix/flags"
+	"github.com/ethulhu/helix/logger"
+	"github.com/ethulhu/helix/netutil"
+	"github.com/ethulhu/helix/upnp"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/upnpav/avtransport"
+	"github.com/ethulhu/helix/upnpav/connectionmanager"
+	"github.com/ethulhu/helix/upnpav/mediarenderer"
+	"github.com/ethulhu/helix/upnpav/renderingcontrol"
+)
+
+var (
+	udn          = flag.Custom("udn", "", "UDN to broadcast (if unset, will generate one)", flags.UDN)
+	friendlyName = flag.Custom("friendly-name", "", "human-readable name to broadcast (if unset, will generate one)", flags.FriendlyName)
+	iface        = flag.Custom("interface", "", "interface to listen on (will try to find a Private IPv4 if unset)", flags.NetInterface)
+
+	playerCommand = flag.String("player", "", `command to play media with, e.g. "mpv --no-video --start={start} {uri}" (if unset, will play nothing)`)
+)
+
+// sinkMIMETypes are the formats that helix-renderer accepts.
+var sinkMIMETypes = []string{
+	"audio/flac",
+	"audio/mp4",
+	"audio/mpeg",
+	"audio/ogg",
+	"audio/wav",
+	"audio/x-flac",
+	"audio/x-wav",
+	"video/mp4",
+	"video/webm",
+	"video/x-matroska",
+}
+
+func main() {
+	flag.Parse()
+
+	friendlyName := (*friendlyName).(string)
+	iface := (*iface).(*net.Interface)
+	udn := (*udn).(string)
+
+	log, _ := logger.FromContext(context.Background())
+
+	var player mediarenderer.Player = mediarenderer.NewNullPlayer()
+	if *playerCommand != "" {
+		var err error
+		player, err = mediarenderer.NewExternalPlayer(strings.Fields(*playerCommand))
+		if err != nil {
+			log.AddField("player", *playerCommand)
+			log.WithError(err).Fatal("could not create player")
+		}
+	}
+
+	var sinks []upnpav.ProtocolInfo
+	for _, mimeType := range sinkMIMETypes {
+		sinks = append(sinks, upnpav.ProtocolInfo{
+			Protocol:       upnpav.ProtocolHTTP,
+			Network:        "*",
+			ContentFormat:  mimeType,
+			AdditionalInfo: "*",
+		})
+	}
+
+	ip, err := netutil.SuitableIP(iface)
+	if err != nil {
+		name := "ALL"
+		if iface != nil {
+			name = iface.Name
+		}
+		log.AddField("interface", name)
+		log.WithError(err).Fatal("could not find suitable serving IP")
+	}
+	addr := &net.TCPAddr{
+		IP: ip,
+	}
+
+	httpConn, err := net.Listen("tcp", addr.String())
+	if err != nil {
+		log.AddField("listener", addr)
+		log.WithError(err).Fatal("could not create HTTP listener")
+	}
+	defer httpConn.Close()
+
+	device := &upnp.Device{
+		Name:             friendlyName,
+		UDN:              udn,
+		DeviceType:       avtransport.DeviceType,
+		Manufacturer:     "Eth Morgan",
+		ManufacturerURL:  "https://ethulhu.co.uk",
+		ModelDescription: "Helix",
+		ModelName:        "Helix",
+		ModelNumber:      "42",
+		ModelURL:         "https://ethulhu.co.uk",
+		SerialNumber:     "00000000",
+	}
+
+	renderer := mediarenderer.NewRenderer(player, sinks)
+	defer renderer.Close()
+
+	device.Handle(avtransport.Version1, avtransport.ServiceID, avtransport.SCPD, avtransport.SOAPHandler{Interface: renderer})
+	device.Handle(connectionmanager.Version1, connectionmanager.ServiceID, connectionmanager.SCPD, connectionmanager.SOAPHandler{Interface: renderer})
+	device.Handle(renderingcontrol.Version1, renderingcontrol.ServiceID, renderingcontrol.SCPD, renderingcontrol.SOAPHandler{Interface: renderer})
+	renderer.SetEventNotifier(device.Notify)
+
+	mux := http.NewServeMux()
+	mux.Handle("/upnp/", http.StripPrefix("/upnp", device.HTTPHandler("/upnp/")))
+
+	httpServer := &http.Server{Handler: mux}
+	go func() {
+		log := log.WithField("http.listener", httpConn.Addr())
+		log.Info("serving HTTP")
+		if err := httpServer.Serve(httpConn); err != nil {
+			log.WithError(err).Fatal("could not serve HTTP")
+		}
+	}()
+
+	if err := upnp.BroadcastDevice(device, fmt.Sprintf("http://%v/upnp/", httpConn.Addr()), nil); err != nil {
+		log.WithError(err).Fatal("could not serve SSDP")
+	}
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethulhu/helix/media"
+)
+
+// ImageProfiles parses a comma-separated list of media.ImageProfile names, e.g. "JPEG_TN,JPEG_SM".
+func ImageProfiles(raw string) (interface{}, error) {
+	var profiles []media.ImageProfile
+
+	if raw == "" {
+		return profiles, nil
+	}
+
+	for _, name := range strings.Split(raw, ",") {
+		profile, ok := media.ImageProfileByName(strings.TrimSpace(name))
+		if !ok {
+			var names []string
+			for _, profile := range media.ImageProfiles {
+				names = append(names, profile.Name)
+			}
+			return profiles, fmt.Errorf("unknown profile %q, must be one of %q", name, names)
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
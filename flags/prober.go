@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package flags
+
+import (
+	"fmt"
+
+	"github.com/ethulhu/helix/media"
+)
+
+// MetadataProber parses the name of a media.Prober, e.g. "native" or "ffprobe".
+func MetadataProber(raw string) (interface{}, error) {
+	prober, ok := media.ProberByName(raw)
+	if !ok {
+		return nil, fmt.Errorf("unknown metadata backend %q, must be one of %q", raw, media.ProberNames)
+	}
+	return prober, nil
+}
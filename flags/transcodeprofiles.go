@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethulhu/helix/media"
+)
+
+// TranscodeProfiles parses a comma-separated list of media.TranscodeProfile names, e.g. "mp3,lpcm".
+func TranscodeProfiles(raw string) (interface{}, error) {
+	var profiles []media.TranscodeProfile
+
+	if raw == "" {
+		return profiles, nil
+	}
+
+	for _, name := range strings.Split(raw, ",") {
+		profile, ok := media.TranscodeProfileByName(strings.TrimSpace(name))
+		if !ok {
+			var names []string
+			for _, profile := range media.TranscodeProfiles {
+				names = append(names, profile.Name)
+			}
+			return profiles, fmt.Errorf("unknown profile %q, must be one of %q", name, names)
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethulhu/helix/upnpav/contentdirectory/fileserver"
+)
+
+// ContentDirectoryViews parses a comma-separated list of fileserver.View names, e.g. "folders,albums".
+func ContentDirectoryViews(raw string) (interface{}, error) {
+	var views []fileserver.View
+
+	if raw == "" {
+		return views, nil
+	}
+
+	for _, name := range strings.Split(raw, ",") {
+		view, ok := fileserver.ViewByName(strings.TrimSpace(name))
+		if !ok {
+			return views, fmt.Errorf("unknown view %q, must be one of %q", name, fileserver.Views)
+		}
+		views = append(views, view)
+	}
+	return views, nil
+}
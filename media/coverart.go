@@ -17,12 +17,16 @@ var coverArtNames = map[string]bool{
 	"thumb":  true,
 }
 
+// CoverArtForPaths returns the paths of the cover art for each of paths.
+// The art for a file is the images named after it, or else the images in its directory named e.g. cover.jpg.
+// Media files that have neither but have art embedded in their tags are their own art, which IsImage tells apart.
 func CoverArtForPaths(paths []string) [][]string {
 	return coverArtForPaths(realFS{}, paths)
 }
 
 func coverArtForPaths(filesystem fs, paths []string) [][]string {
 	listings := map[string][]string{}
+	infos := map[string]os.FileInfo{}
 	for _, p := range paths {
 		p := path.Clean(p)
 
@@ -30,6 +34,7 @@ func coverArtForPaths(filesystem fs, paths []string) [][]string {
 		if err != nil {
 			continue
 		}
+		infos[p] = fi
 
 		dir := p
 		if !fi.IsDir() {
@@ -61,14 +66,16 @@ func coverArtForPaths(filesystem fs, paths []string) [][]string {
 			file := path.Base(p)
 
 			artPaths := coverArtForFile(dir, file, listings[dir])
-
-			if len(artPaths) > 0 {
-				allArtPaths = append(allArtPaths, artPaths)
-				continue
+			if len(artPaths) == 0 {
+				// Fallback to the parent directory's art.
+				artPaths = coverArtForDir(dir, listings[dir])
 			}
-
-			// Fallback to the parent directory's art.
-			p = dir
+			if len(artPaths) == 0 && IsAudioOrVideo(file) && infos[p] != nil && filesystem.HasEmbeddedCoverArt(p, infos[p]) {
+				// Fallback to the art embedded in the file itself.
+				artPaths = []string{p}
+			}
+			allArtPaths = append(allArtPaths, artPaths)
+			continue
 		}
 
 		allArtPaths = append(allArtPaths, coverArtForDir(p, listings[p]))
@@ -104,6 +111,7 @@ type (
 	fs interface {
 		Stat(string) (os.FileInfo, error)
 		List(string) ([]os.FileInfo, error)
+		HasEmbeddedCoverArt(string, os.FileInfo) bool
 	}
 
 	realFS struct{}
@@ -115,3 +123,6 @@ func (_ realFS) Stat(p string) (os.FileInfo, error) {
 func (_ realFS) List(p string) ([]os.FileInfo, error) {
 	return ioutil.ReadDir(p)
 }
+func (_ realFS) HasEmbeddedCoverArt(p string, fi os.FileInfo) bool {
+	return hasEmbeddedCoverArt.has(p, fi)
+}
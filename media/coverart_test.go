@@ -14,9 +14,10 @@ import (
 
 func TestCoverArtForPath(t *testing.T) {
 	tests := []struct {
-		fs    fakeFS
-		paths []string
-		want  [][]string
+		fs       fakeFS
+		embedded fakeEmbeddedArt
+		paths    []string
+		want     [][]string
 	}{
 		{
 			fs: fakeFS{
@@ -83,10 +84,39 @@ func TestCoverArtForPath(t *testing.T) {
 				{"/music/foo.mp3.jpg"},
 			},
 		},
+		{
+			fs: fakeFS{
+				"/music":         true,
+				"/music/foo.mp3": false,
+				"/music/bar.mp3": false,
+			},
+			embedded: fakeEmbeddedArt{
+				"/music/foo.mp3": true,
+			},
+			paths: []string{"/music/foo.mp3", "/music/bar.mp3"},
+			want: [][]string{
+				{"/music/foo.mp3"},
+				nil,
+			},
+		},
+		{
+			fs: fakeFS{
+				"/music":            true,
+				"/music/folder.jpg": false,
+				"/music/foo.mp3":    false,
+			},
+			embedded: fakeEmbeddedArt{
+				"/music/foo.mp3": true,
+			},
+			paths: []string{"/music/foo.mp3"},
+			want: [][]string{
+				{"/music/folder.jpg"},
+			},
+		},
 	}
 
 	for i, tt := range tests {
-		got := coverArtForPaths(tt.fs, tt.paths)
+		got := coverArtForPaths(fakeFSWithEmbeddedArt{tt.fs, tt.embedded}, tt.paths)
 		if !reflect.DeepEqual(got, tt.want) {
 			t.Errorf("[%d]: coverArtForPath(_, %q) == %q, want %q", i, tt.paths, got, tt.want)
 		}
@@ -125,6 +155,18 @@ func (fs fakeFS) List(p string) ([]os.FileInfo, error) {
 	return fileInfos, nil
 }
 
+// fakeEmbeddedArt is the set of files with embedded cover art.
+type fakeEmbeddedArt map[string]bool
+
+type fakeFSWithEmbeddedArt struct {
+	fakeFS
+	embedded fakeEmbeddedArt
+}
+
+func (fs fakeFSWithEmbeddedArt) HasEmbeddedCoverArt(p string, _ os.FileInfo) bool {
+	return fs.embedded[path.Clean(p)]
+}
+
 type fakeFileInfo struct {
 	os.FileInfo
 	name  string
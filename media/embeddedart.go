@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// CoverArtExtractor extracts the cover art embedded in the tags of media files, and caches it on disk.
+	CoverArtExtractor struct {
+		cache *fileCache
+	}
+
+	// embeddedPicture is a picture embedded in a file's tags.
+	embeddedPicture struct {
+		// pictureType is the ID3v2 APIC picture type, which FLAC also uses, e.g. 3 for the front cover.
+		pictureType int
+		data        []byte
+	}
+
+	// embeddedCoverArtCache remembers whether files have cover art embedded in their tags,
+	// so that describing a directory need not read the tags of every file in it every time.
+	embeddedCoverArtCache struct {
+		hasCoverArt func(p string) bool
+
+		mu      sync.Mutex
+		entries map[string]embeddedCoverArtCacheEntry
+	}
+	embeddedCoverArtCacheEntry struct {
+		mtime       time.Time
+		size        int64
+		hasCoverArt bool
+	}
+
+	// coverArtReader reads the embedded cover art of a format from r, which is size bytes long.
+	coverArtReader func(r io.ReadSeeker, size int64) ([]byte, error)
+)
+
+const (
+	pictureTypeFrontCover = 3
+)
+
+var (
+	errNoCoverArt = errors.New("no embedded cover art")
+
+	hasEmbeddedCoverArt = newEmbeddedCoverArtCache(func(p string) bool {
+		_, err := embeddedCoverArt(p)
+		return err == nil
+	})
+
+	coverArtReaders = map[string]coverArtReader{
+		".mp3":  readMP3CoverArt,
+		".flac": readFLACCoverArt,
+		".m4a":  readMP4CoverArt,
+		".m4b":  readMP4CoverArt,
+		".mp4":  readMP4CoverArt,
+	}
+)
+
+// NewCoverArtExtractor returns a CoverArtExtractor that caches cover art in cacheDir.
+// When the cache grows beyond maxBytes, the least-recently used art is removed; 0 means no limit.
+func NewCoverArtExtractor(cacheDir string, maxBytes int64) (*CoverArtExtractor, error) {
+	cache, err := newFileCache(cacheDir, maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &CoverArtExtractor{cache: cache}, nil
+}
+
+// Extract returns the path in the cache of the cover art embedded in the media file at src.
+// The cached file has no extension, as the format of the art is whatever the tagger embedded.
+func (e *CoverArtExtractor) Extract(ctx context.Context, src string) (string, error) {
+	fi, err := os.Stat(src)
+	if err != nil {
+		return "", err
+	}
+	name := cacheKey(src, fi, "cover-art")
+
+	return e.cache.get(ctx, name, func(w io.Writer) error {
+		art, err := embeddedCoverArt(src)
+		if err != nil {
+			return fmt.Errorf("could not extract cover art from %q: %w", src, err)
+		}
+		_, err = w.Write(art)
+		return err
+	})
+}
+
+// embeddedCoverArt returns the cover art embedded in the tags of the media file at p.
+// It understands ID3v2 APIC frames in MP3, PICTURE blocks in FLAC, and covr items in MP4.
+func embeddedCoverArt(p string) ([]byte, error) {
+	read, ok := coverArtReaders[strings.ToLower(path.Ext(p))]
+	if !ok {
+		return nil, errUnsupportedFormat
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, fmt.Errorf("could not open: %w", err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("could not stat: %w", err)
+	}
+	return read(f, fi.Size())
+}
+
+func newEmbeddedCoverArtCache(hasCoverArt func(p string) bool) *embeddedCoverArtCache {
+	return &embeddedCoverArtCache{
+		hasCoverArt: hasCoverArt,
+		entries:     map[string]embeddedCoverArtCacheEntry{},
+	}
+}
+
+// has returns whether the media file at p, with FileInfo fi, has embedded cover art.
+// It only reads the file again if its mtime or size has changed.
+func (c *embeddedCoverArtCache) has(p string, fi os.FileInfo) bool {
+	c.mu.Lock()
+	entry, ok := c.entries[p]
+	c.mu.Unlock()
+
+	if ok && entry.mtime.Equal(fi.ModTime()) && entry.size == fi.Size() {
+		return entry.hasCoverArt
+	}
+
+	entry = embeddedCoverArtCacheEntry{
+		mtime:       fi.ModTime(),
+		size:        fi.Size(),
+		hasCoverArt: c.hasCoverArt(p),
+	}
+	c.mu.Lock()
+	c.entries[p] = entry
+	c.mu.Unlock()
+	return entry.hasCoverArt
+}
+
+// chooseCoverArt returns the front cover if there is one, or else the first picture.
+func chooseCoverArt(pictures []embeddedPicture) ([]byte, error) {
+	if len(pictures) == 0 {
+		return nil, errNoCoverArt
+	}
+	for _, picture := range pictures {
+		if picture.pictureType == pictureTypeFrontCover {
+			return picture.data, nil
+		}
+	}
+	return pictures[0].data, nil
+}
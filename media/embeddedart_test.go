@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCoverArtReaders(t *testing.T) {
+	front := []byte("\xff\xd8front cover")
+	back := []byte("\xff\xd8back cover")
+
+	tests := []struct {
+		name    string
+		read    coverArtReader
+		file    []byte
+		want    []byte
+		wantErr error
+	}{
+		{
+			name: "MP3 with ID3v2.3 APIC frames",
+			read: readMP3CoverArt,
+			file: concat(
+				id3v2(3,
+					id3v23Frame("TIT2", id3Latin1("Pale")),
+					id3v23Frame("APIC", concat([]byte{0}, []byte("image/jpeg\x00"), []byte{4}, []byte("back\x00"), back)),
+					id3v23Frame("APIC", concat([]byte{0}, []byte("image/jpeg\x00"), []byte{3}, []byte("front\x00"), front)),
+				),
+				mp3Frames(10, nil),
+			),
+			want: front,
+		},
+		{
+			name: "MP3 with ID3v2.4 APIC frame with a UTF-16 description",
+			read: readMP3CoverArt,
+			file: concat(
+				id3v2(4,
+					id3v24Frame("APIC", concat([]byte{1}, []byte("image/jpeg\x00"), []byte{0}, []byte{0xff, 0xfe, 'a', 0, 0, 0}, front)),
+				),
+				mp3Frames(10, nil),
+			),
+			want: front,
+		},
+		{
+			name: "MP3 with ID3v2.2 PIC frame",
+			read: readMP3CoverArt,
+			file: concat(
+				id3v2(2,
+					concat([]byte("PIC"), []byte{0, 0, byte(len(front) + 6)}, []byte{0}, []byte("JPG"), []byte{3, 0}, front),
+				),
+				mp3Frames(10, nil),
+			),
+			want: front,
+		},
+		{
+			name: "MP3 with a link to its cover",
+			read: readMP3CoverArt,
+			file: concat(
+				id3v2(3,
+					id3v23Frame("APIC", concat([]byte{0}, []byte("-->\x00"), []byte{3, 0}, []byte("http://mew/cover.jpg"))),
+				),
+				mp3Frames(10, nil),
+			),
+			wantErr: errNoCoverArt,
+		},
+		{
+			name:    "MP3 without ID3v2",
+			read:    readMP3CoverArt,
+			file:    mp3Frames(10, nil),
+			wantErr: errNoCoverArt,
+		},
+		{
+			name: "FLAC with PICTURE blocks",
+			read: readFLACCoverArt,
+			file: concat(
+				[]byte("fLaC"),
+				flacBlock(flacStreamInfo, false, flacStreamInfoBlock(44100, 44100)),
+				flacBlock(flacPicture, false, flacPictureBlock(4, back)),
+				flacBlock(flacPicture, true, flacPictureBlock(3, front)),
+			),
+			want: front,
+		},
+		{
+			name: "FLAC without PICTURE blocks",
+			read: readFLACCoverArt,
+			file: concat(
+				[]byte("fLaC"),
+				flacBlock(flacStreamInfo, true, flacStreamInfoBlock(44100, 44100)),
+			),
+			wantErr: errNoCoverArt,
+		},
+		{
+			name: "M4A with covr",
+			read: readMP4CoverArt,
+			file: mp4Box("moov",
+				mp4Box("udta",
+					mp4Box("meta", concat(make([]byte, 4),
+						mp4Box("ilst",
+							mp4Box("\xa9nam", mp4Data(1, []byte("Pale"))),
+							mp4Box("covr", mp4Data(13, front), mp4Data(13, back)),
+						),
+					)),
+				),
+			),
+			want: front,
+		},
+		{
+			name: "M4A without covr",
+			read: readMP4CoverArt,
+			file: mp4Box("moov",
+				mp4Box("udta",
+					mp4Box("meta", concat(make([]byte, 4),
+						mp4Box("ilst",
+							mp4Box("\xa9nam", mp4Data(1, []byte("Pale"))),
+						),
+					)),
+				),
+			),
+			wantErr: errNoCoverArt,
+		},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.read(bytes.NewReader(tt.file), int64(len(tt.file)))
+		if err != tt.wantErr {
+			t.Errorf("[%d]: %v: got error %v, want %v", i, tt.name, err, tt.wantErr)
+			continue
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("[%d]: %v: got %q, want %q", i, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCoverArtExtractor(t *testing.T) {
+	dir, err := ioutil.TempDir("", "embeddedart")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	front := []byte("\xff\xd8front cover")
+	tagged := filepath.Join(dir, "tagged.mp3")
+	if err := ioutil.WriteFile(tagged, concat(id3v2(3, id3v23Frame("APIC", concat([]byte{0}, []byte("image/jpeg\x00"), []byte{3, 0}, front))), mp3Frames(10, nil)), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+	untagged := filepath.Join(dir, "untagged.mp3")
+	if err := ioutil.WriteFile(untagged, mp3Frames(10, nil), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+
+	extractor, err := NewCoverArtExtractor(filepath.Join(dir, "cache"), 0)
+	if err != nil {
+		t.Fatalf("NewCoverArtExtractor(...) returned error: %v", err)
+	}
+
+	ctx := context.Background()
+	got, err := extractor.Extract(ctx, tagged)
+	if err != nil {
+		t.Fatalf("Extract(_, %q) returned error: %v", tagged, err)
+	}
+	if art, err := ioutil.ReadFile(got); err != nil || !bytes.Equal(art, front) {
+		t.Errorf("Extract(_, %q) wrote %q, %v, want %q", tagged, art, err, front)
+	}
+	if again, err := extractor.Extract(ctx, tagged); err != nil || again != got {
+		t.Errorf("second Extract(_, %q) == %q, %v, want %q, nil", tagged, again, err, got)
+	}
+
+	if _, err := extractor.Extract(ctx, untagged); err == nil {
+		t.Errorf("Extract(_, %q) returned nil error", untagged)
+	}
+}
+
+func TestEmbeddedCoverArtCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "embeddedart")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	p := filepath.Join(dir, "track.mp3")
+	if err := ioutil.WriteFile(p, []byte("tagged"), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+
+	reads := 0
+	cache := newEmbeddedCoverArtCache(func(p string) bool {
+		reads++
+		content, _ := ioutil.ReadFile(p)
+		return string(content) == "tagged"
+	})
+
+	for i := 0; i < 3; i++ {
+		fi, err := os.Stat(p)
+		if err != nil {
+			t.Fatalf("could not stat file: %v", err)
+		}
+		if !cache.has(p, fi) {
+			t.Errorf("[%d]: has(%q) == false, want true", i, p)
+		}
+	}
+	if reads != 1 {
+		t.Errorf("after 3 calls for an unchanged file, read it %d times, want 1", reads)
+	}
+
+	// Changing the file invalidates the cache.
+	if err := ioutil.WriteFile(p, []byte("untagged"), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+	fi, err := os.Stat(p)
+	if err != nil {
+		t.Fatalf("could not stat file: %v", err)
+	}
+	if cache.has(p, fi) {
+		t.Errorf("has(%q) == true after removing its art, want false", p)
+	}
+	if reads != 2 {
+		t.Errorf("after changing the file, read it %d times, want 2", reads)
+	}
+}
+
+func flacPictureBlock(pictureType int, data []byte) []byte {
+	return concat(be32(pictureType), be32(10), []byte("image/jpeg"), be32(0), make([]byte, 16), be32(len(data)), data)
+}
@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	jpegSOI  = 0xd8
+	jpegEOI  = 0xd9
+	jpegSOS  = 0xda
+	jpegAPP1 = 0xe1
+
+	exifDateTime                  = 0x0132
+	exifIFDPointer                = 0x8769
+	exifDateTimeOriginal          = 0x9003
+	exifJPEGInterchangeFormat     = 0x0201
+	exifJPEGInterchangeFormatSize = 0x0202
+
+	exifTypeShort = 3
+	exifTypeLong  = 4
+
+	exifDateFormat = "2006:01:02 15:04:05"
+)
+
+var (
+	// exifTypeSizes are the sizes in bytes of the TIFF field types.
+	exifTypeSizes = map[uint16]int{
+		1:  1, // BYTE
+		2:  1, // ASCII
+		3:  2, // SHORT
+		4:  4, // LONG
+		5:  8, // RATIONAL
+		6:  1, // SBYTE
+		7:  1, // UNDEFINED
+		8:  2, // SSHORT
+		9:  4, // SLONG
+		10: 8, // SRATIONAL
+		11: 4, // FLOAT
+		12: 8, // DOUBLE
+	}
+
+	exifHeader = []byte("Exif\x00\x00")
+
+	errNoExif        = errors.New("no EXIF metadata")
+	errTruncatedExif = errors.New("truncated EXIF metadata")
+)
+
+type (
+	// exifMetadata is what is read from the EXIF metadata of a JPEG.
+	exifMetadata struct {
+		// date is when the photo was taken, or zero if unknown.
+		date time.Time
+
+		// thumbnailOffset and thumbnailSize locate the embedded JPEG thumbnail in the file, and are 0 if there is none.
+		thumbnailOffset int64
+		thumbnailSize   int64
+	}
+
+	// tiffReader reads the IFDs of the TIFF structure that EXIF metadata is stored in.
+	tiffReader struct {
+		data  []byte
+		order binary.ByteOrder
+	}
+	tiffEntry struct {
+		kind  uint16
+		value []byte
+	}
+)
+
+// readJPEGExif reads the EXIF metadata in the APP1 segment of a JPEG.
+func readJPEGExif(r io.ReadSeeker) (exifMetadata, error) {
+	magic, err := readAt(r, 0, 2)
+	if err != nil || magic[0] != 0xff || magic[1] != jpegSOI {
+		return exifMetadata{}, errors.New("not a JPEG")
+	}
+
+	offset := int64(2)
+	for {
+		header, err := readAt(r, offset, 4)
+		if err != nil {
+			return exifMetadata{}, fmt.Errorf("could not read segment header: %w", err)
+		}
+		if header[0] != 0xff {
+			return exifMetadata{}, errors.New("bad segment marker")
+		}
+		marker := header[1]
+		if marker == 0xff {
+			// Markers may be preceded by any number of 0xff fill bytes.
+			offset++
+			continue
+		}
+		if marker == jpegSOS || marker == jpegEOI {
+			// EXIF metadata must come before the image data.
+			return exifMetadata{}, errNoExif
+		}
+		length := int64(binary.BigEndian.Uint16(header[2:]))
+		if length < 2 {
+			return exifMetadata{}, errors.New("bad segment length")
+		}
+
+		if marker == jpegAPP1 {
+			segment, err := readAt(r, offset+4, int(length-2))
+			if err != nil {
+				return exifMetadata{}, fmt.Errorf("could not read APP1 segment: %w", err)
+			}
+			if bytes.HasPrefix(segment, exifHeader) {
+				return parseExif(segment[len(exifHeader):], offset+4+int64(len(exifHeader)))
+			}
+		}
+		offset += 2 + length
+	}
+}
+
+// parseExif parses EXIF metadata, which starts at base in the file.
+func parseExif(data []byte, base int64) (exifMetadata, error) {
+	if len(data) < 8 {
+		return exifMetadata{}, errTruncatedExif
+	}
+
+	tiff := tiffReader{data: data}
+	switch string(data[:2]) {
+	case "II":
+		tiff.order = binary.LittleEndian
+	case "MM":
+		tiff.order = binary.BigEndian
+	default:
+		return exifMetadata{}, fmt.Errorf("unknown byte order %q", data[:2])
+	}
+	if tiff.order.Uint16(data[2:]) != 42 {
+		return exifMetadata{}, errors.New("bad TIFF header")
+	}
+
+	ifd0, next, err := tiff.ifd(tiff.order.Uint32(data[4:]))
+	if err != nil {
+		return exifMetadata{}, fmt.Errorf("could not read IFD0: %w", err)
+	}
+
+	md := exifMetadata{}
+	md.date = exifDate(ifd0[exifDateTime])
+	if offset, ok := tiff.uint(ifd0[exifIFDPointer]); ok {
+		if exif, _, err := tiff.ifd(offset); err == nil {
+			if date := exifDate(exif[exifDateTimeOriginal]); !date.IsZero() {
+				md.date = date
+			}
+		}
+	}
+
+	// IFD1 describes the thumbnail.
+	if next != 0 {
+		if ifd1, _, err := tiff.ifd(next); err == nil {
+			offset, okOffset := tiff.uint(ifd1[exifJPEGInterchangeFormat])
+			size, okSize := tiff.uint(ifd1[exifJPEGInterchangeFormatSize])
+			if okOffset && okSize && size > 0 && int64(offset)+int64(size) <= int64(len(data)) {
+				md.thumbnailOffset = base + int64(offset)
+				md.thumbnailSize = int64(size)
+			}
+		}
+	}
+	return md, nil
+}
+
+// ifd reads the IFD at offset, returning its entries by tag and the offset of the next IFD.
+func (t tiffReader) ifd(offset uint32) (map[uint16]tiffEntry, uint32, error) {
+	if int64(offset)+2 > int64(len(t.data)) {
+		return nil, 0, errTruncatedExif
+	}
+	count := int64(t.order.Uint16(t.data[offset:]))
+	start := int64(offset) + 2
+	if start+count*12+4 > int64(len(t.data)) {
+		return nil, 0, errTruncatedExif
+	}
+
+	entries := map[uint16]tiffEntry{}
+	for i := int64(0); i < count; i++ {
+		raw := t.data[start+i*12 : start+(i+1)*12]
+		tag := t.order.Uint16(raw)
+		kind := t.order.Uint16(raw[2:])
+		n := int64(t.order.Uint32(raw[4:]))
+
+		typeSize, ok := exifTypeSizes[kind]
+		if !ok {
+			continue
+		}
+		size := n * int64(typeSize)
+
+		// Values of 4 bytes or fewer are stored in the entry itself.
+		value := raw[8:12]
+		if size > 4 {
+			valueOffset := int64(t.order.Uint32(raw[8:]))
+			if valueOffset+size > int64(len(t.data)) {
+				continue
+			}
+			value = t.data[valueOffset : valueOffset+size]
+		}
+		entries[tag] = tiffEntry{kind: kind, value: value[:size]}
+	}
+
+	next := t.order.Uint32(t.data[start+count*12:])
+	return entries, next, nil
+}
+
+// uint returns the value of a SHORT or LONG entry.
+func (t tiffReader) uint(entry tiffEntry) (uint32, bool) {
+	switch {
+	case entry.kind == exifTypeShort && len(entry.value) >= 2:
+		return uint32(t.order.Uint16(entry.value)), true
+	case entry.kind == exifTypeLong && len(entry.value) >= 4:
+		return t.order.Uint32(entry.value), true
+	default:
+		return 0, false
+	}
+}
+
+// exifDate parses an EXIF date, which has no timezone.
+func exifDate(entry tiffEntry) time.Time {
+	raw := strings.TrimRight(string(entry.value), "\x00 ")
+	date, err := time.Parse(exifDateFormat, raw)
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}
@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// fileCache is a directory of generated files, e.g. transcodes, that are removed least-recently used first.
+	fileCache struct {
+		dir      string
+		maxBytes int64
+
+		mu   sync.Mutex
+		jobs map[string]*fileCacheJob
+	}
+	fileCacheJob struct {
+		done chan struct{}
+		err  error
+	}
+
+	// detachableWriter writes to w until writing fails or it is detached, and then discards what it is given.
+	// It lets a file that is being streamed to a client still be written to the cache when the client goes away.
+	detachableWriter struct {
+		mu     sync.Mutex
+		w      io.Writer
+		err    error
+		failed chan struct{}
+	}
+)
+
+// newFileCache returns a fileCache in dir.
+// When the cache grows beyond maxBytes, the least-recently used files are removed; 0 means no limit.
+func newFileCache(dir string, maxBytes int64) (*fileCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("could not create cache directory: %w", err)
+	}
+	return &fileCache{
+		dir:      dir,
+		maxBytes: maxBytes,
+
+		jobs: map[string]*fileCacheJob{},
+	}, nil
+}
+
+// get returns the path of the file name in the cache, writing it with create if it is not already cached.
+// Concurrent calls for the same name share a single create.
+func (c *fileCache) get(ctx context.Context, name string, create func(w io.Writer) error) (string, error) {
+	dst := filepath.Join(c.dir, name)
+
+	c.mu.Lock()
+	if job, ok := c.jobs[name]; ok {
+		c.mu.Unlock()
+		select {
+		case <-job.done:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+		if job.err != nil {
+			return "", job.err
+		}
+		return dst, nil
+	}
+	if _, err := os.Stat(dst); err == nil {
+		c.mu.Unlock()
+		now := time.Now()
+		_ = os.Chtimes(dst, now, now)
+		return dst, nil
+	}
+	job := &fileCacheJob{done: make(chan struct{})}
+	c.jobs[name] = job
+	c.mu.Unlock()
+
+	// The file is not tied to the first request, as other requests may be waiting for it.
+	job.err = c.create(dst, create)
+
+	c.mu.Lock()
+	delete(c.jobs, name)
+	c.mu.Unlock()
+	close(job.done)
+
+	if job.err != nil {
+		return "", job.err
+	}
+	c.evict()
+	return dst, nil
+}
+
+// peek returns the path of the file name in the cache, and false if it is not cached.
+func (c *fileCache) peek(name string) (string, bool) {
+	dst := filepath.Join(c.dir, name)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.jobs[name]; ok {
+		return "", false
+	}
+	if _, err := os.Stat(dst); err != nil {
+		return "", false
+	}
+	now := time.Now()
+	_ = os.Chtimes(dst, now, now)
+	return dst, true
+}
+
+// stream writes the file name to w as create writes it, and caches it too, so that w need not wait for the whole file.
+// If w fails or ctx is done, stream returns, but the file is still created for the cache in the background.
+// If the file is already being created for another call, create writes to w alone.
+func (c *fileCache) stream(ctx context.Context, name string, w io.Writer, create func(ctx context.Context, w io.Writer) error) error {
+	dst := filepath.Join(c.dir, name)
+
+	c.mu.Lock()
+	if _, ok := c.jobs[name]; ok {
+		c.mu.Unlock()
+		return create(ctx, w)
+	}
+	if f, err := os.Open(dst); err == nil {
+		c.mu.Unlock()
+		defer f.Close()
+		_, err := io.Copy(w, f)
+		return err
+	}
+	job := &fileCacheJob{done: make(chan struct{})}
+	c.jobs[name] = job
+	c.mu.Unlock()
+
+	client := &detachableWriter{w: w, failed: make(chan struct{})}
+	go func() {
+		job.err = c.create(dst, func(f io.Writer) error {
+			return create(context.Background(), io.MultiWriter(f, client))
+		})
+
+		c.mu.Lock()
+		delete(c.jobs, name)
+		c.mu.Unlock()
+		close(job.done)
+
+		if job.err == nil {
+			c.evict()
+		}
+	}()
+
+	select {
+	case <-job.done:
+		if job.err != nil {
+			return job.err
+		}
+		return client.error()
+	case <-client.failed:
+		return client.error()
+	case <-ctx.Done():
+		client.detach()
+		return ctx.Err()
+	}
+}
+
+func (c *fileCache) create(dst string, create func(w io.Writer) error) error {
+	f, err := ioutil.TempFile(c.dir, "partial-")
+	if err != nil {
+		return fmt.Errorf("could not create temporary file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if err := create(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("could not write file: %w", err)
+	}
+	if err := os.Rename(f.Name(), dst); err != nil {
+		return fmt.Errorf("could not move file into cache: %w", err)
+	}
+	return nil
+}
+
+// evict removes the least-recently used files until the cache is within maxBytes.
+func (c *fileCache) evict() {
+	if c.maxBytes <= 0 {
+		return
+	}
+
+	fis, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+
+	var cached []os.FileInfo
+	var total int64
+	for _, fi := range fis {
+		if fi.IsDir() || strings.HasPrefix(fi.Name(), "partial-") {
+			continue
+		}
+		cached = append(cached, fi)
+		total += fi.Size()
+	}
+	sort.Slice(cached, func(i, j int) bool {
+		return cached[i].ModTime().Before(cached[j].ModTime())
+	})
+
+	// Always keep the most recent file, which is about to be served.
+	for i := 0; i < len(cached)-1 && total > c.maxBytes; i++ {
+		if err := os.Remove(filepath.Join(c.dir, cached[i].Name())); err == nil {
+			total -= cached[i].Size()
+		}
+	}
+}
+
+// cacheKey identifies a derivative of a specific version of a file, e.g. a transcode to a given profile.
+func cacheKey(src string, fi os.FileInfo, variant ...string) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\x00%d\x00%d", src, fi.ModTime().UnixNano(), fi.Size())
+	for _, v := range variant {
+		fmt.Fprintf(hash, "\x00%s", v)
+	}
+	return fmt.Sprintf("%x", hash.Sum(nil))[:32]
+}
+
+func (d *detachableWriter) Write(b []byte) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.w != nil {
+		if _, err := d.w.Write(b); err != nil {
+			d.w, d.err = nil, err
+			close(d.failed)
+		}
+	}
+	return len(b), nil
+}
+
+// detach stops writing to w, and returns once any write in progress has finished.
+func (d *detachableWriter) detach() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.w = nil
+}
+
+func (d *detachableWriter) error() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.err
+}
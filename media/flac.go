@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	flacStreamInfo    = 0
+	flacVorbisComment = 4
+	flacPicture       = 6
+)
+
+var (
+	// vorbisCommentKeys maps Vorbis comment fields to ffprobe's tag names, where they differ.
+	// Other fields are lowercased.
+	vorbisCommentKeys = map[string]string{
+		"tracknumber":  "track",
+		"discnumber":   "disc",
+		"albumartist":  "album_artist",
+		"album artist": "album_artist",
+		"description":  "comment",
+	}
+
+	errTruncatedVorbisComment = errors.New("truncated Vorbis comment")
+)
+
+func readFLAC(r io.ReadSeeker, size int64) (nativeMetadata, error) {
+	md := nativeMetadata{tags: map[string]string{}}
+
+	err := walkFLACMetadata(r, func(blockType byte, offset int64, length int) error {
+		switch blockType {
+		case flacStreamInfo:
+			block, err := readAt(r, offset, length)
+			if err != nil || length < 18 {
+				return errors.New("truncated STREAMINFO block")
+			}
+			sampleRate := int64(block[10])<<12 | int64(block[11])<<4 | int64(block[12])>>4
+			samples := int64(block[13]&0x0f)<<32 | int64(binary.BigEndian.Uint32(block[14:18]))
+			if sampleRate > 0 {
+				md.duration = time.Duration(samples * int64(time.Second) / sampleRate)
+			}
+			md.sampleRate = int(sampleRate)
+			md.channels = int(block[12]>>1&0x07) + 1
+			md.bitsPerSample = int(block[12]&0x01)<<4 | int(block[13]>>4) + 1
+		case flacVorbisComment:
+			block, err := readAt(r, offset, length)
+			if err != nil {
+				return fmt.Errorf("could not read VORBIS_COMMENT block: %w", err)
+			}
+			tags, err := parseVorbisComment(block)
+			if err != nil {
+				return err
+			}
+			md.tags = tags
+		}
+		return nil
+	})
+	return md, err
+}
+
+// readFLACCoverArt reads the cover art from the PICTURE blocks of a FLAC stream.
+func readFLACCoverArt(r io.ReadSeeker, size int64) ([]byte, error) {
+	var pictures []embeddedPicture
+	err := walkFLACMetadata(r, func(blockType byte, offset int64, length int) error {
+		if blockType != flacPicture {
+			return nil
+		}
+		block, err := readAt(r, offset, length)
+		if err != nil {
+			return fmt.Errorf("could not read PICTURE block: %w", err)
+		}
+		if picture, ok := parseFLACPicture(block); ok {
+			pictures = append(pictures, picture)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return chooseCoverArt(pictures)
+}
+
+// walkFLACMetadata calls fn with the type, offset, and length of each metadata block of a FLAC stream.
+func walkFLACMetadata(r io.ReadSeeker, fn func(blockType byte, offset int64, length int) error) error {
+	// Some taggers put an ID3v2 tag before the FLAC stream.
+	offset := int64(0)
+	if _, tagSize, err := readID3v2(r, 0); err == nil {
+		offset = tagSize
+	}
+
+	magic, err := readAt(r, offset, 4)
+	if err != nil || string(magic) != "fLaC" {
+		return errors.New("not a FLAC stream")
+	}
+	offset += 4
+
+	for last := false; !last; {
+		header, err := readAt(r, offset, 4)
+		if err != nil {
+			return fmt.Errorf("could not read metadata block header: %w", err)
+		}
+		last = header[0]&0x80 != 0
+		blockType := header[0] & 0x7f
+		length := int(header[1])<<16 | int(header[2])<<8 | int(header[3])
+		offset += 4
+
+		if err := fn(blockType, offset, length); err != nil {
+			return err
+		}
+		offset += int64(length)
+	}
+	return nil
+}
+
+// parseFLACPicture parses a PICTURE block.
+func parseFLACPicture(b []byte) (embeddedPicture, bool) {
+	if len(b) < 4 {
+		return embeddedPicture{}, false
+	}
+	picture := embeddedPicture{pictureType: int(binary.BigEndian.Uint32(b[0:4]))}
+	i := 4
+
+	// Skip the MIME-type and description, then the width, height, color depth, and number of colors.
+	for field := 0; field < 2; field++ {
+		if i+4 > len(b) {
+			return embeddedPicture{}, false
+		}
+		i += 4 + int(binary.BigEndian.Uint32(b[i:i+4]))
+	}
+	i += 16
+
+	if i < 0 || i+4 > len(b) {
+		return embeddedPicture{}, false
+	}
+	length := int(binary.BigEndian.Uint32(b[i : i+4]))
+	i += 4
+	if length <= 0 || length > len(b)-i {
+		return embeddedPicture{}, false
+	}
+	picture.data = b[i : i+length]
+	return picture, true
+}
+
+// parseVorbisComment parses a Vorbis comment, as used by FLAC, Ogg Vorbis, and Opus, without any framing.
+func parseVorbisComment(b []byte) (map[string]string, error) {
+	next := func(n int) ([]byte, error) {
+		if n < 0 || n > len(b) {
+			return nil, errTruncatedVorbisComment
+		}
+		value := b[:n]
+		b = b[n:]
+		return value, nil
+	}
+	nextUint32 := func() (int, error) {
+		raw, err := next(4)
+		if err != nil {
+			return 0, err
+		}
+		return int(binary.LittleEndian.Uint32(raw)), nil
+	}
+
+	vendorLength, err := nextUint32()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := next(vendorLength); err != nil {
+		return nil, err
+	}
+
+	count, err := nextUint32()
+	if err != nil {
+		return nil, err
+	}
+
+	tags := map[string]string{}
+	for i := 0; i < count; i++ {
+		length, err := nextUint32()
+		if err != nil {
+			return nil, err
+		}
+		comment, err := next(length)
+		if err != nil {
+			return nil, err
+		}
+
+		parts := strings.SplitN(string(comment), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.ToLower(parts[0])
+		if mapped, ok := vorbisCommentKeys[key]; ok {
+			key = mapped
+		}
+		addTag(tags, key, parts[1])
+	}
+	return tags, nil
+}
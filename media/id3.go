@@ -0,0 +1,609 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+type (
+	// mp3Frame is an MPEG audio frame header.
+	mp3Frame struct {
+		version     int // 1 for MPEG-1, 2 for MPEG-2, and 25 for MPEG-2.5.
+		layer       int
+		bitrate     int // in bits per second.
+		sampleRate  int
+		padding     bool
+		mono        bool
+		frameLength int
+	}
+
+	// id3Frame is an ID3v2 frame, with the extra data that its flags add removed.
+	id3Frame struct {
+		id   string
+		body []byte
+	}
+)
+
+var (
+	// id3Frames maps ID3v2.3 & ID3v2.4 frames, and their ID3v2.2 equivalents, to ffprobe's tag names.
+	// Other text frames keep their frame ID.
+	id3Frames = map[string]string{
+		"TIT2": "title", "TT2": "title",
+		"TPE1": "artist", "TP1": "artist",
+		"TPE2": "album_artist", "TP2": "album_artist",
+		"TALB": "album", "TAL": "album",
+		"TRCK": "track", "TRK": "track",
+		"TPOS": "disc", "TPA": "disc",
+		"TCON": "genre", "TCO": "genre",
+		"TDRC": "date", "TYER": "date", "TYE": "date",
+		"TCOM": "composer", "TCM": "composer",
+		"TCOP": "copyright", "TCR": "copyright",
+		"TENC": "encoded_by", "TEN": "encoded_by",
+		"TSSE": "encoder", "TSS": "encoder",
+		"TPUB": "publisher", "TPB": "publisher",
+		"TLAN": "language", "TLA": "language",
+		"TIT1": "grouping", "TT1": "grouping",
+	}
+
+	// id3Genres are the ID3v1 genres, including the Winamp extensions.
+	id3Genres = []string{
+		"Blues", "Classic Rock", "Country", "Dance", "Disco", "Funk", "Grunge", "Hip-Hop", "Jazz", "Metal",
+		"New Age", "Oldies", "Other", "Pop", "R&B", "Rap", "Reggae", "Rock", "Techno", "Industrial",
+		"Alternative", "Ska", "Death Metal", "Pranks", "Soundtrack", "Euro-Techno", "Ambient", "Trip-Hop", "Vocal", "Jazz+Funk",
+		"Fusion", "Trance", "Classical", "Instrumental", "Acid", "House", "Game", "Sound Clip", "Gospel", "Noise",
+		"AlternRock", "Bass", "Soul", "Punk", "Space", "Meditative", "Instrumental Pop", "Instrumental Rock", "Ethnic", "Gothic",
+		"Darkwave", "Techno-Industrial", "Electronic", "Pop-Folk", "Eurodance", "Dream", "Southern Rock", "Comedy", "Cult", "Gangsta",
+		"Top 40", "Christian Rap", "Pop/Funk", "Jungle", "Native American", "Cabaret", "New Wave", "Psychadelic", "Rave", "Showtunes",
+		"Trailer", "Lo-Fi", "Tribal", "Acid Punk", "Acid Jazz", "Polka", "Retro", "Musical", "Rock & Roll", "Hard Rock",
+		"Folk", "Folk-Rock", "National Folk", "Swing", "Fast Fusion", "Bebob", "Latin", "Revival", "Celtic", "Bluegrass",
+		"Avantgarde", "Gothic Rock", "Progressive Rock", "Psychedelic Rock", "Symphonic Rock", "Slow Rock", "Big Band", "Chorus", "Easy Listening", "Acoustic",
+		"Humour", "Speech", "Chanson", "Opera", "Chamber Music", "Sonata", "Symphony", "Booty Bass", "Primus", "Porn Groove",
+		"Satire", "Slow Jam", "Club", "Tango", "Samba", "Folklore", "Ballad", "Power Ballad", "Rhythmic Soul", "Freestyle",
+		"Duet", "Punk Rock", "Drum Solo", "A capella", "Euro-House", "Dance Hall",
+	}
+
+	mp3Bitrates = map[[2]int][]int{
+		{1, 1}: {0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+		{1, 2}: {0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+		{1, 3}: {0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+		{2, 1}: {0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+		{2, 2}: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		{2, 3}: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+	}
+	mp3SampleRates = map[int][]int{
+		1:  {44100, 48000, 32000},
+		2:  {22050, 24000, 16000},
+		25: {11025, 12000, 8000},
+	}
+
+	errNoID3v2    = errors.New("no ID3v2 tag")
+	errNoMP3Frame = errors.New("no MPEG audio frame")
+)
+
+// mp3FrameSearchLimit is how far past the tags to look for the first MPEG audio frame.
+const mp3FrameSearchLimit = 64 * 1024
+
+func readMP3(r io.ReadSeeker, size int64) (nativeMetadata, error) {
+	md := nativeMetadata{tags: map[string]string{}}
+
+	audioStart := int64(0)
+	tags, tagSize, err := readID3v2(r, 0)
+	switch {
+	case err == nil:
+		md.tags = tags
+		audioStart = tagSize
+	case err != errNoID3v2:
+		return md, err
+	}
+
+	audioEnd := size
+	if v1, ok := readID3v1(r, size); ok {
+		audioEnd -= 128
+		for k, v := range v1 {
+			if _, ok := md.tags[k]; !ok {
+				md.tags[k] = v
+			}
+		}
+	}
+
+	frame, duration, err := mp3Duration(r, audioStart, audioEnd)
+	if err != nil {
+		return md, err
+	}
+	md.duration = duration
+	md.sampleRate = frame.sampleRate
+	md.channels = 2
+	if frame.mono {
+		md.channels = 1
+	}
+	return md, nil
+}
+
+// readID3v2 reads an ID3v2 tag at offset, and returns its tags and total size.
+func readID3v2(r io.ReadSeeker, offset int64) (map[string]string, int64, error) {
+	data, version, totalSize, err := readID3v2Data(r, offset)
+	if err != nil {
+		return nil, totalSize, err
+	}
+	return parseID3v2Frames(data, version), totalSize, nil
+}
+
+// readID3v2Data reads the frames of an ID3v2 tag at offset, and returns them with the tag's version and total size.
+func readID3v2Data(r io.ReadSeeker, offset int64) ([]byte, int, int64, error) {
+	header, err := readAt(r, offset, 10)
+	if err != nil || string(header[0:3]) != "ID3" {
+		return nil, 0, 0, errNoID3v2
+	}
+	version := int(header[3])
+	flags := header[5]
+	size := int64(synchsafe(header[6:10]))
+
+	totalSize := 10 + size
+	if version >= 4 && flags&0x10 != 0 {
+		// Footer.
+		totalSize += 10
+	}
+
+	if version < 2 || version > 4 {
+		return nil, version, totalSize, fmt.Errorf("unsupported ID3v2 version 2.%d", version)
+	}
+
+	data, err := readAt(r, offset+10, int(size))
+	if err != nil {
+		return nil, version, totalSize, fmt.Errorf("could not read ID3v2 tag: %w", err)
+	}
+
+	if version < 4 && flags&0x80 != 0 {
+		data = removeUnsynchronization(data)
+	}
+	if version >= 3 && flags&0x40 != 0 {
+		// Extended header.
+		if len(data) < 4 {
+			return nil, version, totalSize, errors.New("truncated ID3v2 extended header")
+		}
+		extendedSize := int(binary.BigEndian.Uint32(data[0:4])) + 4
+		if version == 4 {
+			extendedSize = synchsafe(data[0:4])
+		}
+		if extendedSize > len(data) {
+			return nil, version, totalSize, errors.New("truncated ID3v2 extended header")
+		}
+		data = data[extendedSize:]
+	}
+	return data, version, totalSize, nil
+}
+
+func parseID3v2Frames(data []byte, version int) map[string]string {
+	tags := map[string]string{}
+	for _, frame := range splitID3v2Frames(data, version) {
+		id, body := frame.id, frame.body
+		switch {
+		case id == "TXXX" || id == "TXX":
+			if key, value, ok := id3DescribedText(body); ok {
+				addTag(tags, strings.ToLower(key), value)
+			}
+		case id == "COMM" || id == "COM":
+			// Skip the language.
+			if len(body) > 4 {
+				if _, value, ok := id3DescribedText(append([]byte{body[0]}, body[4:]...)); ok {
+					addTag(tags, "comment", value)
+				}
+			}
+		case strings.HasPrefix(id, "T"):
+			key, ok := id3Frames[id]
+			if !ok {
+				key = id
+			}
+			for _, value := range id3Text(body) {
+				if key == "genre" {
+					value = id3Genre(value)
+				}
+				addTag(tags, key, value)
+			}
+		}
+	}
+	return tags
+}
+
+// splitID3v2Frames splits the frames of an ID3v2 tag, skipping those that cannot be read.
+func splitID3v2Frames(data []byte, version int) []id3Frame {
+	headerSize, idSize := 10, 4
+	if version == 2 {
+		headerSize, idSize = 6, 3
+	}
+
+	var frames []id3Frame
+	for len(data) >= headerSize && data[0] != 0 {
+		id := string(data[0:idSize])
+
+		var size int
+		var flags byte
+		switch version {
+		case 2:
+			size = int(data[3])<<16 | int(data[4])<<8 | int(data[5])
+		case 3:
+			size = int(binary.BigEndian.Uint32(data[4:8]))
+			flags = data[9]
+		case 4:
+			size = synchsafe(data[4:8])
+			flags = data[9]
+		}
+		if size > len(data)-headerSize {
+			break
+		}
+		body := data[headerSize : headerSize+size]
+		data = data[headerSize+size:]
+
+		if body, ok := id3FrameBody(body, version, flags); ok {
+			frames = append(frames, id3Frame{id: id, body: body})
+		}
+	}
+	return frames
+}
+
+// readMP3CoverArt reads the cover art from the APIC frames of an MP3's ID3v2 tag.
+func readMP3CoverArt(r io.ReadSeeker, size int64) ([]byte, error) {
+	data, version, _, err := readID3v2Data(r, 0)
+	if err == errNoID3v2 {
+		return nil, errNoCoverArt
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var pictures []embeddedPicture
+	for _, frame := range splitID3v2Frames(data, version) {
+		if frame.id != "APIC" && frame.id != "PIC" {
+			continue
+		}
+		if picture, ok := parseID3v2Picture(frame.body, version); ok {
+			pictures = append(pictures, picture)
+		}
+	}
+	return chooseCoverArt(pictures)
+}
+
+// parseID3v2Picture parses an APIC frame, or an ID3v2.2 PIC frame, which has a 3-letter image format instead of a MIME-type.
+// Pictures that are only a link to an image are skipped.
+func parseID3v2Picture(body []byte, version int) (embeddedPicture, bool) {
+	if len(body) < 1 {
+		return embeddedPicture{}, false
+	}
+	encoding, b := body[0], body[1:]
+
+	var format string
+	if version == 2 {
+		if len(b) < 3 {
+			return embeddedPicture{}, false
+		}
+		format, b = string(b[0:3]), b[3:]
+	} else {
+		end := bytes.IndexByte(b, 0)
+		if end == -1 {
+			return embeddedPicture{}, false
+		}
+		format, b = string(b[:end]), b[end+1:]
+	}
+	if format == "-->" || len(b) < 1 {
+		return embeddedPicture{}, false
+	}
+	picture := embeddedPicture{pictureType: int(b[0])}
+	b = b[1:]
+
+	// Skip the description, which ends with a null in the frame's encoding.
+	terminator := []byte{0}
+	if encoding == 1 || encoding == 2 {
+		terminator = []byte{0, 0}
+	}
+	for i := 0; ; i += len(terminator) {
+		if i+len(terminator) > len(b) {
+			return embeddedPicture{}, false
+		}
+		if bytes.Equal(b[i:i+len(terminator)], terminator) {
+			picture.data = b[i+len(terminator):]
+			break
+		}
+	}
+	return picture, len(picture.data) > 0
+}
+
+// id3FrameBody removes the extra data that a frame's flags add, and returns false if the frame cannot be read.
+func id3FrameBody(body []byte, version int, flags byte) ([]byte, bool) {
+	switch version {
+	case 3:
+		if flags&0xc0 != 0 {
+			// Compressed or encrypted.
+			return nil, false
+		}
+		if flags&0x20 != 0 && len(body) > 0 {
+			// Grouping identity.
+			body = body[1:]
+		}
+	case 4:
+		if flags&0x0c != 0 {
+			// Compressed or encrypted.
+			return nil, false
+		}
+		if flags&0x40 != 0 && len(body) > 0 {
+			// Grouping identity.
+			body = body[1:]
+		}
+		if flags&0x01 != 0 && len(body) >= 4 {
+			// Data length indicator.
+			body = body[4:]
+		}
+		if flags&0x02 != 0 {
+			body = removeUnsynchronization(body)
+		}
+	}
+	return body, len(body) > 0
+}
+
+// id3Text decodes the values of a text frame, which start with an encoding byte and are separated by nulls.
+func id3Text(body []byte) []string {
+	if len(body) == 0 {
+		return nil
+	}
+	var values []string
+	for _, value := range strings.Split(id3Decode(body[0], body[1:]), "\x00") {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// id3DescribedText decodes frames with a description and a value, such as TXXX and COMM.
+func id3DescribedText(body []byte) (string, string, bool) {
+	if len(body) == 0 {
+		return "", "", false
+	}
+	parts := strings.SplitN(id3Decode(body[0], body[1:]), "\x00", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[0], strings.TrimRight(parts[1], "\x00"), true
+}
+
+// id3Decode decodes text in one of the ID3v2 encodings to UTF-8.
+func id3Decode(encoding byte, b []byte) string {
+	switch encoding {
+	case 1, 2:
+		// UTF-16 with a BOM, or UTF-16BE. Values after the first may have their own BOM.
+		bigEndian := encoding == 2
+		var units []uint16
+		for i := 0; i+1 < len(b); i += 2 {
+			switch {
+			case b[i] == 0xff && b[i+1] == 0xfe:
+				bigEndian = false
+				continue
+			case b[i] == 0xfe && b[i+1] == 0xff:
+				bigEndian = true
+				continue
+			}
+			if bigEndian {
+				units = append(units, uint16(b[i])<<8|uint16(b[i+1]))
+			} else {
+				units = append(units, uint16(b[i+1])<<8|uint16(b[i]))
+			}
+		}
+		return string(utf16.Decode(units))
+	case 3:
+		return string(b)
+	default:
+		// ISO-8859-1, whose code points are the same as Unicode's.
+		runes := make([]rune, len(b))
+		for i, c := range b {
+			runes[i] = rune(c)
+		}
+		return string(runes)
+	}
+}
+
+// id3Genre replaces ID3v1 genre numbers, e.g. "17" or "(17)", with their names.
+func id3Genre(raw string) string {
+	trimmed := raw
+	if strings.HasPrefix(trimmed, "(") && strings.HasSuffix(trimmed, ")") {
+		trimmed = trimmed[1 : len(trimmed)-1]
+	}
+	if i, err := strconv.Atoi(trimmed); err == nil && i >= 0 && i < len(id3Genres) {
+		return id3Genres[i]
+	}
+	return raw
+}
+
+// readID3v1 reads the ID3v1 tag at the end of a file, if there is one.
+func readID3v1(r io.ReadSeeker, size int64) (map[string]string, bool) {
+	if size < 128 {
+		return nil, false
+	}
+	b, err := readAt(r, size-128, 128)
+	if err != nil || string(b[0:3]) != "TAG" {
+		return nil, false
+	}
+
+	field := func(b []byte) string {
+		return strings.TrimSpace(id3Decode(0, bytes.TrimRight(b, "\x00")))
+	}
+
+	tags := map[string]string{}
+	for key, value := range map[string]string{
+		"title":   field(b[3:33]),
+		"artist":  field(b[33:63]),
+		"album":   field(b[63:93]),
+		"date":    field(b[93:97]),
+		"comment": field(b[97:127]),
+	} {
+		if value != "" {
+			tags[key] = value
+		}
+	}
+	// ID3v1.1 puts the track number in the last byte of the comment.
+	if b[125] == 0 && b[126] != 0 {
+		tags["comment"] = field(b[97:125])
+		if tags["comment"] == "" {
+			delete(tags, "comment")
+		}
+		tags["track"] = strconv.Itoa(int(b[126]))
+	}
+	if int(b[127]) < len(id3Genres) {
+		tags["genre"] = id3Genres[b[127]]
+	}
+	return tags, true
+}
+
+// mp3Duration finds the first MPEG audio frame, and gets the duration from its Xing or VBRI header,
+// or assumes a constant bitrate if it has neither.
+// It also returns the first frame, which describes the stream.
+func mp3Duration(r io.ReadSeeker, audioStart, audioEnd int64) (mp3Frame, time.Duration, error) {
+	searchLength := audioEnd - audioStart
+	if searchLength > mp3FrameSearchLimit {
+		searchLength = mp3FrameSearchLimit
+	}
+	if searchLength < 4 {
+		return mp3Frame{}, 0, errNoMP3Frame
+	}
+	b, err := readAt(r, audioStart, int(searchLength))
+	if err != nil {
+		return mp3Frame{}, 0, fmt.Errorf("could not read audio: %w", err)
+	}
+
+	for i := 0; i+4 <= len(b); i++ {
+		frame, ok := parseMP3Frame(b[i:])
+		if !ok {
+			continue
+		}
+		// Check that the next frame follows, to avoid false syncs.
+		if next := i + frame.frameLength; next+4 <= len(b) {
+			if _, ok := parseMP3Frame(b[next:]); !ok {
+				continue
+			}
+		}
+
+		samplesPerFrame := 1152
+		switch {
+		case frame.layer == 1:
+			samplesPerFrame = 384
+		case frame.layer == 3 && frame.version != 1:
+			samplesPerFrame = 576
+		}
+
+		if frames, ok := mp3VBRFrames(b[i:], frame); ok {
+			return frame, durationOf(int64(frames)*int64(samplesPerFrame), int64(frame.sampleRate)), nil
+		}
+
+		audioBytes := audioEnd - audioStart - int64(i)
+		return frame, durationOf(audioBytes*8, int64(frame.bitrate)), nil
+	}
+	return mp3Frame{}, 0, errNoMP3Frame
+}
+
+// durationOf returns how long n units last at perSecond units a second, e.g. bits at a bitrate.
+// It divides before multiplying, as n * time.Second overflows int64 for the bits of files over about 1 GB.
+func durationOf(n, perSecond int64) time.Duration {
+	return time.Duration(n/perSecond)*time.Second + time.Duration(n%perSecond)*time.Second/time.Duration(perSecond)
+}
+
+func parseMP3Frame(b []byte) (mp3Frame, bool) {
+	if len(b) < 4 || b[0] != 0xff || b[1]&0xe0 != 0xe0 {
+		return mp3Frame{}, false
+	}
+
+	var frame mp3Frame
+	switch (b[1] >> 3) & 0x03 {
+	case 0:
+		frame.version = 25
+	case 2:
+		frame.version = 2
+	case 3:
+		frame.version = 1
+	default:
+		return mp3Frame{}, false
+	}
+	frame.layer = 4 - int((b[1]>>1)&0x03)
+	if frame.layer == 4 {
+		return mp3Frame{}, false
+	}
+
+	bitrateIndex := int(b[2] >> 4)
+	sampleRateIndex := int((b[2] >> 2) & 0x03)
+	if bitrateIndex == 0 || bitrateIndex == 15 || sampleRateIndex == 3 {
+		return mp3Frame{}, false
+	}
+
+	tableVersion := frame.version
+	if tableVersion == 25 {
+		tableVersion = 2
+	}
+	frame.bitrate = mp3Bitrates[[2]int{tableVersion, frame.layer}][bitrateIndex] * 1000
+	frame.sampleRate = mp3SampleRates[frame.version][sampleRateIndex]
+	frame.padding = b[2]&0x02 != 0
+	frame.mono = b[3]>>6 == 3
+
+	padding := 0
+	if frame.padding {
+		padding = 1
+	}
+	switch {
+	case frame.layer == 1:
+		frame.frameLength = (12*frame.bitrate/frame.sampleRate + padding) * 4
+	case frame.layer == 3 && frame.version != 1:
+		frame.frameLength = 72*frame.bitrate/frame.sampleRate + padding
+	default:
+		frame.frameLength = 144*frame.bitrate/frame.sampleRate + padding
+	}
+	return frame, true
+}
+
+// mp3VBRFrames reads the number of frames from a Xing, Info, or VBRI header in the first frame.
+func mp3VBRFrames(b []byte, frame mp3Frame) (int, bool) {
+	sideInfo := 32
+	switch {
+	case frame.version == 1 && frame.mono:
+		sideInfo = 17
+	case frame.version != 1 && !frame.mono:
+		sideInfo = 17
+	case frame.version != 1 && frame.mono:
+		sideInfo = 9
+	}
+
+	if xing := 4 + sideInfo; len(b) >= xing+12 {
+		if tag := string(b[xing : xing+4]); tag == "Xing" || tag == "Info" {
+			flags := binary.BigEndian.Uint32(b[xing+4 : xing+8])
+			if flags&0x01 == 0 {
+				return 0, false
+			}
+			return int(binary.BigEndian.Uint32(b[xing+8 : xing+12])), true
+		}
+	}
+
+	if vbri := 4 + 32; len(b) >= vbri+18 && string(b[vbri:vbri+4]) == "VBRI" {
+		return int(binary.BigEndian.Uint32(b[vbri+14 : vbri+18])), true
+	}
+	return 0, false
+}
+
+// synchsafe decodes a big-endian integer whose bytes only use their lower 7 bits.
+func synchsafe(b []byte) int {
+	var n int
+	for _, c := range b {
+		n = n<<7 | int(c&0x7f)
+	}
+	return n
+}
+
+// removeUnsynchronization reverses ID3v2 unsynchronization, which inserts a 0x00 after every 0xff.
+func removeUnsynchronization(b []byte) []byte {
+	return bytes.ReplaceAll(b, []byte{0xff, 0x00}, []byte{0xff})
+}
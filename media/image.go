@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"mime"
+	"os"
+	"path"
+	"time"
+)
+
+type (
+	// ImageMetadata is the metadata of an image, read from its headers.
+	ImageMetadata struct {
+		MIMEType  string
+		SizeBytes int64
+
+		// Width and Height are in pixels, and are 0 if unknown.
+		Width, Height int
+
+		// Date is when a photo was taken, from its EXIF metadata, or zero if unknown.
+		Date time.Time
+
+		// ThumbnailWidth and ThumbnailHeight are of the JPEG thumbnail embedded in its EXIF metadata, and are 0 if it has none.
+		ThumbnailWidth, ThumbnailHeight int
+	}
+)
+
+var (
+	errNoThumbnail = errors.New("no embedded thumbnail")
+)
+
+// ProbeImage gets the metadata of the image at p.
+// Only headers are read, so it is cheap enough to not need caching.
+func ProbeImage(p string) (*ImageMetadata, error) {
+	md := &ImageMetadata{
+		MIMEType: mime.TypeByExtension(path.Ext(p)),
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return md, fmt.Errorf("could not open: %w", err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return md, fmt.Errorf("could not stat: %w", err)
+	}
+	md.SizeBytes = fi.Size()
+
+	// Formats without a decoder, e.g. HEIC, are still served, but without a resolution.
+	if config, _, err := image.DecodeConfig(f); err == nil {
+		md.Width = config.Width
+		md.Height = config.Height
+	}
+
+	exif, err := readJPEGExif(f)
+	if err != nil {
+		return md, nil
+	}
+	md.Date = exif.date
+	if exif.thumbnailSize > 0 {
+		thumbnail, err := readAt(f, exif.thumbnailOffset, int(exif.thumbnailSize))
+		if err != nil {
+			return md, nil
+		}
+		if config, err := jpeg.DecodeConfig(bytes.NewReader(thumbnail)); err == nil {
+			md.ThumbnailWidth = config.Width
+			md.ThumbnailHeight = config.Height
+		}
+	}
+	return md, nil
+}
+
+// ExifThumbnail returns the JPEG thumbnail embedded in the EXIF metadata of the image at p.
+func ExifThumbnail(p string) ([]byte, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, fmt.Errorf("could not open: %w", err)
+	}
+	defer f.Close()
+
+	exif, err := readJPEGExif(f)
+	if err != nil {
+		return nil, err
+	}
+	if exif.thumbnailSize == 0 {
+		return nil, errNoThumbnail
+	}
+	return readAt(f, exif.thumbnailOffset, int(exif.thumbnailSize))
+}
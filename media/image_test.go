@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestProbeImage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "image")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	thumbnail := encodeJPEG(t, 16, 12)
+
+	tests := []struct {
+		name string
+		file []byte
+		want *ImageMetadata
+	}{
+		{
+			name: "photo.jpg",
+			file: withExif(encodeJPEG(t, 64, 48), exifSegment(binary.LittleEndian, "2019:12:31 23:59:00", "2020:01:02 03:04:05", thumbnail)),
+			want: &ImageMetadata{
+				MIMEType:        "image/jpeg",
+				Width:           64,
+				Height:          48,
+				Date:            time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC),
+				ThumbnailWidth:  16,
+				ThumbnailHeight: 12,
+			},
+		},
+		{
+			name: "big-endian.jpg",
+			file: withExif(encodeJPEG(t, 48, 64), exifSegment(binary.BigEndian, "2019:12:31 23:59:00", "", nil)),
+			want: &ImageMetadata{
+				MIMEType: "image/jpeg",
+				Width:    48,
+				Height:   64,
+				Date:     time.Date(2019, time.December, 31, 23, 59, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "plain.jpg",
+			file: encodeJPEG(t, 8, 8),
+			want: &ImageMetadata{
+				MIMEType: "image/jpeg",
+				Width:    8,
+				Height:   8,
+			},
+		},
+		{
+			name: "diagram.png",
+			file: encodePNG(t, 20, 10),
+			want: &ImageMetadata{
+				MIMEType: "image/png",
+				Width:    20,
+				Height:   10,
+			},
+		},
+		{
+			name: "broken.jpg",
+			file: []byte("not really a JPEG"),
+			want: &ImageMetadata{
+				MIMEType: "image/jpeg",
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		p := filepath.Join(dir, tt.name)
+		if err := ioutil.WriteFile(p, tt.file, 0644); err != nil {
+			t.Fatalf("could not write file: %v", err)
+		}
+		tt.want.SizeBytes = int64(len(tt.file))
+
+		got, err := ProbeImage(p)
+		if err != nil {
+			t.Errorf("[%d]: ProbeImage(%q) returned error: %v", i, tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: ProbeImage(%q) == %+v, want %+v", i, tt.name, got, tt.want)
+		}
+	}
+
+	got, err := ExifThumbnail(filepath.Join(dir, "photo.jpg"))
+	if err != nil {
+		t.Fatalf("ExifThumbnail(%q) returned error: %v", "photo.jpg", err)
+	}
+	if !bytes.Equal(got, thumbnail) {
+		t.Errorf("ExifThumbnail(%q) returned %d bytes, want the %d byte thumbnail", "photo.jpg", len(got), len(thumbnail))
+	}
+	for _, name := range []string{"big-endian.jpg", "plain.jpg", "diagram.png"} {
+		if _, err := ExifThumbnail(filepath.Join(dir, name)); err == nil {
+			t.Errorf("ExifThumbnail(%q) returned nil error", name)
+		}
+	}
+}
+
+func encodeJPEG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height)), nil); err != nil {
+		t.Fatalf("could not encode JPEG: %v", err)
+	}
+	return buf.Bytes()
+}
+func encodePNG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatalf("could not encode PNG: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// withExif inserts an APP1 segment after a JPEG's SOI marker.
+func withExif(jpeg, segment []byte) []byte {
+	return concat(jpeg[:2], []byte{0xff, jpegAPP1}, []byte{byte((len(segment) + 2) >> 8), byte(len(segment) + 2)}, segment, jpeg[2:])
+}
+
+// exifSegment builds the body of an EXIF APP1 segment, with IFD0's DateTime, the EXIF IFD's DateTimeOriginal, and IFD1's thumbnail.
+// Empty values are left out.
+func exifSegment(order binary.ByteOrder, dateTime, dateTimeOriginal string, thumbnail []byte) []byte {
+	u16 := func(n int) []byte {
+		b := make([]byte, 2)
+		order.PutUint16(b, uint16(n))
+		return b
+	}
+	u32 := func(n int) []byte {
+		b := make([]byte, 4)
+		order.PutUint32(b, uint32(n))
+		return b
+	}
+	entry := func(tag, kind, count int, value []byte) []byte {
+		return concat(u16(tag), u16(kind), u32(count), value)
+	}
+	ifdSize := func(entries int) int {
+		return 2 + 12*entries + 4
+	}
+
+	// The layout is: header, IFD0, DateTime, EXIF IFD, DateTimeOriginal, IFD1, thumbnail.
+	const exifTypeASCII = 2
+	dateTimeValue := []byte(dateTime + "\x00")
+	originalValue := []byte(dateTimeOriginal + "\x00")
+
+	ifd0Offset := 8
+	dateTimeOffset := ifd0Offset + ifdSize(2)
+	exifOffset := dateTimeOffset + len(dateTimeValue)
+	originalOffset := exifOffset + ifdSize(1)
+	ifd1Offset := originalOffset + len(originalValue)
+	thumbnailOffset := ifd1Offset + ifdSize(2)
+
+	var ifd0Entries [][]byte
+	if dateTime != "" {
+		ifd0Entries = append(ifd0Entries, entry(exifDateTime, exifTypeASCII, len(dateTimeValue), u32(dateTimeOffset)))
+	}
+	ifd0Entries = append(ifd0Entries, entry(exifIFDPointer, exifTypeLong, 1, u32(exifOffset)))
+
+	var exifEntries [][]byte
+	if dateTimeOriginal != "" {
+		exifEntries = append(exifEntries, entry(exifDateTimeOriginal, exifTypeASCII, len(originalValue), u32(originalOffset)))
+	}
+
+	next := 0
+	if thumbnail != nil {
+		next = ifd1Offset
+	}
+
+	// IFDs are padded to the size they would be with every entry, to keep the offsets fixed.
+	ifd := func(size int, entries [][]byte, next int) []byte {
+		b := concat(u16(len(entries)), concat(entries...), u32(next))
+		return append(b, make([]byte, size-len(b))...)
+	}
+
+	byteOrder := "MM"
+	if order == binary.LittleEndian {
+		byteOrder = "II"
+	}
+
+	return concat(
+		exifHeader,
+		[]byte(byteOrder),
+		u16(42),
+		u32(ifd0Offset),
+		ifd(ifdSize(2), ifd0Entries, next),
+		dateTimeValue,
+		ifd(ifdSize(1), exifEntries, 0),
+		originalValue,
+		ifd(ifdSize(2), [][]byte{
+			entry(exifJPEGInterchangeFormat, exifTypeLong, 1, u32(thumbnailOffset)),
+			entry(exifJPEGInterchangeFormatSize, exifTypeLong, 1, u32(len(thumbnail))),
+		}, 0),
+		thumbnail,
+	)
+}
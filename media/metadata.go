@@ -21,15 +21,42 @@ type (
 		MIMEType string
 		Tags     map[string]string
 		Title    string
+
+		// Bitrate is the bitrate of the whole file in bits per second, or 0 if unknown.
+		Bitrate int
+		// SampleRate, Channels, and BitsPerSample describe the first audio stream, and are 0 if unknown.
+		SampleRate    int
+		Channels      int
+		BitsPerSample int
+		SizeBytes     int64
+	}
+
+	// Prober gets the metadata of media at a path.
+	Prober interface {
+		Probe(p string) (*Metadata, error)
 	}
 
+	// FFProbeProber is a Prober that runs ffprobe.
+	FFProbeProber struct{}
+
 	ffprobeOutput struct {
-		Format ffprobeFormat `json:"format"`
+		Format  ffprobeFormat   `json:"format"`
+		Streams []ffprobeStream `json:"streams"`
 	}
 	ffprobeFormat struct {
+		BitRate         string            `json:"bit_rate"`
 		DurationSeconds string            `json:"duration"`
+		Size            string            `json:"size"`
 		Tags            map[string]string `json:"tags"`
 	}
+	ffprobeStream struct {
+		BitRate          string `json:"bit_rate"`
+		BitsPerRawSample string `json:"bits_per_raw_sample"`
+		BitsPerSample    int    `json:"bits_per_sample"`
+		Channels         int    `json:"channels"`
+		CodecType        string `json:"codec_type"`
+		SampleRate       string `json:"sample_rate"`
+	}
 )
 
 func (m Metadata) Tag(key string) string {
@@ -42,13 +69,23 @@ func (m Metadata) Tag(key string) string {
 	return ""
 }
 
-var ffprobeArgs = []string{"-hide_banner", "-print_format", "json", "-show_format"}
+var ffprobeArgs = []string{"-hide_banner", "-print_format", "json", "-show_format", "-show_streams"}
 
+// MetadataForPath gets the metadata of a file with ffprobe.
 func MetadataForPath(p string) (*Metadata, error) {
-	md := &Metadata{
+	return FFProbeProber{}.Probe(p)
+}
+
+// newMetadata returns the metadata that can be known from a path alone.
+func newMetadata(p string) *Metadata {
+	return &Metadata{
 		MIMEType: mime.TypeByExtension(path.Ext(p)),
 		Title:    strings.TrimSuffix(path.Base(p), path.Ext(p)),
 	}
+}
+
+func (_ FFProbeProber) Probe(p string) (*Metadata, error) {
+	md := newMetadata(p)
 
 	bytes, err := exec.Command("ffprobe", append(ffprobeArgs, p)...).Output()
 	if err != nil {
@@ -68,11 +105,36 @@ func mergeFFProbeOutput(md *Metadata, ffprobe ffprobeOutput) error {
 	if duration, err := strconv.ParseFloat(ffprobe.Format.DurationSeconds, 64); err == nil {
 		md.Duration = time.Duration(duration) * time.Second
 	}
+	md.Bitrate, _ = strconv.Atoi(ffprobe.Format.BitRate)
+	md.SizeBytes, _ = strconv.ParseInt(ffprobe.Format.Size, 10, 64)
 
+	for _, stream := range ffprobe.Streams {
+		if stream.CodecType != "audio" {
+			continue
+		}
+		md.SampleRate, _ = strconv.Atoi(stream.SampleRate)
+		md.Channels = stream.Channels
+		md.BitsPerSample = stream.BitsPerSample
+		if md.BitsPerSample == 0 {
+			// Lossless codecs, e.g. FLAC, only report bits_per_raw_sample.
+			md.BitsPerSample, _ = strconv.Atoi(stream.BitsPerRawSample)
+		}
+		if md.Bitrate == 0 {
+			md.Bitrate, _ = strconv.Atoi(stream.BitRate)
+		}
+		break
+	}
+
+	mergeTags(md, ffprobe.Format.Tags)
+	return nil
+}
+
+// mergeTags adds tags to md, with the title tag replacing the title from the filename.
+func mergeTags(md *Metadata, tags map[string]string) {
 	if md.Tags == nil {
 		md.Tags = map[string]string{}
 	}
-	for k, v := range ffprobe.Format.Tags {
+	for k, v := range tags {
 		switch strings.ToLower(k) {
 		case "title":
 			md.Title = v
@@ -80,6 +142,4 @@ func mergeFFProbeOutput(md *Metadata, ffprobe ffprobeOutput) error {
 			md.Tags[k] = v
 		}
 	}
-
-	return nil
 }
@@ -71,6 +71,43 @@ func TestMergeFFProbeOutput(t *testing.T) {
 				},
 			},
 		},
+		{
+			md: &Metadata{},
+			ffprobe: ffprobeOutput{
+				Format: ffprobeFormat{
+					BitRate: "1024000",
+					Size:    "2048",
+				},
+				Streams: []ffprobeStream{
+					{CodecType: "video", BitRate: "1000"},
+					{CodecType: "audio", SampleRate: "44100", Channels: 2, BitsPerRawSample: "24", BitRate: "900000"},
+					{CodecType: "audio", SampleRate: "48000", Channels: 6},
+				},
+			},
+			want: &Metadata{
+				Tags:          map[string]string{},
+				Bitrate:       1024000,
+				SizeBytes:     2048,
+				SampleRate:    44100,
+				Channels:      2,
+				BitsPerSample: 24,
+			},
+		},
+		{
+			md: &Metadata{},
+			ffprobe: ffprobeOutput{
+				Streams: []ffprobeStream{
+					{CodecType: "audio", SampleRate: "44100", Channels: 1, BitsPerSample: 16, BitRate: "705600"},
+				},
+			},
+			want: &Metadata{
+				Tags:          map[string]string{},
+				Bitrate:       705600,
+				SampleRate:    44100,
+				Channels:      1,
+				BitsPerSample: 16,
+			},
+		},
 	}
 
 	for i, tt := range tests {
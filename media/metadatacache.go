@@ -5,9 +5,9 @@
 package media
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"path/filepath"
 	"sync"
 	"time"
 )
@@ -16,30 +16,54 @@ type (
 	MetadataCache interface {
 		MetadataForPath(string) (*Metadata, error)
 		MetadataForPaths([]string) []*Metadata
-		Warm(string)
+
+		// Warm caches the metadata of every audio or video file under a path, until done or the context is cancelled.
+		Warm(context.Context, string) error
+		// WarmStatus reports the progress of the most recent Warm.
+		WarmStatus() WarmStatus
+
+		// Invalidate forgets the metadata of a path, and of everything under it, e.g. when a Watcher reports it changed.
+		Invalidate(string)
 	}
 	metadataCache struct {
+		prober Prober
+
 		mu             sync.RWMutex
 		metadataByPath map[string]metadataCacheEntry
+
+		warmer *warmer
 	}
 	metadataCacheEntry struct {
 		metadata *Metadata
 		mtime    time.Time
 	}
 
-	NoOpCache struct{}
+	// NoOpCache probes every time, with Prober, or ffprobe if it is nil.
+	NoOpCache struct {
+		Prober Prober
+	}
 )
 
-func NewMetadataCache() MetadataCache {
+// NewMetadataCache returns an in-memory MetadataCache of metadata from prober.
+// Warm probes up to warmParallelism files at once, or one per CPU if it is not positive.
+func NewMetadataCache(prober Prober, warmParallelism int) MetadataCache {
 	return &metadataCache{
+		prober:         prober,
 		metadataByPath: map[string]metadataCacheEntry{},
+		warmer:         newWarmer(warmParallelism),
 	}
 }
 
 func (mc *metadataCache) MetadataForPath(p string) (*Metadata, error) {
+	md, _, err := mc.lookup(p)
+	return md, err
+}
+
+// lookup returns the metadata for a path, and whether it had to be probed.
+func (mc *metadataCache) lookup(p string) (*Metadata, bool, error) {
 	fi, err := os.Stat(p)
 	if err != nil {
-		return nil, fmt.Errorf("could not stat: %w", err)
+		return nil, false, fmt.Errorf("could not stat: %w", err)
 	}
 
 	mtime := fi.ModTime()
@@ -49,13 +73,13 @@ func (mc *metadataCache) MetadataForPath(p string) (*Metadata, error) {
 	mc.mu.RUnlock()
 
 	if ok && cacheEntry.mtime == mtime {
-		return cacheEntry.metadata, nil
+		return cacheEntry.metadata, false, nil
 	}
 
-	md, err := MetadataForPath(p)
+	md, err := mc.prober.Probe(p)
 	if err != nil {
 		// Return something, but don't add it to the cache.
-		return md, err
+		return md, true, err
 	}
 
 	mc.mu.Lock()
@@ -65,7 +89,7 @@ func (mc *metadataCache) MetadataForPath(p string) (*Metadata, error) {
 	}
 	mc.mu.Unlock()
 
-	return md, nil
+	return md, true, nil
 }
 func (mc *metadataCache) MetadataForPaths(paths []string) []*Metadata {
 	mtimes := make([]time.Time, len(paths))
@@ -87,7 +111,7 @@ func (mc *metadataCache) MetadataForPaths(paths []string) []*Metadata {
 			continue
 		}
 
-		md, err := MetadataForPath(p)
+		md, err := mc.prober.Probe(p)
 		if err != nil {
 			// We got something, but don't put it in the cache.
 			mds[i] = md
@@ -105,33 +129,45 @@ func (mc *metadataCache) MetadataForPaths(paths []string) []*Metadata {
 	return mds
 }
 
-func (mc *metadataCache) Warm(basePath string) {
-	var wg sync.WaitGroup
-	_ = filepath.Walk(basePath, func(p string, fi os.FileInfo, err error) error {
-		if fi.IsDir() {
-			return nil
-		}
-		if IsAudioOrVideo(fi.Name()) {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				_, _ = mc.MetadataForPath(p)
-			}()
-		}
-		return nil
+func (mc *metadataCache) Warm(ctx context.Context, basePath string) error {
+	return mc.warmer.warm(ctx, basePath, func(p string) (bool, error) {
+		_, probed, err := mc.lookup(p)
+		return probed, err
 	})
-	wg.Wait()
+}
+func (mc *metadataCache) WarmStatus() WarmStatus {
+	return mc.warmer.Status()
 }
 
-func (_ NoOpCache) MetadataForPath(p string) (*Metadata, error) {
-	return MetadataForPath(p)
+func (mc *metadataCache) Invalidate(p string) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	for entryPath := range mc.metadataByPath {
+		if isUnder(entryPath, p) {
+			delete(mc.metadataByPath, entryPath)
+		}
+	}
 }
-func (_ NoOpCache) MetadataForPaths(paths []string) []*Metadata {
+
+func (c NoOpCache) MetadataForPath(p string) (*Metadata, error) {
+	if c.Prober == nil {
+		return MetadataForPath(p)
+	}
+	return c.Prober.Probe(p)
+}
+func (c NoOpCache) MetadataForPaths(paths []string) []*Metadata {
 	var mds []*Metadata
 	for _, p := range paths {
-		md, _ := MetadataForPath(p)
+		md, _ := c.MetadataForPath(p)
 		mds = append(mds, md)
 	}
 	return mds
 }
-func (_ NoOpCache) Warm(p string) {}
+func (_ NoOpCache) Warm(_ context.Context, _ string) error {
+	return nil
+}
+func (_ NoOpCache) WarmStatus() WarmStatus {
+	return WarmStatus{}
+}
+func (_ NoOpCache) Invalidate(_ string) {}
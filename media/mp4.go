@@ -0,0 +1,320 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// mp4Atom is the header of an MP4 atom, or box.
+	mp4Atom struct {
+		kind string
+		// offset and size are of the atom's data, after its header.
+		offset int64
+		size   int64
+	}
+
+	// mp4InMemoryAtom is an atom that has been read into memory.
+	mp4InMemoryAtom struct {
+		kind string
+		data []byte
+	}
+)
+
+var (
+	// mp4Items maps iTunes metadata items to ffprobe's tag names.
+	mp4Items = map[string]string{
+		"\xa9nam": "title",
+		"\xa9ART": "artist",
+		"aART":    "album_artist",
+		"\xa9alb": "album",
+		"\xa9day": "date",
+		"\xa9gen": "genre",
+		"\xa9wrt": "composer",
+		"\xa9cmt": "comment",
+		"\xa9too": "encoder",
+		"\xa9grp": "grouping",
+		"cprt":    "copyright",
+		"trkn":    "track",
+		"disk":    "disc",
+		"gnre":    "genre",
+	}
+
+	errNoMP4Atom = errors.New("atom not found")
+)
+
+const (
+	// mp4MaxItemsSize limits how much of the metadata to read, as it can contain embedded cover art.
+	mp4MaxItemsSize = 16 * 1024 * 1024
+
+	mp4DataUTF8 = 1
+)
+
+func readMP4(r io.ReadSeeker, size int64) (nativeMetadata, error) {
+	md := nativeMetadata{tags: map[string]string{}}
+
+	moov, err := findMP4Atom(r, 0, size, "moov")
+	if err != nil {
+		return md, fmt.Errorf("could not find moov atom: %w", err)
+	}
+
+	mvhd, err := findMP4Atom(r, moov.offset, moov.size, "mvhd")
+	if err != nil {
+		return md, fmt.Errorf("could not find mvhd atom: %w", err)
+	}
+	duration, err := readMP4Duration(r, mvhd)
+	if err != nil {
+		return md, err
+	}
+	md.duration = duration
+
+	if err := readMP4AudioSampleEntry(r, moov, &md); err != nil && err != errNoMP4Atom {
+		return md, err
+	}
+
+	ilst, err := findMP4Path(r, moov, "udta", "meta", "ilst")
+	if err == errNoMP4Atom {
+		return md, nil
+	}
+	if err != nil {
+		return md, err
+	}
+	if ilst.size > mp4MaxItemsSize {
+		return md, errors.New("metadata too large")
+	}
+	items, err := readAt(r, ilst.offset, int(ilst.size))
+	if err != nil {
+		return md, fmt.Errorf("could not read metadata: %w", err)
+	}
+	md.tags = parseMP4Items(items)
+	return md, nil
+}
+
+// readMP4CoverArt reads the cover art from the covr item of an MP4's metadata.
+func readMP4CoverArt(r io.ReadSeeker, size int64) ([]byte, error) {
+	moov, err := findMP4Atom(r, 0, size, "moov")
+	if err != nil {
+		return nil, fmt.Errorf("could not find moov atom: %w", err)
+	}
+	covr, err := findMP4Path(r, moov, "udta", "meta", "ilst", "covr")
+	if err == errNoMP4Atom {
+		return nil, errNoCoverArt
+	}
+	if err != nil {
+		return nil, err
+	}
+	if covr.size > mp4MaxItemsSize {
+		return nil, errors.New("cover art too large")
+	}
+	b, err := readAt(r, covr.offset, int(covr.size))
+	if err != nil {
+		return nil, fmt.Errorf("could not read cover art: %w", err)
+	}
+
+	// covr has no picture types, so the first is the cover.
+	var pictures []embeddedPicture
+	for _, child := range splitMP4Atoms(b) {
+		if child.kind == "data" && len(child.data) > 8 {
+			pictures = append(pictures, embeddedPicture{data: child.data[8:]})
+		}
+	}
+	return chooseCoverArt(pictures)
+}
+
+// findMP4Atom finds the first atom of a kind in the size bytes from offset.
+func findMP4Atom(r io.ReadSeeker, offset, size int64, kind string) (mp4Atom, error) {
+	end := offset + size
+	for offset+8 <= end {
+		header, err := readAt(r, offset, 8)
+		if err != nil {
+			return mp4Atom{}, err
+		}
+		atom := mp4Atom{
+			kind:   string(header[4:8]),
+			offset: offset + 8,
+			size:   int64(binary.BigEndian.Uint32(header[0:4])) - 8,
+		}
+		switch atom.size + 8 {
+		case 0:
+			// The atom extends to the end.
+			atom.size = end - atom.offset
+		case 1:
+			largeSize, err := readAt(r, offset+8, 8)
+			if err != nil {
+				return mp4Atom{}, err
+			}
+			atom.offset += 8
+			atom.size = int64(binary.BigEndian.Uint64(largeSize)) - 16
+		}
+		if atom.size < 0 || atom.offset+atom.size > end {
+			return mp4Atom{}, errors.New("invalid atom size")
+		}
+
+		if atom.kind == kind {
+			return atom, nil
+		}
+		offset = atom.offset + atom.size
+	}
+	return mp4Atom{}, errNoMP4Atom
+}
+
+// findMP4Path finds nested atoms under parent.
+func findMP4Path(r io.ReadSeeker, parent mp4Atom, kinds ...string) (mp4Atom, error) {
+	atom := parent
+	for _, kind := range kinds {
+		offset, size := atom.offset, atom.size
+		if atom.kind == "meta" {
+			// meta is usually a full box, with 4 bytes of version & flags before its children, but not in QuickTime files.
+			if header, err := readAt(r, offset, 8); err == nil && string(header[4:8]) != "hdlr" {
+				offset, size = offset+4, size-4
+			}
+		}
+
+		child, err := findMP4Atom(r, offset, size, kind)
+		if err != nil {
+			return mp4Atom{}, err
+		}
+		atom = child
+	}
+	return atom, nil
+}
+
+func readMP4Duration(r io.ReadSeeker, mvhd mp4Atom) (time.Duration, error) {
+	b, err := readAt(r, mvhd.offset, int(min64(mvhd.size, 32)))
+	if err != nil || len(b) < 20 {
+		return 0, errors.New("truncated mvhd atom")
+	}
+
+	var timescale, duration uint64
+	if b[0] == 1 {
+		if len(b) < 32 {
+			return 0, errors.New("truncated mvhd atom")
+		}
+		timescale = uint64(binary.BigEndian.Uint32(b[20:24]))
+		duration = binary.BigEndian.Uint64(b[24:32])
+	} else {
+		timescale = uint64(binary.BigEndian.Uint32(b[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(b[16:20]))
+	}
+	if timescale == 0 {
+		return 0, nil
+	}
+	return time.Duration(duration * uint64(time.Second) / timescale), nil
+}
+
+// readMP4AudioSampleEntry reads the channels, sample size, and sample rate of the first track, if it is audio.
+func readMP4AudioSampleEntry(r io.ReadSeeker, moov mp4Atom, md *nativeMetadata) error {
+	trak, err := findMP4Atom(r, moov.offset, moov.size, "trak")
+	if err != nil {
+		return err
+	}
+	hdlr, err := findMP4Path(r, trak, "mdia", "hdlr")
+	if err != nil {
+		return err
+	}
+	if b, err := readAt(r, hdlr.offset, int(min64(hdlr.size, 12))); err != nil || len(b) < 12 || string(b[8:12]) != "soun" {
+		return nil
+	}
+
+	stsd, err := findMP4Path(r, trak, "mdia", "minf", "stbl", "stsd")
+	if err != nil {
+		return err
+	}
+	// stsd has 4 bytes of version & flags and 4 bytes of entry count, then the entries.
+	b, err := readAt(r, stsd.offset, int(min64(stsd.size, 44)))
+	if err != nil || len(b) < 44 {
+		return errors.New("truncated stsd atom")
+	}
+	md.channels = int(binary.BigEndian.Uint16(b[32:34]))
+	if string(b[12:16]) != "mp4a" {
+		// AAC streams always claim to be 16 bits, as they do not have a sample size.
+		md.bitsPerSample = int(binary.BigEndian.Uint16(b[34:36]))
+	}
+	// The sample rate is a 16.16 fixed-point number.
+	md.sampleRate = int(binary.BigEndian.Uint16(b[40:42]))
+	return nil
+}
+
+// parseMP4Items parses the children of an ilst atom.
+func parseMP4Items(b []byte) map[string]string {
+	tags := map[string]string{}
+	for _, item := range splitMP4Atoms(b) {
+		var name string
+		var values [][]byte
+		var types []uint32
+		for _, child := range splitMP4Atoms(item.data) {
+			switch child.kind {
+			case "name":
+				if len(child.data) > 4 {
+					name = string(child.data[4:])
+				}
+			case "data":
+				if len(child.data) >= 8 {
+					types = append(types, binary.BigEndian.Uint32(child.data[0:4])&0xffffff)
+					values = append(values, child.data[8:])
+				}
+			}
+		}
+
+		key, ok := mp4Items[item.kind]
+		if item.kind == "----" && name != "" {
+			key, ok = strings.ToLower(name), true
+		}
+		if !ok {
+			continue
+		}
+
+		for i, value := range values {
+			switch {
+			case item.kind == "trkn" || item.kind == "disk":
+				if len(value) >= 6 {
+					number, total := binary.BigEndian.Uint16(value[2:4]), binary.BigEndian.Uint16(value[4:6])
+					formatted := strconv.Itoa(int(number))
+					if total > 0 {
+						formatted += "/" + strconv.Itoa(int(total))
+					}
+					addTag(tags, key, formatted)
+				}
+			case item.kind == "gnre":
+				if len(value) >= 2 {
+					if genre := int(binary.BigEndian.Uint16(value[0:2])) - 1; genre >= 0 && genre < len(id3Genres) {
+						addTag(tags, key, id3Genres[genre])
+					}
+				}
+			case types[i] == mp4DataUTF8:
+				addTag(tags, key, string(value))
+			}
+		}
+	}
+	return tags
+}
+
+// splitMP4Atoms splits an in-memory sequence of atoms.
+func splitMP4Atoms(b []byte) []mp4InMemoryAtom {
+	var atoms []mp4InMemoryAtom
+	for len(b) >= 8 {
+		size := int(binary.BigEndian.Uint32(b[0:4]))
+		if size < 8 || size > len(b) {
+			break
+		}
+		atoms = append(atoms, mp4InMemoryAtom{kind: string(b[4:8]), data: b[8:size]})
+		b = b[size:]
+	}
+	return atoms
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+type (
+	// NativeProber is a Prober that reads tags and durations itself, so that it does not need ffprobe.
+	// It understands ID3 in MP3, Vorbis comments in FLAC, Ogg Vorbis, and Opus, MP4 atoms in M4A, and RIFF INFO in WAV.
+	// Other formats, and files that it cannot parse, are probed with Fallback, if set.
+	NativeProber struct {
+		Fallback Prober
+	}
+
+	// nativeMetadata is what the native readers find in a file.
+	// Tags use the same names as ffprobe, e.g. "artist" and "album_artist".
+	// Stream properties are 0 if the reader does not know them.
+	nativeMetadata struct {
+		tags     map[string]string
+		duration time.Duration
+
+		sampleRate    int
+		channels      int
+		bitsPerSample int
+	}
+
+	// nativeReader reads a format from r, which is size bytes long.
+	nativeReader func(r io.ReadSeeker, size int64) (nativeMetadata, error)
+)
+
+var (
+	// ProberNames are the names of the Probers that can be chosen with ProberByName.
+	ProberNames = []string{"native", "ffprobe"}
+
+	errUnsupportedFormat = errors.New("unsupported format")
+
+	nativeReaders = map[string]nativeReader{
+		".mp3":  readMP3,
+		".flac": readFLAC,
+		".ogg":  readOgg,
+		".oga":  readOgg,
+		".opus": readOgg,
+		".m4a":  readMP4,
+		".m4b":  readMP4,
+		".mp4":  readMP4,
+		".wav":  readWAV,
+	}
+)
+
+// ProberByName returns a Prober by name: "native", which falls back to ffprobe, or "ffprobe".
+func ProberByName(name string) (Prober, bool) {
+	switch name {
+	case "native":
+		return NativeProber{Fallback: FFProbeProber{}}, true
+	case "ffprobe":
+		return FFProbeProber{}, true
+	default:
+		return nil, false
+	}
+}
+
+func (p NativeProber) Probe(filePath string) (*Metadata, error) {
+	md, err := probeNative(filePath)
+	if err != nil && p.Fallback != nil {
+		return p.Fallback.Probe(filePath)
+	}
+	return md, err
+}
+
+func probeNative(p string) (*Metadata, error) {
+	md := newMetadata(p)
+
+	read, ok := nativeReaders[strings.ToLower(path.Ext(p))]
+	if !ok {
+		return md, errUnsupportedFormat
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return md, fmt.Errorf("could not open: %w", err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return md, fmt.Errorf("could not stat: %w", err)
+	}
+
+	native, err := read(f, fi.Size())
+	if err != nil {
+		return md, fmt.Errorf("could not read %s: %w", path.Ext(p), err)
+	}
+
+	md.Duration = native.duration
+	md.SampleRate = native.sampleRate
+	md.Channels = native.channels
+	md.BitsPerSample = native.bitsPerSample
+	md.SizeBytes = fi.Size()
+
+	// Like ffprobe, the bitrate is of the whole file, including its tags.
+	if md.Duration > 0 {
+		md.Bitrate = int(float64(fi.Size()*8) / md.Duration.Seconds())
+	}
+
+	mergeTags(md, native.tags)
+	return md, nil
+}
+
+// readAt reads n bytes at offset.
+// As n often comes from the file itself, it checks that r is long enough before allocating them.
+func readAt(r io.ReadSeeker, offset int64, n int) ([]byte, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if offset < 0 || n < 0 || offset+int64(n) > size {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// addTag adds a value to tags, joining it to any existing values for the same key.
+func addTag(tags map[string]string, key, value string) {
+	if value == "" {
+		return
+	}
+	if existing, ok := tags[key]; ok && existing != value {
+		value = existing + "; " + value
+	}
+	tags[key] = value
+}
@@ -0,0 +1,541 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"mime"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNativeReaders(t *testing.T) {
+	tests := []struct {
+		name string
+		read nativeReader
+		file []byte
+		want nativeMetadata
+	}{
+		{
+			name: "MP3 with ID3v2.3 and a constant bitrate",
+			read: readMP3,
+			file: concat(
+				id3v2(3,
+					id3v23Frame("TIT2", id3Latin1("Pale")),
+					id3v23Frame("TPE1", id3UTF16("Ethel Morgan")),
+					id3v23Frame("TRCK", id3Latin1("3/12")),
+					id3v23Frame("TCON", id3Latin1("(17)")),
+					id3v23Frame("TXXX", append(id3Latin1("MusicBrainz Album Id\x00"), "abc"...)),
+					id3v23Frame("COMM", append([]byte{0}, "eng\x00a comment"...)),
+				),
+				mp3Frames(100, nil),
+			),
+			want: nativeMetadata{
+				tags: map[string]string{
+					"title":                "Pale",
+					"artist":               "Ethel Morgan",
+					"track":                "3/12",
+					"genre":                "Rock",
+					"musicbrainz album id": "abc",
+					"comment":              "a comment",
+				},
+				duration:   time.Duration(100 * 417 * 8 * int64(time.Second) / 128000),
+				sampleRate: 44100,
+				channels:   2,
+			},
+		},
+		{
+			name: "MP3 with ID3v2.4 multiple values and a Xing header",
+			read: readMP3,
+			file: concat(
+				id3v2(4,
+					id3v24Frame("TIT2", append([]byte{3}, "Violet"...)),
+					id3v24Frame("TPE1", append([]byte{3}, "Ethel\x00Morgan"...)),
+					id3v24Frame("TDRC", append([]byte{3}, "2020-06-01"...)),
+				),
+				mp3Frames(3, xingHeader(1000)),
+			),
+			want: nativeMetadata{
+				tags: map[string]string{
+					"title":  "Violet",
+					"artist": "Ethel; Morgan",
+					"date":   "2020-06-01",
+				},
+				duration:   time.Duration(1000 * 1152 * int64(time.Second) / 44100),
+				sampleRate: 44100,
+				channels:   2,
+			},
+		},
+		{
+			name: "MP3 with ID3v2.2",
+			read: readMP3,
+			file: concat(
+				id3v2(2, []byte("TT2\x00\x00\x05\x00Blue")),
+				mp3Frames(10, nil),
+			),
+			want: nativeMetadata{
+				tags:       map[string]string{"title": "Blue"},
+				duration:   time.Duration(10 * 417 * 8 * int64(time.Second) / 128000),
+				sampleRate: 44100,
+				channels:   2,
+			},
+		},
+		{
+			name: "MP3 with only ID3v1.1",
+			read: readMP3,
+			file: concat(
+				mp3Frames(10, nil),
+				id3v1("Orange", "Ethel Morgan", "Colours", "2020", 7, 13),
+			),
+			want: nativeMetadata{
+				tags: map[string]string{
+					"title":  "Orange",
+					"artist": "Ethel Morgan",
+					"album":  "Colours",
+					"date":   "2020",
+					"track":  "7",
+					"genre":  "Pop",
+				},
+				duration:   time.Duration(10 * 417 * 8 * int64(time.Second) / 128000),
+				sampleRate: 44100,
+				channels:   2,
+			},
+		},
+		{
+			name: "FLAC",
+			read: readFLAC,
+			file: concat(
+				[]byte("fLaC"),
+				flacBlock(flacStreamInfo, false, flacStreamInfoBlock(44100, 441000)),
+				flacBlock(flacVorbisComment, true, vorbisComment("TITLE=Green", "ARTIST=Ethel Morgan", "TRACKNUMBER=4", "ALBUMARTIST=Various", "GENRE=Pop", "GENRE=Rock")),
+			),
+			want: nativeMetadata{
+				tags: map[string]string{
+					"title":        "Green",
+					"artist":       "Ethel Morgan",
+					"track":        "4",
+					"album_artist": "Various",
+					"genre":        "Pop; Rock",
+				},
+				duration:      10 * time.Second,
+				sampleRate:    44100,
+				channels:      2,
+				bitsPerSample: 16,
+			},
+		},
+		{
+			name: "Ogg Vorbis with a comment header spanning pages",
+			read: readOgg,
+			file: oggStream(7, 441000,
+				concat([]byte("\x01vorbis"), le32(0), []byte{2}, le32(44100), make([]byte, 15)),
+				concat([]byte("\x03vorbis"), vorbisComment("TITLE=Indigo", "COMMENT="+string(bytes.Repeat([]byte("x"), 1000))), []byte{1}),
+			),
+			want: nativeMetadata{
+				tags: map[string]string{
+					"title":   "Indigo",
+					"comment": string(bytes.Repeat([]byte("x"), 1000)),
+				},
+				duration:   10 * time.Second,
+				sampleRate: 44100,
+				channels:   2,
+			},
+		},
+		{
+			name: "Opus",
+			read: readOgg,
+			file: oggStream(8, 480312,
+				concat([]byte("OpusHead"), []byte{1, 2}, le16(312), le32(48000), []byte{0, 0, 0}),
+				concat([]byte("OpusTags"), vorbisComment("TITLE=Yellow", "DISCNUMBER=2")),
+			),
+			want: nativeMetadata{
+				tags: map[string]string{
+					"title": "Yellow",
+					"disc":  "2",
+				},
+				duration:   10 * time.Second,
+				sampleRate: 48000,
+				channels:   2,
+			},
+		},
+		{
+			name: "MP4",
+			read: readMP4,
+			file: concat(
+				mp4Box("ftyp", []byte("M4A \x00\x00\x00\x00")),
+				mp4Box("moov",
+					mp4Box("mvhd", concat(make([]byte, 12), be32(1000), be32(10500), make([]byte, 80))),
+					mp4Box("trak",
+						mp4Box("mdia",
+							mp4Box("hdlr", concat(make([]byte, 8), []byte("soun"), make([]byte, 13))),
+							mp4Box("minf",
+								mp4Box("stbl",
+									mp4Box("stsd", concat(
+										make([]byte, 4),
+										be32(1),
+										mp4Box("alac", concat(make([]byte, 16), []byte{0, 2, 0, 24}, make([]byte, 4), be32(44100<<16))),
+									)),
+								),
+							),
+						),
+					),
+					mp4Box("udta",
+						mp4Box("meta", concat(
+							make([]byte, 4),
+							mp4Box("hdlr", make([]byte, 25)),
+							mp4Box("ilst",
+								mp4Box("\xa9nam", mp4Data(1, []byte("Red"))),
+								mp4Box("\xa9ART", mp4Data(1, []byte("Ethel Morgan"))),
+								mp4Box("trkn", mp4Data(0, []byte{0, 0, 0, 3, 0, 12, 0, 0})),
+								mp4Box("gnre", mp4Data(0, []byte{0, 14})),
+								mp4Box("----",
+									mp4Box("mean", []byte("\x00\x00\x00\x00com.apple.iTunes")),
+									mp4Box("name", []byte("\x00\x00\x00\x00MOOD")),
+									mp4Box("data", concat(be32(1), be32(0), []byte("happy"))),
+								),
+							),
+						)),
+					),
+				),
+				mp4Box("mdat", make([]byte, 100)),
+			),
+			want: nativeMetadata{
+				tags: map[string]string{
+					"title":  "Red",
+					"artist": "Ethel Morgan",
+					"track":  "3/12",
+					"genre":  "Pop",
+					"mood":   "happy",
+				},
+				duration:      10500 * time.Millisecond,
+				sampleRate:    44100,
+				channels:      2,
+				bitsPerSample: 24,
+			},
+		},
+		{
+			name: "WAV",
+			read: readWAV,
+			file: riff(
+				riffChunk("fmt ", concat(le16(1), le16(2), le32(44100), le32(176400), le16(4), le16(16))),
+				riffChunk("LIST", concat([]byte("INFO"), riffChunk("INAM", []byte("White\x00")), riffChunk("IART", []byte("Ethel Morgan\x00")))),
+				riffChunk("data", make([]byte, 88200)),
+			),
+			want: nativeMetadata{
+				tags: map[string]string{
+					"title":  "White",
+					"artist": "Ethel Morgan",
+				},
+				duration:      500 * time.Millisecond,
+				sampleRate:    44100,
+				channels:      2,
+				bitsPerSample: 16,
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		got, err := tt.read(bytes.NewReader(tt.file), int64(len(tt.file)))
+		if err != nil {
+			t.Errorf("[%d] %s: got error: %v", i, tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d] %s: got %+v, want %+v", i, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNativeReadersErrors(t *testing.T) {
+	tests := []struct {
+		read nativeReader
+		file []byte
+	}{
+		{readMP3, nil},
+		{readMP3, []byte("not an mp3 at all")},
+		{readMP3, id3v2(3)},
+		{readMP3, []byte("ID3\x03\x00\x00\x7f\x7f\x7f\x7f")},
+		{readFLAC, concat([]byte("fLaC"), []byte{0x80, 0xff, 0xff, 0xff})},
+		{readFLAC, []byte("fLaC")},
+		{readFLAC, []byte("OggS")},
+		{readOgg, []byte("OggS")},
+		{readOgg, oggStream(1, 0, []byte("\x7fFLAC"), []byte("comment"))},
+		{readMP4, mp4Box("ftyp", nil)},
+		{readMP4, concat(be32(100), []byte("moov"))},
+		{readWAV, []byte("RIFF\x00\x00\x00\x00AVI ")},
+	}
+
+	for i, tt := range tests {
+		if got, err := tt.read(bytes.NewReader(tt.file), int64(len(tt.file))); err == nil {
+			t.Errorf("[%d]: got %+v, want error", i, got)
+		}
+	}
+}
+
+func TestDurationOf(t *testing.T) {
+	tests := []struct {
+		n, perSecond int64
+		want         time.Duration
+	}{
+		{n: 100 * 417 * 8, perSecond: 128000, want: 2606250 * time.Microsecond},
+		// The bits of 2 GB at 128 kbps, which overflow int64 when multiplied by time.Second.
+		{n: 16 << 30, perSecond: 128000, want: 134217*time.Second + 728*time.Millisecond},
+	}
+
+	for i, tt := range tests {
+		if got := durationOf(tt.n, tt.perSecond); got != tt.want {
+			t.Errorf("[%d]: durationOf(%d, %d) == %v, want %v", i, tt.n, tt.perSecond, got, tt.want)
+		}
+	}
+}
+
+func TestReadAtChecksLength(t *testing.T) {
+	r := bytes.NewReader([]byte("0123456789"))
+	tests := []struct {
+		offset int64
+		n      int
+		want   string
+	}{
+		{offset: 2, n: 3, want: "234"},
+		{offset: 8, n: 2, want: "89"},
+		{offset: 8, n: 3},
+		{offset: 0, n: 256 << 20},
+		{offset: 0, n: -1},
+	}
+
+	for i, tt := range tests {
+		got, err := readAt(r, tt.offset, tt.n)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("[%d]: readAt(_, %d, %d) == %q, want error", i, tt.offset, tt.n, got)
+			}
+			continue
+		}
+		if err != nil || string(got) != tt.want {
+			t.Errorf("[%d]: readAt(_, %d, %d) == %q, %v, want %q", i, tt.offset, tt.n, got, err, tt.want)
+		}
+	}
+}
+
+func TestNativeProber(t *testing.T) {
+	dir, err := ioutil.TempDir("", "native")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	good := filepath.Join(dir, "01 Good.mp3")
+	corrupt := filepath.Join(dir, "02 Corrupt.mp3")
+	unsupported := filepath.Join(dir, "03 Unsupported.wma")
+	goodContent := concat(id3v2(3, id3v23Frame("TIT2", id3Latin1("Good")), id3v23Frame("TALB", id3Latin1("Album"))), mp3Frames(10, nil))
+	for p, content := range map[string][]byte{
+		good:        goodContent,
+		corrupt:     []byte("corrupt"),
+		unsupported: []byte("wma"),
+	} {
+		if err := ioutil.WriteFile(p, content, 0644); err != nil {
+			t.Fatalf("could not write file: %v", err)
+		}
+	}
+
+	fallback := &fakeProbe{}
+	prober := NativeProber{Fallback: fallbackProber{fallback}}
+
+	md, err := prober.Probe(good)
+	if err != nil {
+		t.Fatalf("Probe(%q) returned error: %v", good, err)
+	}
+	want := &Metadata{
+		Title:    "Good",
+		MIMEType: mime.TypeByExtension(".mp3"),
+		Duration: time.Duration(10 * 417 * 8 * int64(time.Second) / 128000),
+		Tags:     map[string]string{"album": "Album"},
+
+		SampleRate: 44100,
+		Channels:   2,
+	}
+	want.SizeBytes = int64(len(goodContent))
+	want.Bitrate = int(float64(want.SizeBytes*8) / want.Duration.Seconds())
+	if !reflect.DeepEqual(md, want) {
+		t.Errorf("Probe(%q) == %+v, want %+v", good, md, want)
+	}
+
+	for _, p := range []string{corrupt, unsupported} {
+		if _, err := prober.Probe(p); err != nil {
+			t.Errorf("Probe(%q) returned error: %v", p, err)
+		}
+	}
+	if got, want := fallback.probed(), []string{"02 Corrupt.mp3", "03 Unsupported.wma"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("fell back for %v, want %v", got, want)
+	}
+
+	md, err = NativeProber{}.Probe(corrupt)
+	if err == nil {
+		t.Errorf("Probe(%q) without a fallback returned nil error", corrupt)
+	}
+	if md == nil || md.Title != "02 Corrupt" {
+		t.Errorf("Probe(%q) without a fallback == %+v, want the title from the filename", corrupt, md)
+	}
+}
+
+type fallbackProber struct {
+	probe *fakeProbe
+}
+
+func (p fallbackProber) Probe(path string) (*Metadata, error) {
+	return p.probe.probe(path)
+}
+
+func concat(bs ...[]byte) []byte {
+	return bytes.Join(bs, nil)
+}
+func le16(n int) []byte {
+	b := make([]byte, 2)
+	binary.LittleEndian.PutUint16(b, uint16(n))
+	return b
+}
+func le32(n int) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, uint32(n))
+	return b
+}
+func be32(n int) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(n))
+	return b
+}
+
+func id3v2(version byte, frames ...[]byte) []byte {
+	body := concat(frames...)
+	size := len(body)
+	return concat([]byte{'I', 'D', '3', version, 0, 0, byte(size>>21) & 0x7f, byte(size>>14) & 0x7f, byte(size>>7) & 0x7f, byte(size) & 0x7f}, body)
+}
+func id3v23Frame(id string, body []byte) []byte {
+	return concat([]byte(id), be32(len(body)), []byte{0, 0}, body)
+}
+func id3v24Frame(id string, body []byte) []byte {
+	size := len(body)
+	return concat([]byte(id), []byte{byte(size>>21) & 0x7f, byte(size>>14) & 0x7f, byte(size>>7) & 0x7f, byte(size) & 0x7f}, []byte{0, 0}, body)
+}
+func id3Latin1(s string) []byte {
+	return append([]byte{0}, s...)
+}
+func id3UTF16(s string) []byte {
+	b := []byte{1, 0xff, 0xfe}
+	for _, r := range s {
+		b = append(b, byte(r), 0)
+	}
+	return b
+}
+func id3v1(title, artist, album, year string, track, genre byte) []byte {
+	field := func(s string, n int) []byte {
+		b := make([]byte, n)
+		copy(b, s)
+		return b
+	}
+	return concat([]byte("TAG"), field(title, 30), field(artist, 30), field(album, 30), field(year, 4), field("", 28), []byte{0, track, genre})
+}
+
+// mp3Frames returns MPEG-1 Layer III frames at 128kbps and 44.1kHz, the first of which starts with firstFrameData.
+func mp3Frames(n int, firstFrameData []byte) []byte {
+	var b []byte
+	for i := 0; i < n; i++ {
+		frame := make([]byte, 417)
+		copy(frame, []byte{0xff, 0xfb, 0x90, 0x00})
+		if i == 0 {
+			copy(frame[4:], firstFrameData)
+		}
+		b = append(b, frame...)
+	}
+	return b
+}
+func xingHeader(frames int) []byte {
+	return concat(make([]byte, 32), []byte("Xing"), be32(1), be32(frames))
+}
+
+func flacBlock(blockType byte, last bool, body []byte) []byte {
+	if last {
+		blockType |= 0x80
+	}
+	return concat([]byte{blockType, byte(len(body) >> 16), byte(len(body) >> 8), byte(len(body))}, body)
+}
+func flacStreamInfoBlock(sampleRate, samples int) []byte {
+	b := make([]byte, 34)
+	b[10] = byte(sampleRate >> 12)
+	b[11] = byte(sampleRate >> 4)
+	b[12] = byte(sampleRate<<4) | 1<<1 // 2 channels.
+	b[13] = 15<<4 | byte(samples>>32)&0x0f
+	binary.BigEndian.PutUint32(b[14:18], uint32(samples))
+	return b
+}
+func vorbisComment(comments ...string) []byte {
+	b := concat(le32(6), []byte("vendor"), le32(len(comments)))
+	for _, comment := range comments {
+		b = concat(b, le32(len(comment)), []byte(comment))
+	}
+	return b
+}
+
+// oggStream returns an Ogg stream of header packets, split into pages of at most 2 segments, then an audio page at granule.
+func oggStream(serial uint32, granule int64, packets ...[]byte) []byte {
+	var segments []byte
+	var data []byte
+	for _, packet := range packets {
+		data = append(data, packet...)
+		for n := len(packet); ; n -= 255 {
+			if n < 255 {
+				segments = append(segments, byte(n))
+				break
+			}
+			segments = append(segments, 255)
+		}
+	}
+
+	page := func(granule int64, segments, data []byte) []byte {
+		header := concat([]byte("OggS"), []byte{0, 0}, make([]byte, 8), make([]byte, 4), make([]byte, 8), []byte{byte(len(segments))})
+		binary.LittleEndian.PutUint64(header[6:14], uint64(granule))
+		binary.LittleEndian.PutUint32(header[14:18], serial)
+		return concat(header, segments, data)
+	}
+
+	var b []byte
+	for len(segments) > 0 {
+		n := 2
+		if n > len(segments) {
+			n = len(segments)
+		}
+		size := 0
+		for _, segment := range segments[:n] {
+			size += int(segment)
+		}
+		b = append(b, page(0, segments[:n], data[:size])...)
+		segments, data = segments[n:], data[size:]
+	}
+	return append(b, page(granule, []byte{10}, make([]byte, 10))...)
+}
+
+func mp4Box(kind string, children ...[]byte) []byte {
+	body := concat(children...)
+	return concat(be32(8+len(body)), []byte(kind), body)
+}
+func mp4Data(dataType int, value []byte) []byte {
+	return mp4Box("data", concat(be32(dataType), be32(0), value))
+}
+
+func riff(chunks ...[]byte) []byte {
+	body := concat(chunks...)
+	return concat([]byte("RIFF"), le32(4+len(body)), []byte("WAVE"), body)
+}
+func riffChunk(id string, body []byte) []byte {
+	chunk := concat([]byte(id), le32(len(body)), body)
+	if len(body)%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+	return chunk
+}
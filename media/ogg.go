@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+type (
+	// oggPage is the header of an Ogg page.
+	oggPage struct {
+		granule  int64
+		serial   uint32
+		segments []byte
+	}
+
+	// oggPacketReader reassembles the packets of the first logical stream of an Ogg file, which may span pages.
+	oggPacketReader struct {
+		r      io.ReadSeeker
+		offset int64
+		serial uint32
+
+		// pending are the packets of the current page that have not been returned yet.
+		pending [][]byte
+		// partial is a packet continued from the previous page.
+		partial []byte
+	}
+)
+
+const (
+	// oggTailSize is how much of the end of a file to search for the last page.
+	oggTailSize = 64 * 1024
+	// oggMaxPacketSize limits the size of header packets, which can contain embedded cover art.
+	oggMaxPacketSize = 16 * 1024 * 1024
+
+	opusSampleRate = 48000
+)
+
+func readOgg(r io.ReadSeeker, size int64) (nativeMetadata, error) {
+	md := nativeMetadata{tags: map[string]string{}}
+
+	packets := &oggPacketReader{r: r}
+	identification, err := packets.next()
+	if err != nil {
+		return md, fmt.Errorf("could not read identification header: %w", err)
+	}
+	comment, err := packets.next()
+	if err != nil {
+		return md, fmt.Errorf("could not read comment header: %w", err)
+	}
+
+	var sampleRate, preSkip int64
+	switch {
+	case bytes.HasPrefix(identification, []byte("\x01vorbis")) && len(identification) >= 16:
+		sampleRate = int64(binary.LittleEndian.Uint32(identification[12:16]))
+		md.channels = int(identification[11])
+		if !bytes.HasPrefix(comment, []byte("\x03vorbis")) {
+			return md, errors.New("missing Vorbis comment header")
+		}
+		comment = comment[7:]
+	case bytes.HasPrefix(identification, []byte("OpusHead")) && len(identification) >= 12:
+		sampleRate = opusSampleRate
+		preSkip = int64(binary.LittleEndian.Uint16(identification[10:12]))
+		md.channels = int(identification[9])
+		if !bytes.HasPrefix(comment, []byte("OpusTags")) {
+			return md, errors.New("missing Opus tags header")
+		}
+		comment = comment[8:]
+	default:
+		return md, errUnsupportedFormat
+	}
+
+	md.sampleRate = int(sampleRate)
+
+	tags, err := parseVorbisComment(comment)
+	if err != nil {
+		return md, err
+	}
+	md.tags = tags
+
+	granule, err := lastOggGranule(r, size, packets.serial)
+	if err != nil {
+		return md, err
+	}
+	if samples := granule - preSkip; samples > 0 && sampleRate > 0 {
+		md.duration = time.Duration(samples * int64(time.Second) / sampleRate)
+	}
+	return md, nil
+}
+
+// parseOggPage parses the header of an Ogg page, and returns it and its total size.
+func parseOggPage(b []byte) (oggPage, int, bool) {
+	if len(b) < 27 || string(b[0:4]) != "OggS" {
+		return oggPage{}, 0, false
+	}
+	count := int(b[26])
+	if len(b) < 27+count {
+		return oggPage{}, 0, false
+	}
+
+	page := oggPage{
+		granule:  int64(binary.LittleEndian.Uint64(b[6:14])),
+		serial:   binary.LittleEndian.Uint32(b[14:18]),
+		segments: b[27 : 27+count],
+	}
+	size := 27 + count
+	for _, segment := range page.segments {
+		size += int(segment)
+	}
+	return page, size, true
+}
+
+// next returns the next packet of the first logical stream.
+func (o *oggPacketReader) next() ([]byte, error) {
+	for len(o.pending) == 0 {
+		if err := o.readPage(); err != nil {
+			return nil, err
+		}
+	}
+	packet := o.pending[0]
+	o.pending = o.pending[1:]
+	return packet, nil
+}
+
+func (o *oggPacketReader) readPage() error {
+	header, err := readAt(o.r, o.offset, 27)
+	if err != nil {
+		return err
+	}
+	segmentTable, err := readAt(o.r, o.offset+27, int(header[26]))
+	if err != nil {
+		return err
+	}
+	page, size, ok := parseOggPage(append(header, segmentTable...))
+	if !ok {
+		return errors.New("invalid Ogg page")
+	}
+
+	if o.offset == 0 {
+		o.serial = page.serial
+	}
+	body, err := readAt(o.r, o.offset+int64(27+len(segmentTable)), size-27-len(segmentTable))
+	if err != nil {
+		return err
+	}
+	o.offset += int64(size)
+	if page.serial != o.serial {
+		return nil
+	}
+
+	// A segment of less than 255 bytes ends a packet.
+	for _, segment := range page.segments {
+		o.partial = append(o.partial, body[:segment]...)
+		body = body[segment:]
+		if len(o.partial) > oggMaxPacketSize {
+			return errors.New("Ogg packet too large")
+		}
+		if segment < 255 {
+			o.pending = append(o.pending, o.partial)
+			o.partial = nil
+		}
+	}
+	return nil
+}
+
+// lastOggGranule returns the granule position of the last page of a logical stream, which is its length in samples.
+func lastOggGranule(r io.ReadSeeker, size int64, serial uint32) (int64, error) {
+	tailSize := int64(oggTailSize)
+	if tailSize > size {
+		tailSize = size
+	}
+	tail, err := readAt(r, size-tailSize, int(tailSize))
+	if err != nil {
+		return 0, fmt.Errorf("could not read last page: %w", err)
+	}
+
+	for i := bytes.LastIndex(tail, []byte("OggS")); i >= 0; i = bytes.LastIndex(tail[:i], []byte("OggS")) {
+		page, _, ok := parseOggPage(tail[i:])
+		// Pages that do not end a packet have a granule position of -1.
+		if ok && page.serial == serial && page.granule >= 0 {
+			return page.granule, nil
+		}
+	}
+	return 0, errors.New("could not find last page")
+}
@@ -7,10 +7,16 @@ package media
 import (
 	"mime"
 	"path"
+	"path/filepath"
 	"strings"
 )
 
+// IsAudioOrVideo returns whether p is audio or video, by its extension.
+// Playlists are not, even though some have audio MIME-types.
 func IsAudioOrVideo(p string) bool {
+	if IsPlaylist(p) {
+		return false
+	}
 	ext := path.Ext(p)
 	mimeType := mime.TypeByExtension(ext)
 	return strings.HasPrefix(mimeType, "audio/") || strings.HasPrefix(mimeType, "video/")
@@ -21,3 +27,8 @@ func IsImage(p string) bool {
 	mimeType := mime.TypeByExtension(ext)
 	return strings.HasPrefix(mimeType, "image/")
 }
+
+// isUnder returns whether p is dir, or a path under dir.
+func isUnder(p, dir string) bool {
+	return p == dir || strings.HasPrefix(p, strings.TrimSuffix(dir, string(filepath.Separator))+string(filepath.Separator))
+}
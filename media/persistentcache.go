@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+type (
+	// PersistentMetadataCache is a MetadataCache that is saved to a file as JSON lines, so that it survives restarts.
+	// Entries are keyed by path, mtime and size, and only stale entries are re-probed.
+	PersistentMetadataCache struct {
+		path string
+
+		// probe gets the metadata of a file, and is replaced in tests.
+		probe func(string) (*Metadata, error)
+
+		mu      sync.Mutex
+		entries map[string]persistentCacheEntry
+		file    *os.File
+
+		warmer *warmer
+	}
+
+	// persistentCacheEntry is a line of the cache file.
+	// Lines for deleted files have Deleted set and no Metadata.
+	persistentCacheEntry struct {
+		Version  int       `json:"version"`
+		Path     string    `json:"path"`
+		MTime    time.Time `json:"mtime"`
+		Size     int64     `json:"size"`
+		Metadata *Metadata `json:"metadata,omitempty"`
+		Deleted  bool      `json:"deleted,omitempty"`
+	}
+)
+
+// persistentCacheVersion is the version of Metadata in the cache file.
+// Entries from other versions are ignored, and re-probed when next needed.
+const persistentCacheVersion = 1
+
+// NewPersistentMetadataCache loads a PersistentMetadataCache of metadata from prober from a file, creating it if it does not exist.
+// Warm probes up to warmParallelism files at once, or one per CPU if it is not positive.
+func NewPersistentMetadataCache(path string, prober Prober, warmParallelism int) (*PersistentMetadataCache, error) {
+	return newPersistentMetadataCache(path, warmParallelism, prober.Probe)
+}
+func newPersistentMetadataCache(path string, warmParallelism int, probe func(string) (*Metadata, error)) (*PersistentMetadataCache, error) {
+	mc := &PersistentMetadataCache{
+		path:    path,
+		probe:   probe,
+		entries: map[string]persistentCacheEntry{},
+		warmer:  newWarmer(warmParallelism),
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("could not create cache directory: %w", err)
+	}
+	if err := mc.load(); err != nil {
+		return nil, err
+	}
+
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	if err := mc.compact(); err != nil {
+		return nil, err
+	}
+	return mc, nil
+}
+
+// load reads the cache file, where later lines replace earlier lines for the same path.
+// Lines that cannot be parsed, e.g. from an interrupted write, are skipped.
+func (mc *PersistentMetadataCache) load() error {
+	f, err := os.Open(mc.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not open metadata cache: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		var entry persistentCacheEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil || entry.Path == "" {
+			continue
+		}
+		if entry.Deleted {
+			delete(mc.entries, entry.Path)
+			continue
+		}
+		if entry.Metadata == nil || entry.Version != persistentCacheVersion {
+			continue
+		}
+		mc.entries[entry.Path] = entry
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("could not read metadata cache: %w", err)
+	}
+	return nil
+}
+
+// compact rewrites the cache file with only the current entries, and reopens it for appending.
+// mc.mu must be held.
+func (mc *PersistentMetadataCache) compact() error {
+	tmp, err := ioutil.TempFile(filepath.Dir(mc.path), filepath.Base(mc.path)+".tmp-")
+	if err != nil {
+		return fmt.Errorf("could not create temporary metadata cache: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriter(tmp)
+	encoder := json.NewEncoder(w)
+	for _, entry := range mc.entries {
+		if err := encoder.Encode(entry); err != nil {
+			tmp.Close()
+			return fmt.Errorf("could not write metadata cache: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("could not write metadata cache: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("could not write metadata cache: %w", err)
+	}
+
+	if mc.file != nil {
+		mc.file.Close()
+		mc.file = nil
+	}
+	if err := os.Rename(tmp.Name(), mc.path); err != nil {
+		return fmt.Errorf("could not replace metadata cache: %w", err)
+	}
+
+	f, err := os.OpenFile(mc.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("could not open metadata cache: %w", err)
+	}
+	mc.file = f
+	return nil
+}
+
+// appendEntry writes an entry to the end of the cache file.
+// Errors are ignored, as the in-memory cache is still correct, and the file is only an optimization.
+// mc.mu must be held.
+func (mc *PersistentMetadataCache) appendEntry(entry persistentCacheEntry) {
+	if mc.file == nil {
+		return
+	}
+	bytes, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	_, _ = mc.file.Write(append(bytes, '\n'))
+}
+
+func (mc *PersistentMetadataCache) MetadataForPath(p string) (*Metadata, error) {
+	md, _, err := mc.lookup(p)
+	return md, err
+}
+
+// lookup returns the metadata for a path, and whether it had to be probed.
+func (mc *PersistentMetadataCache) lookup(p string) (*Metadata, bool, error) {
+	fi, err := os.Stat(p)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not stat: %w", err)
+	}
+
+	mc.mu.Lock()
+	entry, ok := mc.entries[p]
+	mc.mu.Unlock()
+
+	if ok && entry.MTime.Equal(fi.ModTime()) && entry.Size == fi.Size() {
+		return entry.Metadata, false, nil
+	}
+
+	md, err := mc.probe(p)
+	if err != nil {
+		// Return something, but don't add it to the cache.
+		return md, true, err
+	}
+
+	entry = persistentCacheEntry{
+		Version:  persistentCacheVersion,
+		Path:     p,
+		MTime:    fi.ModTime(),
+		Size:     fi.Size(),
+		Metadata: md,
+	}
+	mc.mu.Lock()
+	mc.entries[p] = entry
+	mc.appendEntry(entry)
+	mc.mu.Unlock()
+
+	return md, true, nil
+}
+func (mc *PersistentMetadataCache) MetadataForPaths(paths []string) []*Metadata {
+	mds := make([]*Metadata, len(paths))
+	for i, p := range paths {
+		md, _ := mc.MetadataForPath(p)
+		if md == nil {
+			// Like the in-memory cache, return what can be known from the path, e.g. of a file removed since it was listed.
+			md = newMetadata(p)
+		}
+		mds[i] = md
+	}
+	return mds
+}
+
+// Warm probes the stale or missing files under basePath.
+// If it is not cancelled, it then evicts entries for files under basePath that no longer exist.
+func (mc *PersistentMetadataCache) Warm(ctx context.Context, basePath string) error {
+	err := mc.warmer.warm(ctx, basePath, func(p string) (bool, error) {
+		_, probed, err := mc.lookup(p)
+		return probed, err
+	})
+	if err != nil {
+		return err
+	}
+
+	mc.Evict(basePath)
+	return nil
+}
+func (mc *PersistentMetadataCache) WarmStatus() WarmStatus {
+	return mc.warmer.Status()
+}
+
+// Evict removes the entries for files under basePath that no longer exist, and compacts the cache file.
+func (mc *PersistentMetadataCache) Evict(basePath string) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	evicted := false
+	for p := range mc.entries {
+		if !isUnder(p, basePath) {
+			continue
+		}
+		if _, err := os.Stat(p); os.IsNotExist(err) {
+			delete(mc.entries, p)
+			mc.appendEntry(persistentCacheEntry{Path: p, Deleted: true})
+			evicted = true
+		}
+	}
+	if evicted {
+		_ = mc.compact()
+	}
+}
+
+// Invalidate forgets the metadata of a path, and of everything under it.
+func (mc *PersistentMetadataCache) Invalidate(p string) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	for entryPath := range mc.entries {
+		if isUnder(entryPath, p) {
+			delete(mc.entries, entryPath)
+			mc.appendEntry(persistentCacheEntry{Path: entryPath, Deleted: true})
+		}
+	}
+}
+
+// Close closes the cache file. The cache still works in-memory afterwards.
+func (mc *PersistentMetadataCache) Close() error {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	if mc.file == nil {
+		return nil
+	}
+	err := mc.file.Close()
+	mc.file = nil
+	return err
+}
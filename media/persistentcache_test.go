@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPersistentMetadataCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metadatacache")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	library := filepath.Join(dir, "library")
+	if err := os.MkdirAll(library, 0755); err != nil {
+		t.Fatalf("could not create library: %v", err)
+	}
+	for _, name := range []string{"a.mp3", "b.flac", "cover.jpg"} {
+		if err := ioutil.WriteFile(filepath.Join(library, name), []byte(name), 0644); err != nil {
+			t.Fatalf("could not write file: %v", err)
+		}
+	}
+	cachePath := filepath.Join(dir, "cache", "metadata.jsonl")
+
+	probe := &fakeProbe{}
+	mc, err := newPersistentMetadataCache(cachePath, 2, probe.probe)
+	if err != nil {
+		t.Fatalf("newPersistentMetadataCache(%q) returned error: %v", cachePath, err)
+	}
+	if err := mc.Warm(context.Background(), library); err != nil {
+		t.Fatalf("Warm(_, %q) returned error: %v", library, err)
+	}
+	if got, want := probe.probed(), []string{"a.mp3", "b.flac"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("first Warm probed %v, want %v", got, want)
+	}
+	if err := mc.Close(); err != nil {
+		t.Fatalf("Close() returned error: %v", err)
+	}
+
+	// After a "restart", only changed files are probed, and deleted files are evicted.
+	bPath := filepath.Join(library, "b.flac")
+	if err := ioutil.WriteFile(bPath, []byte("a longer b.flac"), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+	if err := os.Remove(filepath.Join(library, "a.mp3")); err != nil {
+		t.Fatalf("could not remove file: %v", err)
+	}
+
+	probe = &fakeProbe{}
+	mc, err = newPersistentMetadataCache(cachePath, 2, probe.probe)
+	if err != nil {
+		t.Fatalf("newPersistentMetadataCache(%q) returned error: %v", cachePath, err)
+	}
+	defer mc.Close()
+
+	if len(mc.entries) != 2 {
+		t.Errorf("after restart, cache had %d entries, want 2", len(mc.entries))
+	}
+	if err := mc.Warm(context.Background(), library); err != nil {
+		t.Fatalf("Warm(_, %q) returned error: %v", library, err)
+	}
+	if got, want := probe.probed(), []string{"b.flac"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("second Warm probed %v, want %v", got, want)
+	}
+	if _, ok := mc.entries[filepath.Join(library, "a.mp3")]; ok {
+		t.Errorf("entry for deleted file a.mp3 was not evicted")
+	}
+
+	md, err := mc.MetadataForPath(bPath)
+	if err != nil {
+		t.Fatalf("MetadataForPath(%q) returned error: %v", bPath, err)
+	}
+	if want := (&Metadata{Title: "b.flac", Duration: time.Minute}); !reflect.DeepEqual(md, want) {
+		t.Errorf("MetadataForPath(%q) == %+v, want %+v", bPath, md, want)
+	}
+
+	aPath := filepath.Join(library, "a.mp3")
+	mds := mc.MetadataForPaths([]string{aPath})
+	if len(mds) != 1 || mds[0] == nil || mds[0].Title != "a" {
+		t.Errorf("MetadataForPaths([%q]) == %+v, want the metadata from the path of the removed file", aPath, mds)
+	}
+
+	raw, err := ioutil.ReadFile(cachePath)
+	if err != nil {
+		t.Fatalf("could not read cache file: %v", err)
+	}
+	if lines := strings.Count(string(raw), "\n"); lines != 1 {
+		t.Errorf("after eviction, cache file has %d lines, want 1:\n%s", lines, raw)
+	}
+}
+
+func TestPersistentMetadataCacheCorruptFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metadatacache")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	track := filepath.Join(dir, "a.mp3")
+	oldTrack := filepath.Join(dir, "b.mp3")
+	var lines []string
+	for _, p := range []string{track, oldTrack} {
+		if err := ioutil.WriteFile(p, []byte("a"), 0644); err != nil {
+			t.Fatalf("could not write file: %v", err)
+		}
+		fi, err := os.Stat(p)
+		if err != nil {
+			t.Fatalf("could not stat file: %v", err)
+		}
+		version := `"version":1,`
+		if p == oldTrack {
+			version = ""
+		}
+		lines = append(lines, `{`+version+`"path":"`+p+`","mtime":"`+fi.ModTime().Format(time.RFC3339Nano)+`","size":1,"metadata":{"Title":"cached"}}`)
+	}
+
+	cachePath := filepath.Join(dir, "metadata.jsonl")
+	if err := ioutil.WriteFile(cachePath, []byte("not json\n"+strings.Join(lines, "\n")+"\n{\"path\":\"trunc"), 0644); err != nil {
+		t.Fatalf("could not write cache file: %v", err)
+	}
+
+	probe := &fakeProbe{}
+	mc, err := newPersistentMetadataCache(cachePath, 2, probe.probe)
+	if err != nil {
+		t.Fatalf("newPersistentMetadataCache(%q) returned error: %v", cachePath, err)
+	}
+	defer mc.Close()
+
+	md, err := mc.MetadataForPath(track)
+	if err != nil {
+		t.Fatalf("MetadataForPath(%q) returned error: %v", track, err)
+	}
+	if md.Title != "cached" {
+		t.Errorf("MetadataForPath(%q).Title == %q, want %q", track, md.Title, "cached")
+	}
+	if got := probe.probed(); len(got) != 0 {
+		t.Errorf("probed %v, want nothing", got)
+	}
+
+	// Entries from older versions of the cache are re-probed.
+	if _, err := mc.MetadataForPath(oldTrack); err != nil {
+		t.Fatalf("MetadataForPath(%q) returned error: %v", oldTrack, err)
+	}
+	if got, want := probe.probed(), []string{"b.mp3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("probed %v, want %v", got, want)
+	}
+}
+
+func TestPersistentMetadataCacheInvalidate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metadatacache")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	album := filepath.Join(dir, "album")
+	if err := os.MkdirAll(album, 0755); err != nil {
+		t.Fatalf("could not create album: %v", err)
+	}
+	aPath := filepath.Join(dir, "a.mp3")
+	bPath := filepath.Join(album, "b.mp3")
+	for _, p := range []string{aPath, bPath} {
+		if err := ioutil.WriteFile(p, []byte("track"), 0644); err != nil {
+			t.Fatalf("could not write file: %v", err)
+		}
+	}
+
+	cachePath := filepath.Join(dir, "metadata.jsonl")
+	probe := &fakeProbe{}
+	mc, err := newPersistentMetadataCache(cachePath, 2, probe.probe)
+	if err != nil {
+		t.Fatalf("newPersistentMetadataCache(%q) returned error: %v", cachePath, err)
+	}
+	if err := mc.Warm(context.Background(), dir); err != nil {
+		t.Fatalf("Warm(_, %q) returned error: %v", dir, err)
+	}
+
+	mc.Invalidate(album)
+	mc.MetadataForPaths([]string{aPath, bPath})
+	if got, want := probe.probed(), []string{"a.mp3", "b.mp3", "b.mp3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after Invalidate(%q), probed %v, want %v", album, got, want)
+	}
+
+	// Invalidation survives a restart.
+	mc.Invalidate(aPath)
+	if err := mc.Close(); err != nil {
+		t.Fatalf("Close() returned error: %v", err)
+	}
+	mc, err = newPersistentMetadataCache(cachePath, 2, probe.probe)
+	if err != nil {
+		t.Fatalf("newPersistentMetadataCache(%q) returned error: %v", cachePath, err)
+	}
+	defer mc.Close()
+	if _, ok := mc.entries[aPath]; ok {
+		t.Errorf("after restart, entry for invalidated file %q is present", aPath)
+	}
+	if _, ok := mc.entries[bPath]; !ok {
+		t.Errorf("after restart, entry for re-probed file %q is missing", bPath)
+	}
+}
+
+// fakeProbe records which files it probed, and returns their name as the title.
+type fakeProbe struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (f *fakeProbe) probe(p string) (*Metadata, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.names = append(f.names, filepath.Base(p))
+	return &Metadata{Title: filepath.Base(p), Duration: time.Minute}, nil
+}
+func (f *fakeProbe) probed() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	names := append([]string(nil), f.names...)
+	sort.Strings(names)
+	return names
+}
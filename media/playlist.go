@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type (
+	// Playlist is a parsed M3U, M3U8, PLS, or XSPF playlist.
+	Playlist struct {
+		Title string
+		// Entries are the local paths in the playlist, resolved against its directory.
+		// Remote URLs are skipped, and paths are not checked to exist.
+		Entries []string
+	}
+
+	xspfPlaylist struct {
+		Title  string   `xml:"title"`
+		Tracks []string `xml:"trackList>track>location"`
+	}
+)
+
+var (
+	playlistMIMETypes = map[string]string{
+		".m3u":  "audio/x-mpegurl",
+		".m3u8": "audio/x-mpegurl",
+		".pls":  "audio/x-scpls",
+		".xspf": "application/xspf+xml",
+	}
+
+	utf8BOM = []byte("\xef\xbb\xbf")
+)
+
+// IsPlaylist returns whether p is a playlist that ParsePlaylist understands.
+func IsPlaylist(p string) bool {
+	_, ok := playlistMIMETypes[strings.ToLower(path.Ext(p))]
+	return ok
+}
+
+// PlaylistMIMEType returns the MIME-type of a playlist, which is not in every system's MIME-type database.
+func PlaylistMIMEType(p string) string {
+	return playlistMIMETypes[strings.ToLower(path.Ext(p))]
+}
+
+// ParsePlaylist parses the playlist at p.
+func ParsePlaylist(p string) (*Playlist, error) {
+	bytes, err := ioutil.ReadFile(p)
+	if err != nil {
+		return nil, fmt.Errorf("could not read playlist: %w", err)
+	}
+
+	playlist := &Playlist{
+		Title: strings.TrimSuffix(filepath.Base(p), filepath.Ext(p)),
+	}
+	var title string
+	var locations []string
+
+	switch strings.ToLower(filepath.Ext(p)) {
+	case ".m3u", ".m3u8":
+		title, locations = parseM3U(bytes)
+	case ".pls":
+		title, locations = parsePLS(bytes)
+	case ".xspf":
+		title, locations, err = parseXSPF(bytes)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errUnsupportedFormat
+	}
+
+	if title != "" {
+		playlist.Title = title
+	}
+	for _, location := range locations {
+		if entry, ok := resolvePlaylistEntry(filepath.Dir(p), location); ok {
+			playlist.Entries = append(playlist.Entries, entry)
+		}
+	}
+	return playlist, nil
+}
+
+// parseM3U parses an M3U playlist, which is UTF-8 if it is an M3U8 playlist, and frequently Latin-1 if it is not.
+func parseM3U(b []byte) (string, []string) {
+	b = bytes.TrimPrefix(b, utf8BOM)
+	if !utf8.Valid(b) {
+		// ID3's encoding 0 is Latin-1.
+		b = []byte(id3Decode(0, b))
+	}
+
+	var title string
+	var locations []string
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "#PLAYLIST:"):
+			title = strings.TrimSpace(strings.TrimPrefix(line, "#PLAYLIST:"))
+		case line == "" || strings.HasPrefix(line, "#"):
+		default:
+			locations = append(locations, line)
+		}
+	}
+	return title, locations
+}
+
+// parsePLS parses a PLS playlist, ordering entries by their FileN keys.
+func parsePLS(b []byte) (string, []string) {
+	b = bytes.TrimPrefix(b, utf8BOM)
+
+	type entry struct {
+		n        int
+		location string
+	}
+	var entries []entry
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		parts := strings.SplitN(strings.TrimSpace(scanner.Text()), "=", 2)
+		if len(parts) != 2 || !strings.HasPrefix(strings.ToLower(parts[0]), "file") {
+			continue
+		}
+		n, err := strconv.Atoi(parts[0][len("file"):])
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry{n, strings.TrimSpace(parts[1])})
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].n < entries[j].n })
+
+	var locations []string
+	for _, entry := range entries {
+		locations = append(locations, entry.location)
+	}
+	return "", locations
+}
+
+// parseXSPF parses an XSPF playlist, whose locations are URIs.
+func parseXSPF(b []byte) (string, []string, error) {
+	var playlist xspfPlaylist
+	if err := xml.Unmarshal(b, &playlist); err != nil {
+		return "", nil, fmt.Errorf("could not parse XSPF: %w", err)
+	}
+
+	var locations []string
+	for _, location := range playlist.Tracks {
+		u, err := url.Parse(strings.TrimSpace(location))
+		if err != nil {
+			continue
+		}
+		if u.Scheme == "" || u.Scheme == "file" {
+			locations = append(locations, u.Path)
+		}
+	}
+	return strings.TrimSpace(playlist.Title), locations, nil
+}
+
+// resolvePlaylistEntry resolves a location in a playlist against the playlist's directory.
+// Locations may be relative or absolute paths, with either kind of slash, or file:// URLs.
+func resolvePlaylistEntry(dir, location string) (string, bool) {
+	if strings.HasPrefix(location, "file://") {
+		u, err := url.Parse(location)
+		if err != nil {
+			return "", false
+		}
+		return filepath.Clean(filepath.FromSlash(u.Path)), true
+	}
+	if strings.Contains(location, "://") {
+		return "", false
+	}
+
+	// Playlists written on Windows use backslashes.
+	location = filepath.FromSlash(strings.ReplaceAll(location, `\`, "/"))
+	if !filepath.IsAbs(location) {
+		location = filepath.Join(dir, location)
+	}
+	return filepath.Clean(location), true
+}
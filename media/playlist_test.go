@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParsePlaylist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "playlist")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	mixes := filepath.Join(dir, "Mixes")
+	if err := os.MkdirAll(mixes, 0755); err != nil {
+		t.Fatalf("could not create directory: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		playlist string
+		want     *Playlist
+	}{
+		{
+			name: "road.m3u",
+			playlist: "#EXTM3U\n" +
+				"#EXTINF:123,Ethel Morgan - Pale\n" +
+				"../Pale/01 Pale.mp3\n" +
+				"\n" +
+				"/mnt/other/02 Blue.mp3\n" +
+				"http://example.com/stream.mp3\n" +
+				`..\Violet\01 Violet.mp3` + "\r\n" +
+				"Caf\xe9.mp3\n",
+			want: &Playlist{
+				Title: "road",
+				Entries: []string{
+					filepath.Join(dir, "Pale/01 Pale.mp3"),
+					"/mnt/other/02 Blue.mp3",
+					filepath.Join(dir, "Violet/01 Violet.mp3"),
+					filepath.Join(mixes, "Café.mp3"),
+				},
+			},
+		},
+		{
+			name:     "walk.m3u8",
+			playlist: "\xef\xbb\xbf#EXTM3U\n#PLAYLIST:A Walk\nCafé.mp3\nfile:///mnt/other/03%20Green.mp3\n",
+			want: &Playlist{
+				Title: "A Walk",
+				Entries: []string{
+					filepath.Join(mixes, "Café.mp3"),
+					"/mnt/other/03 Green.mp3",
+				},
+			},
+		},
+		{
+			name: "radio.pls",
+			playlist: "[playlist]\n" +
+				"File2=02 Blue.mp3\n" +
+				"Title2=Blue\n" +
+				"File1=01 Pale.mp3\n" +
+				"File10=10 Red.mp3\n" +
+				"NumberOfEntries=3\n",
+			want: &Playlist{
+				Title: "radio",
+				Entries: []string{
+					filepath.Join(mixes, "01 Pale.mp3"),
+					filepath.Join(mixes, "02 Blue.mp3"),
+					filepath.Join(mixes, "10 Red.mp3"),
+				},
+			},
+		},
+		{
+			name: "party.xspf",
+			playlist: `<?xml version="1.0" encoding="UTF-8"?>
+<playlist version="1" xmlns="http://xspf.org/ns/0/">
+  <title>Party</title>
+  <trackList>
+    <track><location>../Pale/01%20Pale.mp3</location></track>
+    <track><location>file:///mnt/other/02%20Blue.mp3</location></track>
+    <track><location>http://example.com/stream.mp3</location></track>
+  </trackList>
+</playlist>`,
+			want: &Playlist{
+				Title: "Party",
+				Entries: []string{
+					filepath.Join(dir, "Pale/01 Pale.mp3"),
+					"/mnt/other/02 Blue.mp3",
+				},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		p := filepath.Join(mixes, tt.name)
+		if err := ioutil.WriteFile(p, []byte(tt.playlist), 0644); err != nil {
+			t.Fatalf("could not write playlist: %v", err)
+		}
+
+		if !IsPlaylist(p) {
+			t.Errorf("[%d]: IsPlaylist(%q) == false, want true", i, tt.name)
+		}
+		if IsAudioOrVideo(p) {
+			t.Errorf("[%d]: IsAudioOrVideo(%q) == true, want false", i, tt.name)
+		}
+
+		got, err := ParsePlaylist(p)
+		if err != nil {
+			t.Errorf("[%d]: ParsePlaylist(%q) returned error: %v", i, tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: ParsePlaylist(%q) == %+v, want %+v", i, tt.name, got, tt.want)
+		}
+	}
+
+	broken := filepath.Join(mixes, "broken.xspf")
+	if err := ioutil.WriteFile(broken, []byte("<playlist"), 0644); err != nil {
+		t.Fatalf("could not write playlist: %v", err)
+	}
+	if _, err := ParsePlaylist(broken); err == nil {
+		t.Errorf("ParsePlaylist(%q) returned nil error", broken)
+	}
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io"
+	"os"
+)
+
+type (
+	// ImageProfile is a DLNA JPEG profile that images can be resized to.
+	ImageProfile struct {
+		// Name is the DLNA.ORG_PN of the resized image, e.g. "JPEG_TN", and identifies the profile in URLs and flags.
+		Name string
+		// Width and Height bound the resized image, in either orientation.
+		Width, Height int
+	}
+
+	// Resizer resizes images to JPEGs, and caches the results on disk.
+	Resizer struct {
+		profiles []ImageProfile
+		cache    *fileCache
+	}
+)
+
+const (
+	resizeQuality = 85
+)
+
+var (
+	ImageProfileJPEGTN = ImageProfile{
+		Name:   "JPEG_TN",
+		Width:  160,
+		Height: 160,
+	}
+	ImageProfileJPEGSM = ImageProfile{
+		Name:   "JPEG_SM",
+		Width:  640,
+		Height: 480,
+	}
+
+	// ImageProfiles are the profiles that can be chosen by name.
+	ImageProfiles = []ImageProfile{
+		ImageProfileJPEGTN,
+		ImageProfileJPEGSM,
+	}
+)
+
+// ImageProfileByName returns the profile from ImageProfiles with a given name.
+func ImageProfileByName(name string) (ImageProfile, bool) {
+	for _, profile := range ImageProfiles {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return ImageProfile{}, false
+}
+
+// Fit returns the size of an image of width and height resized to fit the profile, keeping its aspect ratio.
+// Images are never enlarged, and portrait images are fitted to the profile turned on its side.
+func (p ImageProfile) Fit(width, height int) (int, int) {
+	maxWidth, maxHeight := p.Width, p.Height
+	if height > width {
+		maxWidth, maxHeight = maxHeight, maxWidth
+	}
+	if width <= maxWidth && height <= maxHeight {
+		return width, height
+	}
+
+	scale := float64(maxWidth) / float64(width)
+	if s := float64(maxHeight) / float64(height); s < scale {
+		scale = s
+	}
+	return atLeastOne(int(float64(width)*scale + 0.5)), atLeastOne(int(float64(height)*scale + 0.5))
+}
+
+// NewResizer returns a Resizer for profiles that caches resized images in cacheDir.
+// When the cache grows beyond maxBytes, the least-recently used images are removed; 0 means no limit.
+func NewResizer(profiles []ImageProfile, cacheDir string, maxBytes int64) (*Resizer, error) {
+	cache, err := newFileCache(cacheDir, maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &Resizer{
+		profiles: profiles,
+		cache:    cache,
+	}, nil
+}
+
+// Profiles returns the profiles that the Resizer offers.
+func (r *Resizer) Profiles() []ImageProfile {
+	return r.profiles
+}
+
+// Profile returns the Resizer's profile with a given name.
+func (r *Resizer) Profile(name string) (ImageProfile, bool) {
+	for _, profile := range r.profiles {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return ImageProfile{}, false
+}
+
+// Resize resizes the image at src, or the cover art embedded in the media file at src, to a JPEG that fits profile,
+// and returns the path of the result in the cache.
+// Concurrent calls for the same image and profile share a single resize.
+func (r *Resizer) Resize(ctx context.Context, src string, profile ImageProfile) (string, error) {
+	fi, err := os.Stat(src)
+	if err != nil {
+		return "", err
+	}
+	name := cacheKey(src, fi, profile.Name, fmt.Sprintf("%dx%d", profile.Width, profile.Height)) + ".jpg"
+
+	return r.cache.get(ctx, name, func(w io.Writer) error {
+		if err := resizeImage(src, profile, w); err != nil {
+			return fmt.Errorf("could not resize %q to %v: %w", src, profile.Name, err)
+		}
+		return nil
+	})
+}
+
+func resizeImage(src string, profile ImageProfile, w io.Writer) error {
+	var r io.Reader
+	if IsImage(src) {
+		f, err := os.Open(src)
+		if err != nil {
+			return fmt.Errorf("could not open: %w", err)
+		}
+		defer f.Close()
+		r = f
+	} else {
+		art, err := embeddedCoverArt(src)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(art)
+	}
+
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return fmt.Errorf("could not decode: %w", err)
+	}
+
+	bounds := img.Bounds()
+	width, height := profile.Fit(bounds.Dx(), bounds.Dy())
+	return jpeg.Encode(w, shrink(img, width, height), &jpeg.Options{Quality: resizeQuality})
+}
+
+// shrink scales img down to width and height by averaging the pixels under each output pixel.
+// JPEGs cannot be transparent, so transparent images are drawn onto white.
+func shrink(img image.Image, width, height int) *image.RGBA {
+	bounds := img.Bounds()
+	src := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Over)
+
+	srcWidth, srcHeight := src.Bounds().Dx(), src.Bounds().Dy()
+	if width == srcWidth && height == srcHeight {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0, y1 := span(y, height, srcHeight)
+		for x := 0; x < width; x++ {
+			x0, x1 := span(x, width, srcWidth)
+
+			var r, g, b, n uint64
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					r += uint64(row[sx*4])
+					g += uint64(row[sx*4+1])
+					b += uint64(row[sx*4+2])
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = 0xff
+		}
+	}
+	return dst
+}
+
+// span returns the source pixels [start, end) under output pixel i of n, for a source of size pixels.
+func span(i, n, size int) (int, int) {
+	start := i * size / n
+	end := (i + 1) * size / n
+	if end <= start {
+		end = start + 1
+	}
+	return start, end
+}
+
+func atLeastOne(n int) int {
+	if n < 1 {
+		return 1
+	}
+	return n
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestImageProfileFit(t *testing.T) {
+	tests := []struct {
+		profile       ImageProfile
+		width, height int
+
+		wantWidth, wantHeight int
+	}{
+		{ImageProfileJPEGTN, 1000, 1000, 160, 160},
+		{ImageProfileJPEGTN, 1600, 1200, 160, 120},
+		{ImageProfileJPEGTN, 100, 50, 100, 50},
+		{ImageProfileJPEGTN, 10000, 10, 160, 1},
+		{ImageProfileJPEGSM, 1600, 1200, 640, 480},
+		{ImageProfileJPEGSM, 1200, 1600, 480, 640},
+		{ImageProfileJPEGSM, 2000, 500, 640, 160},
+	}
+
+	for i, tt := range tests {
+		gotWidth, gotHeight := tt.profile.Fit(tt.width, tt.height)
+		if gotWidth != tt.wantWidth || gotHeight != tt.wantHeight {
+			t.Errorf("[%d]: %v.Fit(%d, %d) == %d, %d, want %d, %d", i, tt.profile.Name, tt.width, tt.height, gotWidth, gotHeight, tt.wantWidth, tt.wantHeight)
+		}
+	}
+}
+
+func TestResizer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "resize")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// A transparent image with an opaque black left half.
+	img := image.NewNRGBA(image.Rect(0, 0, 400, 300))
+	for y := 0; y < 300; y++ {
+		for x := 0; x < 200; x++ {
+			img.Set(x, y, color.Black)
+		}
+	}
+	src := filepath.Join(dir, "cover.png")
+	f, err := os.Create(src)
+	if err != nil {
+		t.Fatalf("could not create file: %v", err)
+	}
+	if err := png.Encode(f, img); err != nil {
+		t.Fatalf("could not encode PNG: %v", err)
+	}
+	f.Close()
+
+	resizer, err := NewResizer(ImageProfiles, filepath.Join(dir, "cache"), 0)
+	if err != nil {
+		t.Fatalf("NewResizer(...) returned error: %v", err)
+	}
+
+	ctx := context.Background()
+	got, err := resizer.Resize(ctx, src, ImageProfileJPEGTN)
+	if err != nil {
+		t.Fatalf("Resize(_, %q, JPEG_TN) returned error: %v", src, err)
+	}
+
+	f, err = os.Open(got)
+	if err != nil {
+		t.Fatalf("could not open resized image: %v", err)
+	}
+	defer f.Close()
+	resized, err := jpeg.Decode(f)
+	if err != nil {
+		t.Fatalf("could not decode resized image: %v", err)
+	}
+	if size := resized.Bounds().Size(); size != (image.Point{X: 160, Y: 120}) {
+		t.Errorf("resized image is %v, want 160x120", size)
+	}
+	if r, g, b, _ := resized.At(20, 60).RGBA(); r>>8 > 0x10 || g>>8 > 0x10 || b>>8 > 0x10 {
+		t.Errorf("left of resized image is %x,%x,%x, want black", r>>8, g>>8, b>>8)
+	}
+	if r, g, b, _ := resized.At(140, 60).RGBA(); r>>8 < 0xf0 || g>>8 < 0xf0 || b>>8 < 0xf0 {
+		t.Errorf("transparent right of resized image is %x,%x,%x, want white", r>>8, g>>8, b>>8)
+	}
+
+	if again, err := resizer.Resize(ctx, src, ImageProfileJPEGTN); err != nil || again != got {
+		t.Errorf("second Resize(_, %q, JPEG_TN) == %q, %v, want %q, nil", src, again, err, got)
+	}
+	if small, err := resizer.Resize(ctx, src, ImageProfileJPEGSM); err != nil || small == got {
+		t.Errorf("Resize(_, %q, JPEG_SM) == %q, %v, want a different file", src, small, err)
+	}
+
+	broken := filepath.Join(dir, "broken.png")
+	if err := ioutil.WriteFile(broken, []byte("not a PNG"), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+	if _, err := resizer.Resize(ctx, broken, ImageProfileJPEGTN); err == nil {
+		t.Errorf("Resize(_, %q, JPEG_TN) returned nil error", broken)
+	}
+}
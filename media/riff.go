@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+var (
+	// riffInfoKeys maps RIFF INFO chunks to ffprobe's tag names.
+	riffInfoKeys = map[string]string{
+		"INAM": "title",
+		"IART": "artist",
+		"IPRD": "album",
+		"ICRD": "date",
+		"IGNR": "genre",
+		"ITRK": "track",
+		"IPRT": "track",
+		"ICMT": "comment",
+		"ICOP": "copyright",
+		"ISFT": "encoder",
+	}
+)
+
+// readWAV reads the fmt, data, LIST INFO, and id3 chunks of a RIFF WAVE file.
+func readWAV(r io.ReadSeeker, size int64) (nativeMetadata, error) {
+	md := nativeMetadata{tags: map[string]string{}}
+
+	header, err := readAt(r, 0, 12)
+	if err != nil || string(header[0:4]) != "RIFF" || string(header[8:12]) != "WAVE" {
+		return md, errors.New("not a RIFF WAVE file")
+	}
+
+	var byteRate, dataSize int64
+	for offset := int64(12); offset+8 <= size; {
+		chunkHeader, err := readAt(r, offset, 8)
+		if err != nil {
+			return md, fmt.Errorf("could not read chunk header: %w", err)
+		}
+		id := string(chunkHeader[0:4])
+		chunkSize := int64(binary.LittleEndian.Uint32(chunkHeader[4:8]))
+		offset += 8
+
+		switch id {
+		case "fmt ":
+			chunk, err := readAt(r, offset, int(min64(chunkSize, 16)))
+			if err != nil || len(chunk) < 12 {
+				return md, errors.New("truncated fmt chunk")
+			}
+			md.channels = int(binary.LittleEndian.Uint16(chunk[2:4]))
+			md.sampleRate = int(binary.LittleEndian.Uint32(chunk[4:8]))
+			byteRate = int64(binary.LittleEndian.Uint32(chunk[8:12]))
+			if len(chunk) >= 16 {
+				md.bitsPerSample = int(binary.LittleEndian.Uint16(chunk[14:16]))
+			}
+		case "data":
+			// The data chunk of a file still being written may claim to be longer than the file.
+			dataSize = min64(chunkSize, size-offset)
+		case "LIST":
+			chunk, err := readAt(r, offset, int(min64(chunkSize, size-offset)))
+			if err != nil {
+				return md, fmt.Errorf("could not read LIST chunk: %w", err)
+			}
+			if bytes.HasPrefix(chunk, []byte("INFO")) {
+				for k, v := range parseRIFFInfo(chunk[4:]) {
+					md.tags[k] = v
+				}
+			}
+		case "id3 ", "ID3 ":
+			if tags, _, err := readID3v2(r, offset); err == nil {
+				for k, v := range tags {
+					md.tags[k] = v
+				}
+			}
+		}
+
+		// Chunks are padded to an even size.
+		offset += chunkSize + chunkSize%2
+	}
+
+	if byteRate > 0 {
+		md.duration = time.Duration(dataSize * int64(time.Second) / byteRate)
+	}
+	return md, nil
+}
+
+func parseRIFFInfo(b []byte) map[string]string {
+	tags := map[string]string{}
+	for len(b) >= 8 {
+		id := string(b[0:4])
+		size := int(binary.LittleEndian.Uint32(b[4:8]))
+		if size > len(b)-8 {
+			break
+		}
+		value := string(bytes.TrimRight(b[8:8+size], "\x00"))
+		if key, ok := riffInfoKeys[id]; ok {
+			addTag(tags, key, value)
+		}
+
+		size += size % 2
+		if size > len(b)-8 {
+			break
+		}
+		b = b[8+size:]
+	}
+	return tags
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+type (
+	// TranscodeProfile is a format that media can be transcoded to.
+	TranscodeProfile struct {
+		// Name identifies the profile, e.g. in URLs and flags.
+		Name string
+		// MIMEType is the MIME-type of the transcoded media, e.g. "audio/mpeg".
+		MIMEType string
+		// DLNAProfile is the DLNA.ORG_PN of the transcoded media, if any, e.g. "MP3".
+		DLNAProfile string
+		// Extension is the file extension of the transcoded media, e.g. ".mp3".
+		Extension string
+		// Args are the ffmpeg output arguments that produce the format.
+		Args []string
+	}
+
+	// Encoder transcodes media at a path, writing the result to w.
+	Encoder interface {
+		Encode(ctx context.Context, src string, profile TranscodeProfile, w io.Writer) error
+	}
+
+	// FFmpegEncoder is an Encoder that runs ffmpeg.
+	FFmpegEncoder struct{}
+
+	// Transcoder transcodes media with an Encoder, and caches the results on disk.
+	Transcoder struct {
+		encoder  Encoder
+		profiles []TranscodeProfile
+		cache    *fileCache
+	}
+)
+
+var (
+	TranscodeProfileMP3 = TranscodeProfile{
+		Name:        "mp3",
+		MIMEType:    "audio/mpeg",
+		DLNAProfile: "MP3",
+		Extension:   ".mp3",
+		Args:        []string{"-codec:a", "libmp3lame", "-b:a", "320k", "-f", "mp3"},
+	}
+	TranscodeProfileLPCM = TranscodeProfile{
+		Name:        "lpcm",
+		MIMEType:    "audio/L16;rate=44100;channels=2",
+		DLNAProfile: "LPCM",
+		Extension:   ".pcm",
+		Args:        []string{"-codec:a", "pcm_s16be", "-ar", "44100", "-ac", "2", "-f", "s16be"},
+	}
+
+	// TranscodeProfiles are the profiles that can be chosen by name.
+	TranscodeProfiles = []TranscodeProfile{
+		TranscodeProfileMP3,
+		TranscodeProfileLPCM,
+	}
+)
+
+// TranscodeProfileByName returns the profile from TranscodeProfiles with a given name.
+func TranscodeProfileByName(name string) (TranscodeProfile, bool) {
+	for _, profile := range TranscodeProfiles {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return TranscodeProfile{}, false
+}
+
+func (_ FFmpegEncoder) Encode(ctx context.Context, src string, profile TranscodeProfile, w io.Writer) error {
+	args := []string{"-hide_banner", "-loglevel", "error", "-i", src, "-vn"}
+	args = append(args, profile.Args...)
+	args = append(args, "pipe:1")
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	cmd.Stdout = w
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("could not run ffmpeg: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// NewTranscoder returns a Transcoder for profiles that caches transcodes in cacheDir.
+// When the cache grows beyond maxBytes, the least-recently used transcodes are removed; 0 means no limit.
+func NewTranscoder(encoder Encoder, profiles []TranscodeProfile, cacheDir string, maxBytes int64) (*Transcoder, error) {
+	cache, err := newFileCache(cacheDir, maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &Transcoder{
+		encoder:  encoder,
+		profiles: profiles,
+		cache:    cache,
+	}, nil
+}
+
+// Profiles returns the profiles that the Transcoder offers.
+func (t *Transcoder) Profiles() []TranscodeProfile {
+	return t.profiles
+}
+
+// Profile returns the Transcoder's profile with a given name.
+func (t *Transcoder) Profile(name string) (TranscodeProfile, bool) {
+	for _, profile := range t.profiles {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return TranscodeProfile{}, false
+}
+
+// Transcode transcodes src to profile, and returns the path of the result in the cache.
+// Concurrent calls for the same file and profile share a single transcode.
+func (t *Transcoder) Transcode(ctx context.Context, src string, profile TranscodeProfile) (string, error) {
+	name, err := t.cacheName(src, profile)
+	if err != nil {
+		return "", err
+	}
+
+	return t.cache.get(ctx, name, func(w io.Writer) error {
+		return t.encode(context.Background(), src, profile, w)
+	})
+}
+
+// Cached returns the path of the transcode of src to profile in the cache, and false if it is not cached yet.
+func (t *Transcoder) Cached(src string, profile TranscodeProfile) (string, bool) {
+	name, err := t.cacheName(src, profile)
+	if err != nil {
+		return "", false
+	}
+	return t.cache.peek(name)
+}
+
+// Stream writes src transcoded to profile to w as it is transcoded, rather than waiting for the whole transcode.
+// The transcode is also cached, and finishes in the background if w fails or ctx is done, e.g. because the client went away.
+func (t *Transcoder) Stream(ctx context.Context, src string, profile TranscodeProfile, w io.Writer) error {
+	name, err := t.cacheName(src, profile)
+	if err != nil {
+		return err
+	}
+
+	return t.cache.stream(ctx, name, w, func(ctx context.Context, w io.Writer) error {
+		return t.encode(ctx, src, profile, w)
+	})
+}
+
+func (t *Transcoder) cacheName(src string, profile TranscodeProfile) (string, error) {
+	fi, err := os.Stat(src)
+	if err != nil {
+		return "", err
+	}
+	return cacheKey(src, fi, profile.Name, strings.Join(profile.Args, " ")) + profile.Extension, nil
+}
+
+func (t *Transcoder) encode(ctx context.Context, src string, profile TranscodeProfile, w io.Writer) error {
+	if err := t.encoder.Encode(ctx, src, profile, w); err != nil {
+		return fmt.Errorf("could not transcode %q to %v: %w", src, profile.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTranscoder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "transcode")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "track.flac")
+	if err := ioutil.WriteFile(src, []byte("flac"), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+
+	encoder := &fakeEncoder{}
+	transcoder, err := NewTranscoder(encoder, TranscodeProfiles, filepath.Join(dir, "cache"), 0)
+	if err != nil {
+		t.Fatalf("NewTranscoder(...) returned error: %v", err)
+	}
+	ctx := context.Background()
+
+	got, err := transcoder.Transcode(ctx, src, TranscodeProfileMP3)
+	if err != nil {
+		t.Fatalf("Transcode(_, %q, mp3) returned error: %v", src, err)
+	}
+	assertFileContent(t, got, "mp3 of flac")
+	if filepath.Ext(got) != ".mp3" {
+		t.Errorf("Transcode(_, %q, mp3) == %q, want a .mp3 file", src, got)
+	}
+
+	// Cached.
+	if again, err := transcoder.Transcode(ctx, src, TranscodeProfileMP3); err != nil || again != got {
+		t.Errorf("second Transcode(_, %q, mp3) == %q, %v, want %q, nil", src, again, err, got)
+	}
+	if encoder.count() != 1 {
+		t.Errorf("after 2 transcodes of the same file, encoder was called %d times, want 1", encoder.count())
+	}
+
+	// A different profile is a different transcode.
+	lpcm, err := transcoder.Transcode(ctx, src, TranscodeProfileLPCM)
+	if err != nil {
+		t.Fatalf("Transcode(_, %q, lpcm) returned error: %v", src, err)
+	}
+	assertFileContent(t, lpcm, "lpcm of flac")
+
+	// Changing the file invalidates the cache.
+	if err := ioutil.WriteFile(src, []byte("new flac"), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(src, later, later); err != nil {
+		t.Fatalf("could not change mtime: %v", err)
+	}
+	changed, err := transcoder.Transcode(ctx, src, TranscodeProfileMP3)
+	if err != nil {
+		t.Fatalf("Transcode(_, %q, mp3) returned error: %v", src, err)
+	}
+	assertFileContent(t, changed, "mp3 of new flac")
+
+	if _, err := transcoder.Transcode(ctx, filepath.Join(dir, "missing.flac"), TranscodeProfileMP3); err == nil {
+		t.Errorf("Transcode(_, missing.flac, mp3) returned nil error")
+	}
+}
+
+func TestTranscoderConcurrent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "transcode")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "track.flac")
+	if err := ioutil.WriteFile(src, []byte("flac"), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+
+	encoder := &fakeEncoder{delay: 50 * time.Millisecond}
+	transcoder, err := NewTranscoder(encoder, TranscodeProfiles, filepath.Join(dir, "cache"), 0)
+	if err != nil {
+		t.Fatalf("NewTranscoder(...) returned error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := transcoder.Transcode(context.Background(), src, TranscodeProfileMP3); err != nil {
+				t.Errorf("Transcode(...) returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if encoder.count() != 1 {
+		t.Errorf("after 5 concurrent transcodes of the same file, encoder was called %d times, want 1", encoder.count())
+	}
+}
+
+func TestTranscoderErrorsAndEviction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "transcode")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var srcs []string
+	for i := 0; i < 3; i++ {
+		src := filepath.Join(dir, fmt.Sprintf("%d.flac", i))
+		if err := ioutil.WriteFile(src, []byte("0123456789"), 0644); err != nil {
+			t.Fatalf("could not write file: %v", err)
+		}
+		srcs = append(srcs, src)
+	}
+
+	cacheDir := filepath.Join(dir, "cache")
+	// Each transcode is 17 bytes, e.g. "mp3 of 0123456789", so only 2 fit.
+	transcoder, err := NewTranscoder(&fakeEncoder{}, TranscodeProfiles, cacheDir, 40)
+	if err != nil {
+		t.Fatalf("NewTranscoder(...) returned error: %v", err)
+	}
+
+	var paths []string
+	for i, src := range srcs {
+		p, err := transcoder.Transcode(context.Background(), src, TranscodeProfileMP3)
+		if err != nil {
+			t.Fatalf("Transcode(_, %q, mp3) returned error: %v", src, err)
+		}
+		paths = append(paths, p)
+
+		// Ensure distinct mtimes for LRU ordering.
+		past := time.Now().Add(time.Duration(i-10) * time.Minute)
+		_ = os.Chtimes(p, past, past)
+	}
+
+	if _, err := os.Stat(paths[0]); !os.IsNotExist(err) {
+		t.Errorf("oldest transcode %q was not evicted", paths[0])
+	}
+	for _, p := range paths[1:] {
+		if _, err := os.Stat(p); err != nil {
+			t.Errorf("transcode %q was evicted: %v", p, err)
+		}
+	}
+
+	failing, err := NewTranscoder(&fakeEncoder{err: errors.New("codec not found")}, TranscodeProfiles, cacheDir, 0)
+	if err != nil {
+		t.Fatalf("NewTranscoder(...) returned error: %v", err)
+	}
+	if _, err := failing.Transcode(context.Background(), srcs[0], TranscodeProfileLPCM); err == nil {
+		t.Errorf("Transcode(...) with a failing encoder returned nil error")
+	}
+	fis, err := ioutil.ReadDir(cacheDir)
+	if err != nil {
+		t.Fatalf("could not read cache directory: %v", err)
+	}
+	if len(fis) != 2 {
+		t.Errorf("after a failed transcode, cache has %d files, want 2", len(fis))
+	}
+}
+
+func TestTranscoderStream(t *testing.T) {
+	dir, err := ioutil.TempDir("", "transcode")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "track.flac")
+	if err := ioutil.WriteFile(src, []byte("flac"), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+
+	encoder := &fakeEncoder{}
+	transcoder, err := NewTranscoder(encoder, TranscodeProfiles, filepath.Join(dir, "cache"), 0)
+	if err != nil {
+		t.Fatalf("NewTranscoder(...) returned error: %v", err)
+	}
+	ctx := context.Background()
+
+	if got, ok := transcoder.Cached(src, TranscodeProfileMP3); ok {
+		t.Errorf("Cached(%q, mp3) == %q, true before transcoding, want false", src, got)
+	}
+
+	// A client that goes away still leaves the transcode in the cache.
+	if err := transcoder.Stream(ctx, src, TranscodeProfileMP3, failingWriter{}); err == nil {
+		t.Errorf("Stream(_, %q, mp3, failingWriter) returned nil error", src)
+	}
+	// The transcode finishes in the background.
+	var got string
+	var ok bool
+	for i := 0; i < 100 && !ok; i++ {
+		got, ok = transcoder.Cached(src, TranscodeProfileMP3)
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !ok {
+		t.Fatalf("Cached(%q, mp3) == false after streaming, want true", src)
+	}
+	assertFileContent(t, got, "mp3 of flac")
+
+	var buf bytes.Buffer
+	if err := transcoder.Stream(ctx, src, TranscodeProfileLPCM, &buf); err != nil {
+		t.Fatalf("Stream(_, %q, lpcm) returned error: %v", src, err)
+	}
+	if buf.String() != "lpcm of flac" {
+		t.Errorf("Stream(_, %q, lpcm) wrote %q, want %q", src, buf.String(), "lpcm of flac")
+	}
+	if cached, ok := transcoder.Cached(src, TranscodeProfileLPCM); !ok {
+		t.Errorf("Cached(%q, lpcm) == false after streaming, want true", src)
+	} else {
+		assertFileContent(t, cached, "lpcm of flac")
+	}
+	if encoder.count() != 2 {
+		t.Errorf("after streaming 2 profiles, encoder was called %d times, want 2", encoder.count())
+	}
+}
+
+func TestTranscodeProfileByName(t *testing.T) {
+	if profile, ok := TranscodeProfileByName("mp3"); !ok || profile.MIMEType != "audio/mpeg" {
+		t.Errorf("TranscodeProfileByName(mp3) == %+v, %v, want the MP3 profile", profile, ok)
+	}
+	if _, ok := TranscodeProfileByName("wma"); ok {
+		t.Errorf("TranscodeProfileByName(wma) returned a profile")
+	}
+}
+
+// fakeEncoder "transcodes" by writing the profile name and the original content.
+type fakeEncoder struct {
+	delay time.Duration
+	err   error
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (e *fakeEncoder) Encode(_ context.Context, src string, profile TranscodeProfile, w io.Writer) error {
+	e.mu.Lock()
+	e.calls++
+	e.mu.Unlock()
+
+	time.Sleep(e.delay)
+	if e.err != nil {
+		return e.err
+	}
+
+	content, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s of %s", profile.Name, content)
+	return err
+}
+func (e *fakeEncoder) count() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.calls
+}
+
+// failingWriter is a client that has gone away.
+type failingWriter struct{}
+
+func (_ failingWriter) Write(_ []byte) (int, error) {
+	return 0, errors.New("broken pipe")
+}
+
+func assertFileContent(t *testing.T, p, want string) {
+	t.Helper()
+	got, err := ioutil.ReadFile(p)
+	if err != nil {
+		t.Fatalf("could not read %q: %v", p, err)
+	}
+	if string(got) != want {
+		t.Errorf("%q contains %q, want %q", p, got, want)
+	}
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+)
+
+type (
+	// WarmStatus is the progress of the most recent MetadataCache.Warm.
+	WarmStatus struct {
+		// Running is whether Warm is in progress.
+		Running bool
+		// Walked is whether all the files under the base path have been seen.
+		Walked bool
+
+		Started  time.Time
+		Finished time.Time
+
+		// Seen is the number of media files found so far.
+		Seen int
+		// Cached is the number of files whose metadata was already cached, Probed the number that had to be probed,
+		// and Failed the number that could not be probed.
+		Cached int
+		Probed int
+		Failed int
+	}
+
+	// warmer runs Warm for a MetadataCache, with a bounded number of workers.
+	warmer struct {
+		parallelism int
+
+		mu     sync.Mutex
+		status WarmStatus
+	}
+
+	// refreshFunc makes sure that the metadata for a path is cached,
+	// and reports whether it had to be probed.
+	refreshFunc func(p string) (probed bool, err error)
+)
+
+var (
+	ErrAlreadyWarming = errors.New("metadata cache is already warming")
+)
+
+// Processed is the number of files that have been dealt with, successfully or not.
+func (s WarmStatus) Processed() int {
+	return s.Cached + s.Probed + s.Failed
+}
+
+// ETA estimates the time until Warm finishes, based on the rate so far.
+// It returns false if there is no estimate, e.g. because the walk has not finished, so the total is not yet known.
+func (s WarmStatus) ETA(now time.Time) (time.Duration, bool) {
+	if !s.Walked {
+		return 0, false
+	}
+	if !s.Running {
+		return 0, true
+	}
+	processed := s.Processed()
+	if processed == 0 {
+		return 0, false
+	}
+	elapsed := now.Sub(s.Started)
+	remaining := s.Seen - processed
+	return time.Duration(float64(elapsed) / float64(processed) * float64(remaining)), true
+}
+
+// newWarmer returns a warmer with parallelism workers, or one per CPU if parallelism is not positive.
+func newWarmer(parallelism int) *warmer {
+	if parallelism <= 0 {
+		parallelism = runtime.NumCPU()
+	}
+	return &warmer{parallelism: parallelism}
+}
+
+func (w *warmer) Status() WarmStatus {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.status
+}
+
+// warm calls refresh for every audio or video file under basePath, until done or ctx is cancelled.
+func (w *warmer) warm(ctx context.Context, basePath string, refresh refreshFunc) error {
+	w.mu.Lock()
+	if w.status.Running {
+		w.mu.Unlock()
+		return ErrAlreadyWarming
+	}
+	w.status = WarmStatus{Running: true, Started: time.Now()}
+	w.mu.Unlock()
+
+	paths := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < w.parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range paths {
+				probed, err := refresh(p)
+
+				w.mu.Lock()
+				switch {
+				case err != nil:
+					w.status.Failed++
+				case probed:
+					w.status.Probed++
+				default:
+					w.status.Cached++
+				}
+				w.mu.Unlock()
+			}
+		}()
+	}
+
+	err := filepath.Walk(basePath, func(p string, fi os.FileInfo, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err != nil || fi.IsDir() || !IsAudioOrVideo(fi.Name()) {
+			return nil
+		}
+
+		w.mu.Lock()
+		w.status.Seen++
+		w.mu.Unlock()
+
+		select {
+		case paths <- p:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	close(paths)
+
+	w.mu.Lock()
+	w.status.Walked = err == nil
+	w.mu.Unlock()
+
+	wg.Wait()
+
+	w.mu.Lock()
+	w.status.Running = false
+	w.status.Finished = time.Now()
+	w.mu.Unlock()
+
+	return err
+}
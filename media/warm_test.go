@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWarmer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "warm")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for i := 0; i < 20; i++ {
+		if err := ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("%02d.mp3", i)), nil, 0644); err != nil {
+			t.Fatalf("could not write file: %v", err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "cover.jpg"), nil, 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+
+	w := newWarmer(3)
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	refresh := func(p string) (bool, error) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+
+		switch filepath.Base(p) {
+		case "00.mp3", "01.mp3":
+			return false, nil
+		case "02.mp3":
+			return true, errors.New("corrupt file")
+		default:
+			return true, nil
+		}
+	}
+
+	if err := w.warm(context.Background(), dir, refresh); err != nil {
+		t.Fatalf("warm(...) returned error: %v", err)
+	}
+	if maxRunning > 3 {
+		t.Errorf("%d files were refreshed at once, want at most 3", maxRunning)
+	}
+
+	status := w.Status()
+	if status.Running || !status.Walked || status.Seen != 20 || status.Cached != 2 || status.Probed != 17 || status.Failed != 1 {
+		t.Errorf("after warm, status == %+v, want 20 seen, 2 cached, 17 probed, 1 failed", status)
+	}
+	if eta, ok := status.ETA(time.Now()); !ok || eta != 0 {
+		t.Errorf("after warm, ETA(_) == %v, %v, want 0, true", eta, ok)
+	}
+}
+
+func TestWarmerCancel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "warm")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for i := 0; i < 20; i++ {
+		if err := ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("%02d.mp3", i)), nil, 0644); err != nil {
+			t.Fatalf("could not write file: %v", err)
+		}
+	}
+
+	w := newWarmer(1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	started := make(chan struct{})
+	var once sync.Once
+	refresh := func(p string) (bool, error) {
+		once.Do(func() { close(started) })
+		time.Sleep(5 * time.Millisecond)
+		return true, nil
+	}
+
+	done := make(chan error)
+	go func() { done <- w.warm(ctx, dir, refresh) }()
+
+	<-started
+	if status := w.Status(); !status.Running {
+		t.Errorf("during warm, status == %+v, want running", status)
+	}
+	if err := w.warm(ctx, dir, refresh); err != ErrAlreadyWarming {
+		t.Errorf("concurrent warm(...) returned %v, want %v", err, ErrAlreadyWarming)
+	}
+	cancel()
+
+	if err := <-done; err != context.Canceled {
+		t.Errorf("cancelled warm(...) returned %v, want %v", err, context.Canceled)
+	}
+	status := w.Status()
+	if status.Running || status.Walked || status.Processed() >= 20 {
+		t.Errorf("after cancelled warm, status == %+v, want stopped, not walked, and fewer than 20 processed", status)
+	}
+}
+
+func TestWarmStatusETA(t *testing.T) {
+	started := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		status WarmStatus
+		now    time.Time
+		want   time.Duration
+		wantOK bool
+	}{
+		{
+			status: WarmStatus{Running: true, Walked: true, Started: started, Seen: 10},
+			now:    started.Add(time.Minute),
+			wantOK: false,
+		},
+		{
+			status: WarmStatus{Running: true, Started: started, Seen: 100, Probed: 20, Cached: 20},
+			now:    started.Add(time.Minute),
+			wantOK: false,
+		},
+		{
+			status: WarmStatus{Running: true, Walked: true, Started: started, Seen: 100, Probed: 20, Cached: 20},
+			now:    started.Add(time.Minute),
+			want:   90 * time.Second,
+			wantOK: true,
+		},
+		{
+			status: WarmStatus{Walked: true, Started: started, Seen: 100, Probed: 90, Cached: 10},
+			want:   0,
+			wantOK: true,
+		},
+		{
+			status: WarmStatus{},
+			wantOK: false,
+		},
+	}
+
+	for i, tt := range tests {
+		got, ok := tt.status.ETA(tt.now)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("[%d]: got %v, %v, want %v, %v", i, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+type (
+	// Watcher reports changes to the files and directories under a path.
+	// It uses inotify where it can, and polls otherwise.
+	Watcher struct {
+		basePath string
+
+		// settle is how long to wait for more changes before reporting a batch.
+		settle time.Duration
+
+		raw     chan string
+		changes chan []string
+
+		done      chan struct{}
+		closeOnce sync.Once
+		stop      func() error
+	}
+
+	// fileState is what polling compares to notice changes.
+	fileState struct {
+		mtime time.Time
+		size  int64
+		isDir bool
+	}
+)
+
+var (
+	errWatchUnsupported = errors.New("watching is not supported on this platform")
+)
+
+// NewWatcher starts watching the tree under basePath.
+// If inotify is unavailable, e.g. because there are too many directories, it polls every pollInterval instead.
+func NewWatcher(basePath string, pollInterval time.Duration) (*Watcher, error) {
+	if _, err := os.Stat(basePath); err != nil {
+		return nil, err
+	}
+
+	w := newWatcher(basePath, time.Second)
+	stop, err := watchInotify(basePath, w.raw, w.done)
+	if err != nil {
+		stop = w.poll(pollInterval)
+	}
+	w.stop = stop
+	return w, nil
+}
+
+// newPollingWatcher returns a Watcher that only polls, for tests.
+func newPollingWatcher(basePath string, pollInterval, settle time.Duration) *Watcher {
+	w := newWatcher(basePath, settle)
+	w.stop = w.poll(pollInterval)
+	return w
+}
+
+func newWatcher(basePath string, settle time.Duration) *Watcher {
+	w := &Watcher{
+		basePath: basePath,
+		settle:   settle,
+		raw:      make(chan string, 64),
+		changes:  make(chan []string),
+		done:     make(chan struct{}),
+	}
+	go w.batch()
+	return w
+}
+
+// Changes returns batches of changed paths, sorted and without duplicates.
+// A path is reported if it was created, modified, moved, or removed.
+// The base path itself is reported if changes may have been missed.
+func (w *Watcher) Changes() <-chan []string {
+	return w.changes
+}
+
+// Close stops watching, and closes the Changes channel.
+func (w *Watcher) Close() error {
+	var err error
+	w.closeOnce.Do(func() {
+		close(w.done)
+		err = w.stop()
+	})
+	return err
+}
+
+// batch collects changed paths until nothing has changed for w.settle, so that e.g. copying an album is one batch.
+func (w *Watcher) batch() {
+	defer close(w.changes)
+
+	pending := map[string]bool{}
+	var settled <-chan time.Time
+	for {
+		select {
+		case <-w.done:
+			return
+		case p := <-w.raw:
+			pending[p] = true
+			settled = time.After(w.settle)
+		case <-settled:
+			var paths []string
+			for p := range pending {
+				paths = append(paths, p)
+			}
+			sort.Strings(paths)
+			pending = map[string]bool{}
+			settled = nil
+
+			select {
+			case w.changes <- paths:
+			case <-w.done:
+				return
+			}
+		}
+	}
+}
+
+// poll compares snapshots of the tree every interval, and returns a function to stop it.
+func (w *Watcher) poll(interval time.Duration) func() error {
+	prev := snapshot(w.basePath)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.done:
+				return
+			case <-ticker.C:
+			}
+
+			curr := snapshot(w.basePath)
+			for _, p := range diffSnapshots(prev, curr) {
+				select {
+				case w.raw <- p:
+				case <-w.done:
+					return
+				}
+			}
+			prev = curr
+		}
+	}()
+	return func() error { return nil }
+}
+
+func snapshot(basePath string) map[string]fileState {
+	states := map[string]fileState{}
+	_ = filepath.Walk(basePath, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		states[p] = fileState{
+			mtime: fi.ModTime(),
+			size:  fi.Size(),
+			isDir: fi.IsDir(),
+		}
+		return nil
+	})
+	return states
+}
+
+// diffSnapshots returns the paths that were added, changed, or removed between two snapshots.
+// Directories are not reported just because their mtime changed, as their children are reported instead.
+func diffSnapshots(prev, curr map[string]fileState) []string {
+	var paths []string
+	for p, state := range curr {
+		old, ok := prev[p]
+		switch {
+		case !ok:
+			paths = append(paths, p)
+		case old.isDir != state.isDir:
+			paths = append(paths, p)
+		case !state.isDir && (!old.mtime.Equal(state.mtime) || old.size != state.size):
+			paths = append(paths, p)
+		}
+	}
+	for p := range prev {
+		if _, ok := curr[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
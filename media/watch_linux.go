@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+)
+
+type (
+	// inotifyWatcher is a recursive inotify watch, as inotify only watches a single directory.
+	inotifyWatcher struct {
+		basePath string
+		fd       int
+		file     *os.File
+
+		// watches maps watch descriptors to directories.
+		// It is only used by the reading goroutine after setup.
+		watches map[int]string
+
+		raw  chan<- string
+		done <-chan struct{}
+	}
+)
+
+const inotifyMask = syscall.IN_CREATE | syscall.IN_CLOSE_WRITE | syscall.IN_DELETE | syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO | syscall.IN_DELETE_SELF | syscall.IN_ONLYDIR
+
+// watchInotify sends the paths that change under basePath to raw, until done is closed.
+// It returns a function to stop watching.
+func watchInotify(basePath string, raw chan<- string, done <-chan struct{}) (func() error, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return nil, fmt.Errorf("could not create inotify instance: %w", err)
+	}
+
+	w := &inotifyWatcher{
+		basePath: basePath,
+		fd:       fd,
+		// As the file descriptor is non-blocking, os.File uses the runtime poller, so Close interrupts Read.
+		file:    os.NewFile(uintptr(fd), "inotify"),
+		watches: map[int]string{},
+		raw:     raw,
+		done:    done,
+	}
+
+	if err := w.addWatches(basePath, false); err != nil {
+		w.file.Close()
+		return nil, err
+	}
+
+	go w.read()
+	return w.file.Close, nil
+}
+
+// addWatches watches dir and the directories under it.
+// If report is set, it also reports everything under dir, which may have been created before the watches were.
+func (w *inotifyWatcher) addWatches(dir string, report bool) error {
+	return filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if report && p != dir {
+			if !w.send(p) {
+				return filepath.SkipDir
+			}
+		}
+		if !fi.IsDir() {
+			return nil
+		}
+
+		wd, err := syscall.InotifyAddWatch(w.fd, p, inotifyMask)
+		if err != nil {
+			return fmt.Errorf("could not watch %q: %w", p, err)
+		}
+		w.watches[wd] = p
+		return nil
+	})
+}
+
+// removeWatches stops watching dir and the directories under it, e.g. when they have been moved away.
+func (w *inotifyWatcher) removeWatches(dir string) {
+	for wd, p := range w.watches {
+		if isUnder(p, dir) {
+			_, _ = syscall.InotifyRmWatch(w.fd, uint32(wd))
+			delete(w.watches, wd)
+		}
+	}
+}
+
+func (w *inotifyWatcher) read() {
+	buf := make([]byte, 64*1024)
+	for {
+		n, err := w.file.Read(buf)
+		if err != nil {
+			return
+		}
+
+		for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+			event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameStart := offset + syscall.SizeofInotifyEvent
+			nameEnd := nameStart + int(event.Len)
+			if nameEnd > n {
+				break
+			}
+			name := string(bytes.TrimRight(buf[nameStart:nameEnd], "\x00"))
+			offset = nameEnd
+
+			if !w.handle(int(event.Wd), event.Mask, name) {
+				return
+			}
+		}
+	}
+}
+
+// handle reports the path of an event, and keeps the watches up to date.
+// It returns false if the watcher has been closed.
+func (w *inotifyWatcher) handle(wd int, mask uint32, name string) bool {
+	if mask&syscall.IN_Q_OVERFLOW != 0 {
+		// Events were dropped, so anything could have changed.
+		return w.send(w.basePath)
+	}
+
+	dir, ok := w.watches[wd]
+	if !ok {
+		return true
+	}
+	if mask&syscall.IN_IGNORED != 0 {
+		delete(w.watches, wd)
+		return true
+	}
+
+	p := dir
+	if name != "" {
+		p = filepath.Join(dir, name)
+	}
+	if !w.send(p) {
+		return false
+	}
+
+	if mask&syscall.IN_ISDIR == 0 {
+		return true
+	}
+	switch {
+	case mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0:
+		if err := w.addWatches(p, true); err != nil {
+			// Changes under p will be missed.
+			return w.send(w.basePath)
+		}
+	case mask&syscall.IN_MOVED_FROM != 0:
+		w.removeWatches(p)
+	}
+	return true
+}
+
+func (w *inotifyWatcher) send(p string) bool {
+	select {
+	case w.raw <- p:
+		return true
+	case <-w.done:
+		return false
+	}
+}
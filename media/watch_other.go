@@ -0,0 +1,13 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+//go:build !linux
+// +build !linux
+
+package media
+
+// watchInotify is only supported on Linux, so other platforms always poll.
+func watchInotify(_ string, _ chan<- string, _ <-chan struct{}) (func() error, error) {
+	return nil, errWatchUnsupported
+}
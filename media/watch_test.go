@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package media
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	t0 := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Minute)
+
+	tests := []struct {
+		prev, curr map[string]fileState
+		want       []string
+	}{
+		{
+			prev: map[string]fileState{"/a": {mtime: t0, isDir: true}, "/a/b.mp3": {mtime: t0, size: 1}},
+			curr: map[string]fileState{"/a": {mtime: t0, isDir: true}, "/a/b.mp3": {mtime: t0, size: 1}},
+			want: nil,
+		},
+		{
+			prev: map[string]fileState{"/a": {mtime: t0, isDir: true}},
+			curr: map[string]fileState{"/a": {mtime: t1, isDir: true}, "/a/b.mp3": {mtime: t1, size: 1}},
+			want: []string{"/a/b.mp3"},
+		},
+		{
+			prev: map[string]fileState{"/a/b.mp3": {mtime: t0, size: 1}, "/a/c.mp3": {mtime: t0, size: 1}},
+			curr: map[string]fileState{"/a/b.mp3": {mtime: t1, size: 1}, "/a/d.mp3": {mtime: t0, size: 1}},
+			want: []string{"/a/b.mp3", "/a/c.mp3", "/a/d.mp3"},
+		},
+		{
+			prev: map[string]fileState{"/a/b": {mtime: t0, size: 1}},
+			curr: map[string]fileState{"/a/b": {mtime: t0, isDir: true}},
+			want: []string{"/a/b"},
+		},
+	}
+
+	for i, tt := range tests {
+		got := diffSnapshots(tt.prev, tt.curr)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: got %v, want %v", i, got, tt.want)
+		}
+	}
+}
+
+func TestPollingWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := newPollingWatcher(dir, 10*time.Millisecond, 50*time.Millisecond)
+	defer w.Close()
+
+	testWatcher(t, w, dir)
+}
+
+func TestWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := NewWatcher(dir, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewWatcher(%q, _) returned error: %v", dir, err)
+	}
+	defer w.Close()
+
+	testWatcher(t, w, dir)
+}
+
+func testWatcher(t *testing.T, w *Watcher, dir string) {
+	t.Helper()
+
+	album := filepath.Join(dir, "Album")
+	track := filepath.Join(album, "01 Track.mp3")
+	if err := os.Mkdir(album, 0755); err != nil {
+		t.Fatalf("could not create directory: %v", err)
+	}
+	if err := ioutil.WriteFile(track, []byte("track"), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+	assertChanged(t, w, album, track)
+
+	if err := os.Remove(track); err != nil {
+		t.Fatalf("could not remove file: %v", err)
+	}
+	assertChanged(t, w, track)
+}
+
+// assertChanged waits for the Watcher to report all of the given paths, possibly across several batches.
+func assertChanged(t *testing.T, w *Watcher, paths ...string) {
+	t.Helper()
+
+	missing := map[string]bool{}
+	for _, p := range paths {
+		missing[p] = true
+	}
+
+	timeout := time.After(5 * time.Second)
+	for len(missing) > 0 {
+		select {
+		case batch, ok := <-w.Changes():
+			if !ok {
+				t.Fatalf("Changes() was closed")
+			}
+			for _, p := range batch {
+				delete(missing, p)
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for changes to %v", missing)
+		}
+	}
+}
+
+func TestWatcherClose(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := NewWatcher(dir, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewWatcher(%q, _) returned error: %v", dir, err)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("Close() returned error: %v", err)
+	}
+
+	select {
+	case _, ok := <-w.Changes():
+		if ok {
+			t.Errorf("Changes() returned a batch after Close()")
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Changes() was not closed after Close()")
+	}
+
+	if _, err := NewWatcher(filepath.Join(dir, "missing"), time.Minute); err == nil {
+		t.Errorf("NewWatcher(missing, _) returned nil error")
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ethulhu/helix/logger"
 	"github.com/ethulhu/helix/soap"
+	"github.com/ethulhu/helix/upnp/gena"
 	"github.com/ethulhu/helix/upnp/scpd"
 	"github.com/ethulhu/helix/upnp/ssdp"
 )
@@ -23,6 +24,10 @@ type (
 		SCPD          scpd.Document
 		SOAPInterface soap.Interface
 		ID            ServiceID
+
+		// EventURL is set for remote devices, Publisher for local devices.
+		EventURL  *url.URL
+		Publisher *gena.Publisher
 	}
 
 	// Device is an UPnP device.
@@ -84,8 +89,11 @@ func newDevice(manifestURL *url.URL, manifest ssdp.Document) (*Device, error) {
 		// TODO: get the actual SCPD.
 		serviceURL := *manifestURL
 		serviceURL.Path = s.ControlURL
+		eventURL := *manifestURL
+		eventURL.Path = s.EventSubURL
 		d.serviceByURN[URN(s.ServiceType)] = service{
 			SOAPInterface: soap.NewClient(&serviceURL),
+			EventURL:      &eventURL,
 		}
 	}
 
@@ -132,7 +140,38 @@ func (d *Device) SOAPInterface(urn URN) (soap.Interface, bool) {
 	return service.SOAPInterface, true
 }
 
-// ServeHTTP serves the SSDP/SCPD UPnP discovery interface, and marshals SOAP requests.
+// EventURL returns the GENA event subscription URL for the given URN, and whether or not the service is evented.
+// A nil Device always returns (nil, false).
+func (d *Device) EventURL(urn URN) (*url.URL, bool) {
+	if d == nil {
+		return nil, false
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	service, ok := d.serviceByURN[urn]
+	if !ok || service.EventURL == nil || service.EventURL.Path == "" {
+		return nil, false
+	}
+	u := *service.EventURL
+	return &u, true
+}
+
+// Notify sets evented state variables of a local service, and sends them to its GENA subscribers.
+func (d *Device) Notify(urn URN, variables map[string]string) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	service, ok := d.serviceByURN[urn]
+	if !ok || service.Publisher == nil {
+		return fmt.Errorf("no local service for URN %v", urn)
+	}
+	service.Publisher.Notify(variables)
+	return nil
+}
+
+// ServeHTTP serves the SSDP/SCPD UPnP discovery interface, marshals SOAP requests, and handles GENA subscriptions.
 func (d *Device) HTTPHandler(basePath string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		log, ctx := logger.FromContext(r.Context())
@@ -172,6 +211,12 @@ func (d *Device) HTTPHandler(basePath string) http.Handler {
 			case "POST":
 				soap.Handle(w, r, service.SOAPInterface)
 				return
+
+			case "SUBSCRIBE", "UNSUBSCRIBE":
+				if service.Publisher != nil {
+					service.Publisher.ServeHTTP(w, r)
+					return
+				}
 			}
 		}
 
@@ -231,5 +276,6 @@ func (d *Device) Handle(urn URN, id ServiceID, doc scpd.Document, handler soap.I
 		ID:            id,
 		SOAPInterface: handler,
 		SCPD:          doc,
+		Publisher:     gena.NewPublisher(doc.EventedVariables()...),
 	}
 }
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+// Package gena implements GENA, the UPnP eventing protocol.
+//
+// A Publisher serves SUBSCRIBE & UNSUBSCRIBE requests for a service and sends NOTIFY requests to its subscribers.
+// A Subscriber subscribes to remote services and receives their NOTIFY requests on a callback HTTP handler.
+package gena
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	subscribeMethod   = "SUBSCRIBE"
+	unsubscribeMethod = "UNSUBSCRIBE"
+	notifyMethod      = "NOTIFY"
+
+	ntEvent       = "upnp:event"
+	ntsPropChange = "upnp:propchange"
+
+	// DefaultTimeout is the subscription duration requested by Subscribers, and granted by Publishers when none is requested.
+	DefaultTimeout = 30 * time.Minute
+
+	// MinimumTimeout is the shortest subscription duration a Publisher will grant.
+	MinimumTimeout = 1 * time.Minute
+)
+
+// parseTimeout parses a TIMEOUT header, of the form "Second-1800" or "Second-infinite".
+// Infinite timeouts are returned as 0.
+func parseTimeout(raw string) (time.Duration, error) {
+	if !strings.HasPrefix(strings.ToLower(raw), "second-") {
+		return 0, fmt.Errorf("TIMEOUT must be of form Second-N, got %q", raw)
+	}
+	seconds := raw[len("second-"):]
+	if strings.ToLower(seconds) == "infinite" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(seconds)
+	if err != nil || i <= 0 {
+		return 0, fmt.Errorf("TIMEOUT must have a positive number of seconds, got %q", raw)
+	}
+	return time.Duration(i) * time.Second, nil
+}
+func formatTimeout(d time.Duration) string {
+	return fmt.Sprintf("Second-%d", int(d.Seconds()))
+}
+
+// parseCallbacks parses a CALLBACK header, of the form "<http://a/b><http://c/d>".
+func parseCallbacks(raw string) ([]string, error) {
+	var urls []string
+	for raw = strings.TrimSpace(raw); raw != ""; raw = strings.TrimSpace(raw) {
+		if raw[0] != '<' {
+			return nil, fmt.Errorf("CALLBACK URLs must be wrapped in <>, got %q", raw)
+		}
+		end := strings.IndexByte(raw, '>')
+		if end == -1 {
+			return nil, fmt.Errorf("CALLBACK URL has no closing >: %q", raw)
+		}
+		if u := raw[1:end]; strings.HasPrefix(u, "http://") {
+			urls = append(urls, u)
+		}
+		raw = raw[end+1:]
+	}
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("CALLBACK has no HTTP URLs")
+	}
+	return urls, nil
+}
+
+func newSID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("uuid:%x-%x-%x-%x-%x", bytes[0:4], bytes[4:6], bytes[6:8], bytes[8:10], bytes[10:])
+}
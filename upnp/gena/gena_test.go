@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package gena
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPublisherAndSubscriber(t *testing.T) {
+	publisher := NewPublisher("Volume", "Mute")
+	publisher.Notify(map[string]string{"Volume": "10"})
+
+	publisherServer := httptest.NewServer(publisher)
+	defer publisherServer.Close()
+
+	subscriberServer := httptest.NewUnstartedServer(nil)
+	subscriber := NewSubscriber("http://" + subscriberServer.Listener.Addr().String() + "/")
+	subscriberServer.Config.Handler = subscriber
+	subscriberServer.Start()
+	defer subscriberServer.Close()
+
+	eventURL, _ := url.Parse(publisherServer.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sub, err := subscriber.Subscribe(ctx, eventURL)
+	if err != nil {
+		t.Fatalf("could not subscribe: %v", err)
+	}
+
+	want := []map[string]string{
+		{"Volume": "10", "Mute": ""},
+		{"Mute": "1"},
+		{"Volume": "20"},
+	}
+
+	publisher.Notify(map[string]string{"Mute": "1", "Unknown": "foo"})
+	publisher.Notify(map[string]string{"Mute": "1", "Volume": "20"})
+
+	for i, wantEvent := range want {
+		select {
+		case got := <-sub.Events():
+			if !reflect.DeepEqual(got, wantEvent) {
+				t.Errorf("[%d]: got %v, want %v", i, got, wantEvent)
+			}
+		case <-ctx.Done():
+			t.Fatalf("[%d]: timed out waiting for event", i)
+		}
+	}
+
+	if err := sub.Unsubscribe(ctx); err != nil {
+		t.Errorf("could not unsubscribe: %v", err)
+	}
+	if _, ok := <-sub.Events(); ok {
+		t.Errorf("events channel still open after Unsubscribe")
+	}
+	if err := sub.Unsubscribe(ctx); err != ErrUnknownSubscription {
+		t.Errorf("second Unsubscribe got %v, want %v", err, ErrUnknownSubscription)
+	}
+}
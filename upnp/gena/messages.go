@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package gena
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"sort"
+)
+
+type (
+	propertySet struct {
+		XMLName    xml.Name   `xml:"urn:schemas-upnp-org:event-1-0 propertyset"`
+		Properties []property `xml:"urn:schemas-upnp-org:event-1-0 property"`
+	}
+	property struct {
+		Variables []variable `xml:",any"`
+	}
+	variable struct {
+		XMLName xml.Name
+		Value   string `xml:",chardata"`
+	}
+)
+
+// serializePropertySet is done by hand because many devices require the "e:" prefix.
+func serializePropertySet(variables map[string]string) []byte {
+	var names []string
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<e:propertyset xmlns:e="urn:schemas-upnp-org:event-1-0">`)
+	for _, name := range names {
+		fmt.Fprintf(&buf, `<e:property><%s>`, name)
+		_ = xml.EscapeText(&buf, []byte(variables[name]))
+		fmt.Fprintf(&buf, `</%s></e:property>`, name)
+	}
+	buf.WriteString(`</e:propertyset>`)
+	return buf.Bytes()
+}
+
+func deserializePropertySet(data []byte) (map[string]string, error) {
+	ps := propertySet{}
+	if err := xml.Unmarshal(data, &ps); err != nil {
+		return nil, fmt.Errorf("could not deserialize XML propertyset: %w", err)
+	}
+
+	variables := map[string]string{}
+	for _, p := range ps.Properties {
+		for _, v := range p.Variables {
+			variables[v.XMLName.Local] = v.Value
+		}
+	}
+	return variables, nil
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package gena
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSerializePropertySet(t *testing.T) {
+	tests := []struct {
+		variables map[string]string
+		want      string
+	}{
+		{
+			variables: map[string]string{
+				"Volume":     "42",
+				"LastChange": `<Event xmlns="urn:schemas-upnp-org:metadata-1-0/AVT/"></Event>`,
+			},
+			want: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<e:propertyset xmlns:e="urn:schemas-upnp-org:event-1-0"><e:property><LastChange>&lt;Event xmlns=&#34;urn:schemas-upnp-org:metadata-1-0/AVT/&#34;&gt;&lt;/Event&gt;</LastChange></e:property><e:property><Volume>42</Volume></e:property></e:propertyset>`,
+		},
+	}
+
+	for i, tt := range tests {
+		got := string(serializePropertySet(tt.variables))
+		if got != tt.want {
+			t.Errorf("[%d]: got:\n\n%s\n\nwant:\n\n%s", i, got, tt.want)
+		}
+	}
+}
+
+func TestDeserializePropertySet(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			raw: `<?xml version="1.0"?>
+<e:propertyset xmlns:e="urn:schemas-upnp-org:event-1-0">
+  <e:property>
+    <LastChange>&lt;Event/&gt;</LastChange>
+  </e:property>
+  <e:property>
+    <Volume>42</Volume>
+  </e:property>
+</e:propertyset>`,
+			want: map[string]string{
+				"LastChange": "<Event/>",
+				"Volume":     "42",
+			},
+		},
+		{
+			raw:  `<e:propertyset xmlns:e="urn:schemas-upnp-org:event-1-0"></e:propertyset>`,
+			want: map[string]string{},
+		},
+		{
+			raw:     `<e:propertyset`,
+			wantErr: true,
+		},
+	}
+
+	for i, tt := range tests {
+		got, err := deserializePropertySet([]byte(tt.raw))
+		if !tt.wantErr && err != nil {
+			t.Errorf("[%d]: got error: %v", i, err)
+		}
+		if tt.wantErr && err == nil {
+			t.Errorf("[%d]: wanted error, got nil", i)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: got %v, want %v", i, got, tt.want)
+		}
+	}
+}
+
+func TestParseTimeout(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{raw: "Second-1800", want: "30m0s"},
+		{raw: "second-60", want: "1m0s"},
+		{raw: "Second-infinite", want: "0s"},
+		{raw: "Second-0", wantErr: true},
+		{raw: "Minute-3", wantErr: true},
+		{raw: "", wantErr: true},
+	}
+
+	for i, tt := range tests {
+		got, err := parseTimeout(tt.raw)
+		if !tt.wantErr && err != nil {
+			t.Errorf("[%d]: got error: %v", i, err)
+		}
+		if tt.wantErr && err == nil {
+			t.Errorf("[%d]: wanted error, got nil", i)
+		}
+		if !tt.wantErr && got.String() != tt.want {
+			t.Errorf("[%d]: got %v, want %v", i, got, tt.want)
+		}
+	}
+}
+
+func TestParseCallbacks(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    []string
+		wantErr bool
+	}{
+		{
+			raw:  "<http://192.168.0.2:8000/events>",
+			want: []string{"http://192.168.0.2:8000/events"},
+		},
+		{
+			raw:  "<ftp://foo/bar> <http://a/b><http://c/d>",
+			want: []string{"http://a/b", "http://c/d"},
+		},
+		{
+			raw:     "http://a/b",
+			wantErr: true,
+		},
+		{
+			raw:     "<http://a/b",
+			wantErr: true,
+		},
+		{
+			raw:     "",
+			wantErr: true,
+		},
+	}
+
+	for i, tt := range tests {
+		got, err := parseCallbacks(tt.raw)
+		if !tt.wantErr && err != nil {
+			t.Errorf("[%d]: got error: %v", i, err)
+		}
+		if tt.wantErr && err == nil {
+			t.Errorf("[%d]: wanted error, got nil", i)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: got %q, want %q", i, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,325 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package gena
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethulhu/helix/logger"
+)
+
+type (
+	// Publisher serves GENA subscriptions for a single service.
+	Publisher struct {
+		mu            sync.Mutex
+		variables     map[string]string
+		subscriptions map[string]*subscription
+	}
+
+	subscription struct {
+		sid       string
+		callbacks []string
+
+		// seq and expires are protected by the Publisher's mutex.
+		seq     uint32
+		expires time.Time
+
+		events chan notification
+		ready  chan struct{}
+		done   chan struct{}
+	}
+	notification struct {
+		seq       uint32
+		variables map[string]string
+	}
+)
+
+const notifyTimeout = 5 * time.Second
+
+// NewPublisher returns a Publisher for the given evented state variables.
+// All of the variables are sent in the initial event of each subscription, with empty values until they are set with Notify.
+func NewPublisher(variables ...string) *Publisher {
+	p := &Publisher{
+		variables:     map[string]string{},
+		subscriptions: map[string]*subscription{},
+	}
+	for _, v := range variables {
+		p.variables[v] = ""
+	}
+	return p
+}
+
+// Notify sets the values of evented state variables, and sends the changed values to all subscribers.
+// Variables that were not passed to NewPublisher are ignored.
+func (p *Publisher) Notify(variables map[string]string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	changed := map[string]string{}
+	for k, v := range variables {
+		if old, ok := p.variables[k]; ok && old != v {
+			p.variables[k] = v
+			changed[k] = v
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+
+	p.expireSubscriptions()
+	for _, s := range p.subscriptions {
+		p.enqueue(s, changed)
+	}
+}
+
+// Variables returns a copy of the current values of the evented state variables.
+func (p *Publisher) Variables() map[string]string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	variables := map[string]string{}
+	for k, v := range p.variables {
+		variables[k] = v
+	}
+	return variables
+}
+
+// ServeHTTP handles SUBSCRIBE and UNSUBSCRIBE requests.
+func (p *Publisher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case subscribeMethod:
+		if r.Header.Get("SID") != "" {
+			p.renew(w, r)
+			return
+		}
+		p.subscribe(w, r)
+	case unsubscribeMethod:
+		p.unsubscribe(w, r)
+	default:
+		http.Error(w, fmt.Sprintf("method must be %s or %s", subscribeMethod, unsubscribeMethod), http.StatusMethodNotAllowed)
+	}
+}
+
+func (p *Publisher) subscribe(w http.ResponseWriter, r *http.Request) {
+	log, _ := logger.FromContext(r.Context())
+
+	if r.Header.Get("NT") != ntEvent {
+		http.Error(w, fmt.Sprintf("NT must be %q", ntEvent), http.StatusPreconditionFailed)
+		log.Warning("invalid NT header")
+		return
+	}
+	callbacks, err := parseCallbacks(r.Header.Get("CALLBACK"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		log.WithError(err).Warning("invalid CALLBACK header")
+		return
+	}
+	timeout := requestedTimeout(r)
+
+	s := &subscription{
+		sid:       newSID(),
+		callbacks: callbacks,
+		expires:   time.Now().Add(timeout),
+		events:    make(chan notification, 16),
+		ready:     make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go s.deliver()
+
+	p.mu.Lock()
+	p.expireSubscriptions()
+	p.subscriptions[s.sid] = s
+	p.enqueue(s, p.variables)
+	p.mu.Unlock()
+
+	w.Header().Set("SID", s.sid)
+	w.Header().Set("TIMEOUT", formatTimeout(timeout))
+	w.WriteHeader(http.StatusOK)
+
+	log.AddField("gena.sid", s.sid)
+	log.AddField("gena.callbacks", callbacks)
+	log.Info("added GENA subscription")
+
+	// The initial event must come after the SUBSCRIBE response.
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	close(s.ready)
+}
+
+func (p *Publisher) renew(w http.ResponseWriter, r *http.Request) {
+	log, _ := logger.FromContext(r.Context())
+
+	sid := r.Header.Get("SID")
+	log.AddField("gena.sid", sid)
+
+	if r.Header.Get("NT") != "" || r.Header.Get("CALLBACK") != "" {
+		http.Error(w, "SID must not be combined with NT or CALLBACK", http.StatusBadRequest)
+		log.Warning("renewal had NT or CALLBACK")
+		return
+	}
+	timeout := requestedTimeout(r)
+
+	p.mu.Lock()
+	p.expireSubscriptions()
+	s, ok := p.subscriptions[sid]
+	if ok {
+		s.expires = time.Now().Add(timeout)
+	}
+	p.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "unknown SID", http.StatusPreconditionFailed)
+		log.Warning("could not renew unknown GENA subscription")
+		return
+	}
+
+	w.Header().Set("SID", sid)
+	w.Header().Set("TIMEOUT", formatTimeout(timeout))
+	log.Debug("renewed GENA subscription")
+}
+
+func (p *Publisher) unsubscribe(w http.ResponseWriter, r *http.Request) {
+	log, _ := logger.FromContext(r.Context())
+
+	sid := r.Header.Get("SID")
+	log.AddField("gena.sid", sid)
+
+	if r.Header.Get("NT") != "" || r.Header.Get("CALLBACK") != "" {
+		http.Error(w, "SID must not be combined with NT or CALLBACK", http.StatusBadRequest)
+		log.Warning("unsubscribe had NT or CALLBACK")
+		return
+	}
+
+	p.mu.Lock()
+	s, ok := p.subscriptions[sid]
+	if ok {
+		delete(p.subscriptions, sid)
+		close(s.done)
+	}
+	p.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "unknown SID", http.StatusPreconditionFailed)
+		log.Warning("could not remove unknown GENA subscription")
+		return
+	}
+	log.Info("removed GENA subscription")
+}
+
+// enqueue must be called with p.mu held.
+func (p *Publisher) enqueue(s *subscription, variables map[string]string) {
+	copied := map[string]string{}
+	for k, v := range variables {
+		copied[k] = v
+	}
+
+	n := notification{seq: s.seq, variables: copied}
+	// SEQ wraps to 1, not 0, because 0 is reserved for the initial event.
+	if s.seq++; s.seq == 0 {
+		s.seq = 1
+	}
+
+	select {
+	case s.events <- n:
+	default:
+		// The subscriber is too slow, so drop it rather than block every other subscriber.
+		delete(p.subscriptions, s.sid)
+		close(s.done)
+
+		log := logger.Background()
+		log.AddField("gena.sid", s.sid)
+		log.Warning("dropped GENA subscription with full event queue")
+	}
+}
+
+// expireSubscriptions must be called with p.mu held.
+func (p *Publisher) expireSubscriptions() {
+	now := time.Now()
+	for sid, s := range p.subscriptions {
+		if now.After(s.expires) {
+			delete(p.subscriptions, sid)
+			close(s.done)
+		}
+	}
+}
+
+func (s *subscription) deliver() {
+	log := logger.Background()
+	log.AddField("gena.sid", s.sid)
+
+	select {
+	case <-s.done:
+		return
+	case <-s.ready:
+	}
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case n := <-s.events:
+			if err := s.notify(n); err != nil {
+				log.WithField("gena.seq", n.seq).WithError(err).Warning("could not send GENA event")
+			}
+		}
+	}
+}
+
+func (s *subscription) notify(n notification) error {
+	body := serializePropertySet(n.variables)
+
+	var err error
+	for _, callback := range s.callbacks {
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		err = sendNotify(ctx, callback, s.sid, n.seq, body)
+		cancel()
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func sendNotify(ctx context.Context, callback, sid string, seq uint32, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, notifyMethod, callback, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create NOTIFY request: %w", err)
+	}
+	req.Header = http.Header{
+		"Content-Type": {`text/xml; charset="utf-8"`},
+		"NT":           {ntEvent},
+		"NTS":          {ntsPropChange},
+		"SID":          {sid},
+		"SEQ":          {fmt.Sprint(seq)},
+	}
+
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not do HTTP request: %w", err)
+	}
+	rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP error: %v", rsp.Status)
+	}
+	return nil
+}
+
+func requestedTimeout(r *http.Request) time.Duration {
+	timeout, err := parseTimeout(r.Header.Get("TIMEOUT"))
+	if err != nil || timeout == 0 {
+		return DefaultTimeout
+	}
+	if timeout < MinimumTimeout {
+		return MinimumTimeout
+	}
+	return timeout
+}
@@ -0,0 +1,316 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package gena
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethulhu/helix/logger"
+)
+
+type (
+	// Subscriber subscribes to remote services, and receives their events on a callback HTTP handler.
+	Subscriber struct {
+		callbackURL string
+
+		mu            sync.Mutex
+		subscriptions map[string]*Subscription
+
+		// pending holds events for subscriptions that have been made, but whose SUBSCRIBE requests have not returned yet.
+		inflight int
+		pending  map[string][]notification
+	}
+
+	// Subscription is a subscription to the events of a single remote service.
+	Subscription struct {
+		subscriber *Subscriber
+		eventURL   *url.URL
+		sid        string
+
+		mu        sync.Mutex
+		seq       uint32
+		events    chan map[string]string
+		done      chan struct{}
+		closeOnce sync.Once
+		timeout   time.Duration
+	}
+)
+
+var (
+	// ErrUnknownSubscription is returned when the Publisher does not know the given SID.
+	ErrUnknownSubscription = errors.New("unknown subscription")
+)
+
+// NewSubscriber returns a Subscriber whose ServeHTTP is reachable by Publishers at callbackURL.
+func NewSubscriber(callbackURL string) *Subscriber {
+	return &Subscriber{
+		callbackURL:   callbackURL,
+		subscriptions: map[string]*Subscription{},
+		pending:       map[string][]notification{},
+	}
+}
+
+// Subscribe subscribes to the events of a remote service, and renews that subscription until it is cancelled.
+func (s *Subscriber) Subscribe(ctx context.Context, eventURL *url.URL) (*Subscription, error) {
+	s.mu.Lock()
+	s.inflight++
+	s.mu.Unlock()
+
+	sid, timeout, err := subscribe(ctx, eventURL, s.callbackURL)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inflight--
+	if err != nil {
+		if s.inflight == 0 {
+			s.pending = map[string][]notification{}
+		}
+		return nil, err
+	}
+
+	sub := &Subscription{
+		subscriber: s,
+		eventURL:   eventURL,
+		sid:        sid,
+		events:     make(chan map[string]string, 16),
+		done:       make(chan struct{}),
+		timeout:    timeout,
+	}
+	for _, n := range s.pending[sid] {
+		select {
+		case sub.events <- n.variables:
+			sub.seq = n.seq + 1
+		default:
+		}
+	}
+	delete(s.pending, sid)
+	if s.inflight == 0 {
+		s.pending = map[string][]notification{}
+	}
+	s.subscriptions[sid] = sub
+
+	go sub.renew()
+
+	return sub, nil
+}
+
+// ServeHTTP handles NOTIFY requests from Publishers.
+func (s *Subscriber) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log, _ := logger.FromContext(r.Context())
+
+	if r.Method != notifyMethod {
+		http.Error(w, fmt.Sprintf("method must be %s", notifyMethod), http.StatusMethodNotAllowed)
+		log.Warning("not a NOTIFY request")
+		return
+	}
+
+	nt, nts, sid := r.Header.Get("NT"), r.Header.Get("NTS"), r.Header.Get("SID")
+	if nt == "" || nts == "" {
+		http.Error(w, "must set NT and NTS", http.StatusBadRequest)
+		log.Warning("missing NT or NTS")
+		return
+	}
+	if nt != ntEvent || nts != ntsPropChange || sid == "" {
+		http.Error(w, "invalid NT, NTS, or SID", http.StatusPreconditionFailed)
+		log.Warning("invalid NT, NTS, or SID")
+		return
+	}
+	log.AddField("gena.sid", sid)
+
+	seq, err := strconv.ParseUint(r.Header.Get("SEQ"), 10, 32)
+	if err != nil {
+		http.Error(w, "invalid SEQ", http.StatusBadRequest)
+		log.Warning("invalid SEQ")
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		log.WithError(err).Warning("could not read body of NOTIFY request")
+		return
+	}
+	variables, err := deserializePropertySet(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		log.WithError(err).Warning("could not deserialize NOTIFY request")
+		return
+	}
+
+	s.mu.Lock()
+	sub, ok := s.subscriptions[sid]
+	if !ok && s.inflight > 0 {
+		s.pending[sid] = append(s.pending[sid], notification{uint32(seq), variables})
+		s.mu.Unlock()
+		log.Debug("received GENA event for in-flight subscription")
+		return
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "unknown SID", http.StatusPreconditionFailed)
+		log.Warning("received GENA event for unknown subscription")
+		return
+	}
+
+	sub.deliver(uint32(seq), variables)
+	log.Debug("received GENA event")
+}
+
+// SID is the subscription ID assigned by the Publisher.
+func (sub *Subscription) SID() string {
+	return sub.sid
+}
+
+// Events returns the evented variables as they are received.
+// The first event contains all evented variables.
+// The channel is closed when the subscription is cancelled or lapses.
+func (sub *Subscription) Events() <-chan map[string]string {
+	return sub.events
+}
+
+// Unsubscribe cancels the subscription with the Publisher.
+func (sub *Subscription) Unsubscribe(ctx context.Context) error {
+	sub.close()
+	return unsubscribe(ctx, sub.eventURL, sub.sid)
+}
+
+func (sub *Subscription) deliver(seq uint32, variables map[string]string) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	select {
+	case <-sub.done:
+		return
+	default:
+	}
+
+	if seq != sub.seq {
+		log := logger.Background()
+		log.AddField("gena.sid", sub.sid)
+		log.AddField("gena.seq", seq)
+		log.AddField("gena.expected_seq", sub.seq)
+		log.Warning("received out-of-sequence GENA event")
+	}
+	if sub.seq = seq + 1; sub.seq == 0 {
+		sub.seq = 1
+	}
+
+	select {
+	case sub.events <- variables:
+	case <-sub.done:
+	}
+}
+
+func (sub *Subscription) close() {
+	s := sub.subscriber
+	s.mu.Lock()
+	delete(s.subscriptions, sub.sid)
+	s.mu.Unlock()
+
+	sub.closeOnce.Do(func() {
+		close(sub.done)
+
+		// Any in-progress deliver will return now that done is closed.
+		sub.mu.Lock()
+		defer sub.mu.Unlock()
+		close(sub.events)
+	})
+}
+
+func (sub *Subscription) renew() {
+	log := logger.Background()
+	log.AddField("gena.sid", sub.sid)
+	log.AddField("gena.url", sub.eventURL)
+
+	for {
+		// Renew with plenty of time to spare.
+		wait := sub.timeout / 2
+		select {
+		case <-sub.done:
+			return
+		case <-time.After(wait):
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		timeout, err := renew(ctx, sub.eventURL, sub.sid)
+		cancel()
+		if err != nil {
+			log.WithError(err).Warning("could not renew GENA subscription")
+			sub.close()
+			return
+		}
+		sub.timeout = timeout
+		log.Debug("renewed GENA subscription")
+	}
+}
+
+func subscribe(ctx context.Context, eventURL *url.URL, callbackURL string) (string, time.Duration, error) {
+	return do(ctx, subscribeMethod, eventURL, http.Header{
+		"CALLBACK": {fmt.Sprintf("<%s>", callbackURL)},
+		"NT":       {ntEvent},
+		"TIMEOUT":  {formatTimeout(DefaultTimeout)},
+	})
+}
+func renew(ctx context.Context, eventURL *url.URL, sid string) (time.Duration, error) {
+	_, timeout, err := do(ctx, subscribeMethod, eventURL, http.Header{
+		"SID":     {sid},
+		"TIMEOUT": {formatTimeout(DefaultTimeout)},
+	})
+	return timeout, err
+}
+func unsubscribe(ctx context.Context, eventURL *url.URL, sid string) error {
+	_, _, err := do(ctx, unsubscribeMethod, eventURL, http.Header{
+		"SID": {sid},
+	})
+	return err
+}
+
+func do(ctx context.Context, method string, eventURL *url.URL, header http.Header) (string, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, method, eventURL.String(), http.NoBody)
+	if err != nil {
+		return "", 0, fmt.Errorf("could not create %s request: %w", method, err)
+	}
+	req.Header = header
+
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("could not do HTTP request: %w", err)
+	}
+	rsp.Body.Close()
+
+	if rsp.StatusCode == http.StatusPreconditionFailed {
+		return "", 0, ErrUnknownSubscription
+	}
+	if rsp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("HTTP error: %v", rsp.Status)
+	}
+	if method == unsubscribeMethod {
+		return "", 0, nil
+	}
+
+	sid := rsp.Header.Get("SID")
+	if sid == "" {
+		return "", 0, errors.New("response did not have a SID")
+	}
+	timeout, err := parseTimeout(rsp.Header.Get("TIMEOUT"))
+	if err != nil {
+		return "", 0, fmt.Errorf("response had invalid TIMEOUT: %w", err)
+	}
+	if timeout == 0 {
+		// An infinite subscription still gets renewed now and then, just in case.
+		timeout = DefaultTimeout
+	}
+	return sid, timeout, nil
+}
@@ -72,7 +72,7 @@ func argumentsAndVariables(obj interface{}, d Direction) ([]Argument, []StateVar
 				sv.AllowedValues.Values = append(sv.AllowedValues.Values, allowed)
 			}
 		}
-		if (parts[1] == "i4" || parts[1] == "ui4") && len(parts) > 2 {
+		if isInteger(parts[1]) && len(parts) > 2 {
 			sv.AllowedValueRange = &AllowedValueRange{}
 			for _, part := range parts[2:] {
 				switch {
@@ -105,3 +105,12 @@ func argumentsAndVariables(obj interface{}, d Direction) ([]Argument, []StateVar
 	}
 	return arguments, variables, nil
 }
+
+func isInteger(dataType string) bool {
+	switch dataType {
+	case "i1", "i2", "i4", "ui1", "ui2", "ui4":
+		return true
+	default:
+		return false
+	}
+}
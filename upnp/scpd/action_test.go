@@ -124,6 +124,7 @@ func TestFromAction(t *testing.T) {
 				XMLName xml.Name `xml"GetFoo"`
 				Foo     string   `xml:"foo" scpd:"A_ARG_TYPE_Foo,ui4,min=2"`
 				Bar     string   `xml:"bar" scpd:"A_ARG_TYPE_Bar,i4,min=2,max=3,step=4"`
+				Baz     string   `xml:"baz" scpd:"A_ARG_TYPE_Baz,ui2,max=100"`
 			}{},
 			rsp: struct {
 				XMLName xml.Name `xml"GetFooResponse"`
@@ -143,6 +144,11 @@ func TestFromAction(t *testing.T) {
 							Direction:            In,
 							RelatedStateVariable: "A_ARG_TYPE_Bar",
 						},
+						{
+							Name:                 "baz",
+							Direction:            In,
+							RelatedStateVariable: "A_ARG_TYPE_Baz",
+						},
 					},
 				}},
 				StateVariables: []StateVariable{
@@ -155,6 +161,13 @@ func TestFromAction(t *testing.T) {
 							Step:    4,
 						},
 					},
+					{
+						Name:     "A_ARG_TYPE_Baz",
+						DataType: "ui2",
+						AllowedValueRange: &AllowedValueRange{
+							Maximum: 100,
+						},
+					},
 					{
 						Name:     "A_ARG_TYPE_Foo",
 						DataType: "ui4",
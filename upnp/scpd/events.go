@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package scpd
+
+import (
+	"fmt"
+
+	"github.com/ethulhu/helix/xmltypes"
+)
+
+// WithEvents returns a copy of doc with the given state variables marked as evented.
+// Variables not already in doc are added.
+func WithEvents(doc Document, vs ...StateVariable) (Document, error) {
+	m := map[string]StateVariable{}
+	for _, v := range doc.StateVariables {
+		m[v.Name] = v
+	}
+	for _, v := range vs {
+		if w, ok := m[v.Name]; ok && w.DataType != v.DataType {
+			return Document{}, fmt.Errorf("conflicting data types for state variable %q: %q and %q", v.Name, w.DataType, v.DataType)
+		} else if ok {
+			v = w
+		}
+		v.SendEventsAttribute = xmltypes.Yes
+		m[v.Name] = v
+	}
+
+	var variables []StateVariable
+	for _, v := range m {
+		variables = append(variables, v)
+	}
+	sortedVariables, err := mergeVariables(variables)
+	if err != nil {
+		return Document{}, err
+	}
+
+	return Document{
+		SpecVersion:    doc.SpecVersion,
+		Actions:        doc.Actions,
+		StateVariables: sortedVariables,
+	}, nil
+}
+
+// EventedVariables returns the names of the state variables that are evented.
+func (doc Document) EventedVariables() []string {
+	var names []string
+	for _, v := range doc.StateVariables {
+		if v.SendEventsAttribute {
+			names = append(names, v.Name)
+		}
+	}
+	return names
+}
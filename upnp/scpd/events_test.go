@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package scpd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethulhu/helix/xmltypes"
+)
+
+func TestWithEvents(t *testing.T) {
+	tests := []struct {
+		doc       Document
+		variables []StateVariable
+
+		want    Document
+		wantErr bool
+	}{
+		{
+			doc: Document{
+				SpecVersion: Version,
+				StateVariables: []StateVariable{
+					{
+						Name:     "A_ARG_TYPE_Foo",
+						DataType: "string",
+					},
+					{
+						Name:     "Volume",
+						DataType: "ui2",
+						AllowedValueRange: &AllowedValueRange{
+							Maximum: 100,
+						},
+					},
+				},
+			},
+			variables: []StateVariable{
+				{
+					Name:     "LastChange",
+					DataType: "string",
+				},
+				{
+					Name:     "Volume",
+					DataType: "ui2",
+				},
+			},
+			want: Document{
+				SpecVersion: Version,
+				StateVariables: []StateVariable{
+					{
+						Name:     "A_ARG_TYPE_Foo",
+						DataType: "string",
+					},
+					{
+						Name:                "LastChange",
+						SendEventsAttribute: xmltypes.Yes,
+						DataType:            "string",
+					},
+					{
+						Name:                "Volume",
+						SendEventsAttribute: xmltypes.Yes,
+						DataType:            "ui2",
+						AllowedValueRange: &AllowedValueRange{
+							Maximum: 100,
+						},
+					},
+				},
+			},
+		},
+		{
+			doc: Document{
+				SpecVersion: Version,
+				StateVariables: []StateVariable{
+					{
+						Name:     "Volume",
+						DataType: "ui2",
+					},
+				},
+			},
+			variables: []StateVariable{
+				{
+					Name:     "Volume",
+					DataType: "string",
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for i, tt := range tests {
+		got, err := WithEvents(tt.doc, tt.variables...)
+		if !tt.wantErr && err != nil {
+			t.Errorf("[%d]: got error: %v", i, err)
+		}
+		if tt.wantErr && err == nil {
+			t.Errorf("[%d]: wanted error, got nil", i)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: got %+v, want %+v", i, got, tt.want)
+		}
+	}
+}
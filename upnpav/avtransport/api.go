@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethulhu/helix/upnp"
+	"github.com/ethulhu/helix/upnp/scpd"
 	"github.com/ethulhu/helix/upnpav"
 )
 
@@ -32,8 +33,8 @@ type (
 		// Stop stops playback altogether.
 		Stop(context.Context) error
 
-		// Seek seeks to a given time.
-		Seek(context.Context, time.Duration) error
+		// Seek seeks to a given target, e.g. a time within the current track, or another track.
+		Seek(context.Context, SeekTarget) error
 
 		// SetCurrentURI sets the URI of the current track.
 		// If metadata is nil, it will create a minimal metadata.
@@ -42,18 +43,102 @@ type (
 		// If metadata is nil, it will create a minimal metadata.
 		SetNextURI(ctx context.Context, uri string, metadata *upnpav.DIDLLite) error
 
-		// MediaInfo returns the current URI, current metadata, next URI, and next metadata.
-		MediaInfo(context.Context) (string, *upnpav.DIDLLite, string, *upnpav.DIDLLite, error)
+		// SetPlayMode sets the order in which tracks are played, e.g. to repeat them.
+		SetPlayMode(context.Context, PlayMode) error
 
-		// PositionInfo returns the current URI, metadata, total time, and elapsed time.
-		PositionInfo(context.Context) (string, *upnpav.DIDLLite, time.Duration, time.Duration, error)
+		// MediaInfo returns information about the current and next URIs.
+		MediaInfo(context.Context) (MediaInfo, error)
+
+		// PositionInfo returns the current track, and the position within it.
+		PositionInfo(context.Context) (PositionInfo, error)
 
 		// TransportInfo returns the current playback state and error status.
-		TransportInfo(context.Context) (State, Status, error)
+		TransportInfo(context.Context) (TransportInfo, error)
+
+		// TransportSettings returns the current play mode and record quality mode.
+		TransportSettings(context.Context) (TransportSettings, error)
+
+		// DeviceCapabilities returns the media that the Renderer can play and record.
+		DeviceCapabilities(context.Context) (DeviceCapabilities, error)
+
+		// CurrentTransportActions returns the actions that can be taken in the current state.
+		CurrentTransportActions(context.Context) ([]TransportAction, error)
+	}
+
+	// MediaInfo is the response to GetMediaInfo.
+	MediaInfo struct {
+		TrackCount uint
+		Duration   time.Duration
+
+		CurrentURI      string
+		CurrentMetadata *upnpav.DIDLLite
+		NextURI         string
+		NextMetadata    *upnpav.DIDLLite
+
+		PlayMedium   string
+		RecordMedium string
+		WriteStatus  string
+	}
+
+	// PositionInfo is the response to GetPositionInfo.
+	PositionInfo struct {
+		// Track is the number of the current track, counting from 1, or 0 if there are no tracks.
+		Track         uint
+		TrackDuration time.Duration
+		TrackMetadata *upnpav.DIDLLite
+		TrackURI      string
+
+		RelativeTime time.Duration
+		AbsoluteTime time.Duration
+
+		// RelativeCount and AbsoluteCount are CounterNotImplemented if the Renderer does not keep them.
+		RelativeCount int
+		AbsoluteCount int
+	}
+
+	// TransportInfo is the response to GetTransportInfo.
+	TransportInfo struct {
+		State  State
+		Status Status
+		Speed  string
+	}
+
+	// TransportSettings is the response to GetTransportSettings.
+	TransportSettings struct {
+		PlayMode          PlayMode
+		RecordQualityMode string
+	}
+
+	// DeviceCapabilities is the response to GetDeviceCapabilities.
+	DeviceCapabilities struct {
+		PlayMedia          []string
+		RecordMedia        []string
+		RecordQualityModes []string
+	}
+
+	// SeekTarget is a position to Seek to.
+	// Which field is used depends on Mode.
+	SeekTarget struct {
+		Mode SeekMode
+
+		// Time is used by SeekRelativeTime and SeekAbsoluteTime.
+		Time time.Duration
+
+		// Track is used by SeekTrack, and counts from 1.
+		Track uint
+
+		// Count is used by SeekAbsoluteCount and SeekRelativeCount.
+		Count int
 	}
 
 	SeekMode string
 
+	// PlayMode is the order in which tracks are played.
+	PlayMode string
+
+	// TransportAction is an action that can be taken in the current state.
+	TransportAction string
+
 	// State is a playback state.
 	// Vendor defined states can exist.
 	State string
@@ -86,11 +171,84 @@ const (
 )
 
 const (
-	SeekRelativeTime = SeekMode("REL_TIME")
-	SeekAbsoluteTime = SeekMode("ABS_TIME")
+	SeekTrack         = SeekMode("TRACK_NR")
+	SeekRelativeTime  = SeekMode("REL_TIME")
+	SeekAbsoluteTime  = SeekMode("ABS_TIME")
+	SeekRelativeCount = SeekMode("REL_COUNT")
+	SeekAbsoluteCount = SeekMode("ABS_COUNT")
+)
+
+const (
+	// The spec requires AVTransports to support PlayModeNormal.
+
+	PlayModeNormal = PlayMode("NORMAL")
+
+	// The spec considers the rest as optional.
+
+	PlayModeShuffle   = PlayMode("SHUFFLE")
+	PlayModeRepeatOne = PlayMode("REPEAT_ONE")
+	PlayModeRepeatAll = PlayMode("REPEAT_ALL")
+	PlayModeRandom    = PlayMode("RANDOM")
+	PlayModeDirect1   = PlayMode("DIRECT_1")
+	PlayModeIntro     = PlayMode("INTRO")
+)
+
+const (
+	ActionPlay     = TransportAction("Play")
+	ActionStop     = TransportAction("Stop")
+	ActionPause    = TransportAction("Pause")
+	ActionSeek     = TransportAction("Seek")
+	ActionNext     = TransportAction("Next")
+	ActionPrevious = TransportAction("Previous")
+	ActionRecord   = TransportAction("Record")
+)
+
+const (
+	// CounterNotImplemented is the value of position counters that a Renderer does not keep.
+	CounterNotImplemented = 2147483647
+
+	// NotImplemented is the value of string state variables that a Renderer does not support, e.g. RecordStorageMedium.
+	NotImplemented = "NOT_IMPLEMENTED"
 )
 
 const (
 	StatusOK    = Status("OK")
 	StatusError = Status("ERROR_OCCURRED")
 )
+
+var (
+	ErrTransitionNotAvailable = upnpav.Error{Code: 701, Description: "Transition not available"}
+	ErrNoContents             = upnpav.Error{Code: 702, Description: "No contents"}
+	ErrReadError              = upnpav.Error{Code: 703, Description: "Read error"}
+	ErrFormatNotSupported     = upnpav.Error{Code: 704, Description: "Format not supported for playback"}
+	ErrSeekModeNotSupported   = upnpav.Error{Code: 710, Description: "Seek mode not supported"}
+	ErrIllegalSeekTarget      = upnpav.Error{Code: 711, Description: "Illegal seek target"}
+	ErrPlayModeNotSupported   = upnpav.Error{Code: 712, Description: "Play mode not supported"}
+	ErrIllegalMIMEType        = upnpav.Error{Code: 714, Description: "Illegal MIME-type"}
+	ErrResourceNotFound       = upnpav.Error{Code: 716, Description: "Resource not found"}
+	ErrPlaySpeedNotSupported  = upnpav.Error{Code: 717, Description: "Play speed not supported"}
+	ErrInvalidInstanceID      = upnpav.Error{Code: 718, Description: "Invalid InstanceID"}
+)
+
+// SCPD describes the actions that SOAPHandler supports.
+// Changes to the other state variables are evented with LastChange.
+var SCPD = scpd.Must(scpd.WithEvents(
+	scpd.Must(scpd.Merge(
+		scpd.Must(scpd.FromAction(setAVTransportURI, setAVTransportURIRequest{}, setAVTransportURIResponse{})),
+		scpd.Must(scpd.FromAction(setNextAVTransportURI, setNextAVTransportURIRequest{}, setNextAVTransportURIResponse{})),
+		scpd.Must(scpd.FromAction(getMediaInfo, getMediaInfoRequest{}, getMediaInfoResponse{})),
+		scpd.Must(scpd.FromAction(getPositionInfo, getPositionInfoRequest{}, getPositionInfoResponse{})),
+		scpd.Must(scpd.FromAction(getTransportInfo, getTransportInfoRequest{}, getTransportInfoResponse{})),
+		scpd.Must(scpd.FromAction(play, playRequest{}, playResponse{})),
+		scpd.Must(scpd.FromAction(pause, pauseRequest{}, pauseResponse{})),
+		scpd.Must(scpd.FromAction(stop, stopRequest{}, stopResponse{})),
+		scpd.Must(scpd.FromAction(seek, seekRequest{}, seekResponse{})),
+		scpd.Must(scpd.FromAction(next, nextRequest{}, nextResponse{})),
+		scpd.Must(scpd.FromAction(previous, previousRequest{}, previousResponse{})),
+		scpd.Must(scpd.FromAction(setPlayMode, setPlayModeRequest{}, setPlayModeResponse{})),
+		scpd.Must(scpd.FromAction(getTransportSettings, getTransportSettingsRequest{}, getTransportSettingsResponse{})),
+		scpd.Must(scpd.FromAction(getDeviceCapabilities, getDeviceCapabilitiesRequest{}, getDeviceCapabilitiesResponse{})),
+		scpd.Must(scpd.FromAction(getCurrentTransportActions, getCurrentTransportActionsRequest{}, getCurrentTransportActionsResponse{})),
+	)),
+	scpd.StateVariable{Name: upnpav.LastChangeVariableName, DataType: "string"},
+))
@@ -9,6 +9,7 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ethulhu/helix/soap"
@@ -62,42 +63,116 @@ func (c *client) Stop(ctx context.Context) error {
 	req := stopRequest{InstanceID: 0}
 	return c.call(ctx, stop, req, nil)
 }
-func (c *client) Seek(ctx context.Context, d time.Duration) error {
+func (c *client) Seek(ctx context.Context, target SeekTarget) error {
 	req := seekRequest{
-		Unit:   SeekRelativeTime,
-		Target: upnpav.Duration{d}.String(),
+		InstanceID: 0,
+		Unit:       target.Mode,
+		Target:     target.String(),
 	}
 	return c.call(ctx, seek, req, nil)
 }
 
-func (c *client) MediaInfo(ctx context.Context) (string, *upnpav.DIDLLite, string, *upnpav.DIDLLite, error) {
+func (c *client) MediaInfo(ctx context.Context) (MediaInfo, error) {
 	req := getMediaInfoRequest{InstanceID: 0}
 	rsp := getMediaInfoResponse{}
 	if err := c.call(ctx, getMediaInfo, req, &rsp); err != nil {
-		return "", nil, "", nil, err
+		return MediaInfo{}, err
+	}
+
+	// Renderers that do not know the duration can send anything, e.g. "NOT_IMPLEMENTED".
+	var duration time.Duration
+	if d, err := upnpav.ParseDuration(rsp.Duration); err == nil {
+		duration = d.Duration
 	}
-	return rsp.CurrentURI, &rsp.CurrentMetadata.DIDLLite, rsp.NextURI, &rsp.NextMetadata.DIDLLite, nil
+
+	return MediaInfo{
+		TrackCount:      rsp.TrackCount,
+		Duration:        duration,
+		CurrentURI:      rsp.CurrentURI,
+		CurrentMetadata: &rsp.CurrentMetadata.DIDLLite,
+		NextURI:         rsp.NextURI,
+		NextMetadata:    &rsp.NextMetadata.DIDLLite,
+		PlayMedium:      rsp.PlayMedium,
+		RecordMedium:    rsp.RecordMedium,
+		WriteStatus:     rsp.WriteStatus,
+	}, nil
 }
-func (c *client) PositionInfo(ctx context.Context) (string, *upnpav.DIDLLite, time.Duration, time.Duration, error) {
+func (c *client) PositionInfo(ctx context.Context) (PositionInfo, error) {
 	req := getPositionInfoRequest{InstanceID: 0}
 	rsp := getPositionInfoResponse{}
 	if err := c.call(ctx, getPositionInfo, req, &rsp); err != nil {
-		return "", nil, 0, 0, err
+		return PositionInfo{}, err
 	}
-	return rsp.URI, &rsp.Metadata.DIDLLite, rsp.Duration.Duration, rsp.RelativeTime.Duration, nil
+	return PositionInfo{
+		Track:         rsp.CurrentTrack,
+		TrackDuration: rsp.Duration.Duration,
+		TrackMetadata: &rsp.Metadata.DIDLLite,
+		TrackURI:      rsp.URI,
+		RelativeTime:  rsp.RelativeTime.Duration,
+		AbsoluteTime:  rsp.AbsoluteTime.Duration,
+		RelativeCount: rsp.RelativeCount,
+		AbsoluteCount: rsp.AbsoluteCount,
+	}, nil
 }
-func (c *client) TransportInfo(ctx context.Context) (State, Status, error) {
+func (c *client) TransportInfo(ctx context.Context) (TransportInfo, error) {
 	req := getTransportInfoRequest{}
 	rsp := getTransportInfoResponse{}
 	if err := c.call(ctx, getTransportInfo, req, &rsp); err != nil {
-		return State(""), Status(""), err
+		return TransportInfo{}, err
 	}
 	if rsp == (getTransportInfoResponse{}) {
-		return State(""), Status(""), errors.New("received an empty GetTransportInfoResponse")
+		return TransportInfo{}, errors.New("received an empty GetTransportInfoResponse")
+	}
+	return TransportInfo{
+		State:  rsp.State,
+		Status: rsp.Status,
+		Speed:  rsp.Speed,
+	}, nil
+}
+func (c *client) TransportSettings(ctx context.Context) (TransportSettings, error) {
+	req := getTransportSettingsRequest{InstanceID: 0}
+	rsp := getTransportSettingsResponse{}
+	if err := c.call(ctx, getTransportSettings, req, &rsp); err != nil {
+		return TransportSettings{}, err
+	}
+	return TransportSettings{
+		PlayMode:          rsp.PlayMode,
+		RecordQualityMode: rsp.RecordQualityMode,
+	}, nil
+}
+func (c *client) DeviceCapabilities(ctx context.Context) (DeviceCapabilities, error) {
+	req := getDeviceCapabilitiesRequest{InstanceID: 0}
+	rsp := getDeviceCapabilitiesResponse{}
+	if err := c.call(ctx, getDeviceCapabilities, req, &rsp); err != nil {
+		return DeviceCapabilities{}, err
+	}
+	return DeviceCapabilities{
+		PlayMedia:          rsp.PlayMedia,
+		RecordMedia:        rsp.RecordMedia,
+		RecordQualityModes: rsp.RecordQualityModes,
+	}, nil
+}
+func (c *client) CurrentTransportActions(ctx context.Context) ([]TransportAction, error) {
+	req := getCurrentTransportActionsRequest{InstanceID: 0}
+	rsp := getCurrentTransportActionsResponse{}
+	if err := c.call(ctx, getCurrentTransportActions, req, &rsp); err != nil {
+		return nil, err
+	}
+	// Some Renderers put spaces after the commas.
+	var actions []TransportAction
+	for _, action := range rsp.Actions {
+		actions = append(actions, TransportAction(strings.TrimSpace(action)))
 	}
-	return rsp.State, rsp.Status, nil
+	return actions, nil
 }
 
+func (c *client) SetPlayMode(ctx context.Context, mode PlayMode) error {
+	req := setPlayModeRequest{
+		InstanceID: 0,
+		PlayMode:   mode,
+	}
+	return c.call(ctx, setPlayMode, req, nil)
+}
 func (c *client) SetCurrentURI(ctx context.Context, uri string, metadata *upnpav.DIDLLite) error {
 	if metadata == nil {
 		var err error
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package avtransport
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethulhu/helix/upnpav"
+)
+
+func TestClientAndHandler(t *testing.T) {
+	fh := &fakeHandler{
+		mediaInfo: MediaInfo{
+			TrackCount:      1,
+			Duration:        3 * time.Minute,
+			CurrentURI:      "http://foo/a.mp3",
+			CurrentMetadata: &upnpav.DIDLLite{Items: []upnpav.Item{{ID: "a", Title: "A"}}},
+			NextMetadata:    &upnpav.DIDLLite{},
+			PlayMedium:      "NETWORK",
+			RecordMedium:    NotImplemented,
+			WriteStatus:     NotImplemented,
+		},
+		positionInfo: PositionInfo{
+			Track:         1,
+			TrackDuration: 3 * time.Minute,
+			TrackMetadata: &upnpav.DIDLLite{},
+			TrackURI:      "http://foo/a.mp3",
+			RelativeTime:  time.Minute,
+			AbsoluteTime:  time.Minute,
+			RelativeCount: CounterNotImplemented,
+			AbsoluteCount: CounterNotImplemented,
+		},
+		transportInfo: TransportInfo{
+			State:  StatePlaying,
+			Status: StatusOK,
+			Speed:  "1",
+		},
+		transportSettings: TransportSettings{
+			PlayMode:          PlayModeRepeatAll,
+			RecordQualityMode: NotImplemented,
+		},
+		deviceCapabilities: DeviceCapabilities{
+			PlayMedia:          []string{"NETWORK"},
+			RecordMedia:        []string{NotImplemented},
+			RecordQualityModes: []string{NotImplemented},
+		},
+		actions: []TransportAction{ActionPause, ActionStop, ActionSeek},
+	}
+
+	ctx := context.Background()
+	client := NewClient(SOAPHandler{Interface: fh})
+
+	mediaInfo, err := client.MediaInfo(ctx)
+	if err != nil {
+		t.Fatalf("MediaInfo(_) returned error: %v", err)
+	}
+	if !reflect.DeepEqual(mediaInfo, fh.mediaInfo) {
+		t.Errorf("MediaInfo(_) == %+v, want %+v", mediaInfo, fh.mediaInfo)
+	}
+
+	positionInfo, err := client.PositionInfo(ctx)
+	if err != nil {
+		t.Fatalf("PositionInfo(_) returned error: %v", err)
+	}
+	if !reflect.DeepEqual(positionInfo, fh.positionInfo) {
+		t.Errorf("PositionInfo(_) == %+v, want %+v", positionInfo, fh.positionInfo)
+	}
+
+	transportInfo, err := client.TransportInfo(ctx)
+	if err != nil {
+		t.Fatalf("TransportInfo(_) returned error: %v", err)
+	}
+	if transportInfo != fh.transportInfo {
+		t.Errorf("TransportInfo(_) == %+v, want %+v", transportInfo, fh.transportInfo)
+	}
+
+	transportSettings, err := client.TransportSettings(ctx)
+	if err != nil {
+		t.Fatalf("TransportSettings(_) returned error: %v", err)
+	}
+	if transportSettings != fh.transportSettings {
+		t.Errorf("TransportSettings(_) == %+v, want %+v", transportSettings, fh.transportSettings)
+	}
+
+	deviceCapabilities, err := client.DeviceCapabilities(ctx)
+	if err != nil {
+		t.Fatalf("DeviceCapabilities(_) returned error: %v", err)
+	}
+	if !reflect.DeepEqual(deviceCapabilities, fh.deviceCapabilities) {
+		t.Errorf("DeviceCapabilities(_) == %+v, want %+v", deviceCapabilities, fh.deviceCapabilities)
+	}
+
+	actions, err := client.CurrentTransportActions(ctx)
+	if err != nil {
+		t.Fatalf("CurrentTransportActions(_) returned error: %v", err)
+	}
+	if !reflect.DeepEqual(actions, fh.actions) {
+		t.Errorf("CurrentTransportActions(_) == %v, want %v", actions, fh.actions)
+	}
+
+	if err := client.SetPlayMode(ctx, PlayModeShuffle); err != nil {
+		t.Fatalf("SetPlayMode(_, %v) returned error: %v", PlayModeShuffle, err)
+	}
+	if fh.transportSettings.PlayMode != PlayModeShuffle {
+		t.Errorf("after SetPlayMode(_, %v), play mode == %v", PlayModeShuffle, fh.transportSettings.PlayMode)
+	}
+
+	targets := []SeekTarget{
+		{Mode: SeekRelativeTime, Time: 90 * time.Second},
+		{Mode: SeekAbsoluteTime, Time: time.Hour},
+		{Mode: SeekTrack, Track: 2},
+		{Mode: SeekAbsoluteCount, Count: 12},
+	}
+	for i, target := range targets {
+		if err := client.Seek(ctx, target); err != nil {
+			t.Errorf("[%d]: Seek(_, %+v) returned error: %v", i, target, err)
+			continue
+		}
+		if fh.seekTarget != target {
+			t.Errorf("[%d]: Seek(_, %+v) seeked to %+v", i, target, fh.seekTarget)
+		}
+	}
+}
+
+func TestHandlerSeek(t *testing.T) {
+	handler := SOAPHandler{Interface: &fakeHandler{}}
+
+	tests := []struct {
+		req     seekRequest
+		wantErr upnpav.Error
+	}{
+		{
+			req:     seekRequest{Unit: SeekMode("FRAME"), Target: "12"},
+			wantErr: ErrSeekModeNotSupported,
+		},
+		{
+			req:     seekRequest{Unit: SeekTrack, Target: "first"},
+			wantErr: ErrIllegalSeekTarget,
+		},
+		{
+			req:     seekRequest{InstanceID: 1, Unit: SeekTrack, Target: "1"},
+			wantErr: ErrInvalidInstanceID,
+		},
+	}
+
+	for i, tt := range tests {
+		in, err := xml.Marshal(tt.req)
+		if err != nil {
+			t.Fatalf("[%d]: could not marshal request: %v", i, err)
+		}
+
+		_, err = handler.Call(context.Background(), string(Version1), seek, in)
+		var upnpErr upnpav.Error
+		if !errors.As(err, &upnpErr) || upnpErr.Code != tt.wantErr.Code {
+			t.Errorf("[%d]: got error %v, want %v", i, err, tt.wantErr)
+		}
+	}
+}
+
+type fakeHandler struct {
+	mediaInfo          MediaInfo
+	positionInfo       PositionInfo
+	transportInfo      TransportInfo
+	transportSettings  TransportSettings
+	deviceCapabilities DeviceCapabilities
+	actions            []TransportAction
+
+	seekTarget SeekTarget
+}
+
+func (f *fakeHandler) Play(_ context.Context) error     { return nil }
+func (f *fakeHandler) Pause(_ context.Context) error    { return nil }
+func (f *fakeHandler) Next(_ context.Context) error     { return nil }
+func (f *fakeHandler) Previous(_ context.Context) error { return nil }
+func (f *fakeHandler) Stop(_ context.Context) error     { return nil }
+func (f *fakeHandler) Seek(_ context.Context, target SeekTarget) error {
+	f.seekTarget = target
+	return nil
+}
+func (f *fakeHandler) SetCurrentURI(_ context.Context, _ string, _ *upnpav.DIDLLite) error {
+	return nil
+}
+func (f *fakeHandler) SetNextURI(_ context.Context, _ string, _ *upnpav.DIDLLite) error {
+	return nil
+}
+func (f *fakeHandler) SetPlayMode(_ context.Context, mode PlayMode) error {
+	f.transportSettings.PlayMode = mode
+	return nil
+}
+func (f *fakeHandler) MediaInfo(_ context.Context) (MediaInfo, error) {
+	return f.mediaInfo, nil
+}
+func (f *fakeHandler) PositionInfo(_ context.Context) (PositionInfo, error) {
+	return f.positionInfo, nil
+}
+func (f *fakeHandler) TransportInfo(_ context.Context) (TransportInfo, error) {
+	return f.transportInfo, nil
+}
+func (f *fakeHandler) TransportSettings(_ context.Context) (TransportSettings, error) {
+	return f.transportSettings, nil
+}
+func (f *fakeHandler) DeviceCapabilities(_ context.Context) (DeviceCapabilities, error) {
+	return f.deviceCapabilities, nil
+}
+func (f *fakeHandler) CurrentTransportActions(_ context.Context) ([]TransportAction, error) {
+	return f.actions, nil
+}
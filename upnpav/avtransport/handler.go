@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package avtransport
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/ethulhu/helix/logger"
+	"github.com/ethulhu/helix/upnpav"
+)
+
+type (
+	SOAPHandler struct {
+		Interface
+	}
+)
+
+func (h SOAPHandler) Call(ctx context.Context, namespace, action string, in []byte) ([]byte, error) {
+	if namespace != string(Version1) {
+		return nil, fmt.Errorf("invalid namespace")
+	}
+
+	switch action {
+	case setAVTransportURI:
+		return h.setAVTransportURI(ctx, in)
+	case setNextAVTransportURI:
+		return h.setNextAVTransportURI(ctx, in)
+	case getMediaInfo:
+		return h.getMediaInfo(ctx, in)
+	case getPositionInfo:
+		return h.getPositionInfo(ctx, in)
+	case getTransportInfo:
+		return h.getTransportInfo(ctx, in)
+	case play:
+		return h.play(ctx, in)
+	case pause:
+		return h.pause(ctx, in)
+	case stop:
+		return h.stop(ctx, in)
+	case seek:
+		return h.seek(ctx, in)
+	case next:
+		return h.next(ctx, in)
+	case previous:
+		return h.previous(ctx, in)
+	case setPlayMode:
+		return h.setPlayMode(ctx, in)
+	case getTransportSettings:
+		return h.getTransportSettings(ctx, in)
+	case getDeviceCapabilities:
+		return h.getDeviceCapabilities(ctx, in)
+	case getCurrentTransportActions:
+		return h.getCurrentTransportActions(ctx, in)
+	default:
+		return nil, upnpav.ErrInvalidAction
+	}
+}
+
+func (h SOAPHandler) setAVTransportURI(ctx context.Context, in []byte) ([]byte, error) {
+	req := setAVTransportURIRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	if err := h.Interface.SetCurrentURI(ctx, req.CurrentURI, &req.CurrentMetadata.DIDLLite); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(setAVTransportURIResponse{})
+}
+func (h SOAPHandler) setNextAVTransportURI(ctx context.Context, in []byte) ([]byte, error) {
+	req := setNextAVTransportURIRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	if err := h.Interface.SetNextURI(ctx, req.NextURI, &req.NextMetadata.DIDLLite); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(setNextAVTransportURIResponse{})
+}
+
+func (h SOAPHandler) getMediaInfo(ctx context.Context, in []byte) ([]byte, error) {
+	req := getMediaInfoRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	info, err := h.Interface.MediaInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getMediaInfoResponse{
+		TrackCount:   info.TrackCount,
+		Duration:     upnpav.Duration{Duration: info.Duration}.String(),
+		CurrentURI:   info.CurrentURI,
+		NextURI:      info.NextURI,
+		PlayMedium:   info.PlayMedium,
+		RecordMedium: info.RecordMedium,
+		WriteStatus:  info.WriteStatus,
+	}
+	if info.CurrentMetadata != nil {
+		rsp.CurrentMetadata = upnpav.EncodedDIDLLite{DIDLLite: *info.CurrentMetadata}
+	}
+	if info.NextMetadata != nil {
+		rsp.NextMetadata = upnpav.EncodedDIDLLite{DIDLLite: *info.NextMetadata}
+	}
+	return xml.Marshal(rsp)
+}
+func (h SOAPHandler) getPositionInfo(ctx context.Context, in []byte) ([]byte, error) {
+	req := getPositionInfoRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	info, err := h.Interface.PositionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getPositionInfoResponse{
+		CurrentTrack:  info.Track,
+		Duration:      upnpav.Duration{Duration: info.TrackDuration},
+		URI:           info.TrackURI,
+		RelativeTime:  upnpav.Duration{Duration: info.RelativeTime},
+		AbsoluteTime:  upnpav.Duration{Duration: info.AbsoluteTime},
+		RelativeCount: info.RelativeCount,
+		AbsoluteCount: info.AbsoluteCount,
+	}
+	if info.TrackMetadata != nil {
+		rsp.Metadata = upnpav.EncodedDIDLLite{DIDLLite: *info.TrackMetadata}
+	}
+	return xml.Marshal(rsp)
+}
+func (h SOAPHandler) getTransportInfo(ctx context.Context, in []byte) ([]byte, error) {
+	req := getTransportInfoRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	info, err := h.Interface.TransportInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getTransportInfoResponse{
+		State:  info.State,
+		Status: info.Status,
+		Speed:  info.Speed,
+	}
+	return xml.Marshal(rsp)
+}
+
+func (h SOAPHandler) play(ctx context.Context, in []byte) ([]byte, error) {
+	req := playRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+	if req.Speed != "1" {
+		return nil, ErrPlaySpeedNotSupported
+	}
+
+	if err := h.Interface.Play(ctx); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(playResponse{})
+}
+func (h SOAPHandler) pause(ctx context.Context, in []byte) ([]byte, error) {
+	req := pauseRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	if err := h.Interface.Pause(ctx); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(pauseResponse{})
+}
+func (h SOAPHandler) stop(ctx context.Context, in []byte) ([]byte, error) {
+	req := stopRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	if err := h.Interface.Stop(ctx); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(stopResponse{})
+}
+func (h SOAPHandler) seek(ctx context.Context, in []byte) ([]byte, error) {
+	req := seekRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	switch req.Unit {
+	case SeekTrack, SeekRelativeTime, SeekAbsoluteTime, SeekRelativeCount, SeekAbsoluteCount:
+	default:
+		return nil, ErrSeekModeNotSupported
+	}
+	target, err := ParseSeekTarget(req.Unit, req.Target)
+	if err != nil {
+		return nil, ErrIllegalSeekTarget
+	}
+
+	if err := h.Interface.Seek(ctx, target); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(seekResponse{})
+}
+func (h SOAPHandler) next(ctx context.Context, in []byte) ([]byte, error) {
+	req := nextRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	if err := h.Interface.Next(ctx); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(nextResponse{})
+}
+func (h SOAPHandler) previous(ctx context.Context, in []byte) ([]byte, error) {
+	req := previousRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	if err := h.Interface.Previous(ctx); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(previousResponse{})
+}
+
+func (h SOAPHandler) setPlayMode(ctx context.Context, in []byte) ([]byte, error) {
+	req := setPlayModeRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	if err := h.Interface.SetPlayMode(ctx, req.PlayMode); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(setPlayModeResponse{})
+}
+func (h SOAPHandler) getTransportSettings(ctx context.Context, in []byte) ([]byte, error) {
+	req := getTransportSettingsRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	settings, err := h.Interface.TransportSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getTransportSettingsResponse{
+		PlayMode:          settings.PlayMode,
+		RecordQualityMode: settings.RecordQualityMode,
+	}
+	return xml.Marshal(rsp)
+}
+func (h SOAPHandler) getDeviceCapabilities(ctx context.Context, in []byte) ([]byte, error) {
+	req := getDeviceCapabilitiesRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	capabilities, err := h.Interface.DeviceCapabilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getDeviceCapabilitiesResponse{
+		PlayMedia:          capabilities.PlayMedia,
+		RecordMedia:        capabilities.RecordMedia,
+		RecordQualityModes: capabilities.RecordQualityModes,
+	}
+	return xml.Marshal(rsp)
+}
+func (h SOAPHandler) getCurrentTransportActions(ctx context.Context, in []byte) ([]byte, error) {
+	req := getCurrentTransportActionsRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	actions, err := h.Interface.CurrentTransportActions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getCurrentTransportActionsResponse{}
+	for _, action := range actions {
+		rsp.Actions = append(rsp.Actions, string(action))
+	}
+	return xml.Marshal(rsp)
+}
+
+func unmarshal(ctx context.Context, in []byte, req interface{}) error {
+	if err := xml.Unmarshal(in, req); err != nil {
+		log, _ := logger.FromContext(ctx)
+		log.WithError(err).Warning("could not unmarshal request")
+		return upnpav.ErrInvalidArgs
+	}
+	return nil
+}
@@ -94,7 +94,7 @@ type (
 	}
 	getTransportSettingsResponse struct {
 		XMLName           xml.Name `xml:"urn:schemas-upnp-org:service:AVTransport:1 GetTransportSettingsResponse"`
-		PlayMode          string   `xml:"PlayMode"       scpd:"CurrentPlayMode,string,NORMAL|SHUFFLE|REPEAT_ONE|REPEAT_ALL|RANDOM|DIRECT_1|INTRO"`
+		PlayMode          PlayMode `xml:"PlayMode"       scpd:"CurrentPlayMode,string,NORMAL|SHUFFLE|REPEAT_ONE|REPEAT_ALL|RANDOM|DIRECT_1|INTRO"`
 		RecordQualityMode string   `xml:"RecQualityMode" scpd:"CurrentRecordQualityMode,string,0:EP|1:LP|2:SP|0:BASIC|1:MEDIUM|2:HIGH"`
 	}
 
@@ -160,7 +160,7 @@ type (
 	setPlayModeRequest struct {
 		XMLName    xml.Name `xml:"urn:schemas-upnp-org:service:AVTransport:1 SetPlayMode"`
 		InstanceID int      `xml:"InstanceID"  scpd:"A_ARG_TYPE_InstanceID,ui4"`
-		PlayMode   string   `xml:"NewPlayMode" scpd:"CurrentPlayMode,string,NORMAL|SHUFFLE|REPEAT_ONE|REPEAT_ALL|RANDOM|DIRECT_1|INTRO"`
+		PlayMode   PlayMode `xml:"NewPlayMode" scpd:"CurrentPlayMode,string,NORMAL|SHUFFLE|REPEAT_ONE|REPEAT_ALL|RANDOM|DIRECT_1|INTRO"`
 	}
 	setPlayModeResponse struct {
 		XMLName xml.Name `xml:"urn:schemas-upnp-org:service:AVTransport:1 SetPlayModeResponse"`
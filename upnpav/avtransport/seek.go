@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package avtransport
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethulhu/helix/upnpav"
+)
+
+// ParseSeekTarget parses the Target argument of Seek for a given Unit.
+func ParseSeekTarget(mode SeekMode, raw string) (SeekTarget, error) {
+	target := SeekTarget{Mode: mode}
+	switch mode {
+	case SeekRelativeTime, SeekAbsoluteTime:
+		d, err := upnpav.ParseDuration(raw)
+		if err != nil {
+			return SeekTarget{}, fmt.Errorf("invalid time %q: %w", raw, err)
+		}
+		target.Time = d.Duration
+	case SeekTrack:
+		track, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			return SeekTarget{}, fmt.Errorf("invalid track number %q: %w", raw, err)
+		}
+		target.Track = uint(track)
+	case SeekRelativeCount, SeekAbsoluteCount:
+		count, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return SeekTarget{}, fmt.Errorf("invalid count %q: %w", raw, err)
+		}
+		target.Count = int(count)
+	default:
+		return SeekTarget{}, fmt.Errorf("unsupported seek mode %q", mode)
+	}
+	return target, nil
+}
+
+// String returns the Target argument of Seek.
+func (t SeekTarget) String() string {
+	switch t.Mode {
+	case SeekRelativeTime, SeekAbsoluteTime:
+		return upnpav.Duration{Duration: t.Time}.String()
+	case SeekTrack:
+		return strconv.FormatUint(uint64(t.Track), 10)
+	case SeekRelativeCount, SeekAbsoluteCount:
+		return strconv.Itoa(t.Count)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package avtransport
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSeekTarget(t *testing.T) {
+	tests := []struct {
+		mode SeekMode
+		raw  string
+
+		want    SeekTarget
+		wantErr bool
+	}{
+		{
+			mode: SeekRelativeTime,
+			raw:  "0:01:30",
+			want: SeekTarget{Mode: SeekRelativeTime, Time: 90 * time.Second},
+		},
+		{
+			mode: SeekAbsoluteTime,
+			raw:  "1:00:00",
+			want: SeekTarget{Mode: SeekAbsoluteTime, Time: time.Hour},
+		},
+		{
+			mode: SeekTrack,
+			raw:  "3",
+			want: SeekTarget{Mode: SeekTrack, Track: 3},
+		},
+		{
+			mode: SeekAbsoluteCount,
+			raw:  "-12",
+			want: SeekTarget{Mode: SeekAbsoluteCount, Count: -12},
+		},
+		{
+			mode:    SeekTrack,
+			raw:     "-1",
+			wantErr: true,
+		},
+		{
+			mode:    SeekRelativeTime,
+			raw:     "soon",
+			wantErr: true,
+		},
+		{
+			mode:    SeekMode("FRAME"),
+			raw:     "12",
+			wantErr: true,
+		},
+	}
+
+	for i, tt := range tests {
+		got, err := ParseSeekTarget(tt.mode, tt.raw)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("[%d]: ParseSeekTarget(%q, %q) == %+v, want error", i, tt.mode, tt.raw, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("[%d]: ParseSeekTarget(%q, %q) returned error: %v", i, tt.mode, tt.raw, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("[%d]: ParseSeekTarget(%q, %q) == %+v, want %+v", i, tt.mode, tt.raw, got, tt.want)
+		}
+		if s := got.String(); s != tt.raw {
+			t.Errorf("[%d]: %+v.String() == %q, want %q", i, got, s, tt.raw)
+		}
+	}
+}
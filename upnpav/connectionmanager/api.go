@@ -13,10 +13,53 @@ import (
 )
 
 type (
+	// Interface is the UPnP ConnectionManager:1 interface.
 	Interface interface {
 		// ProtocolInfo lists the protocols that the device can send and receive, respectively.
 		ProtocolInfo(context.Context) ([]upnpav.ProtocolInfo, []upnpav.ProtocolInfo, error)
+
+		// PrepareForConnection creates a connection to send or receive media with a given ProtocolInfo.
+		// peerConnectionManager is the "UDN/ServiceID" of the ConnectionManager on the other end, if any.
+		PrepareForConnection(ctx context.Context, remote upnpav.ProtocolInfo, peerConnectionManager string, peerConnectionID int, direction Direction) (Connection, error)
+
+		// ConnectionComplete closes a connection created by PrepareForConnection.
+		ConnectionComplete(ctx context.Context, connectionID int) error
+
+		// CurrentConnectionIDs lists the IDs of the current connections.
+		CurrentConnectionIDs(context.Context) ([]int, error)
+
+		// CurrentConnectionInfo returns information about a current connection.
+		CurrentConnectionInfo(ctx context.Context, connectionID int) (ConnectionInfo, error)
+	}
+
+	// Connection is the response to PrepareForConnection.
+	// AVTransportID and RenderingControlID are -1 if the device does not have those services.
+	Connection struct {
+		ID                 int
+		AVTransportID      int
+		RenderingControlID int
 	}
+
+	// ConnectionInfo is the response to GetCurrentConnectionInfo.
+	ConnectionInfo struct {
+		AVTransportID      int
+		RenderingControlID int
+
+		// ProtocolInfo is empty for the default connection.
+		ProtocolInfo string
+
+		PeerConnectionManager string
+		PeerConnectionID      int
+
+		Direction Direction
+		Status    ConnectionStatus
+	}
+
+	// Direction is whether a connection sends or receives media.
+	Direction string
+
+	// ConnectionStatus is the health of a connection.
+	ConnectionStatus string
 )
 
 const (
@@ -25,6 +68,41 @@ const (
 	ServiceID = upnp.ServiceID("urn:upnp-org:serviceId:ConnectionManager")
 )
 
+const (
+	// DirectionInput is for devices that receive media, i.e. MediaRenderers.
+	DirectionInput = Direction("Input")
+	// DirectionOutput is for devices that send media, i.e. MediaServers.
+	DirectionOutput = Direction("Output")
+)
+
+const (
+	StatusOK                    = ConnectionStatus("OK")
+	StatusContentFormatMismatch = ConnectionStatus("ContentFormatMismatch")
+	StatusInsufficientBandwidth = ConnectionStatus("InsufficientBandwidth")
+	StatusUnreliableChannel     = ConnectionStatus("UnreliableChannel")
+	StatusUnknown               = ConnectionStatus("Unknown")
+)
+
+const (
+	// DefaultConnectionID is the connection that always exists, even without PrepareForConnection.
+	DefaultConnectionID = 0
+)
+
+var (
+	ErrIncompatibleProtocolInfo     = upnpav.Error{Code: 701, Description: "Incompatible protocol info"}
+	ErrIncompatibleDirections       = upnpav.Error{Code: 702, Description: "Incompatible directions"}
+	ErrInsufficientNetworkResources = upnpav.Error{Code: 703, Description: "Insufficient network resources"}
+	ErrLocalRestrictions            = upnpav.Error{Code: 704, Description: "Local restrictions"}
+	ErrAccessDenied                 = upnpav.Error{Code: 705, Description: "Access denied"}
+	ErrInvalidConnectionReference   = upnpav.Error{Code: 706, Description: "Invalid connection reference"}
+	ErrNotInNetwork                 = upnpav.Error{Code: 707, Description: "Not in network"}
+)
+
+// SCPD describes the actions that SOAPHandler supports.
 var SCPD = scpd.Must(scpd.Merge(
 	scpd.Must(scpd.FromAction(getProtocolInfo, getProtocolInfoRequest{}, getProtocolInfoResponse{})),
+	scpd.Must(scpd.FromAction(prepareForConnection, prepareForConnectionRequest{}, prepareForConnectionResponse{})),
+	scpd.Must(scpd.FromAction(connectionComplete, connectionCompleteRequest{}, connectionCompleteResponse{})),
+	scpd.Must(scpd.FromAction(getCurrentConnectionIDs, getCurrentConnectionIDsRequest{}, getCurrentConnectionIDsResponse{})),
+	scpd.Must(scpd.FromAction(getCurrentConnectionInfo, getCurrentConnectionInfoRequest{}, getCurrentConnectionInfoResponse{})),
 ))
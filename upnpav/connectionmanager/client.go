@@ -42,3 +42,51 @@ func (c *client) ProtocolInfo(ctx context.Context) ([]upnpav.ProtocolInfo, []upn
 	}
 	return rsp.Sources, rsp.Sinks, nil
 }
+
+func (c *client) PrepareForConnection(ctx context.Context, remote upnpav.ProtocolInfo, peerConnectionManager string, peerConnectionID int, direction Direction) (Connection, error) {
+	req := prepareForConnectionRequest{
+		RemoteProtocolInfo:    remote.String(),
+		PeerConnectionManager: peerConnectionManager,
+		PeerConnectionID:      peerConnectionID,
+		Direction:             direction,
+	}
+	rsp := prepareForConnectionResponse{}
+	if err := c.call(ctx, prepareForConnection, req, &rsp); err != nil {
+		return Connection{}, err
+	}
+	return Connection{
+		ID:                 rsp.ConnectionID,
+		AVTransportID:      rsp.AVTransportID,
+		RenderingControlID: rsp.RenderingControlID,
+	}, nil
+}
+func (c *client) ConnectionComplete(ctx context.Context, connectionID int) error {
+	req := connectionCompleteRequest{ConnectionID: connectionID}
+	rsp := connectionCompleteResponse{}
+	return c.call(ctx, connectionComplete, req, &rsp)
+}
+
+func (c *client) CurrentConnectionIDs(ctx context.Context) ([]int, error) {
+	req := getCurrentConnectionIDsRequest{}
+	rsp := getCurrentConnectionIDsResponse{}
+	if err := c.call(ctx, getCurrentConnectionIDs, req, &rsp); err != nil {
+		return nil, err
+	}
+	return rsp.ConnectionIDs, nil
+}
+func (c *client) CurrentConnectionInfo(ctx context.Context, connectionID int) (ConnectionInfo, error) {
+	req := getCurrentConnectionInfoRequest{ConnectionID: connectionID}
+	rsp := getCurrentConnectionInfoResponse{}
+	if err := c.call(ctx, getCurrentConnectionInfo, req, &rsp); err != nil {
+		return ConnectionInfo{}, err
+	}
+	return ConnectionInfo{
+		AVTransportID:         rsp.AVTransportID,
+		RenderingControlID:    rsp.RenderingControlID,
+		ProtocolInfo:          rsp.ProtocolInfo,
+		PeerConnectionManager: rsp.PeerConnectionManager,
+		PeerConnectionID:      rsp.PeerConnectionID,
+		Direction:             rsp.Direction,
+		Status:                rsp.Status,
+	}, nil
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package connectionmanager
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ethulhu/helix/upnpav"
+)
+
+func TestClientAndManager(t *testing.T) {
+	sources := []upnpav.ProtocolInfo{{
+		Protocol:       upnpav.ProtocolHTTP,
+		Network:        "*",
+		ContentFormat:  "audio/mpeg",
+		AdditionalInfo: "*",
+	}}
+	mp3 := upnpav.ProtocolInfo{Protocol: upnpav.ProtocolHTTP, Network: "*", ContentFormat: "audio/mpeg", AdditionalInfo: "*"}
+
+	ctx := context.Background()
+	client := NewClient(SOAPHandler{Interface: NewManager(DirectionOutput, sources, nil)})
+
+	gotSources, gotSinks, err := client.ProtocolInfo(ctx)
+	if err != nil {
+		t.Fatalf("ProtocolInfo(_) returned error: %v", err)
+	}
+	if !reflect.DeepEqual(gotSources, sources) || len(gotSinks) != 0 {
+		t.Errorf("ProtocolInfo(_) == %v, %v, want %v, []", gotSources, gotSinks, sources)
+	}
+
+	ids, err := client.CurrentConnectionIDs(ctx)
+	if err != nil {
+		t.Fatalf("CurrentConnectionIDs(_) returned error: %v", err)
+	}
+	if want := []int{DefaultConnectionID}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("CurrentConnectionIDs(_) == %v, want %v", ids, want)
+	}
+
+	info, err := client.CurrentConnectionInfo(ctx, DefaultConnectionID)
+	if err != nil {
+		t.Fatalf("CurrentConnectionInfo(_, 0) returned error: %v", err)
+	}
+	wantInfo := ConnectionInfo{
+		AVTransportID:      -1,
+		RenderingControlID: -1,
+		PeerConnectionID:   -1,
+		Direction:          DirectionOutput,
+		Status:             StatusUnknown,
+	}
+	if info != wantInfo {
+		t.Errorf("CurrentConnectionInfo(_, 0) == %+v, want %+v", info, wantInfo)
+	}
+
+	connection, err := client.PrepareForConnection(ctx, mp3, "uuid:peer/urn:upnp-org:serviceId:ConnectionManager", 3, DirectionOutput)
+	if err != nil {
+		t.Fatalf("PrepareForConnection(_, %v, ...) returned error: %v", mp3, err)
+	}
+	if want := (Connection{ID: 1, AVTransportID: -1, RenderingControlID: -1}); connection != want {
+		t.Errorf("PrepareForConnection(_, %v, ...) == %+v, want %+v", mp3, connection, want)
+	}
+
+	info, err = client.CurrentConnectionInfo(ctx, connection.ID)
+	if err != nil {
+		t.Fatalf("CurrentConnectionInfo(_, %d) returned error: %v", connection.ID, err)
+	}
+	wantInfo = ConnectionInfo{
+		AVTransportID:         -1,
+		RenderingControlID:    -1,
+		ProtocolInfo:          "http-get:*:audio/mpeg:*",
+		PeerConnectionManager: "uuid:peer/urn:upnp-org:serviceId:ConnectionManager",
+		PeerConnectionID:      3,
+		Direction:             DirectionOutput,
+		Status:                StatusOK,
+	}
+	if info != wantInfo {
+		t.Errorf("CurrentConnectionInfo(_, %d) == %+v, want %+v", connection.ID, info, wantInfo)
+	}
+
+	ids, err = client.CurrentConnectionIDs(ctx)
+	if err != nil {
+		t.Fatalf("CurrentConnectionIDs(_) returned error: %v", err)
+	}
+	if want := []int{DefaultConnectionID, connection.ID}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("CurrentConnectionIDs(_) == %v, want %v", ids, want)
+	}
+
+	if err := client.ConnectionComplete(ctx, connection.ID); err != nil {
+		t.Fatalf("ConnectionComplete(_, %d) returned error: %v", connection.ID, err)
+	}
+	ids, err = client.CurrentConnectionIDs(ctx)
+	if err != nil {
+		t.Fatalf("CurrentConnectionIDs(_) returned error: %v", err)
+	}
+	if want := []int{DefaultConnectionID}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("after ConnectionComplete, CurrentConnectionIDs(_) == %v, want %v", ids, want)
+	}
+}
+
+func TestManagerErrors(t *testing.T) {
+	sinks := []upnpav.ProtocolInfo{{
+		Protocol:       upnpav.ProtocolHTTP,
+		Network:        "*",
+		ContentFormat:  "audio/flac",
+		AdditionalInfo: "*",
+	}}
+	ctx := context.Background()
+	client := NewClient(SOAPHandler{Interface: NewManager(DirectionInput, nil, sinks)})
+
+	flac := upnpav.ProtocolInfo{Protocol: upnpav.ProtocolHTTP, Network: "*", ContentFormat: "audio/flac", AdditionalInfo: "*"}
+	video := upnpav.ProtocolInfo{Protocol: upnpav.ProtocolHTTP, Network: "*", ContentFormat: "video/mp4", AdditionalInfo: "*"}
+	rtsp := upnpav.ProtocolInfo{Protocol: upnpav.ProtocolRTSP, Network: "*", ContentFormat: "audio/flac", AdditionalInfo: "*"}
+
+	tests := []struct {
+		call    func() error
+		wantErr upnpav.Error
+	}{
+		{
+			call: func() error {
+				_, err := client.PrepareForConnection(ctx, video, "", -1, DirectionInput)
+				return err
+			},
+			wantErr: ErrIncompatibleProtocolInfo,
+		},
+		{
+			call: func() error {
+				_, err := client.PrepareForConnection(ctx, rtsp, "", -1, DirectionInput)
+				return err
+			},
+			wantErr: ErrIncompatibleProtocolInfo,
+		},
+		{
+			call: func() error {
+				_, err := client.PrepareForConnection(ctx, flac, "", -1, DirectionOutput)
+				return err
+			},
+			wantErr: ErrIncompatibleDirections,
+		},
+		{
+			call: func() error {
+				_, err := client.PrepareForConnection(ctx, flac, "", -1, Direction("Sideways"))
+				return err
+			},
+			wantErr: upnpav.ErrInvalidArgs,
+		},
+		{
+			call: func() error {
+				return client.ConnectionComplete(ctx, DefaultConnectionID)
+			},
+			wantErr: ErrInvalidConnectionReference,
+		},
+		{
+			call: func() error {
+				return client.ConnectionComplete(ctx, 12)
+			},
+			wantErr: ErrInvalidConnectionReference,
+		},
+		{
+			call: func() error {
+				_, err := client.CurrentConnectionInfo(ctx, 12)
+				return err
+			},
+			wantErr: ErrInvalidConnectionReference,
+		},
+	}
+
+	for i, tt := range tests {
+		err := tt.call()
+		var upnpErr upnpav.Error
+		if !errors.As(err, &upnpErr) || upnpErr.Code != tt.wantErr.Code {
+			t.Errorf("[%d]: got error %v, want %v", i, err, tt.wantErr)
+		}
+	}
+
+	connection, err := client.PrepareForConnection(ctx, flac, "", -1, DirectionInput)
+	if err != nil {
+		t.Fatalf("PrepareForConnection(_, %v, ...) returned error: %v", flac, err)
+	}
+	if want := (Connection{ID: 1, AVTransportID: 0, RenderingControlID: 0}); connection != want {
+		t.Errorf("PrepareForConnection(_, %v, ...) == %+v, want %+v", flac, connection, want)
+	}
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package connectionmanager
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/ethulhu/helix/logger"
+	"github.com/ethulhu/helix/upnpav"
+)
+
+type (
+	SOAPHandler struct {
+		Interface
+	}
+)
+
+func (h SOAPHandler) Call(ctx context.Context, namespace, action string, in []byte) ([]byte, error) {
+	if namespace != string(Version1) {
+		return nil, fmt.Errorf("invalid namespace")
+	}
+
+	switch action {
+	case getProtocolInfo:
+		return h.getProtocolInfo(ctx, in)
+	case prepareForConnection:
+		return h.prepareForConnection(ctx, in)
+	case connectionComplete:
+		return h.connectionComplete(ctx, in)
+	case getCurrentConnectionIDs:
+		return h.getCurrentConnectionIDs(ctx, in)
+	case getCurrentConnectionInfo:
+		return h.getCurrentConnectionInfo(ctx, in)
+	default:
+		return nil, upnpav.ErrInvalidAction
+	}
+}
+
+func (h SOAPHandler) getProtocolInfo(ctx context.Context, in []byte) ([]byte, error) {
+	req := getProtocolInfoRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+
+	sources, sinks, err := h.Interface.ProtocolInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getProtocolInfoResponse{
+		Sources: sources,
+		Sinks:   sinks,
+	}
+	return xml.Marshal(rsp)
+}
+
+func (h SOAPHandler) prepareForConnection(ctx context.Context, in []byte) ([]byte, error) {
+	req := prepareForConnectionRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	switch req.Direction {
+	case DirectionInput, DirectionOutput:
+	default:
+		return nil, upnpav.ErrInvalidArgs
+	}
+	remote, err := upnpav.ParseProtocolInfo(req.RemoteProtocolInfo)
+	if err != nil {
+		return nil, ErrIncompatibleProtocolInfo
+	}
+
+	connection, err := h.Interface.PrepareForConnection(ctx, remote, req.PeerConnectionManager, req.PeerConnectionID, req.Direction)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := prepareForConnectionResponse{
+		ConnectionID:       connection.ID,
+		AVTransportID:      connection.AVTransportID,
+		RenderingControlID: connection.RenderingControlID,
+	}
+	return xml.Marshal(rsp)
+}
+func (h SOAPHandler) connectionComplete(ctx context.Context, in []byte) ([]byte, error) {
+	req := connectionCompleteRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+
+	if err := h.Interface.ConnectionComplete(ctx, req.ConnectionID); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(connectionCompleteResponse{})
+}
+
+func (h SOAPHandler) getCurrentConnectionIDs(ctx context.Context, in []byte) ([]byte, error) {
+	req := getCurrentConnectionIDsRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+
+	ids, err := h.Interface.CurrentConnectionIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getCurrentConnectionIDsResponse{
+		ConnectionIDs: ids,
+	}
+	return xml.Marshal(rsp)
+}
+func (h SOAPHandler) getCurrentConnectionInfo(ctx context.Context, in []byte) ([]byte, error) {
+	req := getCurrentConnectionInfoRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+
+	info, err := h.Interface.CurrentConnectionInfo(ctx, req.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getCurrentConnectionInfoResponse{
+		RenderingControlID:    info.RenderingControlID,
+		AVTransportID:         info.AVTransportID,
+		ProtocolInfo:          info.ProtocolInfo,
+		PeerConnectionManager: info.PeerConnectionManager,
+		PeerConnectionID:      info.PeerConnectionID,
+		Direction:             info.Direction,
+		Status:                info.Status,
+	}
+	return xml.Marshal(rsp)
+}
+
+func unmarshal(ctx context.Context, in []byte, req interface{}) error {
+	if err := xml.Unmarshal(in, req); err != nil {
+		log, _ := logger.FromContext(ctx)
+		log.WithError(err).Warning("could not unmarshal request")
+		return upnpav.ErrInvalidArgs
+	}
+	return nil
+}
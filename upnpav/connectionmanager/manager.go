@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package connectionmanager
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/ethulhu/helix/upnpav"
+)
+
+type (
+	// Manager is an in-memory Interface, for devices that do not need to do anything special per connection.
+	Manager struct {
+		direction Direction
+		sources   []upnpav.ProtocolInfo
+		sinks     []upnpav.ProtocolInfo
+
+		mu          sync.Mutex
+		nextID      int
+		connections map[int]ConnectionInfo
+	}
+)
+
+var _ Interface = &Manager{}
+
+// NewManager returns a Manager for a device that sends (DirectionOutput) or receives (DirectionInput) media.
+// Connections are only prepared for ProtocolInfos matching sources or sinks, respectively.
+func NewManager(direction Direction, sources, sinks []upnpav.ProtocolInfo) *Manager {
+	// MediaServers have no AVTransport or RenderingControl, so they use -1.
+	// MediaRenderers have a single instance of each, 0.
+	serviceID := -1
+	if direction == DirectionInput {
+		serviceID = 0
+	}
+
+	return &Manager{
+		direction: direction,
+		sources:   sources,
+		sinks:     sinks,
+
+		nextID: DefaultConnectionID + 1,
+		connections: map[int]ConnectionInfo{
+			DefaultConnectionID: {
+				AVTransportID:      serviceID,
+				RenderingControlID: serviceID,
+				PeerConnectionID:   -1,
+				Direction:          direction,
+				Status:             StatusUnknown,
+			},
+		},
+	}
+}
+
+func (m *Manager) ProtocolInfo(_ context.Context) ([]upnpav.ProtocolInfo, []upnpav.ProtocolInfo, error) {
+	return m.sources, m.sinks, nil
+}
+
+// PrepareForConnection adds a connection, if remote matches one of the Manager's ProtocolInfos.
+// All connections share the default AVTransport and RenderingControl instances.
+func (m *Manager) PrepareForConnection(_ context.Context, remote upnpav.ProtocolInfo, peerConnectionManager string, peerConnectionID int, direction Direction) (Connection, error) {
+	if direction != m.direction {
+		return Connection{}, ErrIncompatibleDirections
+	}
+
+	supported := m.sources
+	if m.direction == DirectionInput {
+		supported = m.sinks
+	}
+	if !matchesAny(remote, supported) {
+		return Connection{}, ErrIncompatibleProtocolInfo
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	defaultConnection := m.connections[DefaultConnectionID]
+
+	id := m.nextID
+	m.nextID++
+	m.connections[id] = ConnectionInfo{
+		AVTransportID:         defaultConnection.AVTransportID,
+		RenderingControlID:    defaultConnection.RenderingControlID,
+		ProtocolInfo:          remote.String(),
+		PeerConnectionManager: peerConnectionManager,
+		PeerConnectionID:      peerConnectionID,
+		Direction:             direction,
+		Status:                StatusOK,
+	}
+
+	return Connection{
+		ID:                 id,
+		AVTransportID:      defaultConnection.AVTransportID,
+		RenderingControlID: defaultConnection.RenderingControlID,
+	}, nil
+}
+
+// ConnectionComplete removes a connection. The default connection cannot be removed.
+func (m *Manager) ConnectionComplete(_ context.Context, connectionID int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.connections[connectionID]; !ok || connectionID == DefaultConnectionID {
+		return ErrInvalidConnectionReference
+	}
+	delete(m.connections, connectionID)
+	return nil
+}
+
+func (m *Manager) CurrentConnectionIDs(_ context.Context) ([]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var ids []int
+	for id := range m.connections {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids, nil
+}
+
+func (m *Manager) CurrentConnectionInfo(_ context.Context, connectionID int) (ConnectionInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	info, ok := m.connections[connectionID]
+	if !ok {
+		return ConnectionInfo{}, ErrInvalidConnectionReference
+	}
+	return info, nil
+}
+
+func matchesAny(remote upnpav.ProtocolInfo, protocolInfos []upnpav.ProtocolInfo) bool {
+	for _, p := range protocolInfos {
+		if remote.Matches(p) {
+			return true
+		}
+	}
+	return false
+}
@@ -16,9 +16,6 @@ import (
 type (
 	commaSeparatedProtocolInfos []upnpav.ProtocolInfo
 
-	direction string
-	status    string
-
 	getProtocolInfoRequest struct {
 		XMLName xml.Name `xml:"urn:schemas-upnp-org:service:ConnectionManager:1 GetProtocolInfo"`
 	}
@@ -33,13 +30,13 @@ type (
 		RemoteProtocolInfo    string    `xml:"RemoteProtocolInfo"    scpd:"A_ARG_TYPE_ProtocolInfo,string"`
 		PeerConnectionManager string    `xml:"PeerConnectionManager" scpd:"A_ARG_TYPE_ConnectionManager,string"`
 		PeerConnectionID      int       `xml:"PeerConnectionID"      scpd:"A_ARG_TYPE_ConnectionID,i4"`
-		Direction             direction `xml:"Direction"             scpd:"A_ARG_TYPE_Direction,string,Input|Output"`
+		Direction             Direction `xml:"Direction"             scpd:"A_ARG_TYPE_Direction,string,Input|Output"`
 	}
 	prepareForConnectionResponse struct {
-		XMLName       xml.Name `xml:"urn:schemas-upnp-org:service:ConnectionManager:1 PrepareForConnectionResponse"`
-		ConnectionID  int      `xml:"ConnectionID"  scpd:"A_ARG_TYPE_ConnectionID,i4"`
-		AVTransportID int      `xml:"AVTransportID" scpd:"A_ARG_TYPE_AVTransportID,i4"`
-		ResID         int      `xml:"ResID"         scpd:"A_ARG_TYPE_ResID,i4"`
+		XMLName            xml.Name `xml:"urn:schemas-upnp-org:service:ConnectionManager:1 PrepareForConnectionResponse"`
+		ConnectionID       int      `xml:"ConnectionID"  scpd:"A_ARG_TYPE_ConnectionID,i4"`
+		AVTransportID      int      `xml:"AVTransportID" scpd:"A_ARG_TYPE_AVTransportID,i4"`
+		RenderingControlID int      `xml:"RcsID"         scpd:"A_ARG_TYPE_RcsID,i4"`
 	}
 
 	connectionCompleteRequest struct {
@@ -63,30 +60,17 @@ type (
 		ConnectionID int      `xml:"ConnectionID" scpd:"A_ARG_TYPE_ConnectionID,i4"`
 	}
 	getCurrentConnectionInfoResponse struct {
-		XMLName               xml.Name  `xml:"urn:schemas-upnp-org:service:ConnectionManager:1 GetCurrentConnectionInfoResponse"`
-		AVTransportID         int       `xml:"AVTransportID"         scpd:"A_ARG_TYPE_AVTransportID,i4"`
-		ResID                 int       `xml:"ResID"                 scpd:"A_ARG_TYPE_ResID,i4"`
-		ProtocolInfo          string    `xml:"ProtocolInfo"          scpd:"A_ARG_TYPE_ProtocolInfo,string"`
-		PeerConnecitonManager string    `xml:"PeerConnecitonManager" scpd:"A_ARG_TYPE_ConnectionManager,string"`
-		PeerConnecitonID      int       `xml:"PeerConnecitonID"      scpd:"A_ARG_TYPE_ConnectionID,i4"`
-		Direction             direction `xml:"Direction"             scpd:"A_ARG_TYPE_Direction,string,Input|Output"`
-		Status                status    `xml:"Status"                scpd:"A_ARG_TYPE_ConnectionStatus,string,OK|ContentFormatMismatch|InsufficientBandwidth|UnreliableChannel|Unknown"`
+		XMLName               xml.Name         `xml:"urn:schemas-upnp-org:service:ConnectionManager:1 GetCurrentConnectionInfoResponse"`
+		RenderingControlID    int              `xml:"RcsID"                 scpd:"A_ARG_TYPE_RcsID,i4"`
+		AVTransportID         int              `xml:"AVTransportID"         scpd:"A_ARG_TYPE_AVTransportID,i4"`
+		ProtocolInfo          string           `xml:"ProtocolInfo"          scpd:"A_ARG_TYPE_ProtocolInfo,string"`
+		PeerConnectionManager string           `xml:"PeerConnectionManager" scpd:"A_ARG_TYPE_ConnectionManager,string"`
+		PeerConnectionID      int              `xml:"PeerConnectionID"      scpd:"A_ARG_TYPE_ConnectionID,i4"`
+		Direction             Direction        `xml:"Direction"             scpd:"A_ARG_TYPE_Direction,string,Input|Output"`
+		Status                ConnectionStatus `xml:"Status"                scpd:"A_ARG_TYPE_ConnectionStatus,string,OK|ContentFormatMismatch|InsufficientBandwidth|UnreliableChannel|Unknown"`
 	}
 )
 
-const (
-	input  = direction("Input")
-	output = direction("Output")
-)
-
-const (
-	ok                    = status("OK")
-	contentFormatMismatch = status("ContentFormatMismatch")
-	insufficientBandwidth = status("InsufficientBandwidth")
-	unreliableChannel     = status("UnreliableChannel")
-	unknown               = status("Unknown")
-)
-
 const (
 	getProtocolInfo          = "GetProtocolInfo"
 	prepareForConnection     = "PrepareForConnection"
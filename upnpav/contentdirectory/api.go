@@ -27,11 +27,41 @@ type (
 		// BrowseChildren lists the child objects of a given object.
 		BrowseChildren(context.Context, upnpav.ObjectID) (*upnpav.DIDLLite, error)
 
+		// BrowseChildrenPage lists a page of the child objects of a given object.
+		BrowseChildrenPage(context.Context, upnpav.ObjectID, Page) (Result, error)
+
 		// Search queries the ContentDirectory service for objects under a given object that match a given criteria.
 		Search(context.Context, upnpav.ObjectID, search.Criteria) (*upnpav.DIDLLite, error)
 
+		// SearchPage is like Search, but only returns a page of the matching objects.
+		SearchPage(context.Context, upnpav.ObjectID, search.Criteria, Page) (Result, error)
+
 		SystemUpdateID(ctx context.Context) (uint, error)
 	}
+
+	// Page selects a window of the objects returned by Browse or Search.
+	Page struct {
+		// StartingIndex is the zero-based offset of the first object to return.
+		StartingIndex uint
+
+		// RequestedCount is the maximum number of objects to return.
+		// A RequestedCount of 0 requests all objects after StartingIndex.
+		RequestedCount uint
+	}
+
+	// Result is a page of the objects returned by Browse or Search.
+	Result struct {
+		DIDLLite *upnpav.DIDLLite
+
+		// NumberReturned is the number of objects in DIDLLite.
+		NumberReturned uint
+
+		// TotalMatches is the number of objects across all pages, or 0 if unknown.
+		TotalMatches uint
+
+		// UpdateID is the SystemUpdateID or ContainerUpdateID at the time of the request.
+		UpdateID uint
+	}
 )
 
 const (
@@ -68,10 +98,16 @@ var (
 	ErrCannotProcessRequest            = upnpav.Error{Code: 720, Description: "Cannot process the request"}
 )
 
-var SCPD = scpd.Must(scpd.Merge(
-	scpd.Must(scpd.FromAction(browse, browseRequest{}, browseResponse{})),
-	scpd.Must(scpd.FromAction(getSearchCapabilities, getSearchCapabilitiesRequest{}, getSearchCapabilitiesResponse{})),
-	scpd.Must(scpd.FromAction(getSortCapabilities, getSortCapabilitiesRequest{}, getSortCapabilitiesResponse{})),
-	scpd.Must(scpd.FromAction(searchA, searchRequest{}, searchResponse{})),
-	scpd.Must(scpd.FromAction(getSystemUpdateID, getSystemUpdateIDRequest{}, getSystemUpdateIDResponse{})),
+// SCPD describes the actions that SOAPHandler supports.
+// SystemUpdateID and ContainerUpdateIDs are evented by UpdateIDs.
+var SCPD = scpd.Must(scpd.WithEvents(
+	scpd.Must(scpd.Merge(
+		scpd.Must(scpd.FromAction(browse, browseRequest{}, browseResponse{})),
+		scpd.Must(scpd.FromAction(getSearchCapabilities, getSearchCapabilitiesRequest{}, getSearchCapabilitiesResponse{})),
+		scpd.Must(scpd.FromAction(getSortCapabilities, getSortCapabilitiesRequest{}, getSortCapabilitiesResponse{})),
+		scpd.Must(scpd.FromAction(searchA, searchRequest{}, searchResponse{})),
+		scpd.Must(scpd.FromAction(getSystemUpdateID, getSystemUpdateIDRequest{}, getSystemUpdateIDResponse{})),
+	)),
+	scpd.StateVariable{Name: SystemUpdateIDVariableName, DataType: "ui4"},
+	scpd.StateVariable{Name: ContainerUpdateIDsVariableName, DataType: "string"},
 ))
@@ -62,35 +62,82 @@ func (c *client) SystemUpdateID(ctx context.Context) (uint, error) {
 }
 
 func (c *client) BrowseMetadata(ctx context.Context, object upnpav.ObjectID) (*upnpav.DIDLLite, error) {
-	return c.browse(ctx, browseMetadata, object)
+	result, err := c.browse(ctx, browseMetadata, object, Page{})
+	return result.DIDLLite, err
 }
 func (c *client) BrowseChildren(ctx context.Context, object upnpav.ObjectID) (*upnpav.DIDLLite, error) {
-	return c.browse(ctx, browseChildren, object)
+	return allPages(func(page Page) (Result, error) {
+		return c.BrowseChildrenPage(ctx, object, page)
+	})
 }
-func (c *client) browse(ctx context.Context, bf browseFlag, object upnpav.ObjectID) (*upnpav.DIDLLite, error) {
+func (c *client) BrowseChildrenPage(ctx context.Context, object upnpav.ObjectID, page Page) (Result, error) {
+	return c.browse(ctx, browseChildren, object, page)
+}
+func (c *client) browse(ctx context.Context, bf browseFlag, object upnpav.ObjectID, page Page) (Result, error) {
 	req := browseRequest{
-		Object:     object,
-		BrowseFlag: bf,
-		Filter:     xmltypes.CommaSeparatedStrings{"*"},
+		Object:         object,
+		BrowseFlag:     bf,
+		Filter:         xmltypes.CommaSeparatedStrings{"*"},
+		StartingIndex:  page.StartingIndex,
+		RequestedCount: page.RequestedCount,
 	}
 
 	rsp := browseResponse{}
 	if err := c.call(ctx, browse, req, &rsp); err != nil {
-		return nil, fmt.Errorf("could not perform Browse request: %w", err)
+		return Result{}, fmt.Errorf("could not perform Browse request: %w", err)
 	}
-	return &rsp.Result.DIDLLite, nil
+	return Result{
+		DIDLLite:       &rsp.Result.DIDLLite,
+		NumberReturned: rsp.NumberReturned,
+		TotalMatches:   rsp.TotalMatches,
+		UpdateID:       rsp.UpdateID,
+	}, nil
 }
 
 func (c *client) Search(ctx context.Context, container upnpav.ObjectID, criteria search.Criteria) (*upnpav.DIDLLite, error) {
+	return allPages(func(page Page) (Result, error) {
+		return c.SearchPage(ctx, container, criteria, page)
+	})
+}
+func (c *client) SearchPage(ctx context.Context, container upnpav.ObjectID, criteria search.Criteria, page Page) (Result, error) {
 	req := searchRequest{
 		Container:      container,
 		Filter:         xmltypes.CommaSeparatedStrings{"*"},
 		SearchCriteria: criteria.String(),
+		StartingIndex:  page.StartingIndex,
+		RequestedCount: page.RequestedCount,
 	}
 
 	rsp := searchResponse{}
-	if err := c.call(ctx, "Search", req, &rsp); err != nil {
-		return nil, fmt.Errorf("could not perform Search request: %w", err)
+	if err := c.call(ctx, searchA, req, &rsp); err != nil {
+		return Result{}, fmt.Errorf("could not perform Search request: %w", err)
+	}
+	return Result{
+		DIDLLite:       &rsp.Result.DIDLLite,
+		NumberReturned: rsp.NumberReturned,
+		TotalMatches:   rsp.TotalMatches,
+		UpdateID:       rsp.UpdateID,
+	}, nil
+}
+
+// allPages requests pages until it has all the objects.
+// Some servers return fewer objects than requested even when asked for all of them.
+func allPages(getPage func(Page) (Result, error)) (*upnpav.DIDLLite, error) {
+	didllite := &upnpav.DIDLLite{}
+	page := Page{}
+	for {
+		result, err := getPage(page)
+		if err != nil {
+			return nil, err
+		}
+		if result.DIDLLite != nil {
+			didllite.Containers = append(didllite.Containers, result.DIDLLite.Containers...)
+			didllite.Items = append(didllite.Items, result.DIDLLite.Items...)
+		}
+
+		page.StartingIndex += result.NumberReturned
+		if result.NumberReturned == 0 || page.StartingIndex >= result.TotalMatches {
+			return didllite, nil
+		}
 	}
-	return &rsp.Result.DIDLLite, nil
 }
@@ -6,6 +6,7 @@ package contentdirectory
 
 import (
 	"context"
+	"encoding/xml"
 	"fmt"
 	"reflect"
 	"testing"
@@ -29,9 +30,13 @@ func TestClientAndHandler(t *testing.T) {
 
 		browseChildrenObject: upnpav.ObjectID("thirteen"),
 		browseChildrenDIDLLite: &upnpav.DIDLLite{
-			Items: []upnpav.Item{{
-				Creator: "foo",
+			Containers: []upnpav.Container{{
+				ID: "bar",
 			}},
+			Items: []upnpav.Item{
+				{ID: "foo", Creator: "foo"},
+				{ID: "baz", Creator: "baz"},
+			},
 		},
 
 		searchObject:   upnpav.ObjectID("fourteen"),
@@ -85,6 +90,34 @@ func TestClientAndHandler(t *testing.T) {
 		t.Fatalf("BrowseChildren(_, %q) == %v, want %v", fh.browseChildrenObject, browseChildrenDIDLLite, fh.browseChildrenDIDLLite)
 	}
 
+	browseChildrenPage := Page{StartingIndex: 1, RequestedCount: 1}
+	browseChildrenResult, err := client.BrowseChildrenPage(nil, fh.browseChildrenObject, browseChildrenPage)
+	if err != nil {
+		t.Fatalf("BrowseChildrenPage(_, %q, %+v) returned error: %v", fh.browseChildrenObject, browseChildrenPage, err)
+	}
+	wantBrowseChildrenResult := Result{
+		DIDLLite: &upnpav.DIDLLite{
+			Items: fh.browseChildrenDIDLLite.Items[:1],
+		},
+		NumberReturned: 1,
+		TotalMatches:   3,
+		UpdateID:       fh.systemUpdateID,
+	}
+	if !reflect.DeepEqual(browseChildrenResult, wantBrowseChildrenResult) {
+		t.Fatalf("BrowseChildrenPage(_, %q, %+v) == %+v, want %+v", fh.browseChildrenObject, browseChildrenPage, browseChildrenResult, wantBrowseChildrenResult)
+	}
+
+	// BrowseChildren must get all of the pages from servers that limit the page size.
+	fh.maxPageSize = 1
+	browseChildrenDIDLLite, err = client.BrowseChildren(nil, fh.browseChildrenObject)
+	if err != nil {
+		t.Fatalf("BrowseChildren(_, %q) with a maximum page size returned error: %v", fh.browseChildrenObject, err)
+	}
+	if !reflect.DeepEqual(browseChildrenDIDLLite, fh.browseChildrenDIDLLite) {
+		t.Fatalf("BrowseChildren(_, %q) with a maximum page size == %v, want %v", fh.browseChildrenObject, browseChildrenDIDLLite, fh.browseChildrenDIDLLite)
+	}
+	fh.maxPageSize = 0
+
 	searchDIDLLite, err := client.Search(nil, fh.searchObject, fh.searchCriteria)
 	if err != nil {
 		t.Fatalf("Search(_, %q, %q) returned error: %v", fh.searchObject, fh.searchCriteria, err)
@@ -95,6 +128,91 @@ func TestClientAndHandler(t *testing.T) {
 
 }
 
+func TestHandlerSortCriteria(t *testing.T) {
+	fh := fakeHandler{
+		sortCapabilities: []string{"dc:title"},
+
+		browseChildrenObject: upnpav.ObjectID("thirteen"),
+		browseChildrenDIDLLite: &upnpav.DIDLLite{
+			Items: []upnpav.Item{
+				{ID: "1", Title: "Charlie"},
+				{ID: "2", Title: "Alpha"},
+				{ID: "3", Title: "Bravo"},
+			},
+		},
+	}
+	handler := SOAPHandler{&fh}
+
+	tests := []struct {
+		req     browseRequest
+		want    []upnpav.ObjectID
+		wantErr error
+	}{
+		{
+			req: browseRequest{
+				Object:       fh.browseChildrenObject,
+				BrowseFlag:   browseChildren,
+				SortCriteria: []string{"+dc:title"},
+			},
+			want: []upnpav.ObjectID{"2", "3", "1"},
+		},
+		{
+			req: browseRequest{
+				Object:         fh.browseChildrenObject,
+				BrowseFlag:     browseChildren,
+				SortCriteria:   []string{"-dc:title"},
+				StartingIndex:  1,
+				RequestedCount: 1,
+			},
+			want: []upnpav.ObjectID{"3"},
+		},
+		{
+			req: browseRequest{
+				Object:       fh.browseChildrenObject,
+				BrowseFlag:   browseChildren,
+				SortCriteria: []string{"+dc:date"},
+			},
+			wantErr: ErrInvalidSortCriteria,
+		},
+		{
+			req: browseRequest{
+				Object:       fh.browseChildrenObject,
+				BrowseFlag:   browseChildren,
+				SortCriteria: []string{"dc:title"},
+			},
+			wantErr: ErrInvalidSortCriteria,
+		},
+	}
+
+	for i, tt := range tests {
+		in, err := xml.Marshal(tt.req)
+		if err != nil {
+			t.Fatalf("[%d]: could not marshal request: %v", i, err)
+		}
+
+		out, err := handler.Call(context.Background(), string(Version1), browse, in)
+		if err != tt.wantErr {
+			t.Errorf("[%d]: got error %v, want %v", i, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		rsp := browseResponse{}
+		if err := xml.Unmarshal(out, &rsp); err != nil {
+			t.Fatalf("[%d]: could not unmarshal response: %v", i, err)
+		}
+		var got []upnpav.ObjectID
+		for _, item := range rsp.Result.Items {
+			got = append(got, item.ID)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: got %v, want %v", i, got, tt.want)
+		}
+	}
+}
+
 type fakeHandler struct {
 	searchCapabilities []string
 	sortCapabilities   []string
@@ -109,6 +227,9 @@ type fakeHandler struct {
 	searchObject   upnpav.ObjectID
 	searchCriteria search.Criteria
 	searchDIDLLite *upnpav.DIDLLite
+
+	// maxPageSize limits the number of objects returned, like some real servers.
+	maxPageSize uint
 }
 
 func (f *fakeHandler) SearchCapabilities(_ context.Context) ([]string, error) {
@@ -132,6 +253,28 @@ func (f *fakeHandler) BrowseChildren(_ context.Context, id upnpav.ObjectID) (*up
 	}
 	return f.browseChildrenDIDLLite, nil
 }
+func (f *fakeHandler) BrowseChildrenPage(ctx context.Context, id upnpav.ObjectID, page Page) (Result, error) {
+	didllite, err := f.BrowseChildren(ctx, id)
+	if err != nil {
+		return Result{}, err
+	}
+	return f.paginate(didllite, page), nil
+}
+func (f *fakeHandler) SearchPage(ctx context.Context, id upnpav.ObjectID, criteria search.Criteria, page Page) (Result, error) {
+	didllite, err := f.Search(ctx, id, criteria)
+	if err != nil {
+		return Result{}, err
+	}
+	return f.paginate(didllite, page), nil
+}
+func (f *fakeHandler) paginate(didllite *upnpav.DIDLLite, page Page) Result {
+	if f.maxPageSize != 0 && (page.RequestedCount == 0 || page.RequestedCount > f.maxPageSize) {
+		page.RequestedCount = f.maxPageSize
+	}
+	result := Paginate(didllite, page)
+	result.UpdateID = f.systemUpdateID
+	return result
+}
 func (f *fakeHandler) Search(_ context.Context, id upnpav.ObjectID, criteria search.Criteria) (*upnpav.DIDLLite, error) {
 	if id != f.searchObject {
 		return nil, fmt.Errorf("id == %v", id)
@@ -15,12 +15,13 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
-	"time"
+	"sync"
 
 	"github.com/ethulhu/helix/media"
 	"github.com/ethulhu/helix/upnpav"
 	"github.com/ethulhu/helix/upnpav/contentdirectory"
 	"github.com/ethulhu/helix/upnpav/contentdirectory/search"
+	"github.com/ethulhu/helix/upnpav/mediaserver"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -30,11 +31,48 @@ type (
 		basePath string
 		baseURL  *url.URL
 
-		metadataCache media.MetadataCache
+		metadataCache     media.MetadataCache
+		transcodeProfiles []media.TranscodeProfile
+		imageProfiles     []media.ImageProfile
+
+		updateIDs *contentdirectory.UpdateIDs
+
+		// views are listed by the root, unless they are only the Folders view.
+		views     []View
+		foldersID upnpav.ObjectID
+
+		// photos is whether images are served as Photo items, rather than only as cover art.
+		photos bool
+
+		// lib is the most recently built library, and build is the library being built, if any.
+		mu    sync.Mutex
+		lib   *library
+		build *libraryBuild
+	}
+
+	// ContentDirectoryOptions configure a ContentDirectory.
+	ContentDirectoryOptions struct {
+		// MetadataCache describes the media files.
+		MetadataCache media.MetadataCache
+
+		// Audio items also have a Resource for each of TranscodeProfiles, served by mediaserver.NewFileHandler.
+		TranscodeProfiles []media.TranscodeProfile
+		// Album art, including art embedded in media files, and photos also have a URI for each of ImageProfiles, served by the same.
+		ImageProfiles []media.ImageProfile
+
+		// UpdateIDs are the SystemUpdateID and ContainerUpdateIDs, which should be updated with ChangedContainers.
+		UpdateIDs *contentdirectory.UpdateIDs
+
+		// The root lists each of Views, e.g. Albums and Folders, or is the directory tree if Views is empty or only FoldersView.
+		Views []View
+		// If Photos, images are served as Photo items, and directories of images as PhotoAlbums.
+		Photos bool
 	}
 )
 
-func NewContentDirectory(basePath, baseURL string, metadataCache media.MetadataCache) (contentdirectory.Interface, error) {
+// NewContentDirectory returns a ContentDirectory of the media under basePath, served from baseURL.
+// The MetadataCache and UpdateIDs of options are required.
+func NewContentDirectory(basePath, baseURL string, options ContentDirectoryOptions) (contentdirectory.Interface, error) {
 	maybeURL, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse base URL: %w", err)
@@ -45,37 +83,71 @@ func NewContentDirectory(basePath, baseURL string, metadataCache media.MetadataC
 		return nil, fmt.Errorf("could not get absolute path: %w", err)
 	}
 
-	go func() {
-		fields := log.Fields{"path": absPath}
-		log.WithFields(fields).Info("warming metadata cache")
+	cd := &contentDirectory{
+		basePath: absPath,
+		baseURL:  maybeURL,
 
-		start := time.Now()
-		metadataCache.Warm(absPath)
-		fields["duration"] = time.Since(start)
+		metadataCache:     options.MetadataCache,
+		transcodeProfiles: options.TranscodeProfiles,
+		imageProfiles:     options.ImageProfiles,
 
-		log.WithFields(fields).Info("finished warming metadata cache")
-	}()
+		updateIDs: options.UpdateIDs,
 
-	return &contentDirectory{
-		basePath: absPath,
-		baseURL:  maybeURL,
+		views:     options.Views,
+		foldersID: foldersRootID(options.Views),
 
-		metadataCache: metadataCache,
-	}, nil
+		photos: options.Photos,
+	}
+	if !foldersOnly(options.Views) {
+		// Build the library for the views in the background, so that it is ready by the time it is browsed.
+		cd.refreshLibrary()
+	}
+	return cd, nil
 }
 
-func (cd *contentDirectory) BrowseMetadata(_ context.Context, id upnpav.ObjectID) (*upnpav.DIDLLite, error) {
+func (cd *contentDirectory) BrowseMetadata(ctx context.Context, id upnpav.ObjectID) (*upnpav.DIDLLite, error) {
 	fields := log.Fields{
 		"method": "BrowseMetadata",
 		"object": id,
 	}
 
-	p, ok := pathForObjectID(cd.basePath, id)
+	if id == contentdirectory.Root && cd.foldersID != contentdirectory.Root {
+		return &upnpav.DIDLLite{Containers: []upnpav.Container{cd.rootContainer()}}, nil
+	}
+	if vid, ok := cd.parseVirtualID(id); ok && vid.view != FoldersView {
+		didllite, ok, err := cd.browseVirtualMetadata(ctx, vid)
+		if err != nil {
+			fields["error"] = err
+			log.WithFields(fields).Warning("could not build views")
+			return nil, upnpav.ErrActionFailed
+		}
+		if !ok {
+			log.WithFields(fields).Info("object does not exist")
+			return nil, contentdirectory.ErrNoSuchObject
+		}
+		return didllite, nil
+	}
+
+	p, ok := cd.pathForObjectID(id)
 	if !ok {
 		log.WithFields(fields).Error("bad path")
 		return nil, contentdirectory.ErrNoSuchObject
 	}
 
+	if playlist, name, ok := splitPlaylistPath(p); ok {
+		didllite, ok, err := cd.playlistChild(playlist, name)
+		if err != nil {
+			fields["error"] = err
+			log.WithFields(fields).Warning("could not describe playlist entry")
+			return nil, upnpav.ErrActionFailed
+		}
+		if !ok {
+			log.WithFields(fields).Info("playlist entry does not exist")
+			return nil, contentdirectory.ErrNoSuchObject
+		}
+		return didllite, nil
+	}
+
 	fi, err := os.Stat(p)
 	if errors.Is(err, os.ErrNotExist) {
 		log.WithFields(fields).Info("path does not exist")
@@ -87,7 +159,7 @@ func (cd *contentDirectory) BrowseMetadata(_ context.Context, id upnpav.ObjectID
 		return nil, upnpav.ErrActionFailed
 	}
 
-	if fi.IsDir() {
+	if fi.IsDir() || media.IsPlaylist(p) {
 		container, err := cd.containerFromPath(p)
 		if err != nil {
 			fields["error"] = err
@@ -97,6 +169,16 @@ func (cd *contentDirectory) BrowseMetadata(_ context.Context, id upnpav.ObjectID
 		return &upnpav.DIDLLite{Containers: []upnpav.Container{container}}, nil
 	}
 
+	if cd.photos && media.IsImage(p) {
+		item, err := cd.photoItem(p)
+		if err != nil {
+			fields["error"] = err
+			log.WithFields(fields).Warning("could not describe photo from path")
+			return nil, upnpav.ErrActionFailed
+		}
+		return &upnpav.DIDLLite{Items: []upnpav.Item{item}}, nil
+	}
+
 	if !media.IsAudioOrVideo(p) {
 		log.WithFields(fields).Warning("item exists but is not a media item")
 		return nil, contentdirectory.ErrNoSuchObject
@@ -111,32 +193,80 @@ func (cd *contentDirectory) BrowseMetadata(_ context.Context, id upnpav.ObjectID
 	return &upnpav.DIDLLite{Items: items}, nil
 }
 
-func (cd *contentDirectory) BrowseChildren(_ context.Context, parent upnpav.ObjectID) (*upnpav.DIDLLite, error) {
+func (cd *contentDirectory) BrowseChildren(ctx context.Context, parent upnpav.ObjectID) (*upnpav.DIDLLite, error) {
+	result, err := cd.BrowseChildrenPage(ctx, parent, contentdirectory.Page{})
+	return result.DIDLLite, err
+}
+func (cd *contentDirectory) BrowseChildrenPage(ctx context.Context, parent upnpav.ObjectID, page contentdirectory.Page) (contentdirectory.Result, error) {
 	fields := log.Fields{
 		"method": "BrowseChildren",
 		"object": parent,
+		"page":   page,
 	}
 
-	p, ok := pathForObjectID(cd.basePath, parent)
+	if parent == contentdirectory.Root && cd.foldersID != contentdirectory.Root {
+		containers, err := cd.viewContainers()
+		if err != nil {
+			fields["error"] = err
+			log.WithFields(fields).Warning("could not build views")
+			return contentdirectory.Result{}, upnpav.ErrActionFailed
+		}
+		result := contentdirectory.Paginate(&upnpav.DIDLLite{Containers: containers}, page)
+		result.UpdateID = cd.updateIDs.ContainerUpdateID(parent)
+		return result, nil
+	}
+	if vid, ok := cd.parseVirtualID(parent); ok && vid.view != FoldersView {
+		if vid.isItem() {
+			log.WithFields(fields).Info("not a container")
+			return contentdirectory.Result{}, nil
+		}
+		didllite, err := cd.browseVirtualChildren(ctx, vid)
+		if err != nil {
+			fields["error"] = err
+			log.WithFields(fields).Warning("could not build views")
+			return contentdirectory.Result{}, upnpav.ErrActionFailed
+		}
+		result := contentdirectory.Paginate(didllite, page)
+		result.UpdateID = cd.updateIDs.ContainerUpdateID(parent)
+		return result, nil
+	}
+
+	p, ok := cd.pathForObjectID(parent)
 	if !ok {
 		log.WithFields(fields).Error("bad path")
-		return nil, contentdirectory.ErrNoSuchObject
+		return contentdirectory.Result{}, contentdirectory.ErrNoSuchObject
+	}
+
+	if _, _, ok := splitPlaylistPath(p); ok {
+		log.WithFields(fields).Info("not a container")
+		return contentdirectory.Result{}, nil
 	}
 
 	fi, err := os.Stat(p)
 	if errors.Is(err, os.ErrNotExist) {
 		log.WithFields(fields).Info("path does not exist")
-		return nil, contentdirectory.ErrNoSuchObject
+		return contentdirectory.Result{}, contentdirectory.ErrNoSuchObject
 	}
 	if err != nil {
 		fields["error"] = err
 		log.WithFields(fields).Warning("could not stat path")
-		return nil, upnpav.ErrActionFailed
+		return contentdirectory.Result{}, upnpav.ErrActionFailed
 	}
 
+	if !fi.IsDir() && media.IsPlaylist(p) {
+		items, err := cd.playlistChildren(p)
+		if err != nil {
+			fields["error"] = err
+			log.WithFields(fields).Warning("could not describe playlist entries")
+			return contentdirectory.Result{}, upnpav.ErrActionFailed
+		}
+		result := contentdirectory.Paginate(&upnpav.DIDLLite{Items: items}, page)
+		result.UpdateID = cd.updateIDs.ContainerUpdateID(parent)
+		return result, nil
+	}
 	if !fi.IsDir() {
 		log.WithFields(fields).Info("not a directory")
-		return nil, nil
+		return contentdirectory.Result{}, nil
 	}
 
 	didllite := &upnpav.DIDLLite{}
@@ -145,57 +275,120 @@ func (cd *contentDirectory) BrowseChildren(_ context.Context, parent upnpav.Obje
 	if err != nil {
 		fields["error"] = err
 		log.WithFields(fields).Error("could not list directory")
-		return didllite, upnpav.ErrActionFailed
+		return contentdirectory.Result{DIDLLite: didllite}, upnpav.ErrActionFailed
 	}
 
-	var itemPaths []string
+	// Containers come before items, and only the requested page is described, because describing items is slow.
+	// Playlists are both containers of their entries, and items of their own.
+	var dirPaths, playlistPaths, itemPaths []string
 	for _, fi := range fs {
 		if strings.HasPrefix(fi.Name(), ".") {
 			continue
 		}
-
-		if !fi.IsDir() {
-			if media.IsAudioOrVideo(fi.Name()) {
-				itemPaths = append(itemPaths, path.Join(p, fi.Name()))
+		if fi.Mode()&os.ModeSymlink != 0 {
+			if _, err := os.Stat(path.Join(p, fi.Name())); err != nil {
+				// Dangling symlinks cannot be described, so they are not counted.
+				continue
 			}
+		}
+
+		switch {
+		case fi.IsDir():
+			dirPaths = append(dirPaths, path.Join(p, fi.Name()))
+		case media.IsPlaylist(fi.Name()):
+			playlistPaths = append(playlistPaths, path.Join(p, fi.Name()))
+		case cd.isItem(fi.Name()):
+			itemPaths = append(itemPaths, path.Join(p, fi.Name()))
+		}
+	}
+	containerPaths := append(dirPaths, playlistPaths...)
+	mediaPaths, photoPaths := cd.splitItemPaths(itemPaths)
+
+	total := len(containerPaths) + len(mediaPaths) + len(photoPaths) + len(playlistPaths)
+	start, end := page.Bounds(total)
+
+	var pageMediaPaths, pagePhotoPaths, pagePlaylistPaths []string
+	for i := start; i < end; i++ {
+		switch j := i - len(containerPaths); {
+		case j >= len(mediaPaths)+len(photoPaths):
+			pagePlaylistPaths = append(pagePlaylistPaths, playlistPaths[j-len(mediaPaths)-len(photoPaths)])
+			continue
+		case j >= len(mediaPaths):
+			pagePhotoPaths = append(pagePhotoPaths, photoPaths[j-len(mediaPaths)])
+			continue
+		case j >= 0:
+			pageMediaPaths = append(pageMediaPaths, mediaPaths[j])
 			continue
 		}
 
-		container, err := cd.containerFromPath(path.Join(p, fi.Name()))
+		container, err := cd.containerFromPath(containerPaths[i])
 		if err != nil {
 			fields["error"] = err
 			log.WithFields(fields).Warning("could not create container from path")
-			continue
+			container.Title = path.Base(containerPaths[i])
 		}
 		didllite.Containers = append(didllite.Containers, container)
 	}
 
-	items, err := cd.itemsForPaths(itemPaths...)
+	items, err := cd.itemsForPaths(pageMediaPaths...)
 	if err != nil {
 		fields["error"] = err
 		log.WithFields(fields).Warning("could not create items from paths")
 	}
 	didllite.Items = items
 
-	return didllite, nil
-}
-func (cd *contentDirectory) SearchCapabilities(_ context.Context) ([]string, error) {
-	return []string{"dc:title"}, nil
+	for _, photoPath := range pagePhotoPaths {
+		item, err := cd.photoItem(photoPath)
+		if err != nil {
+			fields["error"] = err
+			log.WithFields(fields).Warning("could not create item from photo")
+			item = cd.placeholderItem(photoPath, upnpav.Photo)
+		}
+		didllite.Items = append(didllite.Items, item)
+	}
+
+	for _, playlistPath := range pagePlaylistPaths {
+		item, err := cd.playlistItem(playlistPath)
+		if err != nil {
+			fields["error"] = err
+			log.WithFields(fields).Warning("could not create item from playlist")
+			item = cd.placeholderItem(playlistPath, upnpav.PlaylistItem)
+			item.ID = upnpav.ObjectID(path.Join(string(item.ID), playlistFileName))
+		}
+		didllite.Items = append(didllite.Items, item)
+	}
+
+	return contentdirectory.Result{
+		DIDLLite:       didllite,
+		NumberReturned: uint(len(didllite.Containers) + len(didllite.Items)),
+		TotalMatches:   uint(total),
+		UpdateID:       cd.updateIDs.ContainerUpdateID(parent),
+	}, nil
 }
 func (cd *contentDirectory) SortCapabilities(_ context.Context) ([]string, error) {
-	return nil, nil
+	return []string{"dc:title", "dc:date", "upnp:class", "upnp:album", "upnp:originalTrackNumber", "upnp:artist", "upnp:genre", "res@duration"}, nil
 }
 func (cd *contentDirectory) SystemUpdateID(_ context.Context) (uint, error) {
-	return 0, nil
+	return cd.updateIDs.SystemUpdateID(), nil
 }
-func (cd *contentDirectory) Search(_ context.Context, _ upnpav.ObjectID, _ search.Criteria) (*upnpav.DIDLLite, error) {
-	return nil, nil
+func (cd *contentDirectory) SearchPage(ctx context.Context, id upnpav.ObjectID, criteria search.Criteria, page contentdirectory.Page) (contentdirectory.Result, error) {
+	didllite, err := cd.Search(ctx, id, criteria)
+	if err != nil {
+		return contentdirectory.Result{}, err
+	}
+	result := contentdirectory.Paginate(didllite, page)
+	result.UpdateID = cd.updateIDs.SystemUpdateID()
+	return result, nil
 }
 
 func (cd *contentDirectory) containerFromPath(p string) (upnpav.Container, error) {
+	if isPlaylistFile(p) {
+		return cd.playlistContainer(p)
+	}
+
 	container := upnpav.Container{
-		ID:     objectIDForPath(cd.basePath, p),
-		Parent: parentIDForPath(cd.basePath, p),
+		ID:     cd.objectIDForPath(p),
+		Parent: cd.parentIDForPath(p),
 		Class:  upnpav.StorageFolder,
 	}
 
@@ -211,6 +404,9 @@ func (cd *contentDirectory) containerFromPath(p string) (upnpav.Container, error
 		return container, err
 	}
 	container.ChildCount = len(fs)
+	if cd.photos && isPhotoAlbum(fs) {
+		container.Class = upnpav.PhotoAlbum
+	}
 
 	return container, nil
 }
@@ -227,38 +423,33 @@ func (cd *contentDirectory) itemsForPaths(paths ...string) ([]upnpav.Item, error
 
 	var items []upnpav.Item
 	for i, p := range paths {
-		md := metadatas[i]
-
-		class, err := upnpav.ClassForMIMEType(md.MIMEType)
+		item, err := mediaserver.Item(cd.uri(p), metadatas[i], cd.transcodeProfiles)
 		if err != nil {
-			panic(fmt.Sprintf("should only have audio or video MIME-Types, got %q for path %q", md.MIMEType, p))
+			panic(fmt.Sprintf("should only have audio or video MIME-Types, got %q for path %q", metadatas[i].MIMEType, p))
 		}
-
-		var albumArtURIs []string
+		item.ID = cd.objectIDForPath(p)
+		item.Parent = cd.parentIDForPath(p)
+		item.Title = titles[i]
 		for _, artPath := range coverArts[i] {
-			albumArtURIs = append(albumArtURIs, cd.uri(artPath))
+			item.AlbumArtURIs = append(item.AlbumArtURIs, mediaserver.AlbumArtURIs(cd.artURI(artPath), cd.imageProfiles)...)
 		}
-
-		items = append(items, upnpav.Item{
-			ID:           objectIDForPath(cd.basePath, p),
-			Parent:       parentIDForPath(cd.basePath, p),
-			Class:        class,
-			Title:        titles[i],
-			AlbumArtURIs: albumArtURIs,
-			Resources: []upnpav.Resource{{
-				URI:      cd.uri(p),
-				Duration: &upnpav.Duration{md.Duration},
-				ProtocolInfo: &upnpav.ProtocolInfo{
-					Protocol:      upnpav.ProtocolHTTP,
-					ContentFormat: md.MIMEType,
-				},
-			}},
-		})
+		items = append(items, item)
 	}
 
 	return items, nil
 }
 
+// placeholderItem describes an item that could not be described properly, e.g. because it changed while being listed,
+// so that each page still has as many objects as it counts.
+func (cd *contentDirectory) placeholderItem(p string, class upnpav.Class) upnpav.Item {
+	return upnpav.Item{
+		ID:     cd.objectIDForPath(p),
+		Parent: cd.parentIDForPath(p),
+		Class:  class,
+		Title:  strings.TrimSuffix(path.Base(p), path.Ext(p)),
+	}
+}
+
 func (cd *contentDirectory) uri(p string) string {
 	uri := *(cd.baseURL)
 	relPath, _ := filepath.Rel(cd.basePath, p)
@@ -267,6 +458,14 @@ func (cd *contentDirectory) uri(p string) string {
 	return strings.Replace((&uri).String(), "&", "%26", -1)
 }
 
+// artURI returns the URI of cover art from media.CoverArtForPaths, which is either an image or a media file with embedded art.
+func (cd *contentDirectory) artURI(p string) string {
+	if media.IsImage(p) {
+		return cd.uri(p)
+	}
+	return mediaserver.EmbeddedCoverArtURI(cd.uri(p))
+}
+
 func trimCommonPrefix(ss []string) []string {
 	if len(ss) == 0 {
 		return ss
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/ethulhu/helix/media"
 	"github.com/ethulhu/helix/upnpav"
 	"github.com/ethulhu/helix/upnpav/contentdirectory"
 )
@@ -42,3 +43,79 @@ func parentIDForPath(basePath, p string) upnpav.ObjectID {
 	}
 	return objectIDForPath(basePath, path.Dir(p))
 }
+
+// pathForObjectID, objectIDForPath, and parentIDForPath account for the Folders view not being the root.
+func (cd *contentDirectory) pathForObjectID(id upnpav.ObjectID) (string, bool) {
+	if id == cd.foldersID {
+		return cd.basePath, true
+	}
+	return pathForObjectID(cd.basePath, id)
+}
+func (cd *contentDirectory) objectIDForPath(p string) upnpav.ObjectID {
+	id := objectIDForPath(cd.basePath, p)
+	if id == contentdirectory.Root {
+		return cd.foldersID
+	}
+	return id
+}
+func (cd *contentDirectory) parentIDForPath(p string) upnpav.ObjectID {
+	id := parentIDForPath(cd.basePath, p)
+	switch {
+	case id == contentdirectory.Root:
+		return cd.foldersID
+	case cd.objectIDForPath(p) == cd.foldersID && cd.foldersID != contentdirectory.Root:
+		return contentdirectory.Root
+	}
+	return id
+}
+
+// ChangedContainers returns the containers affected by changes to paths, e.g. from a media.Watcher, for a ContentDirectory with views.
+// A changed path affects its parent, and paths in hidden directories affect nothing, as they are not served.
+// Any change may affect any of the views built from tags, so those are returned too.
+func ChangedContainers(basePath string, views []View, paths []string) []upnpav.ObjectID {
+	foldersID := foldersRootID(views)
+
+	seen := map[upnpav.ObjectID]bool{}
+	var ids []upnpav.ObjectID
+	for _, p := range paths {
+		relPath, err := filepath.Rel(basePath, p)
+		if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) || isHidden(relPath) {
+			continue
+		}
+
+		id := parentIDForPath(basePath, p)
+		if relPath == "." || id == contentdirectory.Root {
+			// If the base path itself changed, anything under it may have.
+			id = foldersID
+		}
+		changed := []upnpav.ObjectID{id}
+		if media.IsPlaylist(p) {
+			// A changed playlist is also a changed container.
+			changed = append(changed, objectIDForPath(basePath, p))
+		}
+		for _, id := range changed {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	if len(ids) > 0 {
+		for _, view := range views {
+			if view != FoldersView {
+				ids = append(ids, virtualID{view: view}.objectID())
+			}
+		}
+	}
+	return ids
+}
+
+func isHidden(relPath string) bool {
+	for _, part := range strings.Split(relPath, string(filepath.Separator)) {
+		if strings.HasPrefix(part, ".") && part != "." {
+			return true
+		}
+	}
+	return false
+}
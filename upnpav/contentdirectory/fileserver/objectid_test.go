@@ -5,6 +5,7 @@
 package fileserver
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/ethulhu/helix/upnpav"
@@ -114,3 +115,34 @@ func TestPathForObjectID(t *testing.T) {
 		}
 	}
 }
+
+func TestChangedContainers(t *testing.T) {
+	tests := []struct {
+		paths []string
+		want  []upnpav.ObjectID
+	}{
+		{
+			paths: []string{"/mnt/media/Albums/Pale", "/mnt/media/Albums/Pale/01 Pale.mp3", "/mnt/media/Albums/Pale/02 Blue.mp3"},
+			want:  []upnpav.ObjectID{"Albums", "Albums/Pale"},
+		},
+		{
+			paths: []string{"/mnt/media/new.mp3"},
+			want:  []upnpav.ObjectID{contentdirectory.Root},
+		},
+		{
+			paths: []string{"/mnt/media"},
+			want:  []upnpav.ObjectID{contentdirectory.Root},
+		},
+		{
+			paths: []string{"/mnt/media/.cache/foo", "/mnt/media/Albums/.01 Pale.mp3.part", "/mnt/other/foo.mp3"},
+			want:  nil,
+		},
+	}
+
+	for i, tt := range tests {
+		got := ChangedContainers("/mnt/media", nil, tt.paths)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: got %v, want %v", i, got, tt.want)
+		}
+	}
+}
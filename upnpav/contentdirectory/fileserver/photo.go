@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package fileserver
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethulhu/helix/media"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/upnpav/mediaserver"
+)
+
+// isItem returns whether a file is served as an item, i.e. it is media, or it is an image and photos are served.
+func (cd *contentDirectory) isItem(name string) bool {
+	return media.IsAudioOrVideo(name) || (cd.photos && media.IsImage(name))
+}
+
+// splitItemPaths splits the paths of items in a directory into media and photos.
+// Images that are the cover art of the media are not photos.
+func (cd *contentDirectory) splitItemPaths(paths []string) ([]string, []string) {
+	var mediaPaths, imagePaths []string
+	for _, p := range paths {
+		if media.IsAudioOrVideo(p) {
+			mediaPaths = append(mediaPaths, p)
+		} else if cd.photos && media.IsImage(p) {
+			imagePaths = append(imagePaths, p)
+		}
+	}
+	if len(mediaPaths) == 0 || len(imagePaths) == 0 {
+		return mediaPaths, imagePaths
+	}
+
+	coverArt := map[string]bool{}
+	for _, artPaths := range media.CoverArtForPaths(mediaPaths) {
+		for _, artPath := range artPaths {
+			coverArt[artPath] = true
+		}
+	}
+	var photoPaths []string
+	for _, p := range imagePaths {
+		if !coverArt[p] {
+			photoPaths = append(photoPaths, p)
+		}
+	}
+	return mediaPaths, photoPaths
+}
+
+// isPhotoAlbum returns whether a directory's listing is of photos, i.e. it has images but no media.
+func isPhotoAlbum(fis []os.FileInfo) bool {
+	hasImages := false
+	for _, fi := range fis {
+		if fi.IsDir() || strings.HasPrefix(fi.Name(), ".") {
+			continue
+		}
+		if media.IsAudioOrVideo(fi.Name()) {
+			return false
+		}
+		if media.IsImage(fi.Name()) {
+			hasImages = true
+		}
+	}
+	return hasImages
+}
+
+func (cd *contentDirectory) photoItem(p string) (upnpav.Item, error) {
+	fi, err := os.Stat(p)
+	if err != nil {
+		return upnpav.Item{}, err
+	}
+	md, err := media.ProbeImage(p)
+	if err != nil {
+		return upnpav.Item{}, err
+	}
+
+	item := mediaserver.PhotoItem(cd.uri(p), md, cd.imageProfiles)
+	item.ID = cd.objectIDForPath(p)
+	item.Parent = cd.parentIDForPath(p)
+	item.Title = strings.TrimSuffix(fi.Name(), filepath.Ext(p))
+	if item.Date == nil {
+		item.Date = &upnpav.Date{Time: fi.ModTime()}
+	}
+	return item, nil
+}
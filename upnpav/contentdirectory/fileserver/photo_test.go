@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package fileserver
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethulhu/helix/media"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/upnpav/contentdirectory"
+	"github.com/ethulhu/helix/upnpav/contentdirectory/search"
+)
+
+func TestPhotos(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fileserver")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, 64, 48)), nil); err != nil {
+		t.Fatalf("could not encode JPEG: %v", err)
+	}
+	for _, p := range []string{
+		"Holiday/beach.jpg",
+		"Holiday/sunset.jpg",
+		"Pale/01 Pale.mp3",
+		"Pale/cover.jpg",
+		"Pale/booklet.jpg",
+	} {
+		p = filepath.Join(dir, p)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatalf("could not create directory: %v", err)
+		}
+		if err := ioutil.WriteFile(p, buf.Bytes(), 0644); err != nil {
+			t.Fatalf("could not create file: %v", err)
+		}
+	}
+
+	tests := []struct {
+		photos bool
+		object upnpav.ObjectID
+
+		wantClasses []upnpav.Class
+		wantIDs     []upnpav.ObjectID
+	}{
+		{
+			photos:      true,
+			object:      contentdirectory.Root,
+			wantClasses: []upnpav.Class{upnpav.PhotoAlbum, upnpav.StorageFolder},
+			wantIDs:     []upnpav.ObjectID{"Holiday", "Pale"},
+		},
+		{
+			photos:      true,
+			object:      "Holiday",
+			wantClasses: []upnpav.Class{upnpav.Photo, upnpav.Photo},
+			wantIDs:     []upnpav.ObjectID{"Holiday/beach.jpg", "Holiday/sunset.jpg"},
+		},
+		{
+			photos:      true,
+			object:      "Pale",
+			wantClasses: []upnpav.Class{upnpav.MusicTrack, upnpav.Photo},
+			wantIDs:     []upnpav.ObjectID{"Pale/01 Pale.mp3", "Pale/booklet.jpg"},
+		},
+		{
+			photos:      false,
+			object:      contentdirectory.Root,
+			wantClasses: []upnpav.Class{upnpav.StorageFolder, upnpav.StorageFolder},
+			wantIDs:     []upnpav.ObjectID{"Holiday", "Pale"},
+		},
+		{
+			photos: false,
+			object: "Holiday",
+		},
+		{
+			photos:      false,
+			object:      "Pale",
+			wantClasses: []upnpav.Class{upnpav.MusicTrack},
+			wantIDs:     []upnpav.ObjectID{"Pale/01 Pale.mp3"},
+		},
+	}
+
+	for i, tt := range tests {
+		cd, err := NewContentDirectory(dir, "http://foo/", ContentDirectoryOptions{
+			MetadataCache: media.NoOpCache{Prober: fakeProber{}},
+			UpdateIDs:     contentdirectory.NewUpdateIDs(0),
+			Photos:        tt.photos,
+		})
+		if err != nil {
+			t.Fatalf("could not create ContentDirectory: %v", err)
+		}
+
+		didllite, err := cd.BrowseChildren(context.Background(), tt.object)
+		if err != nil {
+			t.Errorf("[%d]: got error: %v", i, err)
+			continue
+		}
+
+		var gotClasses []upnpav.Class
+		var gotIDs []upnpav.ObjectID
+		for _, container := range didllite.Containers {
+			gotClasses = append(gotClasses, container.Class)
+			gotIDs = append(gotIDs, container.ID)
+		}
+		for _, item := range didllite.Items {
+			gotClasses = append(gotClasses, item.Class)
+			gotIDs = append(gotIDs, item.ID)
+		}
+		if !reflect.DeepEqual(gotClasses, tt.wantClasses) {
+			t.Errorf("[%d]: got classes %v, want %v", i, gotClasses, tt.wantClasses)
+		}
+		if !reflect.DeepEqual(gotIDs, tt.wantIDs) {
+			t.Errorf("[%d]: got IDs %v, want %v", i, gotIDs, tt.wantIDs)
+		}
+	}
+
+	cd, err := NewContentDirectory(dir, "http://foo/", ContentDirectoryOptions{
+		MetadataCache: media.NoOpCache{Prober: fakeProber{}},
+		UpdateIDs:     contentdirectory.NewUpdateIDs(0),
+		Photos:        true,
+	})
+	if err != nil {
+		t.Fatalf("could not create ContentDirectory: %v", err)
+	}
+
+	didllite, err := cd.BrowseMetadata(context.Background(), "Holiday/beach.jpg")
+	if err != nil {
+		t.Fatalf("BrowseMetadata(%q) returned error: %v", "Holiday/beach.jpg", err)
+	}
+	if !didllite.IsSingleItem() {
+		t.Fatalf("BrowseMetadata(%q) == %+v, want an item", "Holiday/beach.jpg", didllite)
+	}
+	item := didllite.Items[0]
+	if item.Title != "beach" || item.Parent != "Holiday" || item.Date == nil {
+		t.Errorf("got item %+v, want beach in Holiday with a date", item)
+	}
+	if len(item.Resources) != 1 || item.Resources[0].Resolution == nil || *item.Resources[0].Resolution != (upnpav.Resolution{Width: 64, Height: 48}) {
+		t.Errorf("got resources %+v, want one of 64x48", item.Resources)
+	}
+
+	criteria, err := search.Parse(`upnp:class derivedfrom "object.item.imageItem"`)
+	if err != nil {
+		t.Fatalf("could not parse search criteria: %v", err)
+	}
+	didllite, err = cd.Search(context.Background(), contentdirectory.Root, criteria)
+	if err != nil {
+		t.Fatalf("Search() returned error: %v", err)
+	}
+	var gotIDs []upnpav.ObjectID
+	for _, item := range didllite.Items {
+		gotIDs = append(gotIDs, item.ID)
+	}
+	if want := []upnpav.ObjectID{"Holiday/beach.jpg", "Holiday/sunset.jpg", "Pale/booklet.jpg"}; !reflect.DeepEqual(gotIDs, want) {
+		t.Errorf("Search() returned items %v, want %v", gotIDs, want)
+	}
+
+	cd, err = NewContentDirectory(dir, "http://foo/", ContentDirectoryOptions{
+		MetadataCache: media.NoOpCache{Prober: fakeProber{}},
+		UpdateIDs:     contentdirectory.NewUpdateIDs(0),
+	})
+	if err != nil {
+		t.Fatalf("could not create ContentDirectory: %v", err)
+	}
+	if _, err := cd.BrowseMetadata(context.Background(), "Holiday/beach.jpg"); err != contentdirectory.ErrNoSuchObject {
+		t.Errorf("BrowseMetadata(%q) returned error %v, want %v", "Holiday/beach.jpg", err, contentdirectory.ErrNoSuchObject)
+	}
+}
+
+func TestBrowseChildrenPageWithDanglingSymlink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fileserver")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, 64, 48)), nil); err != nil {
+		t.Fatalf("could not encode JPEG: %v", err)
+	}
+	for _, name := range []string{"a.jpg", "c.jpg"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0644); err != nil {
+			t.Fatalf("could not create file: %v", err)
+		}
+	}
+	if err := os.Symlink(filepath.Join(dir, "missing.jpg"), filepath.Join(dir, "b.jpg")); err != nil {
+		t.Fatalf("could not create symlink: %v", err)
+	}
+
+	cd, err := NewContentDirectory(dir, "http://foo/", ContentDirectoryOptions{
+		MetadataCache: media.NoOpCache{Prober: fakeProber{}},
+		UpdateIDs:     contentdirectory.NewUpdateIDs(0),
+		Photos:        true,
+	})
+	if err != nil {
+		t.Fatalf("could not create ContentDirectory: %v", err)
+	}
+
+	// b.jpg cannot be described, so it is not counted, and each page has as many objects as it says.
+	var gotIDs []upnpav.ObjectID
+	page := contentdirectory.Page{RequestedCount: 1}
+	for page.StartingIndex < 2 {
+		result, err := cd.BrowseChildrenPage(context.Background(), contentdirectory.Root, page)
+		if err != nil {
+			t.Fatalf("BrowseChildrenPage(root, %+v) returned error: %v", page, err)
+		}
+		if result.NumberReturned != 1 || result.TotalMatches != 2 || len(result.DIDLLite.Items) != 1 {
+			t.Fatalf("BrowseChildrenPage(root, %+v) returned %d of %d with %d items, want 1 of 2 with 1 item", page, result.NumberReturned, result.TotalMatches, len(result.DIDLLite.Items))
+		}
+		gotIDs = append(gotIDs, result.DIDLLite.Items[0].ID)
+		page.StartingIndex += result.NumberReturned
+	}
+	if want := []upnpav.ObjectID{"a.jpg", "c.jpg"}; !reflect.DeepEqual(gotIDs, want) {
+		t.Errorf("got IDs %v, want %v", gotIDs, want)
+	}
+}
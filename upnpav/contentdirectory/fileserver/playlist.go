@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package fileserver
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethulhu/helix/media"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/upnpav/mediaserver"
+)
+
+// Playlists are containers with the ObjectID of their path, like directories.
+// Their entries have the ObjectIDs of numbered children, e.g. "Mixes/road.m3u/3", and refer to the items themselves.
+// The playlist file itself is served as a PlaylistItem next to the container, with the ObjectID "Mixes/road.m3u/file".
+const playlistFileName = "file"
+
+// isPlaylistFile returns whether p is a playlist, and not e.g. a directory named like one.
+func isPlaylistFile(p string) bool {
+	if !media.IsPlaylist(p) {
+		return false
+	}
+	fi, err := os.Stat(p)
+	return err == nil && fi.Mode().IsRegular()
+}
+
+// splitPlaylistPath splits the path of a playlist's child, e.g. "/mnt/media/road.m3u/3", into the playlist and child.
+func splitPlaylistPath(p string) (string, string, bool) {
+	playlist := path.Dir(p)
+	if !isPlaylistFile(playlist) {
+		return "", "", false
+	}
+	return playlist, path.Base(p), true
+}
+
+// playlistEntries returns the entries of a playlist that can be served, i.e. media under basePath that exist.
+func (cd *contentDirectory) playlistEntries(p string) (*media.Playlist, []string, error) {
+	playlist, err := media.ParsePlaylist(p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entries []string
+	for _, entry := range playlist.Entries {
+		relPath, err := filepath.Rel(cd.basePath, entry)
+		if err != nil || relPath == "." || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) || isHidden(relPath) {
+			continue
+		}
+		if !media.IsAudioOrVideo(entry) {
+			continue
+		}
+		if fi, err := os.Stat(entry); err != nil || !fi.Mode().IsRegular() {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return playlist, entries, nil
+}
+
+func (cd *contentDirectory) playlistContainer(p string) (upnpav.Container, error) {
+	container := upnpav.Container{
+		ID:     cd.objectIDForPath(p),
+		Parent: cd.parentIDForPath(p),
+		Class:  upnpav.Playlist,
+	}
+
+	fi, err := os.Stat(p)
+	if err != nil {
+		return container, err
+	}
+	container.Date = &upnpav.Date{Time: fi.ModTime()}
+
+	playlist, entries, err := cd.playlistEntries(p)
+	if err != nil {
+		return container, err
+	}
+	container.Title = playlist.Title
+	container.ChildCount = len(entries)
+
+	return container, nil
+}
+
+// playlistItem describes the playlist file itself.
+func (cd *contentDirectory) playlistItem(p string) (upnpav.Item, error) {
+	fi, err := os.Stat(p)
+	if err != nil {
+		return upnpav.Item{}, err
+	}
+
+	title := strings.TrimSuffix(fi.Name(), filepath.Ext(p))
+	if playlist, err := media.ParsePlaylist(p); err == nil {
+		title = playlist.Title
+	}
+
+	mimeType := media.PlaylistMIMEType(p)
+	return upnpav.Item{
+		ID:     upnpav.ObjectID(path.Join(string(cd.objectIDForPath(p)), playlistFileName)),
+		Parent: cd.parentIDForPath(p),
+		Class:  upnpav.PlaylistItem,
+		Title:  title,
+		Date:   &upnpav.Date{Time: fi.ModTime()},
+		Resources: []upnpav.Resource{{
+			URI: cd.uri(p),
+			ProtocolInfo: &upnpav.ProtocolInfo{
+				Protocol:       upnpav.ProtocolHTTP,
+				ContentFormat:  mimeType,
+				AdditionalInfo: mediaserver.DLNAInfo(mimeType, 0).String(),
+			},
+			SizeBytes: uint(fi.Size()),
+		}},
+	}, nil
+}
+
+// playlistChildren describes the entries of a playlist.
+func (cd *contentDirectory) playlistChildren(p string) ([]upnpav.Item, error) {
+	_, entries, err := cd.playlistEntries(p)
+	if err != nil {
+		return nil, err
+	}
+	items, err := cd.itemsForPaths(entries...)
+	if err != nil {
+		return nil, err
+	}
+
+	id := cd.objectIDForPath(p)
+	for i := range items {
+		items[i].RefID = string(items[i].ID)
+		items[i].ID = upnpav.ObjectID(path.Join(string(id), strconv.Itoa(i+1)))
+		items[i].Parent = id
+	}
+	return items, nil
+}
+
+// playlistChild describes an entry of a playlist, or the playlist file itself.
+func (cd *contentDirectory) playlistChild(p, name string) (*upnpav.DIDLLite, bool, error) {
+	if name == playlistFileName {
+		item, err := cd.playlistItem(p)
+		if err != nil {
+			return nil, false, err
+		}
+		return &upnpav.DIDLLite{Items: []upnpav.Item{item}}, true, nil
+	}
+
+	i, err := strconv.Atoi(name)
+	if err != nil || strconv.Itoa(i) != name {
+		return nil, false, nil
+	}
+	items, err := cd.playlistChildren(p)
+	if err != nil {
+		return nil, false, err
+	}
+	if i < 1 || i > len(items) {
+		return nil, false, nil
+	}
+	return &upnpav.DIDLLite{Items: []upnpav.Item{items[i-1]}}, true, nil
+}
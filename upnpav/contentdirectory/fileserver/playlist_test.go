@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package fileserver
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethulhu/helix/media"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/upnpav/contentdirectory"
+	"github.com/ethulhu/helix/upnpav/contentdirectory/search"
+)
+
+func TestPlaylists(t *testing.T) {
+	root, err := ioutil.TempDir("", "fileserver")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	dir := filepath.Join(root, "media")
+	files := map[string]string{
+		"media/Pale/01 Pale.mp3":   "",
+		"media/Pale/02 Blue.mp3":   "",
+		"media/.hidden/secret.mp3": "",
+		"outside.mp3":              "",
+		"media/Mixes/road.m3u": "../Pale/02 Blue.mp3\n" +
+			"../../outside.mp3\n" +
+			"/etc/passwd\n" +
+			"missing.mp3\n" +
+			"../.hidden/secret.mp3\n" +
+			"http://example.com/stream.mp3\n" +
+			"../Pale/01 Pale.mp3\n",
+	}
+	for p, content := range files {
+		p = filepath.Join(root, p)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatalf("could not create directory: %v", err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatalf("could not create file: %v", err)
+		}
+	}
+
+	cd, err := NewContentDirectory(dir, "http://foo/", ContentDirectoryOptions{
+		MetadataCache: media.NoOpCache{Prober: fakeProber{}},
+		UpdateIDs:     contentdirectory.NewUpdateIDs(0),
+	})
+	if err != nil {
+		t.Fatalf("could not create ContentDirectory: %v", err)
+	}
+	ctx := context.Background()
+
+	didllite, err := cd.BrowseChildren(ctx, "Mixes")
+	if err != nil {
+		t.Fatalf("BrowseChildren(%q) returned error: %v", "Mixes", err)
+	}
+	if len(didllite.Containers) != 1 || len(didllite.Items) != 1 {
+		t.Fatalf("BrowseChildren(%q) == %+v, want a container and an item", "Mixes", didllite)
+	}
+	container := didllite.Containers[0]
+	if container.ID != "Mixes/road.m3u" || container.Class != upnpav.Playlist || container.Title != "road" || container.ChildCount != 2 {
+		t.Errorf("got container %+v, want playlist Mixes/road.m3u with 2 children", container)
+	}
+	item := didllite.Items[0]
+	if item.ID != "Mixes/road.m3u/file" || item.Parent != "Mixes" || item.Class != upnpav.PlaylistItem {
+		t.Errorf("got item %+v, want playlist item Mixes/road.m3u/file", item)
+	}
+	if len(item.Resources) != 1 || item.Resources[0].URI != "http://foo/Mixes/road.m3u" || item.Resources[0].ProtocolInfo.ContentFormat != "audio/x-mpegurl" {
+		t.Errorf("got resources %+v, want http://foo/Mixes/road.m3u as audio/x-mpegurl", item.Resources)
+	}
+
+	didllite, err = cd.BrowseChildren(ctx, "Mixes/road.m3u")
+	if err != nil {
+		t.Fatalf("BrowseChildren(%q) returned error: %v", "Mixes/road.m3u", err)
+	}
+	var gotIDs, gotRefIDs []string
+	for _, item := range didllite.Items {
+		gotIDs = append(gotIDs, string(item.ID))
+		gotRefIDs = append(gotRefIDs, item.RefID)
+		if item.Parent != "Mixes/road.m3u" {
+			t.Errorf("item %q has parent %q, want %q", item.ID, item.Parent, "Mixes/road.m3u")
+		}
+	}
+	if want := []string{"Mixes/road.m3u/1", "Mixes/road.m3u/2"}; !reflect.DeepEqual(gotIDs, want) {
+		t.Errorf("got playlist entries %v, want %v", gotIDs, want)
+	}
+	if want := []string{"Pale/02 Blue.mp3", "Pale/01 Pale.mp3"}; !reflect.DeepEqual(gotRefIDs, want) {
+		t.Errorf("got playlist entries referring to %v, want %v", gotRefIDs, want)
+	}
+
+	for id, want := range map[upnpav.ObjectID]upnpav.ObjectID{
+		"Mixes/road.m3u":      "Mixes/road.m3u",
+		"Mixes/road.m3u/2":    "Mixes/road.m3u/2",
+		"Mixes/road.m3u/file": "Mixes/road.m3u/file",
+	} {
+		didllite, err := cd.BrowseMetadata(ctx, id)
+		if err != nil {
+			t.Errorf("BrowseMetadata(%q) returned error: %v", id, err)
+			continue
+		}
+		var got upnpav.ObjectID
+		switch {
+		case didllite.IsSingleContainer():
+			got = didllite.Containers[0].ID
+		case didllite.IsSingleItem():
+			got = didllite.Items[0].ID
+		}
+		if got != want {
+			t.Errorf("BrowseMetadata(%q) == %+v, want %q", id, didllite, want)
+		}
+	}
+	for _, id := range []upnpav.ObjectID{"Mixes/road.m3u/0", "Mixes/road.m3u/3", "Mixes/road.m3u/01", "Mixes/road.m3u/other"} {
+		if _, err := cd.BrowseMetadata(ctx, id); err != contentdirectory.ErrNoSuchObject {
+			t.Errorf("BrowseMetadata(%q) returned error %v, want %v", id, err, contentdirectory.ErrNoSuchObject)
+		}
+	}
+
+	criteria, err := search.Parse(`upnp:class derivedfrom "object.container.playlistContainer" or upnp:class = "object.item.playlistItem"`)
+	if err != nil {
+		t.Fatalf("could not parse search criteria: %v", err)
+	}
+	didllite, err = cd.Search(ctx, contentdirectory.Root, criteria)
+	if err != nil {
+		t.Fatalf("Search() returned error: %v", err)
+	}
+	if len(didllite.Containers) != 1 || didllite.Containers[0].ID != "Mixes/road.m3u" || len(didllite.Items) != 1 || didllite.Items[0].ID != "Mixes/road.m3u/file" {
+		t.Errorf("Search() == %+v, want the playlist and its item", didllite)
+	}
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package fileserver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethulhu/helix/media"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/upnpav/contentdirectory"
+	"github.com/ethulhu/helix/upnpav/contentdirectory/search"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (cd *contentDirectory) SearchCapabilities(_ context.Context) ([]string, error) {
+	return []string{"dc:title", "dc:date", "upnp:artist", "upnp:album", "upnp:class"}, nil
+}
+
+// Search walks the tree under the given container, and returns the containers and items that match the criteria.
+func (cd *contentDirectory) Search(ctx context.Context, id upnpav.ObjectID, criteria search.Criteria) (*upnpav.DIDLLite, error) {
+	fields := log.Fields{
+		"method":   "Search",
+		"object":   id,
+		"criteria": criteria,
+	}
+
+	if vid, ok := cd.parseVirtualID(id); ok && vid.view != FoldersView {
+		if vid.isItem() {
+			log.WithFields(fields).Info("not a container")
+			return nil, contentdirectory.ErrNoSuchContainer
+		}
+		didllite, err := cd.searchVirtual(ctx, vid, criteria)
+		if err != nil {
+			fields["error"] = err
+			log.WithFields(fields).Warning("could not build views")
+			return nil, upnpav.ErrActionFailed
+		}
+		return didllite, nil
+	}
+
+	p, ok := cd.pathForObjectID(id)
+	if !ok {
+		log.WithFields(fields).Error("bad path")
+		return nil, contentdirectory.ErrNoSuchObject
+	}
+
+	fi, err := os.Stat(p)
+	if errors.Is(err, os.ErrNotExist) {
+		log.WithFields(fields).Info("path does not exist")
+		return nil, contentdirectory.ErrNoSuchObject
+	}
+	if err != nil {
+		fields["error"] = err
+		log.WithFields(fields).Warning("could not stat path")
+		return nil, upnpav.ErrActionFailed
+	}
+	if !fi.IsDir() {
+		log.WithFields(fields).Info("not a directory")
+		return nil, contentdirectory.ErrNoSuchContainer
+	}
+
+	// Items are described a directory at a time, so that titles match BrowseChildren.
+	containerPaths, itemPathsByDir, err := walkMedia(ctx, p, cd.photos)
+	if err != nil {
+		fields["error"] = err
+		log.WithFields(fields).Warning("could not walk directory")
+		return nil, upnpav.ErrActionFailed
+	}
+
+	didllite := &upnpav.DIDLLite{}
+	var playlistItems []upnpav.Item
+	for _, containerPath := range containerPaths {
+		container, err := cd.containerFromPath(containerPath)
+		if err != nil {
+			fields["error"] = err
+			log.WithFields(fields).Warning("could not create container from path")
+			continue
+		}
+		if search.MatchesContainer(criteria, container) {
+			didllite.Containers = append(didllite.Containers, container)
+		}
+
+		if isPlaylistFile(containerPath) {
+			item, err := cd.playlistItem(containerPath)
+			if err != nil {
+				fields["error"] = err
+				log.WithFields(fields).Warning("could not create item from playlist")
+				continue
+			}
+			playlistItems = append(playlistItems, item)
+		}
+	}
+
+	for _, dir := range sortedKeys(itemPathsByDir) {
+		mediaPaths, photoPaths := cd.splitItemPaths(itemPathsByDir[dir])
+		items, err := cd.itemsForPaths(mediaPaths...)
+		if err != nil {
+			fields["error"] = err
+			log.WithFields(fields).Warning("could not create items from paths")
+			continue
+		}
+		for _, photoPath := range photoPaths {
+			item, err := cd.photoItem(photoPath)
+			if err != nil {
+				fields["error"] = err
+				log.WithFields(fields).Warning("could not create item from photo")
+				continue
+			}
+			items = append(items, item)
+		}
+		for _, item := range items {
+			if search.MatchesItem(criteria, item) {
+				didllite.Items = append(didllite.Items, item)
+			}
+		}
+	}
+	for _, item := range playlistItems {
+		if search.MatchesItem(criteria, item) {
+			didllite.Items = append(didllite.Items, item)
+		}
+	}
+
+	fields["containers"] = len(didllite.Containers)
+	fields["items"] = len(didllite.Items)
+	log.WithFields(fields).Debug("searched")
+	return didllite, nil
+}
+
+// walkMedia returns the directories and playlists under p, and the media items under p grouped by directory, skipping hidden files.
+// If images, images are included in the items.
+func walkMedia(ctx context.Context, p string, images bool) ([]string, map[string][]string, error) {
+	var containerPaths []string
+	itemPathsByDir := map[string][]string{}
+	err := filepath.Walk(p, func(subPath string, fi os.FileInfo, err error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err != nil {
+			return nil
+		}
+		if strings.HasPrefix(fi.Name(), ".") && subPath != p {
+			if fi.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if fi.IsDir() {
+			if subPath != p {
+				containerPaths = append(containerPaths, subPath)
+			}
+			return nil
+		}
+		if media.IsPlaylist(fi.Name()) {
+			containerPaths = append(containerPaths, subPath)
+			return nil
+		}
+		if media.IsAudioOrVideo(fi.Name()) || (images && media.IsImage(fi.Name())) {
+			dir := path.Dir(subPath)
+			itemPathsByDir[dir] = append(itemPathsByDir[dir], subPath)
+		}
+		return nil
+	})
+	return containerPaths, itemPathsByDir, err
+}
+
+func sortedKeys(m map[string][]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
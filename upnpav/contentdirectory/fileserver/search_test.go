@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package fileserver
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethulhu/helix/media"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/upnpav/contentdirectory"
+	"github.com/ethulhu/helix/upnpav/contentdirectory/search"
+)
+
+func TestSearch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fileserver")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, p := range []string{
+		"Albums/Pale/01 Pale.mp3",
+		"Albums/Pale/02 Blue.mp3",
+		"Albums/Violet/01 Violet.mp3",
+		"Albums/Violet/cover.jpg",
+		".hidden/01 Pale.mp3",
+	} {
+		p = filepath.Join(dir, p)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatalf("could not create directory: %v", err)
+		}
+		if err := ioutil.WriteFile(p, nil, 0644); err != nil {
+			t.Fatalf("could not create file: %v", err)
+		}
+	}
+
+	cd, err := NewContentDirectory(dir, "http://foo/", ContentDirectoryOptions{
+		MetadataCache: media.NoOpCache{},
+		UpdateIDs:     contentdirectory.NewUpdateIDs(0),
+	})
+	if err != nil {
+		t.Fatalf("could not create ContentDirectory: %v", err)
+	}
+
+	tests := []struct {
+		object         upnpav.ObjectID
+		query          string
+		wantContainers []upnpav.ObjectID
+		wantItems      []upnpav.ObjectID
+	}{
+		{
+			object:         "0",
+			query:          `dc:title contains "pale"`,
+			wantContainers: []upnpav.ObjectID{"Albums/Pale"},
+			wantItems:      []upnpav.ObjectID{"Albums/Pale/01 Pale.mp3"},
+		},
+		{
+			object:         "0",
+			query:          `upnp:class derivedfrom "object.container"`,
+			wantContainers: []upnpav.ObjectID{"Albums", "Albums/Pale", "Albums/Violet"},
+		},
+		{
+			object:    "Albums/Violet",
+			query:     `upnp:class derivedfrom "object.item.audioItem"`,
+			wantItems: []upnpav.ObjectID{"Albums/Violet/01 Violet.mp3"},
+		},
+	}
+
+	for i, tt := range tests {
+		criteria, err := search.Parse(tt.query)
+		if err != nil {
+			t.Fatalf("[%d]: could not parse %q: %v", i, tt.query, err)
+		}
+
+		didllite, err := cd.Search(context.Background(), tt.object, criteria)
+		if err != nil {
+			t.Errorf("[%d]: got error: %v", i, err)
+			continue
+		}
+
+		var gotContainers, gotItems []upnpav.ObjectID
+		for _, container := range didllite.Containers {
+			gotContainers = append(gotContainers, container.ID)
+		}
+		for _, item := range didllite.Items {
+			gotItems = append(gotItems, item.ID)
+		}
+		if !reflect.DeepEqual(gotContainers, tt.wantContainers) {
+			t.Errorf("[%d]: got containers %v, want %v", i, gotContainers, tt.wantContainers)
+		}
+		if !reflect.DeepEqual(gotItems, tt.wantItems) {
+			t.Errorf("[%d]: got items %v, want %v", i, gotItems, tt.wantItems)
+		}
+	}
+}
@@ -0,0 +1,545 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package fileserver
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/upnpav/contentdirectory"
+	"github.com/ethulhu/helix/upnpav/contentdirectory/search"
+	"github.com/ethulhu/helix/upnpav/mediaserver"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type (
+	// View is a way to browse the media of a ContentDirectory.
+	// FoldersView is the directory tree, and the others are built from tags.
+	View string
+
+	// virtualID is a parsed ObjectID of a view, a container in a view, or an item in a container.
+	virtualID struct {
+		view View
+		// key identifies a container in the view, e.g. an album and its album artist.
+		key []string
+		// path is the relative path of an item.
+		path string
+	}
+
+	// library is every audio item under basePath, for building views.
+	library struct {
+		// updateID is the SystemUpdateID that the library was built at.
+		updateID uint
+		items    []upnpav.Item
+		// groups are the containers of each view, grouped once when the library is built.
+		groups map[View][]group
+	}
+
+	// libraryBuild is a library being built in the background, which is done when done is closed.
+	libraryBuild struct {
+		updateID uint
+		done     chan struct{}
+
+		lib *library
+		err error
+	}
+
+	// group is a container of a view, and its items.
+	group struct {
+		key   []string
+		items []upnpav.Item
+	}
+)
+
+const (
+	FoldersView = View("folders")
+	AlbumsView  = View("albums")
+	ArtistsView = View("artists")
+	GenresView  = View("genres")
+	TracksView  = View("tracks")
+)
+
+var (
+	// Views are the Views that a ContentDirectory can have, in the order they are listed by default.
+	Views = []View{FoldersView, AlbumsView, ArtistsView, GenresView, TracksView}
+
+	viewTitles = map[View]string{
+		FoldersView: "Folders",
+		AlbumsView:  "Albums",
+		ArtistsView: "Artists",
+		GenresView:  "Genres",
+		TracksView:  "All Tracks",
+	}
+	viewClasses = map[View]upnpav.Class{
+		AlbumsView:  upnpav.MusicAlbum,
+		ArtistsView: upnpav.Artist,
+		GenresView:  upnpav.MusicGenre,
+	}
+	// viewKeySizes are how many parts the keys of a view's containers have.
+	// Views with no key list their items directly.
+	viewKeySizes = map[View]int{
+		AlbumsView:  2,
+		ArtistsView: 1,
+		GenresView:  1,
+		TracksView:  0,
+	}
+)
+
+// ViewByName returns the View with a given name, e.g. "albums".
+func ViewByName(name string) (View, bool) {
+	for _, view := range Views {
+		if string(view) == name {
+			return view, true
+		}
+	}
+	return View(""), false
+}
+
+// foldersOnly returns whether views is just the directory tree, which is then the root of the ContentDirectory.
+func foldersOnly(views []View) bool {
+	return len(views) == 0 || (len(views) == 1 && views[0] == FoldersView)
+}
+
+// foldersRootID returns the ObjectID of basePath given views.
+// If there are other views, the directory tree is moved from the root to its own container.
+func foldersRootID(views []View) upnpav.ObjectID {
+	if foldersOnly(views) {
+		return contentdirectory.Root
+	}
+	return virtualID{view: FoldersView}.objectID()
+}
+
+// ObjectIDs of views start with ".", so they cannot clash with the paths of media, as hidden files are never served.
+// The parts of their keys and paths are escaped, so that they can contain "/".
+func (v virtualID) objectID() upnpav.ObjectID {
+	parts := []string{"." + string(v.view)}
+	for _, part := range v.key {
+		parts = append(parts, url.PathEscape(part))
+	}
+	if v.path != "" {
+		parts = append(parts, url.PathEscape(v.path))
+	}
+	return upnpav.ObjectID(strings.Join(parts, "/"))
+}
+func (v virtualID) isItem() bool {
+	return v.path != ""
+}
+func (v virtualID) isView() bool {
+	return v.key == nil && v.path == ""
+}
+
+func (cd *contentDirectory) parseVirtualID(id upnpav.ObjectID) (virtualID, bool) {
+	if foldersOnly(cd.views) || !strings.HasPrefix(string(id), ".") {
+		return virtualID{}, false
+	}
+
+	parts := strings.Split(strings.TrimPrefix(string(id), "."), "/")
+	view := View(parts[0])
+	if !cd.hasView(view) {
+		return virtualID{}, false
+	}
+	if view == FoldersView {
+		return virtualID{view: view}, len(parts) == 1
+	}
+
+	var unescaped []string
+	for _, part := range parts[1:] {
+		part, err := url.PathUnescape(part)
+		if err != nil {
+			return virtualID{}, false
+		}
+		unescaped = append(unescaped, part)
+	}
+
+	vid := virtualID{view: view}
+	keySize := viewKeySizes[view]
+	switch len(unescaped) {
+	case 0:
+		return vid, true
+	case keySize:
+		vid.key = unescaped
+		return vid, true
+	case keySize + 1:
+		vid.key = unescaped[:keySize]
+		vid.path = unescaped[keySize]
+		return vid, true
+	default:
+		return virtualID{}, false
+	}
+}
+
+func (cd *contentDirectory) hasView(view View) bool {
+	for _, v := range cd.views {
+		if v == view {
+			return true
+		}
+	}
+	return false
+}
+
+// rootContainer describes the root when it lists the views.
+func (cd *contentDirectory) rootContainer() upnpav.Container {
+	return upnpav.Container{
+		ID:         contentdirectory.Root,
+		Parent:     upnpav.ObjectID("-1"),
+		Class:      upnpav.StorageFolder,
+		Title:      path.Base(cd.basePath),
+		ChildCount: len(cd.views),
+	}
+}
+
+// viewContainers describes each view, for listing the root.
+// Rather than wait for the library to be built, the ChildCounts come from the latest library, and are 0 until there is one.
+func (cd *contentDirectory) viewContainers() ([]upnpav.Container, error) {
+	lib := cd.latestLibrary()
+
+	var containers []upnpav.Container
+	for _, view := range cd.views {
+		container, err := cd.viewContainer(view, lib)
+		if err != nil {
+			return nil, err
+		}
+		containers = append(containers, container)
+	}
+	return containers, nil
+}
+
+func (cd *contentDirectory) viewContainer(view View, lib *library) (upnpav.Container, error) {
+	if view == FoldersView {
+		container, err := cd.containerFromPath(cd.basePath)
+		container.Title = viewTitles[view]
+		return container, err
+	}
+
+	container := upnpav.Container{
+		ID:     virtualID{view: view}.objectID(),
+		Parent: contentdirectory.Root,
+		Class:  upnpav.StorageFolder,
+		Title:  viewTitles[view],
+	}
+	if lib != nil {
+		containers, items := lib.children(virtualID{view: view})
+		container.ChildCount = len(containers) + len(items)
+	}
+	return container, nil
+}
+
+// browseVirtualMetadata describes a view, a container in a view, or an item in a container.
+func (cd *contentDirectory) browseVirtualMetadata(ctx context.Context, vid virtualID) (*upnpav.DIDLLite, bool, error) {
+	lib, err := cd.library(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if vid.isView() {
+		container, err := cd.viewContainer(vid.view, lib)
+		if err != nil {
+			return nil, false, err
+		}
+		return &upnpav.DIDLLite{Containers: []upnpav.Container{container}}, true, nil
+	}
+
+	if vid.isItem() {
+		_, items := lib.children(virtualID{view: vid.view, key: vid.key})
+		for _, item := range items {
+			if item.ID == vid.objectID() {
+				return &upnpav.DIDLLite{Items: []upnpav.Item{item}}, true, nil
+			}
+		}
+		return nil, false, nil
+	}
+
+	containers, _ := lib.children(virtualID{view: vid.view})
+	for _, container := range containers {
+		if container.ID == vid.objectID() {
+			return &upnpav.DIDLLite{Containers: []upnpav.Container{container}}, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// browseVirtualChildren lists the containers of a view, or the items of a container.
+func (cd *contentDirectory) browseVirtualChildren(ctx context.Context, vid virtualID) (*upnpav.DIDLLite, error) {
+	lib, err := cd.library(ctx)
+	if err != nil {
+		return nil, err
+	}
+	containers, items := lib.children(vid)
+	return &upnpav.DIDLLite{Containers: containers, Items: items}, nil
+}
+
+// library returns every audio item under basePath, waiting for it to be rebuilt if anything has changed since it was last built.
+// Concurrent calls share a build, and do not hold up Browses that do not need the library.
+func (cd *contentDirectory) library(ctx context.Context) (*library, error) {
+	lib, build := cd.refreshLibrary()
+	if lib != nil {
+		return lib, nil
+	}
+
+	select {
+	case <-build.done:
+		return build.lib, build.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// latestLibrary returns the most recently built library, which may be out of date, or nil if none has been built yet.
+// If it is out of date, it is rebuilt in the background.
+func (cd *contentDirectory) latestLibrary() *library {
+	cd.refreshLibrary()
+
+	cd.mu.Lock()
+	defer cd.mu.Unlock()
+	return cd.lib
+}
+
+// refreshLibrary returns the library if it is up to date, or else the build that will bring it up to date, starting one if needed.
+func (cd *contentDirectory) refreshLibrary() (*library, *libraryBuild) {
+	updateID := cd.updateIDs.SystemUpdateID()
+
+	cd.mu.Lock()
+	defer cd.mu.Unlock()
+
+	if cd.lib != nil && cd.lib.updateID == updateID {
+		return cd.lib, nil
+	}
+	if cd.build != nil && cd.build.updateID == updateID {
+		return nil, cd.build
+	}
+
+	build := &libraryBuild{
+		updateID: updateID,
+		done:     make(chan struct{}),
+	}
+	cd.build = build
+	go func() {
+		// The build is shared, so it is not cancelled with the Browse that started it.
+		build.lib, build.err = cd.buildLibrary(context.Background(), updateID)
+		if build.err != nil {
+			log.WithFields(log.Fields{
+				"updateID": updateID,
+				"error":    build.err,
+			}).Warning("could not build views")
+		}
+
+		cd.mu.Lock()
+		if build.err == nil && (cd.lib == nil || cd.lib.updateID < updateID) {
+			cd.lib = build.lib
+		}
+		if cd.build == build {
+			cd.build = nil
+		}
+		cd.mu.Unlock()
+		close(build.done)
+	}()
+	return nil, build
+}
+
+// buildLibrary walks basePath for every audio item, and groups them for each view.
+func (cd *contentDirectory) buildLibrary(ctx context.Context, updateID uint) (*library, error) {
+	_, itemPathsByDir, err := walkMedia(ctx, cd.basePath, false)
+	if err != nil {
+		return nil, err
+	}
+
+	lib := &library{
+		updateID: updateID,
+		groups:   map[View][]group{},
+	}
+	for _, dir := range sortedKeys(itemPathsByDir) {
+		items, err := cd.itemsForPaths(itemPathsByDir[dir]...)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if strings.HasPrefix(string(item.Class), string(upnpav.AudioItem)) {
+				lib.items = append(lib.items, item)
+			}
+		}
+	}
+	for _, view := range cd.views {
+		if view != FoldersView {
+			lib.groups[view] = groupItems(view, lib.items)
+		}
+	}
+	return lib, nil
+}
+
+// children returns the containers of a view, or the items of a view's container.
+// Items in views have their own ObjectIDs, and refer to their ObjectIDs in the Folders view.
+func (lib *library) children(vid virtualID) ([]upnpav.Container, []upnpav.Item) {
+	groups := lib.groups[vid.view]
+
+	if vid.key == nil && viewKeySizes[vid.view] > 0 {
+		var containers []upnpav.Container
+		for _, g := range groups {
+			containers = append(containers, upnpav.Container{
+				ID:         virtualID{view: vid.view, key: g.key}.objectID(),
+				Parent:     virtualID{view: vid.view}.objectID(),
+				Class:      viewClasses[vid.view],
+				Title:      g.key[0],
+				ChildCount: len(g.items),
+			})
+		}
+		return containers, nil
+	}
+
+	parent := virtualID{view: vid.view, key: vid.key}
+	for _, g := range groups {
+		if !equalKeys(g.key, vid.key) {
+			continue
+		}
+		var items []upnpav.Item
+		for _, item := range g.items {
+			item.RefID = string(item.ID)
+			item.ID = virtualID{view: vid.view, key: vid.key, path: item.RefID}.objectID()
+			item.Parent = parent.objectID()
+			items = append(items, item)
+		}
+		return nil, items
+	}
+	return nil, nil
+}
+
+// groupItems groups the items of a view by their containers, sorted by title.
+func groupItems(view View, items []upnpav.Item) []group {
+	byKey := map[string]*group{}
+	var groups []*group
+	for _, item := range items {
+		for _, key := range keysForItem(view, item) {
+			id := strings.Join(key, "\x00")
+			g, ok := byKey[id]
+			if !ok {
+				g = &group{key: key}
+				byKey[id] = g
+				groups = append(groups, g)
+			}
+			g.items = append(g.items, item)
+		}
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		a, b := strings.ToLower(strings.Join(groups[i].key, "\x00")), strings.ToLower(strings.Join(groups[j].key, "\x00"))
+		return a < b
+	})
+
+	sorted := make([]group, len(groups))
+	for i, g := range groups {
+		sorted[i] = *g
+		if view == TracksView {
+			sort.SliceStable(sorted[i].items, func(a, b int) bool {
+				return strings.ToLower(sorted[i].items[a].Title) < strings.ToLower(sorted[i].items[b].Title)
+			})
+		} else {
+			sort.SliceStable(sorted[i].items, func(a, b int) bool {
+				return lessByAlbum(sorted[i].items[a], sorted[i].items[b])
+			})
+		}
+	}
+	return sorted
+}
+
+// keysForItem returns the keys of the containers of a view that an item belongs in.
+func keysForItem(view View, item upnpav.Item) [][]string {
+	var keys [][]string
+	switch view {
+	case AlbumsView:
+		albumArtist := ""
+		for _, artist := range item.Artists {
+			if artist.Role == mediaserver.AlbumArtistRole {
+				albumArtist = artist.Name
+				break
+			}
+		}
+		for _, album := range item.Albums {
+			keys = append(keys, []string{album, albumArtist})
+		}
+	case ArtistsView:
+		// Album artists are only used if there are no track artists.
+		var performers, albumArtists [][]string
+		for _, artist := range item.Artists {
+			if artist.Role == mediaserver.AlbumArtistRole {
+				albumArtists = append(albumArtists, []string{artist.Name})
+			} else {
+				performers = append(performers, []string{artist.Name})
+			}
+		}
+		keys = performers
+		if len(keys) == 0 {
+			keys = albumArtists
+		}
+	case GenresView:
+		for _, genre := range item.Genres {
+			keys = append(keys, []string{genre})
+		}
+	case TracksView:
+		keys = [][]string{{}}
+	}
+	return keys
+}
+
+// lessByAlbum orders items by album, then directory, then track number, then path.
+func lessByAlbum(a, b upnpav.Item) bool {
+	albumA, albumB := "", ""
+	if len(a.Albums) > 0 {
+		albumA = strings.ToLower(a.Albums[0])
+	}
+	if len(b.Albums) > 0 {
+		albumB = strings.ToLower(b.Albums[0])
+	}
+	if albumA != albumB {
+		return albumA < albumB
+	}
+	if dirA, dirB := path.Dir(string(a.ID)), path.Dir(string(b.ID)); dirA != dirB {
+		return dirA < dirB
+	}
+	if a.TrackNumber != b.TrackNumber {
+		return a.TrackNumber < b.TrackNumber
+	}
+	return a.ID < b.ID
+}
+
+func equalKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// searchVirtual returns the containers and items under a view or container that match the criteria.
+func (cd *contentDirectory) searchVirtual(ctx context.Context, vid virtualID, criteria search.Criteria) (*upnpav.DIDLLite, error) {
+	lib, err := cd.library(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	didllite := &upnpav.DIDLLite{}
+	containers, items := lib.children(vid)
+	for _, container := range containers {
+		if search.MatchesContainer(criteria, container) {
+			didllite.Containers = append(didllite.Containers, container)
+		}
+		containerID, _ := cd.parseVirtualID(container.ID)
+		_, containerItems := lib.children(containerID)
+		items = append(items, containerItems...)
+	}
+	for _, item := range items {
+		if search.MatchesItem(criteria, item) {
+			didllite.Items = append(didllite.Items, item)
+		}
+	}
+	return didllite, nil
+}
@@ -0,0 +1,299 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package fileserver
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethulhu/helix/media"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/upnpav/contentdirectory"
+)
+
+func TestViews(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fileserver")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	prober := fakeProber{
+		"01 Pale.mp3": {
+			Title: "Pale",
+			Tags:  map[string]string{"album": "Pale", "artist": "Ethel Morgan", "genre": "Pop", "track": "1"},
+		},
+		"02 Blue.mp3": {
+			Title: "Blue",
+			Tags:  map[string]string{"album": "Pale", "artist": "Ethel Morgan; Guest", "genre": "Pop; Rock", "track": "2"},
+		},
+		"01 Violet.mp3": {
+			Title: "Violet",
+			Tags:  map[string]string{"album": "Violet", "album_artist": "Various", "artist": "Someone", "genre": "Rock"},
+		},
+		"loose.mp3": {
+			Title: "loose",
+		},
+		"video.mp4": {
+			Title: "video",
+			Tags:  map[string]string{"album": "Videos"},
+		},
+	}
+	for _, p := range []string{
+		"Pale/01 Pale.mp3",
+		"Pale/02 Blue.mp3",
+		"Violet/01 Violet.mp3",
+		"Violet/video.mp4",
+		"loose.mp3",
+	} {
+		p = filepath.Join(dir, p)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatalf("could not create directory: %v", err)
+		}
+		if err := ioutil.WriteFile(p, nil, 0644); err != nil {
+			t.Fatalf("could not create file: %v", err)
+		}
+	}
+
+	updateIDs := contentdirectory.NewUpdateIDs(0)
+	cd, err := NewContentDirectory(dir, "http://foo/", ContentDirectoryOptions{
+		MetadataCache: media.NoOpCache{Prober: prober},
+		UpdateIDs:     updateIDs,
+		Views:         Views,
+	})
+	if err != nil {
+		t.Fatalf("could not create ContentDirectory: %v", err)
+	}
+
+	tests := []struct {
+		object         upnpav.ObjectID
+		wantContainers []upnpav.ObjectID
+		wantItems      []upnpav.ObjectID
+		wantTitles     []string
+	}{
+		{
+			object:         contentdirectory.Root,
+			wantContainers: []upnpav.ObjectID{".folders", ".albums", ".artists", ".genres", ".tracks"},
+			wantTitles:     []string{"Folders", "Albums", "Artists", "Genres", "All Tracks"},
+		},
+		{
+			object:         ".folders",
+			wantContainers: []upnpav.ObjectID{"Pale", "Violet"},
+			wantItems:      []upnpav.ObjectID{"loose.mp3"},
+			wantTitles:     []string{"Pale", "Violet", "loose"},
+		},
+		{
+			object:         ".albums",
+			wantContainers: []upnpav.ObjectID{".albums/Pale/", ".albums/Violet/Various"},
+			wantTitles:     []string{"Pale", "Violet"},
+		},
+		{
+			object:     ".albums/Pale/",
+			wantItems:  []upnpav.ObjectID{".albums/Pale//Pale%2F01%20Pale.mp3", ".albums/Pale//Pale%2F02%20Blue.mp3"},
+			wantTitles: []string{"Pale", "Blue"},
+		},
+		{
+			object:         ".artists",
+			wantContainers: []upnpav.ObjectID{".artists/Ethel%20Morgan", ".artists/Guest", ".artists/Someone"},
+			wantTitles:     []string{"Ethel Morgan", "Guest", "Someone"},
+		},
+		{
+			object:     ".genres/Rock",
+			wantItems:  []upnpav.ObjectID{".genres/Rock/Pale%2F02%20Blue.mp3", ".genres/Rock/Violet%2F01%20Violet.mp3"},
+			wantTitles: []string{"Blue", "Violet"},
+		},
+		{
+			object:     ".tracks",
+			wantItems:  []upnpav.ObjectID{".tracks/Pale%2F02%20Blue.mp3", ".tracks/loose.mp3", ".tracks/Pale%2F01%20Pale.mp3", ".tracks/Violet%2F01%20Violet.mp3"},
+			wantTitles: []string{"Blue", "loose", "Pale", "Violet"},
+		},
+	}
+
+	for i, tt := range tests {
+		didllite, err := cd.BrowseChildren(context.Background(), tt.object)
+		if err != nil {
+			t.Errorf("[%d]: got error: %v", i, err)
+			continue
+		}
+
+		var gotContainers, gotItems []upnpav.ObjectID
+		var gotTitles []string
+		for _, container := range didllite.Containers {
+			gotContainers = append(gotContainers, container.ID)
+			gotTitles = append(gotTitles, container.Title)
+			if container.Parent != tt.object {
+				t.Errorf("[%d]: container %q has parent %q, want %q", i, container.ID, container.Parent, tt.object)
+			}
+		}
+		for _, item := range didllite.Items {
+			gotItems = append(gotItems, item.ID)
+			gotTitles = append(gotTitles, item.Title)
+			if item.Parent != tt.object {
+				t.Errorf("[%d]: item %q has parent %q, want %q", i, item.ID, item.Parent, tt.object)
+			}
+		}
+		if !reflect.DeepEqual(gotContainers, tt.wantContainers) {
+			t.Errorf("[%d]: got containers %v, want %v", i, gotContainers, tt.wantContainers)
+		}
+		if !reflect.DeepEqual(gotItems, tt.wantItems) {
+			t.Errorf("[%d]: got items %v, want %v", i, gotItems, tt.wantItems)
+		}
+		if !reflect.DeepEqual(gotTitles, tt.wantTitles) {
+			t.Errorf("[%d]: got titles %v, want %v", i, gotTitles, tt.wantTitles)
+		}
+	}
+
+	didllite, err := cd.BrowseMetadata(context.Background(), ".albums/Violet/Various/Violet%2F01%20Violet.mp3")
+	if err != nil {
+		t.Fatalf("BrowseMetadata(item) returned error: %v", err)
+	}
+	if !didllite.IsSingleItem() || didllite.Items[0].RefID != "Violet/01 Violet.mp3" || didllite.Items[0].Parent != ".albums/Violet/Various" {
+		t.Errorf("BrowseMetadata(item) == %+v, want an item referring to Violet/01 Violet.mp3", didllite)
+	}
+
+	for _, id := range []upnpav.ObjectID{".albums/Missing/", ".albums/Pale", ".nothing", ".tracks/missing.mp3"} {
+		if _, err := cd.BrowseMetadata(context.Background(), id); err != contentdirectory.ErrNoSuchObject {
+			t.Errorf("BrowseMetadata(%q) returned error %v, want %v", id, err, contentdirectory.ErrNoSuchObject)
+		}
+	}
+
+	// Views are rebuilt when the SystemUpdateID changes.
+	prober["new.mp3"] = &media.Metadata{Title: "new", Tags: map[string]string{"genre": "Rock"}}
+	if err := ioutil.WriteFile(filepath.Join(dir, "new.mp3"), nil, 0644); err != nil {
+		t.Fatalf("could not create file: %v", err)
+	}
+	updateIDs.Update(ChangedContainers(dir, Views, []string{filepath.Join(dir, "new.mp3")})...)
+
+	didllite, err = cd.BrowseChildren(context.Background(), ".genres/Rock")
+	if err != nil {
+		t.Fatalf("BrowseChildren(%q) returned error: %v", ".genres/Rock", err)
+	}
+	if len(didllite.Items) != 3 {
+		t.Errorf("BrowseChildren(%q) returned %d items after an update, want 3", ".genres/Rock", len(didllite.Items))
+	}
+}
+
+func TestViewsRootDoesNotWaitForLibrary(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fileserver")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "loose.mp3"), nil, 0644); err != nil {
+		t.Fatalf("could not create file: %v", err)
+	}
+
+	prober := blockingProber{release: make(chan struct{})}
+	cd, err := NewContentDirectory(dir, "http://foo/", ContentDirectoryOptions{
+		MetadataCache: media.NoOpCache{Prober: prober},
+		UpdateIDs:     contentdirectory.NewUpdateIDs(0),
+		Views:         []View{TracksView},
+	})
+	if err != nil {
+		t.Fatalf("could not create ContentDirectory: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The library cannot be built until the prober is released.
+	didllite, err := cd.BrowseChildren(ctx, contentdirectory.Root)
+	if err != nil {
+		t.Fatalf("BrowseChildren(root) returned error: %v", err)
+	}
+	if len(didllite.Containers) != 1 || didllite.Containers[0].ChildCount != 0 {
+		t.Errorf("BrowseChildren(root) before the library was built == %+v, want .tracks with no ChildCount", didllite.Containers)
+	}
+
+	close(prober.release)
+	didllite, err = cd.BrowseChildren(ctx, ".tracks")
+	if err != nil {
+		t.Fatalf("BrowseChildren(%q) returned error: %v", ".tracks", err)
+	}
+	if len(didllite.Items) != 1 {
+		t.Errorf("BrowseChildren(%q) returned %d items, want 1", ".tracks", len(didllite.Items))
+	}
+
+	didllite, err = cd.BrowseChildren(ctx, contentdirectory.Root)
+	if err != nil {
+		t.Fatalf("BrowseChildren(root) returned error: %v", err)
+	}
+	if len(didllite.Containers) != 1 || didllite.Containers[0].ChildCount != 1 {
+		t.Errorf("BrowseChildren(root) after the library was built == %+v, want .tracks with 1 child", didllite.Containers)
+	}
+}
+
+func TestFoldersOnlyView(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fileserver")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "loose.mp3"), nil, 0644); err != nil {
+		t.Fatalf("could not create file: %v", err)
+	}
+
+	cd, err := NewContentDirectory(dir, "http://foo/", ContentDirectoryOptions{
+		MetadataCache: media.NoOpCache{Prober: fakeProber{}},
+		UpdateIDs:     contentdirectory.NewUpdateIDs(0),
+		Views:         []View{FoldersView},
+	})
+	if err != nil {
+		t.Fatalf("could not create ContentDirectory: %v", err)
+	}
+
+	didllite, err := cd.BrowseChildren(context.Background(), contentdirectory.Root)
+	if err != nil {
+		t.Fatalf("BrowseChildren(root) returned error: %v", err)
+	}
+	if !didllite.IsSingleItem() || didllite.Items[0].ID != "loose.mp3" || didllite.Items[0].Parent != contentdirectory.Root {
+		t.Errorf("BrowseChildren(root) == %+v, want loose.mp3", didllite)
+	}
+
+	if _, err := cd.BrowseChildren(context.Background(), ".albums"); err != contentdirectory.ErrNoSuchObject {
+		t.Errorf("BrowseChildren(%q) returned error %v, want %v", ".albums", err, contentdirectory.ErrNoSuchObject)
+	}
+}
+
+func TestChangedContainersWithViews(t *testing.T) {
+	got := ChangedContainers("/mnt/media", []View{FoldersView, AlbumsView}, []string{"/mnt/media/new.mp3", "/mnt/media/Pale/01 Pale.mp3"})
+	want := []upnpav.ObjectID{".folders", "Pale", ".albums"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// fakeProber returns metadata by filename, with the MIME-type from the extension.
+type fakeProber map[string]*media.Metadata
+
+func (p fakeProber) Probe(path string) (*media.Metadata, error) {
+	md := &media.Metadata{Title: filepath.Base(path)}
+	if known, ok := p[filepath.Base(path)]; ok {
+		copied := *known
+		md = &copied
+	}
+	md.MIMEType = "audio/mpeg"
+	if filepath.Ext(path) == ".mp4" {
+		md.MIMEType = "video/mp4"
+	}
+	return md, nil
+}
+
+// blockingProber waits for release before probing.
+type blockingProber struct {
+	release chan struct{}
+}
+
+func (p blockingProber) Probe(path string) (*media.Metadata, error) {
+	<-p.release
+	return fakeProber{}.Probe(path)
+}
@@ -8,10 +8,12 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
+	"strings"
 
 	"github.com/ethulhu/helix/logger"
 	"github.com/ethulhu/helix/upnpav"
 	"github.com/ethulhu/helix/upnpav/contentdirectory/search"
+	"github.com/ethulhu/helix/upnpav/contentdirectory/sortcriteria"
 )
 
 type (
@@ -98,23 +100,53 @@ func (h SOAPHandler) browse(ctx context.Context, in []byte) ([]byte, error) {
 		return nil, upnpav.ErrInvalidArgs
 	}
 
-	var err error
-	var didllite *upnpav.DIDLLite
+	page := Page{
+		StartingIndex:  req.StartingIndex,
+		RequestedCount: req.RequestedCount,
+	}
+
+	var result Result
 	switch req.BrowseFlag {
 	case browseMetadata:
-		didllite, err = h.Interface.BrowseMetadata(ctx, req.Object)
+		if page.StartingIndex != 0 {
+			return nil, upnpav.ErrInvalidArgs
+		}
+		didllite, err := h.Interface.BrowseMetadata(ctx, req.Object)
+		if err != nil {
+			return nil, err
+		}
+		updateID, err := h.Interface.SystemUpdateID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = Result{
+			DIDLLite:       didllite,
+			NumberReturned: 1,
+			TotalMatches:   1,
+			UpdateID:       updateID,
+		}
 	case browseChildren:
-		didllite, err = h.Interface.BrowseChildren(ctx, req.Object)
+		criteria, err := h.sortCriteria(ctx, req.SortCriteria)
+		if err != nil {
+			return nil, err
+		}
+		result, err = h.sortedPage(page, criteria, func(page Page) (Result, error) {
+			return h.Interface.BrowseChildrenPage(ctx, req.Object, page)
+		})
+		if err != nil {
+			return nil, err
+		}
 	default:
 		return nil, upnpav.ErrInvalidArgs
 	}
-	if err != nil {
-		return nil, err
-	}
 
-	rsp := browseResponse{}
-	if didllite != nil {
-		rsp.Result = upnpav.EncodedDIDLLite{*didllite}
+	rsp := browseResponse{
+		NumberReturned: result.NumberReturned,
+		TotalMatches:   result.TotalMatches,
+		UpdateID:       result.UpdateID,
+	}
+	if result.DIDLLite != nil {
+		rsp.Result = upnpav.EncodedDIDLLite{*result.DIDLLite}
 	}
 	return xml.Marshal(rsp)
 }
@@ -132,14 +164,73 @@ func (h SOAPHandler) search(ctx context.Context, in []byte) ([]byte, error) {
 		return nil, fmt.Errorf("could not parse search query: %v", err)
 	}
 
-	didllite, err := h.Interface.Search(ctx, req.Container, criteria)
+	sortCriteria, err := h.sortCriteria(ctx, req.SortCriteria)
 	if err != nil {
 		return nil, err
 	}
 
-	rsp := searchResponse{}
-	if didllite != nil {
-		rsp.Result = upnpav.EncodedDIDLLite{*didllite}
+	page := Page{
+		StartingIndex:  req.StartingIndex,
+		RequestedCount: req.RequestedCount,
+	}
+
+	result, err := h.sortedPage(page, sortCriteria, func(page Page) (Result, error) {
+		return h.Interface.SearchPage(ctx, req.Container, criteria, page)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := searchResponse{
+		NumberReturned: result.NumberReturned,
+		TotalMatches:   result.TotalMatches,
+		UpdateID:       result.UpdateID,
+	}
+	if result.DIDLLite != nil {
+		rsp.Result = upnpav.EncodedDIDLLite{*result.DIDLLite}
 	}
 	return xml.Marshal(rsp)
 }
+
+// sortCriteria parses the SortCriteria argument, and checks it against the SortCapabilities.
+func (h SOAPHandler) sortCriteria(ctx context.Context, raw []string) (sortcriteria.Criteria, error) {
+	criteria, err := sortcriteria.Parse(strings.Join(raw, ","))
+	if err != nil {
+		log, _ := logger.FromContext(ctx)
+		log.WithError(err).Warning("could not parse sort criteria")
+		return nil, ErrInvalidSortCriteria
+	}
+	if len(criteria) == 0 {
+		return nil, nil
+	}
+
+	caps, err := h.Interface.SortCapabilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if property, ok := criteria.Supported(caps); !ok {
+		log, _ := logger.FromContext(ctx)
+		log.AddField("sort.property", property)
+		log.Warning("unsupported sort property")
+		return nil, ErrInvalidSortCriteria
+	}
+	return criteria, nil
+}
+
+// sortedPage gets a page of objects.
+// If there are sort criteria, it gets all of the objects to sort them before paginating.
+func (h SOAPHandler) sortedPage(page Page, criteria sortcriteria.Criteria, getPage func(Page) (Result, error)) (Result, error) {
+	if len(criteria) == 0 {
+		return getPage(page)
+	}
+
+	all, err := getPage(Page{})
+	if err != nil {
+		return Result{}, err
+	}
+	criteria.Sort(all.DIDLLite)
+
+	result := Paginate(all.DIDLLite, page)
+	result.UpdateID = all.UpdateID
+	return result, nil
+}
@@ -15,13 +15,13 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
-	"time"
 
 	"github.com/ethulhu/helix/logger"
 	"github.com/ethulhu/helix/media"
 	"github.com/ethulhu/helix/upnpav"
 	"github.com/ethulhu/helix/upnpav/contentdirectory"
 	"github.com/ethulhu/helix/upnpav/contentdirectory/search"
+	"github.com/ethulhu/helix/upnpav/mediaserver"
 	"go.eth.moe/jackalope"
 	"go.eth.moe/jackalope/query"
 )
@@ -31,13 +31,20 @@ type (
 		basePath string
 		baseURL  *url.URL
 
-		metadataCache media.MetadataCache
+		metadataCache     media.MetadataCache
+		transcodeProfiles []media.TranscodeProfile
 
 		jackalope jackalope.Interface
+
+		updateIDs *contentdirectory.UpdateIDs
 	}
 )
 
-func NewContentDirectory(basePath, baseURL string, metadataCache media.MetadataCache, jackalope jackalope.Interface) (contentdirectory.Interface, error) {
+// NewContentDirectory returns a ContentDirectory of the media under basePath tagged in jackalope, served from baseURL.
+// Audio items also have a Resource for each of transcodeProfiles, served by mediaserver.NewFileHandler.
+// Its SystemUpdateID comes from updateIDs.
+// As any change can affect any tag query, only contentdirectory.Root should be passed to updateIDs.Update.
+func NewContentDirectory(basePath, baseURL string, metadataCache media.MetadataCache, transcodeProfiles []media.TranscodeProfile, jackalope jackalope.Interface, updateIDs *contentdirectory.UpdateIDs) (contentdirectory.Interface, error) {
 	maybeURL, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse base URL: %w", err)
@@ -48,23 +55,13 @@ func NewContentDirectory(basePath, baseURL string, metadataCache media.MetadataC
 		return nil, fmt.Errorf("could not get absolute path: %w", err)
 	}
 
-	go func() {
-		log := logger.Background()
-		log.AddField("path", absPath)
-		log.Info("warming metadata cache")
-
-		start := time.Now()
-		metadataCache.Warm(absPath)
-
-		log.AddField("duration", time.Since(start))
-		log.Info("finished warming metadata cache")
-	}()
-
 	return &contentDirectory{
-		basePath:      absPath,
-		baseURL:       maybeURL,
-		metadataCache: metadataCache,
-		jackalope:     jackalope,
+		basePath:          absPath,
+		baseURL:           maybeURL,
+		metadataCache:     metadataCache,
+		transcodeProfiles: transcodeProfiles,
+		jackalope:         jackalope,
+		updateIDs:         updateIDs,
 	}, nil
 }
 
@@ -127,29 +124,36 @@ func (cd *contentDirectory) BrowseMetadata(ctx context.Context, id upnpav.Object
 }
 
 func (cd *contentDirectory) BrowseChildren(ctx context.Context, id upnpav.ObjectID) (*upnpav.DIDLLite, error) {
+	result, err := cd.BrowseChildrenPage(ctx, id, contentdirectory.Page{})
+	return result.DIDLLite, err
+}
+func (cd *contentDirectory) BrowseChildrenPage(ctx context.Context, id upnpav.ObjectID, page contentdirectory.Page) (contentdirectory.Result, error) {
 	log, ctx := logger.FromContext(ctx)
 	log.AddField("jackalope.method", "BrowseChildren")
 	log.AddField("object", id)
+	log.AddField("page", page)
 
 	if id == contentdirectory.Root {
 		containers, err := cd.containersForPaths(nil)
 		if err != nil {
 			log.WithError(err).Error("could not list tags from Jackalope")
-			return nil, upnpav.ErrActionFailed
+			return contentdirectory.Result{}, upnpav.ErrActionFailed
 		}
-		return &upnpav.DIDLLite{Containers: containers}, nil
+		result := contentdirectory.Paginate(&upnpav.DIDLLite{Containers: containers}, page)
+		result.UpdateID = cd.updateIDs.ContainerUpdateID(id)
+		return result, nil
 	}
 
 	query, ok := queryForObjectID(id)
 	if !ok {
 		log.Warning("bad query")
-		return nil, contentdirectory.ErrNoSuchObject
+		return contentdirectory.Result{}, contentdirectory.ErrNoSuchObject
 	}
 
 	rawPaths, err := cd.jackalope.Query(query.String())
 	if err != nil {
 		log.WithError(err).Error("could not query Jackalope")
-		return nil, upnpav.ErrActionFailed
+		return contentdirectory.Result{}, upnpav.ErrActionFailed
 	}
 
 	var paths []string
@@ -166,30 +170,62 @@ func (cd *contentDirectory) BrowseChildren(ctx context.Context, id upnpav.Object
 	containers, err := cd.containersForPaths(query, paths...)
 	if err != nil {
 		log.WithError(err).Error("could not list tags from Jackalope")
-		return nil, upnpav.ErrActionFailed
+		return contentdirectory.Result{}, upnpav.ErrActionFailed
 	}
 
-	items, err := cd.itemsForPaths(paths...)
-	if err != nil {
-		log.WithError(err).Warning("could not describe items from path")
-		return nil, upnpav.ErrActionFailed
+	// Containers come before items, and only the requested page of items is described, because describing items is slow.
+	total := len(containers) + len(paths)
+	start, end := page.Bounds(total)
+
+	didllite := &upnpav.DIDLLite{}
+	if start < len(containers) {
+		containerEnd := end
+		if containerEnd > len(containers) {
+			containerEnd = len(containers)
+		}
+		didllite.Containers = containers[start:containerEnd]
 	}
 
-	return &upnpav.DIDLLite{Containers: containers, Items: items}, nil
+	itemStart, itemEnd := start-len(containers), end-len(containers)
+	if itemStart < 0 {
+		itemStart = 0
+	}
+	if itemEnd > itemStart {
+		items, err := cd.itemsForPaths(paths[itemStart:itemEnd]...)
+		if err != nil {
+			log.WithError(err).Warning("could not describe items from path")
+			return contentdirectory.Result{}, upnpav.ErrActionFailed
+		}
+		didllite.Items = items
+	}
+
+	return contentdirectory.Result{
+		DIDLLite:       didllite,
+		NumberReturned: uint(end - start),
+		TotalMatches:   uint(total),
+		UpdateID:       cd.updateIDs.SystemUpdateID(),
+	}, nil
 }
 
 func (cd *contentDirectory) SearchCapabilities(_ context.Context) ([]string, error) {
 	return nil, nil
 }
 func (cd *contentDirectory) SortCapabilities(_ context.Context) ([]string, error) {
-	return nil, nil
+	return []string{"dc:title", "dc:date", "upnp:class", "upnp:album", "upnp:originalTrackNumber", "upnp:artist", "upnp:genre", "res@duration"}, nil
 }
 func (cd *contentDirectory) SystemUpdateID(_ context.Context) (uint, error) {
-	return 0, nil
+	return cd.updateIDs.SystemUpdateID(), nil
 }
 func (cd *contentDirectory) Search(_ context.Context, _ upnpav.ObjectID, _ search.Criteria) (*upnpav.DIDLLite, error) {
 	return nil, nil
 }
+func (cd *contentDirectory) SearchPage(ctx context.Context, id upnpav.ObjectID, criteria search.Criteria, page contentdirectory.Page) (contentdirectory.Result, error) {
+	didllite, err := cd.Search(ctx, id, criteria)
+	if err != nil {
+		return contentdirectory.Result{}, err
+	}
+	return contentdirectory.Paginate(didllite, page), nil
+}
 
 func queryForObjectID(id upnpav.ObjectID) (query.Expr, bool) {
 	if q, err := query.Parse(string(id)); err == nil {
@@ -260,33 +296,20 @@ func (cd *contentDirectory) itemsForPaths(paths ...string) ([]upnpav.Item, error
 
 	var items []upnpav.Item
 	for i, p := range paths {
-		md := metadatas[i]
-
-		class, err := upnpav.ClassForMIMEType(md.MIMEType)
+		item, err := mediaserver.Item(cd.uri(p), metadatas[i], cd.transcodeProfiles)
 		if err != nil {
-			panic(fmt.Sprintf("should only have audio or video MIME-Types, got %q for path %q", md.MIMEType, p))
+			panic(fmt.Sprintf("should only have audio or video MIME-Types, got %q for path %q", metadatas[i].MIMEType, p))
 		}
-
-		var albumArtURIs []string
+		item.ID = objectIDForPath(cd.basePath, p)
+		item.Parent = contentdirectory.Root
 		for _, artPath := range coverArts[i] {
-			albumArtURIs = append(albumArtURIs, cd.uri(artPath))
+			if !media.IsImage(artPath) {
+				// Cover art embedded in media files is not served.
+				continue
+			}
+			item.AlbumArtURIs = append(item.AlbumArtURIs, mediaserver.AlbumArtURIs(cd.uri(artPath), nil)...)
 		}
-
-		items = append(items, upnpav.Item{
-			ID:           objectIDForPath(cd.basePath, p),
-			Parent:       contentdirectory.Root,
-			Class:        class,
-			Title:        md.Title,
-			AlbumArtURIs: albumArtURIs,
-			Resources: []upnpav.Resource{{
-				URI:      cd.uri(p),
-				Duration: &upnpav.Duration{md.Duration},
-				ProtocolInfo: &upnpav.ProtocolInfo{
-					Protocol:      upnpav.ProtocolHTTP,
-					ContentFormat: md.MIMEType,
-				},
-			}},
-		})
+		items = append(items, item)
 	}
 
 	return items, nil
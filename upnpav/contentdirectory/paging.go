@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package contentdirectory
+
+import (
+	"github.com/ethulhu/helix/upnpav"
+)
+
+// Bounds returns the start and end indices of the page within a list of total objects, for slicing.
+func (p Page) Bounds(total int) (int, int) {
+	start := int(p.StartingIndex)
+	if start > total {
+		start = total
+	}
+	end := total
+	if p.RequestedCount != 0 && start+int(p.RequestedCount) < total {
+		end = start + int(p.RequestedCount)
+	}
+	return start, end
+}
+
+// Paginate returns a page of a DIDL-Lite document.
+// Containers are considered to come before items.
+func Paginate(didllite *upnpav.DIDLLite, page Page) Result {
+	if didllite == nil {
+		return Result{DIDLLite: &upnpav.DIDLLite{}}
+	}
+
+	total := len(didllite.Containers) + len(didllite.Items)
+	start, end := page.Bounds(total)
+
+	paged := &upnpav.DIDLLite{}
+	for i := start; i < end; i++ {
+		if i < len(didllite.Containers) {
+			paged.Containers = append(paged.Containers, didllite.Containers[i])
+		} else {
+			paged.Items = append(paged.Items, didllite.Items[i-len(didllite.Containers)])
+		}
+	}
+
+	return Result{
+		DIDLLite:       paged,
+		NumberReturned: uint(end - start),
+		TotalMatches:   uint(total),
+	}
+}
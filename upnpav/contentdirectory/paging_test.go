@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package contentdirectory
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethulhu/helix/upnpav"
+)
+
+func TestPageBounds(t *testing.T) {
+	tests := []struct {
+		page      Page
+		total     int
+		wantStart int
+		wantEnd   int
+	}{
+		{
+			page:      Page{},
+			total:     10,
+			wantStart: 0,
+			wantEnd:   10,
+		},
+		{
+			page:      Page{StartingIndex: 3},
+			total:     10,
+			wantStart: 3,
+			wantEnd:   10,
+		},
+		{
+			page:      Page{StartingIndex: 3, RequestedCount: 4},
+			total:     10,
+			wantStart: 3,
+			wantEnd:   7,
+		},
+		{
+			page:      Page{StartingIndex: 8, RequestedCount: 4},
+			total:     10,
+			wantStart: 8,
+			wantEnd:   10,
+		},
+		{
+			page:      Page{StartingIndex: 12, RequestedCount: 4},
+			total:     10,
+			wantStart: 10,
+			wantEnd:   10,
+		},
+	}
+
+	for i, tt := range tests {
+		gotStart, gotEnd := tt.page.Bounds(tt.total)
+		if gotStart != tt.wantStart || gotEnd != tt.wantEnd {
+			t.Errorf("[%d]: got (%d, %d), want (%d, %d)", i, gotStart, gotEnd, tt.wantStart, tt.wantEnd)
+		}
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	didllite := &upnpav.DIDLLite{
+		Containers: []upnpav.Container{{ID: "c1"}, {ID: "c2"}},
+		Items:      []upnpav.Item{{ID: "i1"}, {ID: "i2"}, {ID: "i3"}},
+	}
+
+	tests := []struct {
+		didllite *upnpav.DIDLLite
+		page     Page
+		want     Result
+	}{
+		{
+			didllite: didllite,
+			page:     Page{},
+			want: Result{
+				DIDLLite:       didllite,
+				NumberReturned: 5,
+				TotalMatches:   5,
+			},
+		},
+		{
+			didllite: didllite,
+			page:     Page{StartingIndex: 1, RequestedCount: 2},
+			want: Result{
+				DIDLLite: &upnpav.DIDLLite{
+					Containers: []upnpav.Container{{ID: "c2"}},
+					Items:      []upnpav.Item{{ID: "i1"}},
+				},
+				NumberReturned: 2,
+				TotalMatches:   5,
+			},
+		},
+		{
+			didllite: didllite,
+			page:     Page{StartingIndex: 4, RequestedCount: 2},
+			want: Result{
+				DIDLLite: &upnpav.DIDLLite{
+					Items: []upnpav.Item{{ID: "i3"}},
+				},
+				NumberReturned: 1,
+				TotalMatches:   5,
+			},
+		},
+		{
+			didllite: nil,
+			page:     Page{StartingIndex: 4, RequestedCount: 2},
+			want: Result{
+				DIDLLite: &upnpav.DIDLLite{},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		got := Paginate(tt.didllite, tt.page)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: got %+v, want %+v", i, got, tt.want)
+		}
+	}
+}
@@ -6,7 +6,6 @@
 package search
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 )
@@ -57,6 +56,13 @@ type (
 		Op       BinaryOp
 		Operand  string
 	}
+
+	// SyntaxError is returned by Parse for malformed queries.
+	SyntaxError struct {
+		// Pos is the offset in the query where the error was found, in runes.
+		Pos int
+		Msg string
+	}
 )
 
 const (
@@ -136,8 +142,16 @@ func (b BinaryExpr) CanonicalExpr() Expr {
 	return b
 }
 
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos)
+}
+func syntaxErrorf(pos int, format string, args ...interface{}) error {
+	return &SyntaxError{pos, fmt.Sprintf(format, args...)}
+}
+
 // Parse parses a DLNA query string and returns a Criteria.
-// TODO: Support operator precidence for And and Or (And binds stronger than Or).
+// And binds more tightly than Or, and keywords such as "and" and "contains" are case-insensitive.
+// Errors are returned as a *SyntaxError.
 func Parse(src string) (Criteria, error) {
 	tokens, err := tokenize(src)
 	if err != nil {
@@ -145,7 +159,8 @@ func Parse(src string) (Criteria, error) {
 	}
 
 	if len(tokens) == 0 {
-		return nil, errors.New("empty query")
+		return nil, syntaxErrorf(0, "empty query")
 	}
+	tokens = append(tokens, token{eof, "", len([]rune(src))})
 	return query(tokens)
 }
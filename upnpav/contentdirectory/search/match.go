@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethulhu/helix/upnpav"
+)
+
+// MatchesItem returns whether an item matches the criteria.
+func MatchesItem(c Criteria, item upnpav.Item) bool {
+	return Matches(c, item.Properties())
+}
+
+// MatchesContainer returns whether a container matches the criteria.
+func MatchesContainer(c Criteria, container upnpav.Container) bool {
+	return Matches(c, container.Properties())
+}
+
+// Filter returns a new DIDL-Lite document with only the objects that match the criteria.
+func Filter(c Criteria, didllite *upnpav.DIDLLite) *upnpav.DIDLLite {
+	filtered := &upnpav.DIDLLite{}
+	if didllite == nil {
+		return filtered
+	}
+	for _, container := range didllite.Containers {
+		if MatchesContainer(c, container) {
+			filtered.Containers = append(filtered.Containers, container)
+		}
+	}
+	for _, item := range didllite.Items {
+		if MatchesItem(c, item) {
+			filtered.Items = append(filtered.Items, item)
+		}
+	}
+	return filtered
+}
+
+// Matches returns whether an object with the given properties matches the criteria.
+// Properties are keyed by their DIDL-Lite names, as returned by upnpav.Item.Properties.
+//
+// Properties can have multiple values, e.g. upnp:artist.
+// Positive operations match if any value matches, and negative operations (!= and doesNotContain) match if no value matches.
+// Only ExistsExpr matches objects without the property.
+func Matches(c Criteria, properties map[string][]string) bool {
+	switch c := c.(type) {
+	case Everything:
+		return true
+	case Query:
+		return matchesExpr(c.Expr, properties)
+	default:
+		panic(fmt.Sprintf("unknown Criteria type %T", c))
+	}
+}
+
+func matchesExpr(expr Expr, properties map[string][]string) bool {
+	switch expr := expr.(type) {
+	case LogicExpr:
+		for _, subExpr := range expr.SubExprs {
+			matched := matchesExpr(subExpr, properties)
+			if expr.Op == And && !matched {
+				return false
+			}
+			if expr.Op == Or && matched {
+				return true
+			}
+		}
+		return expr.Op == And
+
+	case ExistsExpr:
+		_, ok := properties[expr.Property]
+		return ok == expr.Exists
+
+	case BinaryExpr:
+		values, ok := properties[expr.Property]
+		if !ok {
+			return false
+		}
+		switch expr.Op {
+		case NotEqual:
+			return !anyValueMatches(values, Equal, expr.Operand)
+		case DoesNotContain:
+			return !anyValueMatches(values, Contains, expr.Operand)
+		default:
+			return anyValueMatches(values, expr.Op, expr.Operand)
+		}
+
+	default:
+		panic(fmt.Sprintf("unknown Expr type %T", expr))
+	}
+}
+
+func anyValueMatches(values []string, op BinaryOp, operand string) bool {
+	for _, value := range values {
+		if valueMatches(value, op, operand) {
+			return true
+		}
+	}
+	return false
+}
+
+func valueMatches(value string, op BinaryOp, operand string) bool {
+	switch op {
+	case Equal:
+		return upnpav.ComparePropertyValues(value, operand) == 0
+	case LessThan:
+		return upnpav.ComparePropertyValues(value, operand) < 0
+	case LessThanEqual:
+		return upnpav.ComparePropertyValues(value, operand) <= 0
+	case GreaterThan:
+		return upnpav.ComparePropertyValues(value, operand) > 0
+	case GreaterThanEqual:
+		return upnpav.ComparePropertyValues(value, operand) >= 0
+	case Contains:
+		return strings.Contains(strings.ToLower(value), strings.ToLower(operand))
+	case DerivedFrom:
+		// Classes are derived from their dot-separated prefixes, e.g. "object.item" is derived from "object".
+		value, operand = strings.ToLower(value), strings.ToLower(operand)
+		return value == operand || strings.HasPrefix(value, operand+".")
+	default:
+		panic(fmt.Sprintf("unknown BinaryOp %q", op))
+	}
+}
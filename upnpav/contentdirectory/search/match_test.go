@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethulhu/helix/upnpav"
+)
+
+func TestMatchesItem(t *testing.T) {
+	item := upnpav.Item{
+		ID:     "Albums/Walking With Strangers/03.mp3",
+		Parent: "Albums/Walking With Strangers",
+		Title:  "Looking Glass",
+		Class:  upnpav.MusicTrack,
+		Artists: []upnpav.Person{
+			{Name: "The Birthday Massacre", Role: "Performer"},
+			{Name: "Chibi"},
+		},
+		Albums:      []string{"Walking With Strangers"},
+		Genres:      []string{"Synthpop", "Industrial"},
+		Date:        &upnpav.Date{Time: time.Date(2007, time.September, 17, 0, 0, 0, 0, time.UTC)},
+		TrackNumber: 3,
+		Resources: []upnpav.Resource{{
+			URI:      "http://foo/03.mp3",
+			Duration: &upnpav.Duration{Duration: 3*time.Minute + 50*time.Second},
+		}},
+	}
+
+	tests := []struct {
+		query    string
+		criteria Criteria
+		want     bool
+	}{
+		{query: `*`, want: true},
+
+		{query: `dc:title = "looking glass"`, want: true},
+		{query: `dc:title = "Looking"`, want: false},
+		{query: `dc:title != "Red Stars"`, want: true},
+		{query: `dc:title != "Looking Glass"`, want: false},
+		{query: `dc:title contains "GLASS"`, want: true},
+		{query: `dc:title doesNotContain "glass"`, want: false},
+		{query: `dc:title doesNotContain "stars"`, want: true},
+
+		{query: `upnp:artist = "Chibi"`, want: true},
+		{query: `upnp:artist != "Chibi"`, want: false},
+		{query: `upnp:artist contains "birthday"`, want: true},
+		{criteria: Query{BinaryExpr{"upnp:artist@role", Equal, "Performer"}}, want: true},
+		{query: `upnp:genre = "Industrial"`, want: true},
+
+		{query: `upnp:class = "object.item.audioItem.musicTrack"`, want: true},
+		{query: `upnp:class derivedfrom "object.item"`, want: true},
+		{query: `upnp:class derivedfrom "object.item.audioItem"`, want: true},
+		{query: `upnp:class derivedfrom "object.item.audio"`, want: false},
+		{query: `upnp:class derivedfrom "object.container"`, want: false},
+
+		{query: `dc:date = "2007-09-17"`, want: true},
+		{query: `dc:date > "2007-01-01"`, want: true},
+		{query: `dc:date >= "2007-09-17"`, want: true},
+		{query: `dc:date < "2007-09-17"`, want: false},
+		{query: `dc:date <= "2007-09-17"`, want: true},
+
+		{query: `upnp:originalTrackNumber < "10"`, want: true},
+		{query: `upnp:originalTrackNumber > "2"`, want: true},
+		{criteria: Query{BinaryExpr{"res@duration", GreaterThan, "0:03:00"}}, want: true},
+		{criteria: Query{BinaryExpr{"res@duration", LessThan, "0:03:00"}}, want: false},
+		{criteria: Query{BinaryExpr{"res@duration", LessThanEqual, "1:00:00"}}, want: true},
+
+		{criteria: Query{BinaryExpr{"@id", Equal, "Albums/Walking With Strangers/03.mp3"}}, want: true},
+		{criteria: Query{BinaryExpr{"@parentID", Equal, "Albums/Walking With Strangers"}}, want: true},
+		{criteria: Query{ExistsExpr{"@refID", false}}, want: true},
+
+		{query: `upnp:genre exists true`, want: true},
+		{query: `upnp:producer exists true`, want: false},
+		{query: `upnp:producer exists false`, want: true},
+		{query: `upnp:producer != "foo"`, want: false},
+
+		{query: `(upnp:artist = "Chibi") and (dc:title contains "glass")`, want: true},
+		{query: `(upnp:artist = "Rainbow") and (dc:title contains "glass")`, want: false},
+		{query: `(upnp:artist = "Rainbow") or (dc:title contains "glass")`, want: true},
+		{query: `(upnp:artist = "Rainbow") or (dc:title contains "stars")`, want: false},
+	}
+
+	for i, tt := range tests {
+		criteria := tt.criteria
+		if criteria == nil {
+			var err error
+			if criteria, err = Parse(tt.query); err != nil {
+				t.Fatalf("[%d]: could not parse %q: %v", i, tt.query, err)
+			}
+		}
+		if got := MatchesItem(criteria, item); got != tt.want {
+			t.Errorf("[%d]: MatchesItem(%v) == %v, want %v", i, criteria, got, tt.want)
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	didllite := &upnpav.DIDLLite{
+		Containers: []upnpav.Container{
+			{ID: "1", Title: "Albums", Class: upnpav.StorageFolder, ChildCount: 2},
+			{ID: "2", Title: "Walking With Strangers", Class: upnpav.MusicAlbum, ChildCount: 12},
+		},
+		Items: []upnpav.Item{
+			{ID: "3", Title: "Looking Glass", Class: upnpav.MusicTrack},
+			{ID: "4", Title: "Walking With Strangers", Class: upnpav.MusicTrack},
+		},
+	}
+
+	tests := []struct {
+		query string
+		want  *upnpav.DIDLLite
+	}{
+		{
+			query: `dc:title contains "walking"`,
+			want: &upnpav.DIDLLite{
+				Containers: []upnpav.Container{didllite.Containers[1]},
+				Items:      []upnpav.Item{didllite.Items[1]},
+			},
+		},
+		{
+			query: `upnp:class derivedfrom "object.container.album"`,
+			want: &upnpav.DIDLLite{
+				Containers: []upnpav.Container{didllite.Containers[1]},
+			},
+		},
+		{
+			query: `upnp:class derivedfrom "object.item.videoItem"`,
+			want:  &upnpav.DIDLLite{},
+		},
+	}
+
+	for i, tt := range tests {
+		criteria, err := Parse(tt.query)
+		if err != nil {
+			t.Fatalf("[%d]: could not parse %q: %v", i, tt.query, err)
+		}
+		if got := Filter(criteria, didllite); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: Filter(%q) == %+v, want %+v", i, tt.query, got, tt.want)
+		}
+	}
+}
@@ -5,139 +5,148 @@
 package search
 
 import (
-	"errors"
 	"fmt"
 )
 
+// The grammar, from the ContentDirectory spec, with And binding more tightly than Or:
+//
+//	searchCrit ::= '*' | orExp
+//	orExp      ::= andExp ( 'or' andExp )*
+//	andExp     ::= term ( 'and' term )*
+//	term       ::= '(' orExp ')' | relExp
+//	relExp     ::= property binOp quotedVal | property 'exists' boolVal
+
 func consumeToken(tokens []token) (token, []token) {
-	if len(tokens) == 0 {
-		return token{eof, ""}, nil
+	if len(tokens) == 0 || tokens[0].Kind == eof {
+		return peekToken(tokens), tokens
 	}
 	return tokens[0], tokens[1:]
 }
 func peekToken(tokens []token) token {
 	if len(tokens) == 0 {
-		return token{eof, ""}
+		return token{eof, "", 0}
 	}
 	return tokens[0]
 }
 
+func describeToken(t token) string {
+	if t.Kind == eof {
+		return "end of query"
+	}
+	return fmt.Sprintf("%q", t.Text)
+}
+
 func query(tokens []token) (Criteria, error) {
-	var err error
-	var expr Expr
-	switch peekToken(tokens).Kind {
-	case asterisk:
+	if t := peekToken(tokens); t.Kind == asterisk {
 		_, tokens = consumeToken(tokens)
-		if token, _ := consumeToken(tokens); token.Kind != eof {
-			return nil, errors.New("asterisk must be alone")
+		if t, _ := consumeToken(tokens); t.Kind != eof {
+			return nil, syntaxErrorf(t.Pos, "asterisk must be alone, got %s", describeToken(t))
 		}
 		return Everything{}, nil
-	default:
-		tokens, expr, err = expression(tokens)
-		if err != nil {
-			return nil, err
-		}
-		if token, _ := consumeToken(tokens); token.Kind != eof {
-			return nil, errors.New("unexpected end of query")
-		}
-		return Query{expr}, nil
 	}
+
+	tokens, expr, err := orExpression(tokens)
+	if err != nil {
+		return nil, err
+	}
+	if t, _ := consumeToken(tokens); t.Kind != eof {
+		return nil, syntaxErrorf(t.Pos, "expected and, or, or end of query, got %s", describeToken(t))
+	}
+	return Query{expr}, nil
 }
 
-func expression(tokens []token) ([]token, Expr, error) {
-	var err error
-	var expr Expr
-	var t token
-	switch peekToken(tokens).Kind {
-	case openParenthesis:
+func orExpression(tokens []token) ([]token, Expr, error) {
+	return logicExpression(Or, andExpression, tokens)
+}
+func andExpression(tokens []token) ([]token, Expr, error) {
+	return logicExpression(And, term, tokens)
+}
+
+// logicExpression parses one or more subexpressions joined by op.
+func logicExpression(op LogicOp, subExpression func([]token) ([]token, Expr, error), tokens []token) ([]token, Expr, error) {
+	tokens, expr, err := subExpression(tokens)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subExprs := []Expr{expr}
+	for peekToken(tokens).is(string(op)) {
 		_, tokens = consumeToken(tokens)
-		tokens, expr, err = expression(tokens)
-		if err != nil {
-			return nil, expr, err
-		}
-		if t, tokens = consumeToken(tokens); t.Kind != closeParenthesis {
-			return nil, expr, errors.New("could not match '(' with ')'")
-		}
-	default:
-		tokens, expr, err = binaryExpression(tokens)
+		tokens, expr, err = subExpression(tokens)
 		if err != nil {
-			return nil, expr, err
+			return nil, nil, err
 		}
+		subExprs = append(subExprs, expr)
 	}
-	switch peekToken(tokens) {
-	case token{bareString, "and"}:
-		fallthrough
-	case token{bareString, "or"}:
-		return logicExpression(expr, tokens)
-	default:
-		return tokens, expr, nil
+
+	if len(subExprs) == 1 {
+		return tokens, subExprs[0], nil
 	}
+	return tokens, LogicExpr{op, subExprs}, nil
 }
 
-func logicExpression(expr1 Expr, tokens []token) ([]token, Expr, error) {
-	var err error
-	var expr2 Expr
-	var t token
-	var op LogicOp
-	switch t, tokens = consumeToken(tokens); t {
-	case token{bareString, "and"}:
-		op = And
-	case token{bareString, "or"}:
-		op = Or
-	default:
-		panic(fmt.Sprintf("got into logicExpression with token %+v", t))
+func term(tokens []token) ([]token, Expr, error) {
+	if peekToken(tokens).Kind != openParenthesis {
+		return binaryExpression(tokens)
 	}
-	tokens, expr2, err = expression(tokens)
+
+	var open, t token
+	open, tokens = consumeToken(tokens)
+	tokens, expr, err := orExpression(tokens)
 	if err != nil {
-		return nil, expr1, err
+		return nil, nil, err
 	}
-	return tokens, LogicExpr{op, []Expr{expr1, expr2}}, nil
+	if t, tokens = consumeToken(tokens); t.Kind != closeParenthesis {
+		return nil, nil, syntaxErrorf(t.Pos, "expected ')' to match '(' at position %d, got %s", open.Pos, describeToken(t))
+	}
+	return tokens, expr, nil
 }
 
 func binaryExpression(tokens []token) ([]token, Expr, error) {
 	var t token
 	t, tokens = consumeToken(tokens)
 	if t.Kind != bareString {
-		return nil, nil, errors.New("expected property")
+		return nil, nil, syntaxErrorf(t.Pos, "expected property, got %s", describeToken(t))
 	}
 	property := t.Text
 
 	var op BinaryOp
-	switch t, tokens = consumeToken(tokens); t {
-	case token{bareString, "exists"}:
-		switch t, tokens = consumeToken(tokens); t {
-		case token{bareString, "true"}:
+	t, tokens = consumeToken(tokens)
+	switch {
+	case t.is("exists"):
+		switch t, tokens = consumeToken(tokens); {
+		case t.is("true"):
 			return tokens, ExistsExpr{property, true}, nil
-		case token{bareString, "false"}:
+		case t.is("false"):
 			return tokens, ExistsExpr{property, false}, nil
 		default:
-			return nil, nil, errors.New("expected true or false")
+			return nil, nil, syntaxErrorf(t.Pos, "expected true or false, got %s", describeToken(t))
 		}
-	case token{equal, "="}:
+	case t.Kind == equal:
 		op = Equal
-	case token{notEqual, "!="}:
+	case t.Kind == notEqual:
 		op = NotEqual
-	case token{greaterThan, ">"}:
+	case t.Kind == greaterThan:
 		op = GreaterThan
-	case token{greaterThanEqual, ">="}:
+	case t.Kind == greaterThanEqual:
 		op = GreaterThanEqual
-	case token{lessThan, "<"}:
+	case t.Kind == lessThan:
 		op = LessThan
-	case token{lessThanEqual, "<="}:
+	case t.Kind == lessThanEqual:
 		op = LessThanEqual
-	case token{bareString, "contains"}:
+	case t.is("contains"):
 		op = Contains
-	case token{bareString, "doesNotContain"}:
+	case t.is("doesNotContain"):
 		op = DoesNotContain
-	case token{bareString, "derivedfrom"}:
+	case t.is("derivedfrom"):
 		op = DerivedFrom
 	default:
-		return nil, nil, errors.New("unexpected binary operation")
+		return nil, nil, syntaxErrorf(t.Pos, "expected operator after %q, got %s", property, describeToken(t))
 	}
 
 	t, tokens = consumeToken(tokens)
 	if t.Kind != quotedString {
-		return nil, nil, errors.New("expected quoted string")
+		return nil, nil, syntaxErrorf(t.Pos, "expected quoted string, got %s", describeToken(t))
 	}
 	operand := t.Text
 
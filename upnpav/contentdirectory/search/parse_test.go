@@ -129,56 +129,131 @@ func TestParse(t *testing.T) {
 				},
 			},
 		},
-		// {
-		// raw: `(a exists true) and (b exists true) and (c exists true)`,
-		// want: Query{
-		// LogicExpr{
-		// And,
-		// []Expr{
-		// ExistsExpr{"a", true},
-		// ExistsExpr{"b", true},
-		// ExistsExpr{"c", true},
-		// },
-		// },
-		// },
-		// },
-		// {
-		// raw: `a exists true and b exists true and c exists true`,
-		// want: Query{
-		// LogicExpr{
-		// And,
-		// []Expr{
-		// ExistsExpr{"a", true},
-		// ExistsExpr{"b", true},
-		// ExistsExpr{"c", true},
-		// },
-		// },
-		// },
-		// },
-		// {
-		// raw: `(a exists true) and (b exists true) or (c exists true) and (d exists true)`,
-		// want: Query{
-		// LogicExpr{
-		// Or,
-		// []Expr{
-		// LogicExpr{
-		// And,
-		// []Expr{
-		// ExistsExpr{"a", true},
-		// ExistsExpr{"b", true},
-		// },
-		// },
-		// LogicExpr{
-		// And,
-		// []Expr{
-		// ExistsExpr{"c", true},
-		// ExistsExpr{"d", true},
-		// },
-		// },
-		// },
-		// },
-		// },
-		// },
+		{
+			raw: `(a exists true) and (b exists true) and (c exists true)`,
+			want: Query{
+				LogicExpr{
+					And,
+					[]Expr{
+						ExistsExpr{"a", true},
+						ExistsExpr{"b", true},
+						ExistsExpr{"c", true},
+					},
+				},
+			},
+		},
+		{
+			raw: `a exists true and b exists true and c exists true`,
+			want: Query{
+				LogicExpr{
+					And,
+					[]Expr{
+						ExistsExpr{"a", true},
+						ExistsExpr{"b", true},
+						ExistsExpr{"c", true},
+					},
+				},
+			},
+		},
+		{
+			raw: `(a exists true) and (b exists true) or (c exists true) and (d exists true)`,
+			want: Query{
+				LogicExpr{
+					Or,
+					[]Expr{
+						LogicExpr{
+							And,
+							[]Expr{
+								ExistsExpr{"a", true},
+								ExistsExpr{"b", true},
+							},
+						},
+						LogicExpr{
+							And,
+							[]Expr{
+								ExistsExpr{"c", true},
+								ExistsExpr{"d", true},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			raw: `a = "x" or b = "y" and c = "z"`,
+			want: Query{
+				LogicExpr{
+					Or,
+					[]Expr{
+						BinaryExpr{"a", Equal, "x"},
+						LogicExpr{
+							And,
+							[]Expr{
+								BinaryExpr{"b", Equal, "y"},
+								BinaryExpr{"c", Equal, "z"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			raw: `(a = "x" or b = "y") and c = "z"`,
+			want: Query{
+				LogicExpr{
+					And,
+					[]Expr{
+						LogicExpr{
+							Or,
+							[]Expr{
+								BinaryExpr{"a", Equal, "x"},
+								BinaryExpr{"b", Equal, "y"},
+							},
+						},
+						BinaryExpr{"c", Equal, "z"},
+					},
+				},
+			},
+		},
+		{
+			raw:  `(((a exists false)))`,
+			want: Query{ExistsExpr{"a", false}},
+		},
+		{
+			raw: `dc:title CONTAINS "x" AND upnp:class DerivedFrom "object.item" Or upnp:genre EXISTS TRUE`,
+			want: Query{
+				LogicExpr{
+					Or,
+					[]Expr{
+						LogicExpr{
+							And,
+							[]Expr{
+								BinaryExpr{"dc:title", Contains, "x"},
+								BinaryExpr{"upnp:class", DerivedFrom, "object.item"},
+							},
+						},
+						ExistsExpr{"upnp:genre", true},
+					},
+				},
+			},
+		},
+		{
+			raw:  `dc:title doesnotcontain "say \"hello\" \\ goodbye"`,
+			want: Query{BinaryExpr{"dc:title", DoesNotContain, `say "hello" \ goodbye`}},
+		},
+		{
+			raw: `upnp:artist@role = "Composer" and @id != "0" and res@duration >= "0:03:00"`,
+			want: Query{
+				LogicExpr{
+					And,
+					[]Expr{
+						BinaryExpr{"upnp:artist@role", Equal, "Composer"},
+						BinaryExpr{"@id", NotEqual, "0"},
+						BinaryExpr{"res@duration", GreaterThanEqual, "0:03:00"},
+					},
+				},
+			},
+		},
 		{
 			raw:       `banana exists true and`,
 			wantError: true,
@@ -210,3 +285,54 @@ func TestParse(t *testing.T) {
 		}
 	}
 }
+
+func TestParseErrorPosition(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want *SyntaxError
+	}{
+		{
+			raw:  ``,
+			want: &SyntaxError{0, "empty query"},
+		},
+		{
+			raw:  `* and a exists true`,
+			want: &SyntaxError{2, `asterisk must be alone, got "and"`},
+		},
+		{
+			raw:  `a exists true and`,
+			want: &SyntaxError{17, "expected property, got end of query"},
+		},
+		{
+			raw:  `a exists maybe`,
+			want: &SyntaxError{9, `expected true or false, got "maybe"`},
+		},
+		{
+			raw:  `dc:title like "x"`,
+			want: &SyntaxError{9, `expected operator after "dc:title", got "like"`},
+		},
+		{
+			raw:  `dc:title = x`,
+			want: &SyntaxError{11, `expected quoted string, got "x"`},
+		},
+		{
+			raw:  `(a = "x" or (b = "y")`,
+			want: &SyntaxError{21, "expected ')' to match '(' at position 0, got end of query"},
+		},
+		{
+			raw:  `a = "x" b = "y"`,
+			want: &SyntaxError{8, `expected and, or, or end of query, got "b"`},
+		},
+		{
+			raw:  `a = "x" or b = "y`,
+			want: &SyntaxError{15, `unterminated quoted string: "y`},
+		},
+	}
+
+	for i, tt := range tests {
+		_, err := Parse(tt.raw)
+		if got, ok := err.(*SyntaxError); !ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: Parse(`%s`) returned error %#v, want %#v", i, tt.raw, err, tt.want)
+		}
+	}
+}
@@ -5,7 +5,7 @@
 package search
 
 import (
-	"fmt"
+	"strings"
 	"unicode"
 )
 
@@ -15,6 +15,9 @@ type (
 	token struct {
 		Kind tokenKind
 		Text string
+
+		// Pos is the offset of the token in the query, in runes.
+		Pos int
 	}
 )
 
@@ -42,21 +45,34 @@ const (
 	bareString
 )
 
+// is reports whether a token is the given bare keyword, ignoring case.
+func (t token) is(keyword string) bool {
+	return t.Kind == bareString && strings.EqualFold(t.Text, keyword)
+}
+
 func tokenize(src string) ([]token, error) {
 	runes := []rune(src)
 
 	var tokens []token
 
 	var tmp []rune
+	start := 0
 	inQuotedString := false
 
+	flush := func() {
+		if len(tmp) > 0 {
+			tokens = append(tokens, token{bareString, string(tmp), start})
+			tmp = nil
+		}
+	}
+
 	for i := 0; i < len(runes); i++ {
 		r := runes[i]
 
 		if inQuotedString {
 			switch r {
 			case '"':
-				tokens = append(tokens, token{quotedString, string(tmp)})
+				tokens = append(tokens, token{quotedString, string(tmp), start})
 				tmp = nil
 				inQuotedString = false
 			case '\\':
@@ -67,7 +83,7 @@ func tokenize(src string) ([]token, error) {
 					case '\\':
 						tmp = append(tmp, '\\')
 					default:
-						return tokens, fmt.Errorf("unknown escaped character: \\%v", string(runes[i+1]))
+						return tokens, syntaxErrorf(i, "unknown escaped character: \\%v", string(runes[i+1]))
 					}
 					i++
 				} else {
@@ -79,59 +95,57 @@ func tokenize(src string) ([]token, error) {
 			continue
 		}
 
+		if r == '.' || r == ':' || r == '@' || r == '_' || unicode.IsLetter(r) {
+			if len(tmp) == 0 {
+				start = i
+			}
+			tmp = append(tmp, r)
+			continue
+		}
+		flush()
+
 		switch {
 		case unicode.IsSpace(r):
-			if len(tmp) > 0 {
-				tokens = append(tokens, token{bareString, string(tmp)})
-				tmp = nil
-			}
 		case r == '*':
-			tokens = append(tokens, token{asterisk, "*"})
+			tokens = append(tokens, token{asterisk, "*", i})
 		case r == '=':
-			tokens = append(tokens, token{equal, "="})
+			tokens = append(tokens, token{equal, "=", i})
 		case r == '!':
 			if i+1 < len(runes) && runes[i+1] == '=' {
-				tokens = append(tokens, token{notEqual, "!="})
+				tokens = append(tokens, token{notEqual, "!=", i})
 				i++
 			} else {
-				return tokens, fmt.Errorf("unexpected lone '!', should be '!='")
+				return tokens, syntaxErrorf(i, "unexpected lone '!', should be '!='")
 			}
 		case r == '<':
 			if i+1 < len(runes) && runes[i+1] == '=' {
-				tokens = append(tokens, token{lessThanEqual, "<="})
+				tokens = append(tokens, token{lessThanEqual, "<=", i})
 				i++
 			} else {
-				tokens = append(tokens, token{lessThan, "<"})
+				tokens = append(tokens, token{lessThan, "<", i})
 			}
 		case r == '>':
 			if i+1 < len(runes) && runes[i+1] == '=' {
-				tokens = append(tokens, token{greaterThanEqual, ">="})
+				tokens = append(tokens, token{greaterThanEqual, ">=", i})
 				i++
 			} else {
-				tokens = append(tokens, token{greaterThan, ">"})
+				tokens = append(tokens, token{greaterThan, ">", i})
 			}
 		case r == '(':
-			tokens = append(tokens, token{openParenthesis, "("})
+			tokens = append(tokens, token{openParenthesis, "(", i})
 		case r == ')':
-			if len(tmp) > 0 {
-				tokens = append(tokens, token{bareString, string(tmp)})
-				tmp = nil
-			}
-			tokens = append(tokens, token{closeParenthesis, ")"})
+			tokens = append(tokens, token{closeParenthesis, ")", i})
 		case r == '"':
 			inQuotedString = true
-		case r == '.' || r == ':' || unicode.IsLetter(r):
-			tmp = append(tmp, r)
+			start = i
 		default:
-			return tokens, fmt.Errorf("unexpected bare character: %v", string(r))
+			return tokens, syntaxErrorf(i, "unexpected bare character: %v", string(r))
 		}
 	}
-	if len(tmp) > 0 {
-		if inQuotedString {
-			return tokens, fmt.Errorf("unterminated quoted string: \"%v", string(tmp))
-		}
-		tokens = append(tokens, token{bareString, string(tmp)})
+	if inQuotedString {
+		return tokens, syntaxErrorf(start, "unterminated quoted string: \"%v", string(tmp))
 	}
+	flush()
 
 	return tokens, nil
 }
@@ -5,7 +5,6 @@
 package search
 
 import (
-	"fmt"
 	"reflect"
 	"testing"
 )
@@ -19,61 +18,101 @@ func TestTokenize(t *testing.T) {
 		{
 			query: `*`,
 			want: []token{
-				{asterisk, "*"},
+				{asterisk, "*", 0},
 			},
 			wantErr: nil,
 		},
 		{
 			query: `(object.item exists)`,
 			want: []token{
-				{openParenthesis, "("},
-				{bareString, "object.item"},
-				{bareString, "exists"},
-				{closeParenthesis, ")"},
+				{openParenthesis, "(", 0},
+				{bareString, "object.item", 1},
+				{bareString, "exists", 13},
+				{closeParenthesis, ")", 19},
 			},
 			wantErr: nil,
 		},
 		{
 			query: `openID >= "12"`,
 			want: []token{
-				{bareString, "openID"},
-				{greaterThanEqual, ">="},
-				{quotedString, "12"},
+				{bareString, "openID", 0},
+				{greaterThanEqual, ">=", 7},
+				{quotedString, "12", 10},
 			},
 			wantErr: nil,
 		},
 		{
 			query: `contains != "foo \" bar"`,
 			want: []token{
-				{bareString, "contains"},
-				{notEqual, "!="},
-				{quotedString, `foo " bar`},
+				{bareString, "contains", 0},
+				{notEqual, "!=", 9},
+				{quotedString, `foo " bar`, 12},
 			},
 			wantErr: nil,
 		},
 		{
 			query: `"foo \\ bar"`,
 			want: []token{
-				{quotedString, `foo \ bar`},
+				{quotedString, `foo \ bar`, 0},
 			},
 			wantErr: nil,
 		},
 		{
 			query:   `"foo \a bar"`,
 			want:    nil,
-			wantErr: fmt.Errorf("unknown escaped character: \\a"),
+			wantErr: &SyntaxError{5, "unknown escaped character: \\a"},
 		},
 		{
 			query: `exists 3`,
 			want: []token{
-				{bareString, "exists"},
+				{bareString, "exists", 0},
 			},
-			wantErr: fmt.Errorf("unexpected bare character: 3"),
+			wantErr: &SyntaxError{7, "unexpected bare character: 3"},
 		},
 		{
 			query:   `"unterminated`,
 			want:    nil,
-			wantErr: fmt.Errorf("unterminated quoted string: \"unterminated"),
+			wantErr: &SyntaxError{0, "unterminated quoted string: \"unterminated"},
+		},
+		{
+			query: `upnp:artist@role="Composer"`,
+			want: []token{
+				{bareString, "upnp:artist@role", 0},
+				{equal, "=", 16},
+				{quotedString, "Composer", 17},
+			},
+			wantErr: nil,
+		},
+		{
+			query: `(@id exists true)`,
+			want: []token{
+				{openParenthesis, "(", 0},
+				{bareString, "@id", 1},
+				{bareString, "exists", 5},
+				{bareString, "true", 12},
+				{closeParenthesis, ")", 16},
+			},
+			wantErr: nil,
+		},
+		{
+			query: `dc:title(`,
+			want: []token{
+				{bareString, "dc:title", 0},
+				{openParenthesis, "(", 8},
+			},
+			wantErr: nil,
+		},
+		{
+			query: `"foo" ! "bar"`,
+			want: []token{
+				{quotedString, "foo", 0},
+			},
+			wantErr: &SyntaxError{6, "unexpected lone '!', should be '!='"},
+		},
+		{
+			query:   `"`,
+			want:    nil,
+			wantErr: &SyntaxError{0, "unterminated quoted string: \""},
 		},
 	}
 
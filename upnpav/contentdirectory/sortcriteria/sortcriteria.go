@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+// Package sortcriteria implements the sort criteria of the ContentDirectory spec.
+package sortcriteria
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethulhu/helix/upnpav"
+)
+
+type (
+	// Criteria is an ordered list of properties to sort by.
+	// Later properties break ties between earlier properties.
+	Criteria []Criterion
+
+	// Criterion is a single property to sort by, e.g. "+upnp:album" or "-dc:date".
+	Criterion struct {
+		Property   string
+		Descending bool
+	}
+)
+
+// Parse parses a SortCriteria string, such as "+upnp:album,+upnp:originalTrackNumber,-dc:date".
+// An empty string is valid, and sorts nothing.
+func Parse(src string) (Criteria, error) {
+	if strings.TrimSpace(src) == "" {
+		return nil, nil
+	}
+
+	var criteria Criteria
+	offset := 0
+	for _, raw := range strings.Split(src, ",") {
+		part := strings.TrimSpace(raw)
+		if part == "" {
+			return nil, fmt.Errorf("empty sort criterion at position %d", offset)
+		}
+
+		var descending bool
+		switch part[0] {
+		case '+':
+		case '-':
+			descending = true
+		default:
+			return nil, fmt.Errorf("sort criterion %q at position %d must start with + or -", part, offset)
+		}
+
+		property := part[1:]
+		if property == "" || strings.ContainsAny(property, " \t\r\n+-") {
+			return nil, fmt.Errorf("invalid property %q at position %d", property, offset+1)
+		}
+
+		criteria = append(criteria, Criterion{Property: property, Descending: descending})
+		offset += len(raw) + 1
+	}
+	return criteria, nil
+}
+
+func (c Criterion) String() string {
+	if c.Descending {
+		return "-" + c.Property
+	}
+	return "+" + c.Property
+}
+func (c Criteria) String() string {
+	var parts []string
+	for _, criterion := range c {
+		parts = append(parts, criterion.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+// Properties returns the properties that are sorted by.
+func (c Criteria) Properties() []string {
+	var properties []string
+	for _, criterion := range c {
+		properties = append(properties, criterion.Property)
+	}
+	return properties
+}
+
+// Supported returns the first property not in capabilities, and whether all properties were supported.
+// A capability of "*" supports every property.
+func (c Criteria) Supported(capabilities []string) (string, bool) {
+	supported := map[string]bool{}
+	for _, capability := range capabilities {
+		if capability == "*" {
+			return "", true
+		}
+		supported[capability] = true
+	}
+	for _, property := range c.Properties() {
+		if !supported[property] {
+			return property, false
+		}
+	}
+	return "", true
+}
+
+// Sort sorts the containers and items of a DIDL-Lite document in place.
+// The sort is stable, so objects that compare equal keep their order.
+// Objects without a property sort before objects with it.
+func (c Criteria) Sort(didllite *upnpav.DIDLLite) {
+	if didllite == nil || len(c) == 0 {
+		return
+	}
+
+	containerProperties := make([]map[string][]string, len(didllite.Containers))
+	for i, container := range didllite.Containers {
+		containerProperties[i] = container.Properties()
+	}
+	sort.Stable(byCriteria{c, containerProperties, func(i, j int) {
+		didllite.Containers[i], didllite.Containers[j] = didllite.Containers[j], didllite.Containers[i]
+	}})
+
+	itemProperties := make([]map[string][]string, len(didllite.Items))
+	for i, item := range didllite.Items {
+		itemProperties[i] = item.Properties()
+	}
+	sort.Stable(byCriteria{c, itemProperties, func(i, j int) {
+		didllite.Items[i], didllite.Items[j] = didllite.Items[j], didllite.Items[i]
+	}})
+}
+
+type byCriteria struct {
+	criteria   Criteria
+	properties []map[string][]string
+	swap       func(i, j int)
+}
+
+func (b byCriteria) Len() int { return len(b.properties) }
+func (b byCriteria) Swap(i, j int) {
+	b.properties[i], b.properties[j] = b.properties[j], b.properties[i]
+	b.swap(i, j)
+}
+func (b byCriteria) Less(i, j int) bool {
+	for _, criterion := range b.criteria {
+		cmp := compare(b.properties[i][criterion.Property], b.properties[j][criterion.Property])
+		if criterion.Descending {
+			cmp = -cmp
+		}
+		if cmp != 0 {
+			return cmp < 0
+		}
+	}
+	return false
+}
+
+// compare compares multi-valued properties by their first value.
+func compare(a, b []string) int {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return -1
+	case len(b) == 0:
+		return 1
+	default:
+		return upnpav.ComparePropertyValues(a[0], b[0])
+	}
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package sortcriteria
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethulhu/helix/upnpav"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    Criteria
+		wantErr bool
+	}{
+		{
+			raw:  "",
+			want: nil,
+		},
+		{
+			raw: "+upnp:album,+upnp:originalTrackNumber,-dc:date",
+			want: Criteria{
+				{Property: "upnp:album"},
+				{Property: "upnp:originalTrackNumber"},
+				{Property: "dc:date", Descending: true},
+			},
+		},
+		{
+			raw: " +dc:title , -res@duration",
+			want: Criteria{
+				{Property: "dc:title"},
+				{Property: "res@duration", Descending: true},
+			},
+		},
+		{
+			raw:     "dc:title",
+			wantErr: true,
+		},
+		{
+			raw:     "+dc:title,,-dc:date",
+			wantErr: true,
+		},
+		{
+			raw:     "+",
+			wantErr: true,
+		},
+		{
+			raw:     "+dc:title -dc:date",
+			wantErr: true,
+		},
+	}
+
+	for i, tt := range tests {
+		got, err := Parse(tt.raw)
+		if !tt.wantErr && err != nil {
+			t.Errorf("[%d]: got error: %v", i, err)
+		}
+		if tt.wantErr && err == nil {
+			t.Errorf("[%d]: wanted error, got nil", i)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: got %v, want %v", i, got, tt.want)
+		}
+		if !tt.wantErr && tt.want != nil && got.String() != tt.want.String() {
+			t.Errorf("[%d]: round-tripped to %q", i, got.String())
+		}
+	}
+}
+
+func TestSupported(t *testing.T) {
+	tests := []struct {
+		criteria     Criteria
+		capabilities []string
+		wantProperty string
+		wantOK       bool
+	}{
+		{
+			criteria:     nil,
+			capabilities: nil,
+			wantOK:       true,
+		},
+		{
+			criteria:     Criteria{{Property: "dc:title"}},
+			capabilities: []string{"dc:title", "dc:date"},
+			wantOK:       true,
+		},
+		{
+			criteria:     Criteria{{Property: "dc:title"}, {Property: "upnp:album"}},
+			capabilities: []string{"dc:title"},
+			wantProperty: "upnp:album",
+			wantOK:       false,
+		},
+		{
+			criteria:     Criteria{{Property: "upnp:album"}},
+			capabilities: []string{"*"},
+			wantOK:       true,
+		},
+	}
+
+	for i, tt := range tests {
+		gotProperty, gotOK := tt.criteria.Supported(tt.capabilities)
+		if gotProperty != tt.wantProperty || gotOK != tt.wantOK {
+			t.Errorf("[%d]: got (%q, %v), want (%q, %v)", i, gotProperty, gotOK, tt.wantProperty, tt.wantOK)
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		criteria string
+		didllite *upnpav.DIDLLite
+		want     *upnpav.DIDLLite
+	}{
+		{
+			criteria: "+upnp:album,+upnp:originalTrackNumber",
+			didllite: &upnpav.DIDLLite{
+				Items: []upnpav.Item{
+					{ID: "b2", Albums: []string{"B"}, TrackNumber: 2},
+					{ID: "a10", Albums: []string{"A"}, TrackNumber: 10},
+					{ID: "a2", Albums: []string{"a"}, TrackNumber: 2},
+					{ID: "none"},
+				},
+			},
+			want: &upnpav.DIDLLite{
+				Items: []upnpav.Item{
+					{ID: "none"},
+					{ID: "a2", Albums: []string{"a"}, TrackNumber: 2},
+					{ID: "a10", Albums: []string{"A"}, TrackNumber: 10},
+					{ID: "b2", Albums: []string{"B"}, TrackNumber: 2},
+				},
+			},
+		},
+		{
+			criteria: "-dc:title",
+			didllite: &upnpav.DIDLLite{
+				Containers: []upnpav.Container{
+					{ID: "1", Title: "Alpha"},
+					{ID: "2", Title: "Gamma"},
+					{ID: "3", Title: "Beta"},
+				},
+				Items: []upnpav.Item{
+					{ID: "4", Title: "Alpha"},
+					{ID: "5", Title: "Beta"},
+				},
+			},
+			want: &upnpav.DIDLLite{
+				Containers: []upnpav.Container{
+					{ID: "2", Title: "Gamma"},
+					{ID: "3", Title: "Beta"},
+					{ID: "1", Title: "Alpha"},
+				},
+				Items: []upnpav.Item{
+					{ID: "5", Title: "Beta"},
+					{ID: "4", Title: "Alpha"},
+				},
+			},
+		},
+		{
+			criteria: "+dc:title",
+			didllite: &upnpav.DIDLLite{
+				Items: []upnpav.Item{
+					{ID: "1", Title: "Same"},
+					{ID: "2", Title: "Same"},
+					{ID: "3", Title: "Same"},
+				},
+			},
+			want: &upnpav.DIDLLite{
+				Items: []upnpav.Item{
+					{ID: "1", Title: "Same"},
+					{ID: "2", Title: "Same"},
+					{ID: "3", Title: "Same"},
+				},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		criteria, err := Parse(tt.criteria)
+		if err != nil {
+			t.Fatalf("[%d]: could not parse criteria: %v", i, err)
+		}
+
+		criteria.Sort(tt.didllite)
+		if !reflect.DeepEqual(tt.didllite, tt.want) {
+			t.Errorf("[%d]: got %+v, want %+v", i, tt.didllite, tt.want)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package contentdirectory
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethulhu/helix/logger"
+	"github.com/ethulhu/helix/upnp"
+	"github.com/ethulhu/helix/upnpav"
+)
+
+type (
+	// UpdateIDs tracks the SystemUpdateID and ContainerUpdateIDs of a ContentDirectory, and events changes to them.
+	// As in ContentDirectory:2, a changed container's ContainerUpdateID is the SystemUpdateID after the change.
+	UpdateIDs struct {
+		mu         sync.Mutex
+		initial    uint
+		system     uint
+		containers map[upnpav.ObjectID]uint
+
+		notify func(upnp.URN, map[string]string) error
+	}
+)
+
+const (
+	// SystemUpdateIDVariableName and ContainerUpdateIDsVariableName are the names of the evented state variables.
+	SystemUpdateIDVariableName     = "SystemUpdateID"
+	ContainerUpdateIDsVariableName = "ContainerUpdateIDs"
+)
+
+// NewUpdateIDs returns UpdateIDs starting at initial.
+// SystemUpdateID is not persisted, so starting at e.g. the current Unix time keeps it increasing across restarts.
+func NewUpdateIDs(initial uint) *UpdateIDs {
+	return &UpdateIDs{
+		initial:    initial,
+		system:     initial,
+		containers: map[upnpav.ObjectID]uint{},
+	}
+}
+
+// SetEventNotifier sets the function used to event SystemUpdateID & ContainerUpdateIDs to subscribers, e.g. upnp.Device.Notify.
+func (u *UpdateIDs) SetEventNotifier(notify func(upnp.URN, map[string]string) error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.notify = notify
+	u.sendEvent(nil)
+}
+
+func (u *UpdateIDs) SystemUpdateID() uint {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.system
+}
+
+// ContainerUpdateID returns the update ID of a container, which is the initial SystemUpdateID if it has not changed.
+func (u *UpdateIDs) ContainerUpdateID(id upnpav.ObjectID) uint {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if updateID, ok := u.containers[id]; ok {
+		return updateID
+	}
+	return u.initial
+}
+
+// Update increments the SystemUpdateID, sets the ContainerUpdateID of each of the given containers, and events them.
+func (u *UpdateIDs) Update(containers ...upnpav.ObjectID) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.system++
+	changed := map[upnpav.ObjectID]uint{}
+	for _, id := range containers {
+		u.containers[id] = u.system
+		changed[id] = u.system
+	}
+	u.sendEvent(changed)
+}
+
+// sendEvent must be called with the lock held.
+func (u *UpdateIDs) sendEvent(changed map[upnpav.ObjectID]uint) {
+	if u.notify == nil {
+		return
+	}
+	err := u.notify(Version1, map[string]string{
+		SystemUpdateIDVariableName:     strconv.FormatUint(uint64(u.system), 10),
+		ContainerUpdateIDsVariableName: formatContainerUpdateIDs(changed),
+	})
+	if err != nil {
+		log := logger.Background()
+		log.AddField("upnp.urn", Version1)
+		log.WithError(err).Warning("could not send update IDs event")
+	}
+}
+
+// formatContainerUpdateIDs formats the ContainerUpdateIDs state variable, a CSV list of alternating container IDs and update IDs.
+func formatContainerUpdateIDs(changed map[upnpav.ObjectID]uint) string {
+	var ids []string
+	for id := range changed {
+		ids = append(ids, string(id))
+	}
+	sort.Strings(ids)
+
+	// Commas within values are escaped with backslashes, as are backslashes.
+	escaper := strings.NewReplacer(`\`, `\\`, `,`, `\,`)
+
+	var parts []string
+	for _, id := range ids {
+		parts = append(parts, escaper.Replace(id), strconv.FormatUint(uint64(changed[upnpav.ObjectID(id)]), 10))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package contentdirectory
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethulhu/helix/upnp"
+	"github.com/ethulhu/helix/upnpav"
+)
+
+func TestUpdateIDs(t *testing.T) {
+	var events []map[string]string
+	notify := func(urn upnp.URN, variables map[string]string) error {
+		if urn != Version1 {
+			t.Errorf("evented URN %q, want %q", urn, Version1)
+		}
+		events = append(events, variables)
+		return nil
+	}
+
+	u := NewUpdateIDs(100)
+	u.SetEventNotifier(notify)
+
+	u.Update(Root, upnpav.ObjectID("Albums/Pale"))
+	u.Update(upnpav.ObjectID("Albums/Violet, Blue"))
+
+	if got := u.SystemUpdateID(); got != 102 {
+		t.Errorf("SystemUpdateID() == %d, want 102", got)
+	}
+	for id, want := range map[upnpav.ObjectID]uint{
+		Root:                             101,
+		"Albums/Pale":                    101,
+		"Albums/Violet, Blue":            102,
+		upnpav.ObjectID("Albums/Orange"): 100,
+	} {
+		if got := u.ContainerUpdateID(id); got != want {
+			t.Errorf("ContainerUpdateID(%q) == %d, want %d", id, got, want)
+		}
+	}
+
+	want := []map[string]string{
+		{"SystemUpdateID": "100", "ContainerUpdateIDs": ""},
+		{"SystemUpdateID": "101", "ContainerUpdateIDs": "0,101,Albums/Pale,101"},
+		{"SystemUpdateID": "102", "ContainerUpdateIDs": `Albums/Violet\, Blue,102`},
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("evented %v, want %v", events, want)
+	}
+}
+
+func TestSCPDEventsUpdateIDs(t *testing.T) {
+	got := SCPD.EventedVariables()
+	want := []string{ContainerUpdateIDsVariableName, SystemUpdateIDVariableName}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SCPD evented %v, want %v", got, want)
+	}
+}
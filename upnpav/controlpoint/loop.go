@@ -9,23 +9,37 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/ethulhu/helix/logger"
 	"github.com/ethulhu/helix/upnp"
+	"github.com/ethulhu/helix/upnp/gena"
 	"github.com/ethulhu/helix/upnpav"
 	"github.com/ethulhu/helix/upnpav/avtransport"
 	"github.com/ethulhu/helix/upnpav/connectionmanager"
+	"github.com/ethulhu/helix/upnpav/renderingcontrol"
 )
 
 type (
 	Loop struct {
-		device *upnp.Device
-		queue  Queue
+		// mu guards the desired state, which the user sets and the Loop's goroutine reconciles with the transport.
+		mu sync.Mutex
+
+		device     *upnp.Device
+		queue      Queue
+		subscriber *gena.Subscriber
 
 		state    avtransport.State
 		elapsed  time.Duration
 		duration time.Duration
+
+		// volume is only meaningful once hasVolume is set by the first renderer.
+		volume    volumeState
+		hasVolume bool
+
+		done chan struct{}
 	}
 	transportState struct {
 		state    avtransport.State
@@ -34,6 +48,10 @@ type (
 		elapsed  time.Duration
 		duration time.Duration
 	}
+	volumeState struct {
+		volume int
+		mute   bool
+	}
 
 	action int
 )
@@ -47,6 +65,16 @@ const (
 	setURI
 	setNextURI
 	skipTrack
+	setVolume
+	setMute
+)
+
+const (
+	// positionPollInterval is how often the elapsed time of an evented transport is polled, to correct the local count.
+	positionPollInterval = 10 * time.Second
+
+	// resubscribeInterval is how long to wait before trying again to renew a lapsed subscription.
+	resubscribeInterval = 30 * time.Second
 )
 
 func (a action) String() string {
@@ -67,14 +95,24 @@ func (a action) String() string {
 		return "setNextURI"
 	case skipTrack:
 		return "skipTrack"
+	case setVolume:
+		return "setVolume"
+	case setMute:
+		return "setMute"
 	default:
 		panic(fmt.Sprintf("unknown action: %#v", a))
 	}
 }
 
 func NewLoop() *Loop {
+	return newLoop(1 * time.Second)
+}
+
+// newLoop returns a Loop that reconciles the transport with the desired state every interval.
+func newLoop(interval time.Duration) *Loop {
 	loop := &Loop{
 		state: avtransport.StateStopped,
+		done:  make(chan struct{}),
 	}
 
 	ctx := context.Background()
@@ -87,11 +125,113 @@ func NewLoop() *Loop {
 			panic(fmt.Sprintf("could not get initial transport state: %v", err))
 		}
 		var protocolInfos []upnpav.ProtocolInfo
+		var prevVolumeState volumeState
+
+		// When the transport supports eventing, its state comes from LastChange events instead of polling.
+		// evented is nil until the first event arrives, and whenever the subscription lapses.
+		var subscription *gena.Subscription
+		var events <-chan map[string]string
+		var evented *transportState
+
+		// Likewise for the volume, when the RenderingControl supports eventing.
+		// eventedVolume is nil until the volume has been polled once since subscribing, and whenever the subscription lapses.
+		var volumeSubscription *gena.Subscription
+		var volumeEvents <-chan map[string]string
+		var eventedVolume *volumeState
+
+		// The elapsed time is not evented, so it is counted locally from when it was last updated,
+		// and only polled every positionPollInterval, or after anything that may have moved it.
+		var positionPolled, positionUpdated time.Time
+
+		// A lapsed subscription is renewed at these times, or never if they are zero.
+		var resubscribe, resubscribeVolume time.Time
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-loop.done:
+				for _, subscription := range []*gena.Subscription{subscription, volumeSubscription} {
+					if subscription != nil {
+						ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+						_ = subscription.Unsubscribe(ctx)
+						cancel()
+					}
+				}
+				return
+			case <-ticker.C:
+			case variables, ok := <-events:
+				if !ok {
+					log, _ := logger.FromContext(ctx)
+					log.Warning("transport event subscription ended, polling until it is renewed")
+					subscription, events, evented = nil, nil, nil
+					resubscribe = time.Now()
+					continue
+				}
+				lastChange, err := upnpav.ParseLastChange(variables[upnpav.LastChangeVariableName])
+				if err != nil {
+					log, _ := logger.FromContext(ctx)
+					log.WithError(err).Warning("could not parse transport LastChange event")
+					continue
+				}
+				var curr transportState
+				if evented != nil {
+					curr = *evented
+				}
+				curr = applyLastChange(curr, lastChange.Variables(0))
+				evented = &curr
+				positionPolled = time.Time{}
+			case variables, ok := <-volumeEvents:
+				if !ok {
+					log, _ := logger.FromContext(ctx)
+					log.Warning("volume event subscription ended, polling until it is renewed")
+					volumeSubscription, volumeEvents, eventedVolume = nil, nil, nil
+					resubscribeVolume = time.Now()
+					continue
+				}
+				lastChange, err := upnpav.ParseLastChange(variables[upnpav.LastChangeVariableName])
+				if err != nil {
+					log, _ := logger.FromContext(ctx)
+					log.WithError(err).Warning("could not parse volume LastChange event")
+					continue
+				}
+				if eventedVolume == nil {
+					// The volume will be polled on this tick anyway.
+					break
+				}
+				curr := applyVolumeLastChange(*eventedVolume, lastChange.Variables(0))
+				eventedVolume = &curr
+			}
 
-		for _ = range time.Tick(1 * time.Second) {
 			log, ctx := logger.FromContext(ctx)
 
-			deviceChanged := udnOrDefault(prevDevice, "") != udnOrDefault(loop.device, "")
+			// The device is read once per tick, so that the user can change it while the transport is being asked.
+			device := loop.Transport()
+			deviceChanged := udnOrDefault(prevDevice, "") != udnOrDefault(device, "")
+
+			if deviceChanged && subscription != nil {
+				go func(subscription *gena.Subscription) {
+					ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+					defer cancel()
+					if err := subscription.Unsubscribe(ctx); err != nil {
+						log.WithError(err).Warning("could not unsubscribe from previous transport events")
+					}
+				}(subscription)
+				subscription, events, evented = nil, nil, nil
+			}
+			if deviceChanged && volumeSubscription != nil {
+				go func(subscription *gena.Subscription) {
+					ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+					defer cancel()
+					if err := subscription.Unsubscribe(ctx); err != nil {
+						log.WithError(err).Warning("could not unsubscribe from previous volume events")
+					}
+				}(volumeSubscription)
+				volumeSubscription, volumeEvents, eventedVolume = nil, nil, nil
+			}
+			if deviceChanged {
+				resubscribe, resubscribeVolume = time.Time{}, time.Time{}
+			}
 
 			if deviceChanged && prevDevice != nil {
 				go func(transport avtransport.Interface, udn, name string) {
@@ -106,35 +246,115 @@ func NewLoop() *Loop {
 					log.Info("stopped previous transport")
 				}(transport(prevDevice), prevDevice.UDN, prevDevice.Name)
 			}
-			prevDevice = loop.device
+			prevDevice = device
 
-			if loop.device == nil {
+			if device == nil {
 				if deviceChanged {
 					log.Info("no current renderer device")
 				}
 				continue
 			}
-			log.AddField("transport.udn", loop.device.UDN)
-			log.AddField("transport.name", loop.device.Name)
+			log.AddField("transport.udn", device.UDN)
+			log.AddField("transport.name", device.Name)
 
-			if deviceChanged { // && loop.device != nil
+			if deviceChanged { // && device != nil
 				var err error
-				_, protocolInfos, err = manager(loop.device).ProtocolInfo(ctx)
+				_, protocolInfos, err = manager(device).ProtocolInfo(ctx)
 				if err != nil {
-					loop.device = nil
+					loop.clearTransport(device)
 					log.WithError(err).Error("could not get sink protocols for renderer")
 					continue
 				}
 				if len(protocolInfos) == 0 {
-					loop.device = nil
+					loop.clearTransport(device)
 					log.WithError(err).Error("got 0 sink protocols for renderer, expected at least 1")
 					continue
 				}
 				log.Info("got sink protocols for renderer")
+
+				if subscription = loop.subscribe(ctx, device, avtransport.Version1, "transport"); subscription != nil {
+					events = subscription.Events()
+				}
+				if _, ok := renderingControl(device); ok {
+					if volumeSubscription = loop.subscribe(ctx, device, renderingcontrol.Version1, "volume"); volumeSubscription != nil {
+						volumeEvents = volumeSubscription.Events()
+					}
+				}
 			}
 
-			currTransport := transport(loop.device)
-			currTransportState, err := newTransportState(ctx, currTransport)
+			if !resubscribe.IsZero() && !time.Now().Before(resubscribe) {
+				if subscription = loop.subscribe(ctx, device, avtransport.Version1, "transport"); subscription != nil {
+					events = subscription.Events()
+					resubscribe = time.Time{}
+				} else {
+					resubscribe = time.Now().Add(resubscribeInterval)
+				}
+			}
+			if !resubscribeVolume.IsZero() && !time.Now().Before(resubscribeVolume) {
+				if volumeSubscription = loop.subscribe(ctx, device, renderingcontrol.Version1, "volume"); volumeSubscription != nil {
+					volumeEvents = volumeSubscription.Events()
+					resubscribeVolume = time.Time{}
+				} else {
+					resubscribeVolume = time.Now().Add(resubscribeInterval)
+				}
+			}
+
+			if renderingControl, ok := renderingControl(device); ok {
+				var currVolumeState volumeState
+				var err error
+				if eventedVolume != nil {
+					currVolumeState = *eventedVolume
+				} else {
+					currVolumeState, err = newVolumeState(ctx, renderingControl)
+					if err == nil && volumeSubscription != nil {
+						// From now on, the volume comes from events.
+						v := currVolumeState
+						eventedVolume = &v
+					}
+				}
+				if err != nil {
+					log.WithError(err).Warning("could not get renderer volume")
+				} else {
+					loop.mu.Lock()
+					loopVolume, hasLoopVolume := loop.volume, loop.hasVolume
+					newLoopVolume, action := tickVolume(prevVolumeState, currVolumeState, loopVolume, hasLoopVolume, deviceChanged)
+					if !loop.hasVolume || loop.volume != newLoopVolume {
+						loop.volume, loop.hasVolume = newLoopVolume, true
+						log.AddField("new.volume", newLoopVolume.volume)
+						log.AddField("new.mute", newLoopVolume.mute)
+						log.Info("updated desired loop volume")
+					}
+					loop.mu.Unlock()
+
+					if enactVolume(ctx, renderingControl, action, newLoopVolume) {
+						// Assume that the renderer took the new value, so that it is not set again every tick.
+						// If the renderer reports another, e.g. rounded, value, the next tick follows that instead.
+						currVolumeState = appliedVolume(currVolumeState, action, newLoopVolume)
+						if eventedVolume != nil {
+							v := currVolumeState
+							eventedVolume = &v
+						}
+					}
+					prevVolumeState = currVolumeState
+				}
+			}
+
+			currTransport := transport(device)
+			var currTransportState transportState
+			if evented != nil {
+				now := time.Now()
+				poll := now.Sub(positionPolled) >= positionPollInterval
+				currTransportState, err = eventedTransportState(ctx, currTransport, *evented, poll, now.Sub(positionUpdated))
+				if err == nil {
+					evented.elapsed = currTransportState.elapsed
+					positionUpdated = now
+					if poll {
+						positionPolled = now
+					}
+				}
+			} else {
+				currTransportState, err = newTransportState(ctx, currTransport)
+			}
 			if err != nil {
 				log.WithError(err).Error("could not get transport state")
 				continue
@@ -144,18 +364,22 @@ func NewLoop() *Loop {
 			if currTransportState.state == avtransport.StatePlaying || currTransportState.state == avtransport.StatePaused {
 				log.AddField("current.uri", currTransportState.uri)
 			}
-			loop.duration = currTransportState.duration
 
+			loop.mu.Lock()
+			loop.duration = currTransportState.duration
 			newLoopState, newLoopElapsed, action := tick(loop.queue, protocolInfos, prevTransportState, currTransportState, loop.state, loop.elapsed, deviceChanged)
-
 			if loop.state != newLoopState {
 				loop.state = newLoopState
 				log.AddField("new.state", newLoopState)
 				log.Info("updated desired loop state")
 			}
 			loop.elapsed = newLoopElapsed
+			loop.mu.Unlock()
 
-			loop.enact(ctx, protocolInfos, action)
+			loop.enact(ctx, device, protocolInfos, action, newLoopElapsed)
+			if action != doNothing {
+				positionPolled = time.Time{}
+			}
 
 			prevTransportState = currTransportState
 		}
@@ -163,19 +387,83 @@ func NewLoop() *Loop {
 	return loop
 }
 
-func (loop *Loop) State() avtransport.State { return loop.state }
+// Close stops the Loop's goroutine, leaving the transport as it is.
+func (loop *Loop) Close() {
+	close(loop.done)
+}
+
+// SetSubscriber makes the Loop subscribe to transport events, and only poll the transport when it does not support them.
+// The subscriber must be set before setting a transport.
+func (loop *Loop) SetSubscriber(subscriber *gena.Subscriber) {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
+	loop.subscriber = subscriber
+}
+
+// subscribe subscribes to the events of a service of the transport, e.g. AVTransport, for logging as name.
+// It returns nil if the service cannot be subscribed to.
+func (loop *Loop) subscribe(ctx context.Context, device *upnp.Device, service upnp.URN, name string) *gena.Subscription {
+	log, ctx := logger.FromContext(ctx)
+	log, ctx = log.Fork(ctx)
+
+	loop.mu.Lock()
+	subscriber := loop.subscriber
+	loop.mu.Unlock()
+
+	if subscriber == nil {
+		return nil
+	}
+	log.AddField("gena.service", name)
+
+	eventURL, ok := device.EventURL(service)
+	if !ok {
+		log.Info("service does not support events, polling instead")
+		return nil
+	}
+	log.AddField("gena.event_url", eventURL)
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	subscription, err := subscriber.Subscribe(ctx, eventURL)
+	if err != nil {
+		log.WithError(err).Warning("could not subscribe to events, polling instead")
+		return nil
+	}
+	log.AddField("gena.sid", subscription.SID())
+	log.Info("subscribed to events")
+	return subscription
+}
+
+func (loop *Loop) State() avtransport.State {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
+	return loop.state
+}
+
+func (loop *Loop) Play()  { loop.setState(avtransport.StatePlaying) }
+func (loop *Loop) Pause() { loop.setState(avtransport.StatePaused) }
+func (loop *Loop) Stop()  { loop.setState(avtransport.StateStopped) }
 
-func (loop *Loop) Play()  { loop.state = avtransport.StatePlaying }
-func (loop *Loop) Pause() { loop.state = avtransport.StatePaused }
-func (loop *Loop) Stop()  { loop.state = avtransport.StateStopped }
+func (loop *Loop) setState(state avtransport.State) {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
+	loop.state = state
+}
 
 func (loop *Loop) Duration() time.Duration {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
 	return loop.duration
 }
 func (loop *Loop) Elapsed() time.Duration {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
 	return loop.elapsed
 }
 func (loop *Loop) SetElapsed(d time.Duration) error {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
 	if d < loop.duration {
 		loop.elapsed = d
 		return nil
@@ -183,36 +471,108 @@ func (loop *Loop) SetElapsed(d time.Duration) error {
 	return fmt.Errorf("elapsed %v is after duration %v", d, loop.duration)
 }
 
+// Volume returns the desired volume, and false if it is not yet known.
+func (loop *Loop) Volume() (int, bool) {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
+	return loop.volume.volume, loop.hasVolume
+}
+
+// SetVolume sets the desired volume, which is carried over when the transport changes.
+// Like StepVolume and SetMute, it needs the renderer's volume and mute to be known first.
+func (loop *Loop) SetVolume(volume int) error {
+	if volume < renderingcontrol.MinVolume || volume > renderingcontrol.MaxVolume {
+		return fmt.Errorf("volume %v must be between %v and %v", volume, renderingcontrol.MinVolume, renderingcontrol.MaxVolume)
+	}
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
+	if !loop.hasVolume {
+		return errors.New("volume is not yet known")
+	}
+	loop.volume.volume = volume
+	return nil
+}
+
+// StepVolume changes the desired volume by delta, clamped to the valid range.
+func (loop *Loop) StepVolume(delta int) error {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
+	if !loop.hasVolume {
+		return errors.New("volume is not yet known")
+	}
+	volume := loop.volume.volume + delta
+	if volume < renderingcontrol.MinVolume {
+		volume = renderingcontrol.MinVolume
+	}
+	if volume > renderingcontrol.MaxVolume {
+		volume = renderingcontrol.MaxVolume
+	}
+	loop.volume.volume = volume
+	return nil
+}
+
+func (loop *Loop) Muted() bool {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
+	return loop.volume.mute
+}
+func (loop *Loop) SetMute(mute bool) error {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
+	if !loop.hasVolume {
+		return errors.New("volume is not yet known")
+	}
+	loop.volume.mute = mute
+	return nil
+}
+
 func (loop *Loop) Queue() Queue {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
 	return loop.queue
 }
 func (loop *Loop) SetQueue(queue Queue) {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
 	loop.queue = queue
 }
 
 func (loop *Loop) Transport() *upnp.Device {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
 	return loop.device
 }
 func (loop *Loop) SetTransport(device *upnp.Device) error {
-	if device == nil {
-		loop.device = nil
-		return nil
-	}
-
-	if _, ok := device.SOAPInterface(avtransport.Version1); !ok {
-		return errors.New("device does not support AVTransport")
-	}
-	if _, ok := device.SOAPInterface(connectionmanager.Version1); !ok {
-		return errors.New("device does not support ConnectionManager")
+	if device != nil {
+		if _, ok := device.SOAPInterface(avtransport.Version1); !ok {
+			return errors.New("device does not support AVTransport")
+		}
+		if _, ok := device.SOAPInterface(connectionmanager.Version1); !ok {
+			return errors.New("device does not support ConnectionManager")
+		}
 	}
 
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
 	loop.device = device
 	return nil
 }
 
-func (loop *Loop) enact(ctx context.Context, protocolInfos []upnpav.ProtocolInfo, action action) {
+// clearTransport unsets the transport, unless the user has already changed it from device.
+func (loop *Loop) clearTransport(device *upnp.Device) {
+	loop.mu.Lock()
+	defer loop.mu.Unlock()
+	if loop.device == device {
+		loop.device = nil
+	}
+}
+
+// enact makes the transport of device carry out action.
+// Seeks go to elapsed.
+func (loop *Loop) enact(ctx context.Context, device *upnp.Device, protocolInfos []upnpav.ProtocolInfo, action action, elapsed time.Duration) {
 	log, ctx := logger.FromContext(ctx)
-	transport := transport(loop.device)
+	transport := transport(device)
+	queue := loop.Queue()
 	log.AddField("action", action)
 
 	switch action {
@@ -220,7 +580,7 @@ func (loop *Loop) enact(ctx context.Context, protocolInfos []upnpav.ProtocolInfo
 		log.Debug("doing nothing")
 
 	case skipTrack:
-		loop.queue.Skip()
+		queue.Skip()
 		log.Info("skipped track")
 
 	case play:
@@ -245,15 +605,15 @@ func (loop *Loop) enact(ctx context.Context, protocolInfos []upnpav.ProtocolInfo
 		log.Info("stopped transport")
 
 	case seek:
-		log.AddField("seek", loop.elapsed)
-		if err := transport.Seek(ctx, loop.elapsed); err != nil {
+		log.AddField("seek", elapsed)
+		if err := transport.Seek(ctx, avtransport.SeekTarget{Mode: avtransport.SeekRelativeTime, Time: elapsed}); err != nil {
 			log.WithError(err).Warning("could not seek transport")
 			return
 		}
 		log.Info("seeked transport")
 
 	case setURI:
-		item, ok := loop.queue.Current()
+		item, ok := queue.Current()
 		if !ok {
 			panic("got empty queue for action setURI")
 		}
@@ -277,7 +637,7 @@ func (loop *Loop) enact(ctx context.Context, protocolInfos []upnpav.ProtocolInfo
 		log.Info("set transport URI")
 
 	case setNextURI:
-		item, ok := loop.queue.Next()
+		item, ok := queue.Next()
 		if !ok {
 			panic("got empty queue for action setNextURI")
 		}
@@ -299,6 +659,40 @@ func (loop *Loop) enact(ctx context.Context, protocolInfos []upnpav.ProtocolInfo
 	}
 }
 
+// enactVolume makes the renderer carry out a volume action, setting it to volume.
+// It returns whether the renderer's volume was changed.
+func enactVolume(ctx context.Context, renderingControl renderingcontrol.Interface, action action, volume volumeState) bool {
+	log, ctx := logger.FromContext(ctx)
+	log.AddField("action", action)
+
+	switch action {
+	case doNothing:
+		log.Debug("doing nothing")
+		return false
+
+	case setVolume:
+		log.AddField("volume", volume.volume)
+		if err := renderingControl.SetVolume(ctx, volume.volume); err != nil {
+			log.WithError(err).Warning("could not set renderer volume")
+			return false
+		}
+		log.Info("set renderer volume")
+		return true
+
+	case setMute:
+		log.AddField("mute", volume.mute)
+		if err := renderingControl.SetMute(ctx, volume.mute); err != nil {
+			log.WithError(err).Warning("could not set renderer mute")
+			return false
+		}
+		log.Info("set renderer mute")
+		return true
+
+	default:
+		panic(fmt.Sprintf("got unhandled volume action %#v", action))
+	}
+}
+
 // manager will panic if device is invalid because SetTransport should make that impossible.
 func manager(device *upnp.Device) connectionmanager.Interface {
 	managerClient, ok := device.SOAPInterface(connectionmanager.Version1)
@@ -317,6 +711,15 @@ func transport(device *upnp.Device) avtransport.Interface {
 	return avtransport.NewClient(transportClient)
 }
 
+// renderingControl returns false if the device has no RenderingControl, because unlike AVTransport it is optional.
+func renderingControl(device *upnp.Device) (renderingcontrol.Interface, bool) {
+	renderingControlClient, ok := device.SOAPInterface(renderingcontrol.Version1)
+	if !ok {
+		return nil, false
+	}
+	return renderingcontrol.NewClient(renderingControlClient), true
+}
+
 // tick is a 7-argument monstrosity to make it clear what it consumes.
 func tick(
 	queue Queue,
@@ -404,6 +807,59 @@ func tick(
 	}
 }
 
+// tickVolume reconciles the desired volume with the renderer's, like tick does for the playback state.
+func tickVolume(prev, curr, loopVolume volumeState, hasLoopVolume, deviceChanged bool) (volumeState, action) {
+	if !hasLoopVolume {
+		return curr, doNothing
+	}
+
+	// If the volume or mute was changed on the renderer itself, or the renderer did not take exactly what it was set to, follow it.
+	if !deviceChanged {
+		if prev.volume == loopVolume.volume && curr.volume != prev.volume {
+			loopVolume.volume = curr.volume
+		}
+		if prev.mute == loopVolume.mute && curr.mute != prev.mute {
+			loopVolume.mute = curr.mute
+		}
+	}
+
+	if curr.volume != loopVolume.volume {
+		return loopVolume, setVolume
+	}
+	if curr.mute != loopVolume.mute {
+		return loopVolume, setMute
+	}
+	return loopVolume, doNothing
+}
+
+// appliedVolume returns the renderer's volume after action successfully set it to loopVolume.
+func appliedVolume(curr volumeState, action action, loopVolume volumeState) volumeState {
+	switch action {
+	case setVolume:
+		curr.volume = loopVolume.volume
+	case setMute:
+		curr.mute = loopVolume.mute
+	}
+	return curr
+}
+
+func newVolumeState(ctx context.Context, renderingControl renderingcontrol.Interface) (volumeState, error) {
+	v := volumeState{}
+
+	volume, err := renderingControl.GetVolume(ctx)
+	if err != nil {
+		return v, err
+	}
+	v.volume = volume
+
+	mute, err := renderingControl.GetMute(ctx)
+	if err != nil {
+		return v, err
+	}
+	v.mute = mute
+	return v, nil
+}
+
 func newTransportState(ctx context.Context, transport avtransport.Interface) (transportState, error) {
 	t := transportState{}
 
@@ -411,30 +867,103 @@ func newTransportState(ctx context.Context, transport avtransport.Interface) (tr
 		return t, nil
 	}
 
-	state, _, err := transport.TransportInfo(ctx)
+	transportInfo, err := transport.TransportInfo(ctx)
 	if err != nil {
 		return t, err
 	}
-	t.state = state
+	t.state = transportInfo.State
 
-	if state != avtransport.StateStopped {
-		uri, _, duration, elapsed, err := transport.PositionInfo(ctx)
+	if t.state != avtransport.StateStopped {
+		positionInfo, err := transport.PositionInfo(ctx)
 		if err != nil {
 			return t, nil
 		}
-		t.uri = uri
-		t.elapsed = elapsed
-		t.duration = duration
+		t.uri = positionInfo.TrackURI
+		t.elapsed = positionInfo.RelativeTime
+		t.duration = positionInfo.TrackDuration
 
-		_, _, nextURI, _, err := transport.MediaInfo(ctx)
+		mediaInfo, err := transport.MediaInfo(ctx)
 		if err != nil {
 			return t, nil
 		}
-		t.nextURI = nextURI
+		t.nextURI = mediaInfo.NextURI
+	}
+	return t, nil
+}
+
+// eventedTransportState only asks the transport for the elapsed time, because it is not evented.
+// Unless poll is set, it does not even ask for that, and instead adds since, the time since evented's elapsed time was updated.
+func eventedTransportState(ctx context.Context, transport avtransport.Interface, evented transportState, poll bool, since time.Duration) (transportState, error) {
+	t := evented
+
+	if t.state != avtransport.StatePlaying {
+		return t, nil
+	}
+	if !poll && t.uri != "" && t.duration != 0 {
+		t.elapsed += since
+		return t, nil
+	}
+
+	info, err := transport.PositionInfo(ctx)
+	if err != nil {
+		return t, err
 	}
+	if t.uri == "" {
+		t.uri = info.TrackURI
+	}
+	if t.duration == 0 {
+		t.duration = info.TrackDuration
+	}
+	t.elapsed = info.RelativeTime
 	return t, nil
 }
 
+// applyLastChange updates a transportState with the AVTransport state variables from a LastChange event.
+func applyLastChange(t transportState, variables map[string]string) transportState {
+	prevURI := t.uri
+
+	if state, ok := variables["TransportState"]; ok {
+		t.state = avtransport.State(state)
+	}
+	if uri, ok := variables["AVTransportURI"]; ok {
+		t.uri = uri
+	}
+	if uri, ok := variables["CurrentTrackURI"]; ok && uri != "" {
+		t.uri = uri
+	}
+	if nextURI, ok := variables["NextAVTransportURI"]; ok {
+		t.nextURI = nextURI
+	}
+	if raw, ok := variables["CurrentTrackDuration"]; ok {
+		if duration, err := upnpav.ParseDuration(raw); err == nil {
+			t.duration = duration.Duration
+		}
+	}
+
+	if t.uri != prevURI {
+		t.elapsed = 0
+	}
+	if t.state == avtransport.StateStopped || t.state == avtransport.StateNoMediaPresent {
+		t.elapsed = 0
+	}
+	return t
+}
+
+// applyVolumeLastChange updates a volumeState with the Master channel's RenderingControl state variables from a LastChange event.
+func applyVolumeLastChange(v volumeState, variables map[string]string) volumeState {
+	if raw, ok := variables["Volume"]; ok {
+		if volume, err := strconv.Atoi(raw); err == nil {
+			v.volume = volume
+		}
+	}
+	if raw, ok := variables["Mute"]; ok {
+		if mute, err := strconv.ParseBool(raw); err == nil {
+			v.mute = mute
+		}
+	}
+	return v
+}
+
 func udnOrDefault(device *upnp.Device, def string) string {
 	if device == nil {
 		return def
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package controlpoint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethulhu/helix/upnp"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/upnpav/avtransport"
+	"github.com/ethulhu/helix/upnpav/connectionmanager"
+	"github.com/ethulhu/helix/upnpav/mediarenderer"
+	"github.com/ethulhu/helix/upnpav/renderingcontrol"
+)
+
+// TestLoopWithRenderer runs a Loop against a local MediaRenderer, without audio or a network.
+func TestLoopWithRenderer(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping end-to-end test in short mode")
+	}
+
+	sinks := []upnpav.ProtocolInfo{{
+		Protocol:       upnpav.ProtocolHTTP,
+		Network:        "*",
+		ContentFormat:  "audio/mpeg",
+		AdditionalInfo: "*",
+	}}
+	player := mediarenderer.NewNullPlayer()
+	renderer := mediarenderer.NewRenderer(player, sinks)
+	defer renderer.Close()
+
+	device := &upnp.Device{
+		Name:       "Test Renderer",
+		UDN:        "uuid:test-renderer",
+		DeviceType: avtransport.DeviceType,
+	}
+	device.Handle(avtransport.Version1, avtransport.ServiceID, avtransport.SCPD, avtransport.SOAPHandler{Interface: renderer})
+	device.Handle(connectionmanager.Version1, connectionmanager.ServiceID, connectionmanager.SCPD, connectionmanager.SOAPHandler{Interface: renderer})
+	device.Handle(renderingcontrol.Version1, renderingcontrol.ServiceID, renderingcontrol.SCPD, renderingcontrol.SOAPHandler{Interface: renderer})
+
+	first := resource("http://foo/first.mp3", "audio/mpeg")
+	first.Duration = &upnpav.Duration{Duration: 500 * time.Millisecond}
+	second := resource("http://foo/second.mp3", "audio/mpeg")
+	second.Duration = &upnpav.Duration{Duration: time.Hour}
+
+	queue := NewTrackList()
+	queue.Append(upnpav.Item{ID: "1", Title: "first", Resources: []upnpav.Resource{first}})
+	queue.Append(upnpav.Item{ID: "2", Title: "second", Resources: []upnpav.Resource{second}})
+
+	loop := newLoop(50 * time.Millisecond)
+	defer loop.Close()
+	loop.SetQueue(queue)
+	if err := loop.SetVolume(20); err == nil {
+		t.Errorf("before a renderer, SetVolume(20) returned no error")
+	}
+	if err := loop.SetTransport(device); err != nil {
+		t.Fatalf("SetTransport(_) returned error: %v", err)
+	}
+
+	// The volume is known once the Loop has asked the renderer for it.
+	deadline := time.Now().Add(15 * time.Second)
+	for time.Now().Before(deadline) {
+		if _, ok := loop.Volume(); ok {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if err := loop.SetVolume(20); err != nil {
+		t.Fatalf("SetVolume(20) returned error: %v", err)
+	}
+	loop.Play()
+
+	want := []string{
+		"SetVolume 20 false",
+		"Play http://foo/first.mp3 0s",
+		"Play http://foo/second.mp3 0s",
+	}
+	for time.Now().Before(deadline) {
+		if containsInOrder(player.Calls(), want) {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if calls := player.Calls(); !containsInOrder(calls, want) {
+		t.Fatalf("player calls == %q, want them to contain %q in order", calls, want)
+	}
+}
+
+// containsInOrder reports whether got contains all of want, in order, possibly with other elements in between.
+func containsInOrder(got, want []string) bool {
+	i := 0
+	for _, g := range got {
+		if i < len(want) && g == want[i] {
+			i++
+		}
+	}
+	return i == len(want)
+}
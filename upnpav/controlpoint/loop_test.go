@@ -5,6 +5,7 @@
 package controlpoint
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -458,6 +459,393 @@ func TestLoop(t *testing.T) {
 	}
 }
 
+// positionTransport is an AVTransport that only answers PositionInfo, and counts how often it is asked.
+type positionTransport struct {
+	avtransport.Interface
+
+	info  avtransport.PositionInfo
+	calls int
+}
+
+func (p *positionTransport) PositionInfo(_ context.Context) (avtransport.PositionInfo, error) {
+	p.calls++
+	return p.info, nil
+}
+
+func TestEventedTransportState(t *testing.T) {
+	tests := []struct {
+		comment string
+
+		evented transportState
+		poll    bool
+		since   time.Duration
+
+		want      transportState
+		wantCalls int
+	}{
+		{
+			comment: "a playing transport counts elapsed time locally",
+
+			evented: transportState{state: avtransport.StatePlaying, uri: "http://foo/bar.mp3", duration: time.Minute, elapsed: 10 * time.Second},
+			since:   time.Second,
+
+			want:      transportState{state: avtransport.StatePlaying, uri: "http://foo/bar.mp3", duration: time.Minute, elapsed: 11 * time.Second},
+			wantCalls: 0,
+		},
+		{
+			comment: "polling corrects the local count",
+
+			evented: transportState{state: avtransport.StatePlaying, uri: "http://foo/bar.mp3", duration: time.Minute, elapsed: 10 * time.Second},
+			poll:    true,
+			since:   time.Second,
+
+			want:      transportState{state: avtransport.StatePlaying, uri: "http://foo/bar.mp3", duration: time.Minute, elapsed: 30 * time.Second},
+			wantCalls: 1,
+		},
+		{
+			comment: "an unknown track is polled for",
+
+			evented: transportState{state: avtransport.StatePlaying},
+			since:   time.Second,
+
+			want:      transportState{state: avtransport.StatePlaying, uri: "http://foo/bar.mp3", duration: time.Minute, elapsed: 30 * time.Second},
+			wantCalls: 1,
+		},
+		{
+			comment: "a paused transport is not counted or polled",
+
+			evented: transportState{state: avtransport.StatePaused, uri: "http://foo/bar.mp3", duration: time.Minute, elapsed: 10 * time.Second},
+			poll:    true,
+			since:   time.Second,
+
+			want:      transportState{state: avtransport.StatePaused, uri: "http://foo/bar.mp3", duration: time.Minute, elapsed: 10 * time.Second},
+			wantCalls: 0,
+		},
+	}
+
+	for i, tt := range tests {
+		transport := &positionTransport{info: avtransport.PositionInfo{
+			TrackURI:      "http://foo/bar.mp3",
+			TrackDuration: time.Minute,
+			RelativeTime:  30 * time.Second,
+		}}
+
+		got, err := eventedTransportState(context.Background(), transport, tt.evented, tt.poll, tt.since)
+		if err != nil {
+			t.Errorf("[%d]: %v: got error: %v", i, tt.comment, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("[%d]: %v: got %+v, want %+v", i, tt.comment, got, tt.want)
+		}
+		if transport.calls != tt.wantCalls {
+			t.Errorf("[%d]: %v: got %v calls to PositionInfo, want %v", i, tt.comment, transport.calls, tt.wantCalls)
+		}
+	}
+}
+
+func TestApplyLastChange(t *testing.T) {
+	tests := []struct {
+		comment string
+
+		transportState transportState
+		variables      map[string]string
+
+		want transportState
+	}{
+		{
+			comment: "initial event",
+
+			variables: map[string]string{
+				"TransportState":       "PLAYING",
+				"AVTransportURI":       "http://foo/1.mp3",
+				"CurrentTrackURI":      "http://foo/1.mp3",
+				"NextAVTransportURI":   "http://foo/2.mp3",
+				"CurrentTrackDuration": "0:03:00",
+			},
+
+			want: transportState{
+				state:    avtransport.StatePlaying,
+				uri:      "http://foo/1.mp3",
+				nextURI:  "http://foo/2.mp3",
+				duration: 3 * time.Minute,
+			},
+		},
+		{
+			comment: "pausing keeps the elapsed time",
+
+			transportState: transportState{
+				state:    avtransport.StatePlaying,
+				uri:      "http://foo/1.mp3",
+				elapsed:  1 * time.Minute,
+				duration: 3 * time.Minute,
+			},
+			variables: map[string]string{
+				"TransportState": "PAUSED_PLAYBACK",
+			},
+
+			want: transportState{
+				state:    avtransport.StatePaused,
+				uri:      "http://foo/1.mp3",
+				elapsed:  1 * time.Minute,
+				duration: 3 * time.Minute,
+			},
+		},
+		{
+			comment: "track transition resets the elapsed time",
+
+			transportState: transportState{
+				state:    avtransport.StatePlaying,
+				uri:      "http://foo/1.mp3",
+				nextURI:  "http://foo/2.mp3",
+				elapsed:  3 * time.Minute,
+				duration: 3 * time.Minute,
+			},
+			variables: map[string]string{
+				"AVTransportURI":       "http://foo/2.mp3",
+				"NextAVTransportURI":   "",
+				"CurrentTrackDuration": "0:04:00",
+			},
+
+			want: transportState{
+				state:    avtransport.StatePlaying,
+				uri:      "http://foo/2.mp3",
+				duration: 4 * time.Minute,
+			},
+		},
+		{
+			comment: "stopping resets the elapsed time",
+
+			transportState: transportState{
+				state:   avtransport.StatePlaying,
+				uri:     "http://foo/1.mp3",
+				elapsed: 1 * time.Minute,
+			},
+			variables: map[string]string{
+				"TransportState": "STOPPED",
+			},
+
+			want: transportState{
+				state: avtransport.StateStopped,
+				uri:   "http://foo/1.mp3",
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		got := applyLastChange(tt.transportState, tt.variables)
+		if got != tt.want {
+			t.Errorf("[%d]: %v: got %+v, want %+v", i, tt.comment, got, tt.want)
+		}
+	}
+}
+
+func TestTickVolume(t *testing.T) {
+	tests := []struct {
+		comment string
+
+		prev          volumeState
+		curr          volumeState
+		loopVolume    volumeState
+		hasLoopVolume bool
+		deviceChanged bool
+
+		wantLoopVolume volumeState
+		wantAction     action
+	}{
+		{
+			comment: "the first renderer sets the volume",
+
+			curr:          volumeState{volume: 30},
+			deviceChanged: true,
+
+			wantLoopVolume: volumeState{volume: 30},
+			wantAction:     doNothing,
+		},
+		{
+			comment: "matching volumes do nothing",
+
+			prev:          volumeState{volume: 30},
+			curr:          volumeState{volume: 30},
+			loopVolume:    volumeState{volume: 30},
+			hasLoopVolume: true,
+
+			wantLoopVolume: volumeState{volume: 30},
+			wantAction:     doNothing,
+		},
+		{
+			comment: "a new desired volume is set",
+
+			prev:          volumeState{volume: 30},
+			curr:          volumeState{volume: 30},
+			loopVolume:    volumeState{volume: 50},
+			hasLoopVolume: true,
+
+			wantLoopVolume: volumeState{volume: 50},
+			wantAction:     setVolume,
+		},
+		{
+			comment: "a new desired mute is set",
+
+			prev:          volumeState{volume: 30},
+			curr:          volumeState{volume: 30},
+			loopVolume:    volumeState{volume: 30, mute: true},
+			hasLoopVolume: true,
+
+			wantLoopVolume: volumeState{volume: 30, mute: true},
+			wantAction:     setMute,
+		},
+		{
+			comment: "mute is set after volume",
+
+			prev:          volumeState{volume: 30},
+			curr:          volumeState{volume: 50},
+			loopVolume:    volumeState{volume: 50, mute: true},
+			hasLoopVolume: true,
+
+			wantLoopVolume: volumeState{volume: 50, mute: true},
+			wantAction:     setMute,
+		},
+		{
+			comment: "changing the volume on the renderer is followed",
+
+			prev:          volumeState{volume: 30},
+			curr:          volumeState{volume: 20, mute: true},
+			loopVolume:    volumeState{volume: 30},
+			hasLoopVolume: true,
+
+			wantLoopVolume: volumeState{volume: 20, mute: true},
+			wantAction:     doNothing,
+		},
+		{
+			comment: "changing renderers carries over the volume",
+
+			prev:          volumeState{volume: 30},
+			curr:          volumeState{volume: 80},
+			loopVolume:    volumeState{volume: 30},
+			hasLoopVolume: true,
+			deviceChanged: true,
+
+			wantLoopVolume: volumeState{volume: 30},
+			wantAction:     setVolume,
+		},
+		{
+			comment: "a volume the renderer rounded is followed rather than set again",
+
+			prev:          volumeState{volume: 21},
+			curr:          volumeState{volume: 20},
+			loopVolume:    volumeState{volume: 21},
+			hasLoopVolume: true,
+
+			wantLoopVolume: volumeState{volume: 20},
+			wantAction:     doNothing,
+		},
+		{
+			comment: "a rounded volume is followed while a new mute is set",
+
+			prev:          volumeState{volume: 21},
+			curr:          volumeState{volume: 20},
+			loopVolume:    volumeState{volume: 21, mute: true},
+			hasLoopVolume: true,
+
+			wantLoopVolume: volumeState{volume: 20, mute: true},
+			wantAction:     setMute,
+		},
+	}
+
+	for i, tt := range tests {
+		gotLoopVolume, gotAction := tickVolume(tt.prev, tt.curr, tt.loopVolume, tt.hasLoopVolume, tt.deviceChanged)
+		if gotLoopVolume != tt.wantLoopVolume {
+			t.Errorf("[%d]: %v: got loop volume %+v, want %+v", i, tt.comment, gotLoopVolume, tt.wantLoopVolume)
+		}
+		if gotAction != tt.wantAction {
+			t.Errorf("[%d]: %v: got action %v, want %v", i, tt.comment, gotAction, tt.wantAction)
+		}
+	}
+}
+
+func TestAppliedVolume(t *testing.T) {
+	tests := []struct {
+		curr       volumeState
+		action     action
+		loopVolume volumeState
+
+		want volumeState
+	}{
+		{
+			curr:       volumeState{volume: 30},
+			action:     setVolume,
+			loopVolume: volumeState{volume: 50, mute: true},
+
+			want: volumeState{volume: 50},
+		},
+		{
+			curr:       volumeState{volume: 30},
+			action:     setMute,
+			loopVolume: volumeState{volume: 50, mute: true},
+
+			want: volumeState{volume: 30, mute: true},
+		},
+		{
+			curr:       volumeState{volume: 30},
+			action:     doNothing,
+			loopVolume: volumeState{volume: 50, mute: true},
+
+			want: volumeState{volume: 30},
+		},
+	}
+
+	for i, tt := range tests {
+		got := appliedVolume(tt.curr, tt.action, tt.loopVolume)
+		if got != tt.want {
+			t.Errorf("[%d]: appliedVolume(%+v, %v, %+v) == %+v, want %+v", i, tt.curr, tt.action, tt.loopVolume, got, tt.want)
+		}
+	}
+}
+
+func TestApplyVolumeLastChange(t *testing.T) {
+	tests := []struct {
+		comment string
+
+		volumeState volumeState
+		variables   map[string]string
+
+		want volumeState
+	}{
+		{
+			comment: "volume and mute",
+
+			volumeState: volumeState{volume: 30},
+			variables:   map[string]string{"Volume": "40", "Mute": "1"},
+
+			want: volumeState{volume: 40, mute: true},
+		},
+		{
+			comment: "only mute changes",
+
+			volumeState: volumeState{volume: 30, mute: true},
+			variables:   map[string]string{"Mute": "false"},
+
+			want: volumeState{volume: 30},
+		},
+		{
+			comment: "invalid values are ignored",
+
+			volumeState: volumeState{volume: 30},
+			variables:   map[string]string{"Volume": "loud", "Mute": "maybe"},
+
+			want: volumeState{volume: 30},
+		},
+	}
+
+	for i, tt := range tests {
+		got := applyVolumeLastChange(tt.volumeState, tt.variables)
+		if got != tt.want {
+			t.Errorf("[%d]: %v: got %+v, want %+v", i, tt.comment, got, tt.want)
+		}
+	}
+}
+
 func resource(uri, mime string) upnpav.Resource {
 	return upnpav.Resource{
 		URI: uri,
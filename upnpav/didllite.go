@@ -5,6 +5,7 @@
 package upnpav
 
 import (
+	"bytes"
 	"encoding/xml"
 	"fmt"
 	"net/url"
@@ -160,10 +161,10 @@ type (
 		Albums    []string `xml:"urn:schemas-upnp-org:metadata-1-0/upnp/ album",omitempty`
 		Playlists []string `xml:"urn:schemas-upnp-org:metadata-1-0/upnp/ playlist,omitempty"`
 
-		AlbumArtURIs         []string `xml:"urn:schemas-upnp-org:metadata-1-0/upnp/ albumArtURI,omitempty"`
-		ArtistDiscographyURI string   `xml:"urn:schemas-upnp-org:metadata-1-0/upnp/ artistDiscographyURI,omitempty"`
-		LyricsURI            string   `xml:"urn:schemas-upnp-org:metadata-1-0/upnp/ lyricsURI,omitempty"`
-		RelationURI          string   `xml:"http://purl.org/dc/elements/1.1/ relation,omitempty"`
+		AlbumArtURIs         []AlbumArtURI `xml:"urn:schemas-upnp-org:metadata-1-0/upnp/ albumArtURI,omitempty"`
+		ArtistDiscographyURI string        `xml:"urn:schemas-upnp-org:metadata-1-0/upnp/ artistDiscographyURI,omitempty"`
+		LyricsURI            string        `xml:"urn:schemas-upnp-org:metadata-1-0/upnp/ lyricsURI,omitempty"`
+		RelationURI          string        `xml:"http://purl.org/dc/elements/1.1/ relation,omitempty"`
 
 		TrackNumber int `xml:"urn:schemas-upnp-org:metadata-1-0/upnp/ originalTrackNumber,omitempty"`
 
@@ -194,23 +195,23 @@ type (
 
 		RefID string `xml:"refID,attr,omitempty"`
 
-		Creator              string     `xml:"dc:creator,omitempty"`
-		Artists              []Person   `xml:"upnp:artist,omitempty"`
-		Actors               []Person   `xml:"upnp:actor,omitempty"`
-		Authors              []Person   `xml:"upnp:author,omitempty"`
-		Directors            []string   `xml:"upnp:director,omitempty"`
-		Producers            []string   `xml:"upnp:producer,omitempty"`
-		Publishers           []string   `xml:"dc:publisher,omitempty"`
-		Contributors         []string   `xml:"dc:contributor,omitempty"`
-		Genres               []string   `xml:"upnp:genre,omitempty"`
-		Albums               []string   `xml:"upnp:album",omitempty`
-		Playlists            []string   `xml:"upnp:playlist,omitempty"`
-		AlbumArtURIs         []string   `xml:"upnp:albumArtURI,omitempty"`
-		ArtistDiscographyURI string     `xml:"upnp:artistDiscographyURI,omitempty"`
-		LyricsURI            string     `xml:"upnp:lyricsURI,omitempty"`
-		RelationURI          string     `xml:"dc:relation,omitempty"`
-		TrackNumber          int        `xml:"upnp:originalTrackNumber,omitempty"`
-		Resources            []Resource `xml:"res,omitempty"`
+		Creator              string        `xml:"dc:creator,omitempty"`
+		Artists              []Person      `xml:"upnp:artist,omitempty"`
+		Actors               []Person      `xml:"upnp:actor,omitempty"`
+		Authors              []Person      `xml:"upnp:author,omitempty"`
+		Directors            []string      `xml:"upnp:director,omitempty"`
+		Producers            []string      `xml:"upnp:producer,omitempty"`
+		Publishers           []string      `xml:"dc:publisher,omitempty"`
+		Contributors         []string      `xml:"dc:contributor,omitempty"`
+		Genres               []string      `xml:"upnp:genre,omitempty"`
+		Albums               []string      `xml:"upnp:album",omitempty`
+		Playlists            []string      `xml:"upnp:playlist,omitempty"`
+		AlbumArtURIs         []AlbumArtURI `xml:"upnp:albumArtURI,omitempty"`
+		ArtistDiscographyURI string        `xml:"upnp:artistDiscographyURI,omitempty"`
+		LyricsURI            string        `xml:"upnp:lyricsURI,omitempty"`
+		RelationURI          string        `xml:"dc:relation,omitempty"`
+		TrackNumber          int           `xml:"upnp:originalTrackNumber,omitempty"`
+		Resources            []Resource    `xml:"res,omitempty"`
 	}
 
 	Person struct {
@@ -218,6 +219,14 @@ type (
 		Role string `xml:"role,attr,omitempty"`
 	}
 
+	// AlbumArtURI is a upnp:albumArtURI.
+	AlbumArtURI struct {
+		URI string `xml:",chardata"`
+
+		// ProfileID is the dlna:profileID, the DLNA profile of the image, e.g. "JPEG_TN".
+		ProfileID string `xml:"urn:schemas-dlna-org:metadata-1-0/ profileID,attr,omitempty"`
+	}
+
 	Resource struct {
 		URI          string        `xml:",innerxml"`
 		ProtocolInfo *ProtocolInfo `xml:"protocolInfo,attr,omitempty"`
@@ -272,10 +281,25 @@ func (d DIDLLite) String() string {
 	return xml.Header + string(bytes)
 }
 
+// MarshalXML writes the dlna:profileID attribute with the prefix that DIDLLite.String declares.
+func (a AlbumArtURI) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if a.ProfileID != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "dlna:profileID"}, Value: a.ProfileID})
+	}
+	return e.EncodeElement(struct {
+		URI string `xml:",chardata"`
+	}{a.URI}, start)
+}
+
 func (ed EncodedDIDLLite) MarshalText() ([]byte, error) {
 	return []byte(ed.DIDLLite.String()), nil
 }
 func (ed *EncodedDIDLLite) UnmarshalText(raw []byte) error {
+	// Many control points send empty metadata.
+	if len(bytes.TrimSpace(raw)) == 0 {
+		*ed = EncodedDIDLLite{}
+		return nil
+	}
 	dd, err := ParseDIDLLite(string(raw))
 	if err != nil {
 		return err
@@ -319,19 +343,38 @@ func DIDLForURI(uri string) (*DIDLLite, error) {
 }
 
 // URIForProtocolInfos finds a URI from an item that matches a set of valid ProtocolInfos.
-// TODO: Return the "best" supported URI instead of just the first.
+// Original resources are preferred over converted (DLNA.ORG_CI=1) ones, e.g. transcodes,
+// then resources with a DLNA profile (DLNA.ORG_PN) that one of the infos also lists,
+// then the first matching resource.
 func (item Item) URIForProtocolInfos(infos []ProtocolInfo) (string, bool) {
+	uri, bestScore := "", -1
 	for _, resource := range item.Resources {
-		resInfo := resource.ProtocolInfo
+		if resource.ProtocolInfo == nil {
+			continue
+		}
+		resInfo := *resource.ProtocolInfo
+		resDLNA, _ := resInfo.DLNA()
+
 		for _, info := range infos {
-			if resInfo.Protocol == info.Protocol &&
-				resInfo.Network == info.Network &&
-				resInfo.ContentFormat == info.ContentFormat {
-				return resource.URI, true
+			if !resInfo.Matches(info) {
+				continue
+			}
+
+			score := 0
+			if !resDLNA.Converted {
+				score += 2
+			}
+			if resDLNA.Profile != "" {
+				if dlna, err := info.DLNA(); err == nil && dlna.Profile == resDLNA.Profile {
+					score++
+				}
+			}
+			if score > bestScore {
+				uri, bestScore = resource.URI, score
 			}
 		}
 	}
-	return "", false
+	return uri, bestScore >= 0
 }
 func (item Item) HasURI(uri string) bool {
 	for _, resource := range item.Resources {
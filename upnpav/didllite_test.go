@@ -114,6 +114,18 @@ xmlns:dlna="urn:schemas-dlna-org:metadata-1-0/">
 				},
 			},
 		},
+		{
+			raw: `<DIDL-Lite xmlns:upnp="urn:schemas-upnp-org:metadata-1-0/upnp/" xmlns="urn:schemas-upnp-org:metadata-1-0/DIDL-Lite/" xmlns:dlna="urn:schemas-dlna-org:metadata-1-0/"><item restricted="1" searchable="0"><upnp:albumArtURI dlna:profileID="JPEG_TN">http://mew/cover.png?resize=JPEG_TN</upnp:albumArtURI><upnp:albumArtURI>http://mew/cover.png</upnp:albumArtURI></item></DIDL-Lite>`,
+			want: &DIDLLite{
+				Items: []Item{{
+					Restricted: true,
+					AlbumArtURIs: []AlbumArtURI{
+						{URI: "http://mew/cover.png?resize=JPEG_TN", ProfileID: "JPEG_TN"},
+						{URI: "http://mew/cover.png"},
+					},
+				}},
+			},
+		},
 	}
 
 	for i, tt := range tests {
@@ -216,6 +228,29 @@ func TestMarshalDIDLLite(t *testing.T) {
     <res protocolInfo="http-get:*:audio/mpeg:*" bitrate="131072">http://mew/purr.mp3</res>
     <res protocolInfo="http-get:*:video/mp4:*" resolution="480x360">http://mew/purr.mp4</res>
   </item>
+</DIDL-Lite>`,
+		},
+		{
+			didllite: &DIDLLite{
+				Items: []Item{
+					{
+						ID:     ObjectID("70"),
+						Parent: ObjectID("12"),
+						Title:  "art",
+						AlbumArtURIs: []AlbumArtURI{
+							{URI: "http://mew/cover.png?resize=JPEG_TN", ProfileID: "JPEG_TN"},
+							{URI: "http://mew/cover.png"},
+						},
+					},
+				},
+			},
+			want: `<?xml version="1.0" encoding="UTF-8"?>
+<DIDL-Lite xmlns="urn:schemas-upnp-org:metadata-1-0/DIDL-Lite/" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:upnp="urn:schemas-upnp-org:metadata-1-0/upnp/" xmlns:dlna="urn:schemas-dlna-org:metadata-1-0/">
+  <item id="70" parentID="12" restricted="0" searchable="0">
+    <dc:title>art</dc:title>
+    <upnp:albumArtURI dlna:profileID="JPEG_TN">http://mew/cover.png?resize=JPEG_TN</upnp:albumArtURI>
+    <upnp:albumArtURI>http://mew/cover.png</upnp:albumArtURI>
+  </item>
 </DIDL-Lite>`,
 		},
 	}
@@ -227,3 +262,115 @@ func TestMarshalDIDLLite(t *testing.T) {
 		}
 	}
 }
+
+func TestDIDLLiteRoundTrip(t *testing.T) {
+	tests := []*DIDLLite{
+		{
+			Items: []Item{{
+				ID:     ObjectID("Pale/01 Pale.mp3"),
+				Parent: ObjectID("Pale"),
+				Title:  "Pale",
+				AlbumArtURIs: []AlbumArtURI{
+					{URI: "http://mew/Pale/01%20Pale.mp3?coverart=1&resize=JPEG_TN", ProfileID: "JPEG_TN"},
+					{URI: "http://mew/Pale/01%20Pale.mp3?coverart=1&resize=JPEG_SM", ProfileID: "JPEG_SM"},
+					{URI: "http://mew/Pale/01%20Pale.mp3?coverart=1"},
+				},
+			}},
+		},
+	}
+
+	for i, want := range tests {
+		got, err := ParseDIDLLite(want.String())
+		if err != nil {
+			t.Errorf("[%d]: could not parse marshalled DIDL-Lite: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(got.Items[0].AlbumArtURIs, want.Items[0].AlbumArtURIs) {
+			t.Errorf("[%d]: got album art %+v, want %+v", i, got.Items[0].AlbumArtURIs, want.Items[0].AlbumArtURIs)
+		}
+	}
+}
+
+func TestItemURIForProtocolInfos(t *testing.T) {
+	item := Item{
+		Resources: []Resource{
+			{
+				URI:          "http://foo/transcoded.mp3",
+				ProtocolInfo: &ProtocolInfo{Protocol: ProtocolHTTP, ContentFormat: "audio/mpeg", AdditionalInfo: "*"},
+			},
+			{
+				URI:          "http://foo/original.flac",
+				ProtocolInfo: &ProtocolInfo{Protocol: ProtocolHTTP, ContentFormat: "audio/flac", AdditionalInfo: "*"},
+			},
+			{
+				URI:          "http://foo/profiled.mp3",
+				ProtocolInfo: &ProtocolInfo{Protocol: ProtocolHTTP, ContentFormat: "audio/mpeg", AdditionalInfo: "DLNA.ORG_PN=MP3;DLNA.ORG_OP=01"},
+			},
+			{
+				URI:          "http://foo/transcoded.wav",
+				ProtocolInfo: &ProtocolInfo{Protocol: ProtocolHTTP, ContentFormat: "audio/L16", AdditionalInfo: "DLNA.ORG_PN=LPCM;DLNA.ORG_CI=1"},
+			},
+			{
+				URI:          "http://foo/converted.flac",
+				ProtocolInfo: &ProtocolInfo{Protocol: ProtocolHTTP, ContentFormat: "audio/flac", AdditionalInfo: "DLNA.ORG_CI=1"},
+			},
+			{
+				URI: "http://foo/unknown",
+			},
+		},
+	}
+
+	tests := []struct {
+		sinks  []ProtocolInfo
+		want   string
+		wantOK bool
+	}{
+		{
+			sinks:  []ProtocolInfo{{Protocol: ProtocolHTTP, Network: "*", ContentFormat: "audio/flac", AdditionalInfo: "*"}},
+			want:   "http://foo/original.flac",
+			wantOK: true,
+		},
+		{
+			sinks:  []ProtocolInfo{{Protocol: ProtocolHTTP, Network: "*", ContentFormat: "audio/mpeg", AdditionalInfo: "*"}},
+			want:   "http://foo/transcoded.mp3",
+			wantOK: true,
+		},
+		{
+			sinks: []ProtocolInfo{
+				{Protocol: ProtocolHTTP, Network: "*", ContentFormat: "audio/flac", AdditionalInfo: "*"},
+				{Protocol: ProtocolHTTP, Network: "*", ContentFormat: "audio/mpeg", AdditionalInfo: "DLNA.ORG_PN=MP3"},
+			},
+			want:   "http://foo/profiled.mp3",
+			wantOK: true,
+		},
+		{
+			sinks:  []ProtocolInfo{{Protocol: ProtocolHTTP, Network: "*", ContentFormat: "*", AdditionalInfo: "*"}},
+			want:   "http://foo/transcoded.mp3",
+			wantOK: true,
+		},
+		{
+			sinks:  []ProtocolInfo{{Protocol: ProtocolHTTP, Network: "*", ContentFormat: "audio/L16", AdditionalInfo: "DLNA.ORG_PN=LPCM"}},
+			want:   "http://foo/transcoded.wav",
+			wantOK: true,
+		},
+		{
+			sinks: []ProtocolInfo{
+				{Protocol: ProtocolHTTP, Network: "*", ContentFormat: "audio/L16", AdditionalInfo: "DLNA.ORG_PN=LPCM"},
+				{Protocol: ProtocolHTTP, Network: "*", ContentFormat: "audio/flac", AdditionalInfo: "*"},
+			},
+			want:   "http://foo/original.flac",
+			wantOK: true,
+		},
+		{
+			sinks:  []ProtocolInfo{{Protocol: ProtocolHTTP, Network: "*", ContentFormat: "video/mp4", AdditionalInfo: "*"}},
+			wantOK: false,
+		},
+	}
+
+	for i, tt := range tests {
+		got, ok := item.URIForProtocolInfos(tt.sinks)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("[%d]: URIForProtocolInfos(%v) == %q, %v, want %q, %v", i, tt.sinks, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package upnpav
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type (
+	// DLNAInfo is the DLNA.ORG_* parameters of a ProtocolInfo's 4th field, AdditionalInfo.
+	DLNAInfo struct {
+		// Profile is the DLNA.ORG_PN media format profile, e.g. "MP3" or "JPEG_TN".
+		Profile string
+
+		// Operations is the DLNA.ORG_OP parameter, the kinds of seeking that the server supports.
+		Operations DLNAOperations
+
+		// Converted is the DLNA.ORG_CI parameter, whether the content has been transcoded.
+		Converted bool
+
+		// Flags is the primary flags of the DLNA.ORG_FLAGS parameter.
+		Flags DLNAFlags
+
+		// Other is any other parameters, e.g. DLNA.ORG_PS, as raw "key=value" strings.
+		Other []string
+	}
+
+	// DLNAOperations is the DLNA.ORG_OP parameter.
+	DLNAOperations struct {
+		// TimeSeek is whether the server supports the TimeSeekRange.dlna.org HTTP header.
+		TimeSeek bool
+		// ByteSeek is whether the server supports the Range HTTP header.
+		ByteSeek bool
+	}
+
+	// DLNAFlags is the primary flags of the DLNA.ORG_FLAGS parameter.
+	DLNAFlags uint32
+)
+
+const (
+	DLNAFlagSenderPaced             = DLNAFlags(1 << 31)
+	DLNAFlagLimitedTimeSeek         = DLNAFlags(1 << 30)
+	DLNAFlagLimitedByteSeek         = DLNAFlags(1 << 29)
+	DLNAFlagPlayContainer           = DLNAFlags(1 << 28)
+	DLNAFlagS0Increasing            = DLNAFlags(1 << 27)
+	DLNAFlagSNIncreasing            = DLNAFlags(1 << 26)
+	DLNAFlagRTSPPause               = DLNAFlags(1 << 25)
+	DLNAFlagStreamingTransferMode   = DLNAFlags(1 << 24)
+	DLNAFlagInteractiveTransferMode = DLNAFlags(1 << 23)
+	DLNAFlagBackgroundTransferMode  = DLNAFlags(1 << 22)
+	DLNAFlagConnectionStall         = DLNAFlags(1 << 21)
+	DLNAFlagDLNAv15                 = DLNAFlags(1 << 20)
+)
+
+const (
+	dlnaProfile    = "DLNA.ORG_PN"
+	dlnaOperations = "DLNA.ORG_OP"
+	dlnaConverted  = "DLNA.ORG_CI"
+	dlnaFlags      = "DLNA.ORG_FLAGS"
+
+	// dlnaFlagsReserved is the 24 hex digits of reserved flags that follow the 8 of primary flags.
+	dlnaFlagsReserved = "000000000000000000000000"
+)
+
+// ParseDLNAInfo parses the DLNA.ORG_* parameters of a ProtocolInfo's AdditionalInfo.
+// An AdditionalInfo of "*" or "" has no parameters.
+func ParseDLNAInfo(raw string) (DLNAInfo, error) {
+	info := DLNAInfo{}
+	if raw == "*" || raw == "" {
+		return info, nil
+	}
+
+	for _, param := range strings.Split(raw, ";") {
+		parts := strings.SplitN(param, "=", 2)
+		if len(parts) != 2 {
+			return DLNAInfo{}, fmt.Errorf("parameter %q must be of the form key=value", param)
+		}
+		key, value := parts[0], parts[1]
+
+		switch key {
+		case dlnaProfile:
+			info.Profile = value
+
+		case dlnaOperations:
+			if len(value) != 2 || strings.Trim(value, "01") != "" {
+				return DLNAInfo{}, fmt.Errorf("%v must be 2 binary digits, got %q", dlnaOperations, value)
+			}
+			info.Operations = DLNAOperations{
+				TimeSeek: value[0] == '1',
+				ByteSeek: value[1] == '1',
+			}
+
+		case dlnaConverted:
+			switch value {
+			case "0":
+				info.Converted = false
+			case "1":
+				info.Converted = true
+			default:
+				return DLNAInfo{}, fmt.Errorf("%v must be 0 or 1, got %q", dlnaConverted, value)
+			}
+
+		case dlnaFlags:
+			if len(value) != 32 {
+				return DLNAInfo{}, fmt.Errorf("%v must be 32 hex digits, got %q", dlnaFlags, value)
+			}
+			flags, err := strconv.ParseUint(value[:8], 16, 32)
+			if err != nil {
+				return DLNAInfo{}, fmt.Errorf("%v must be 32 hex digits, got %q", dlnaFlags, value)
+			}
+			info.Flags = DLNAFlags(flags)
+
+		default:
+			info.Other = append(info.Other, param)
+		}
+	}
+	return info, nil
+}
+
+// String returns the DLNAInfo as a ProtocolInfo's AdditionalInfo, or "*" if it has no parameters.
+// Parameters are in the order that DLNA requires, with DLNA.ORG_PN first.
+func (info DLNAInfo) String() string {
+	var params []string
+	if info.Profile != "" {
+		params = append(params, fmt.Sprintf("%v=%v", dlnaProfile, info.Profile))
+	}
+	if info.Operations != (DLNAOperations{}) {
+		params = append(params, fmt.Sprintf("%v=%v", dlnaOperations, info.Operations))
+	}
+	params = append(params, info.Other...)
+	if info.Converted {
+		params = append(params, fmt.Sprintf("%v=1", dlnaConverted))
+	}
+	if info.Flags != 0 {
+		params = append(params, fmt.Sprintf("%v=%v", dlnaFlags, info.Flags))
+	}
+
+	if len(params) == 0 {
+		return "*"
+	}
+	return strings.Join(params, ";")
+}
+
+func (op DLNAOperations) String() string {
+	digit := func(b bool) string {
+		if b {
+			return "1"
+		}
+		return "0"
+	}
+	return digit(op.TimeSeek) + digit(op.ByteSeek)
+}
+
+func (flags DLNAFlags) String() string {
+	return fmt.Sprintf("%08x%v", uint32(flags), dlnaFlagsReserved)
+}
+
+// DLNA parses the DLNA.ORG_* parameters of the ProtocolInfo's AdditionalInfo.
+func (p ProtocolInfo) DLNA() (DLNAInfo, error) {
+	return ParseDLNAInfo(p.AdditionalInfo)
+}
+
+// Matches reports whether p can be used where other is accepted, e.g. whether a resource can be played by a renderer with a given sink.
+// Protocol, Network, and ContentFormat must be equal, or "*" on either side; AdditionalInfo is not compared.
+func (p ProtocolInfo) Matches(other ProtocolInfo) bool {
+	// An empty Network is written as "*", so it is treated as one.
+	network, otherNetwork := p.Network, other.Network
+	if network == "" {
+		network = "*"
+	}
+	if otherNetwork == "" {
+		otherNetwork = "*"
+	}
+
+	return matchesField(string(p.Protocol), string(other.Protocol)) &&
+		matchesField(network, otherNetwork) &&
+		matchesField(p.ContentFormat, other.ContentFormat)
+}
+func matchesField(a, b string) bool {
+	return a == "*" || b == "*" || a == b
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package upnpav
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDLNAInfo(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    DLNAInfo
+		wantErr bool
+	}{
+		{
+			raw:  "*",
+			want: DLNAInfo{},
+		},
+		{
+			raw:  "DLNA.ORG_PN=MP3",
+			want: DLNAInfo{Profile: "MP3"},
+		},
+		{
+			raw: "DLNA.ORG_PN=AVC_MP4_BL_CIF15_AAC_520;DLNA.ORG_OP=01;DLNA.ORG_CI=1;DLNA.ORG_FLAGS=01700000000000000000000000000000",
+			want: DLNAInfo{
+				Profile:    "AVC_MP4_BL_CIF15_AAC_520",
+				Operations: DLNAOperations{ByteSeek: true},
+				Converted:  true,
+				Flags:      DLNAFlagStreamingTransferMode | DLNAFlagBackgroundTransferMode | DLNAFlagConnectionStall | DLNAFlagDLNAv15,
+			},
+		},
+		{
+			raw: "DLNA.ORG_OP=10;DLNA.ORG_PS=-2,-1,1,2",
+			want: DLNAInfo{
+				Operations: DLNAOperations{TimeSeek: true},
+				Other:      []string{"DLNA.ORG_PS=-2,-1,1,2"},
+			},
+		},
+		{
+			raw:     "DLNA.ORG_OP=2",
+			wantErr: true,
+		},
+		{
+			raw:     "DLNA.ORG_CI=yes",
+			wantErr: true,
+		},
+		{
+			raw:     "DLNA.ORG_FLAGS=0170",
+			wantErr: true,
+		},
+		{
+			raw:     "DLNA.ORG_PN",
+			wantErr: true,
+		},
+	}
+
+	for i, tt := range tests {
+		got, err := ParseDLNAInfo(tt.raw)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("[%d]: ParseDLNAInfo(%q) returned error %v, wantErr %v", i, tt.raw, err, tt.wantErr)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: ParseDLNAInfo(%q) == %+v, want %+v", i, tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestDLNAInfoString(t *testing.T) {
+	tests := []struct {
+		info DLNAInfo
+		want string
+	}{
+		{
+			info: DLNAInfo{},
+			want: "*",
+		},
+		{
+			info: DLNAInfo{
+				Flags:      DLNAFlagStreamingTransferMode | DLNAFlagDLNAv15,
+				Converted:  true,
+				Operations: DLNAOperations{TimeSeek: true, ByteSeek: true},
+				Profile:    "JPEG_TN",
+			},
+			want: "DLNA.ORG_PN=JPEG_TN;DLNA.ORG_OP=11;DLNA.ORG_CI=1;DLNA.ORG_FLAGS=01100000000000000000000000000000",
+		},
+		{
+			info: DLNAInfo{
+				Operations: DLNAOperations{ByteSeek: true},
+				Other:      []string{"DLNA.ORG_PS=1"},
+			},
+			want: "DLNA.ORG_OP=01;DLNA.ORG_PS=1",
+		},
+	}
+
+	for i, tt := range tests {
+		if got := tt.info.String(); got != tt.want {
+			t.Errorf("[%d]: got %q, want %q", i, got, tt.want)
+		}
+	}
+}
+
+func TestProtocolInfoMatches(t *testing.T) {
+	mp3 := ProtocolInfo{Protocol: ProtocolHTTP, ContentFormat: "audio/mpeg", AdditionalInfo: "DLNA.ORG_PN=MP3"}
+
+	tests := []struct {
+		sink ProtocolInfo
+		want bool
+	}{
+		{
+			sink: ProtocolInfo{Protocol: ProtocolHTTP, Network: "*", ContentFormat: "audio/mpeg", AdditionalInfo: "*"},
+			want: true,
+		},
+		{
+			sink: ProtocolInfo{Protocol: ProtocolHTTP, Network: "*", ContentFormat: "*", AdditionalInfo: "*"},
+			want: true,
+		},
+		{
+			sink: ProtocolInfo{Protocol: ProtocolHTTP, Network: "*", ContentFormat: "audio/flac", AdditionalInfo: "*"},
+			want: false,
+		},
+		{
+			sink: ProtocolInfo{Protocol: ProtocolRTSP, Network: "*", ContentFormat: "audio/mpeg", AdditionalInfo: "*"},
+			want: false,
+		},
+		{
+			sink: ProtocolInfo{Protocol: ProtocolHTTP, Network: "192.168.1.2", ContentFormat: "audio/mpeg", AdditionalInfo: "*"},
+			want: true,
+		},
+	}
+
+	for i, tt := range tests {
+		if got := mp3.Matches(tt.sink); got != tt.want {
+			t.Errorf("[%d]: %v.Matches(%v) == %v, want %v", i, mp3, tt.sink, got, tt.want)
+		}
+	}
+}
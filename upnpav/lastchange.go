@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package upnpav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"sort"
+)
+
+type (
+	// LastChange is the value of the LastChange evented state variable of AVTransport & RenderingControl.
+	// It collects changes to the non-evented state variables of each instance.
+	LastChange struct {
+		XMLName   xml.Name             `xml:"Event"`
+		Instances []LastChangeInstance `xml:"InstanceID"`
+	}
+	LastChangeInstance struct {
+		ID        uint                 `xml:"val,attr"`
+		Variables []LastChangeVariable `xml:",any"`
+	}
+	LastChangeVariable struct {
+		XMLName xml.Name
+		Value   string `xml:"val,attr"`
+		// Channel is only used by RenderingControl.
+		Channel string `xml:"channel,attr,omitempty"`
+	}
+)
+
+const (
+	// LastChangeVariableName is the name of the evented state variable.
+	LastChangeVariableName = "LastChange"
+
+	// ChannelMaster is the RenderingControl channel that affects all outputs.
+	ChannelMaster = "Master"
+
+	// LastChangeNamespaceAVTransport and LastChangeNamespaceRenderingControl are the XML namespaces of each service's LastChange.
+	LastChangeNamespaceAVTransport      = "urn:schemas-upnp-org:metadata-1-0/AVT/"
+	LastChangeNamespaceRenderingControl = "urn:schemas-upnp-org:metadata-1-0/RCS/"
+)
+
+// NewLastChange returns a LastChange for the given state variables of a single instance, sorted by name.
+func NewLastChange(instanceID uint, variables map[string]string) LastChange {
+	var names []string
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	instance := LastChangeInstance{ID: instanceID}
+	for _, name := range names {
+		instance.Variables = append(instance.Variables, LastChangeVariable{
+			XMLName: xml.Name{Local: name},
+			Value:   variables[name],
+		})
+	}
+	return LastChange{Instances: []LastChangeInstance{instance}}
+}
+
+func ParseLastChange(raw string) (LastChange, error) {
+	lc := LastChange{}
+	if err := xml.Unmarshal([]byte(raw), &lc); err != nil {
+		return LastChange{}, fmt.Errorf("could not unmarshal LastChange: %w", err)
+	}
+	return lc, nil
+}
+
+// Marshal returns the XML of the LastChange in the given namespace, ready to be evented.
+func (lc LastChange) Marshal(namespace string) string {
+	var buf bytes.Buffer
+	start := xml.StartElement{Name: xml.Name{Space: namespace, Local: "Event"}}
+	if err := xml.NewEncoder(&buf).EncodeElement(lc, start); err != nil {
+		panic(fmt.Sprintf("could not marshal LastChange: %v", err))
+	}
+	return buf.String()
+}
+
+// Variables returns the changed state variables for the given instance.
+// For RenderingControl, only the Master channel is returned.
+func (lc LastChange) Variables(instanceID uint) map[string]string {
+	variables := map[string]string{}
+	for _, instance := range lc.Instances {
+		if instance.ID != instanceID {
+			continue
+		}
+		for _, v := range instance.Variables {
+			if v.Channel != "" && v.Channel != ChannelMaster {
+				continue
+			}
+			variables[v.XMLName.Local] = v.Value
+		}
+	}
+	return variables
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package upnpav
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLastChangeVariables(t *testing.T) {
+	tests := []struct {
+		raw        string
+		instanceID uint
+
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			raw: `<Event xmlns="urn:schemas-upnp-org:metadata-1-0/AVT/">
+  <InstanceID val="0">
+    <TransportState val="PLAYING"/>
+    <AVTransportURI val="http://192.168.0.2/foo.mp3"/>
+    <CurrentTrackDuration val="0:03:00"/>
+  </InstanceID>
+  <InstanceID val="1">
+    <TransportState val="STOPPED"/>
+  </InstanceID>
+</Event>`,
+			want: map[string]string{
+				"TransportState":       "PLAYING",
+				"AVTransportURI":       "http://192.168.0.2/foo.mp3",
+				"CurrentTrackDuration": "0:03:00",
+			},
+		},
+		{
+			raw: `<Event xmlns="urn:schemas-upnp-org:metadata-1-0/RCS/">
+  <InstanceID val="0">
+    <Volume channel="Master" val="42"/>
+    <Volume channel="LF" val="10"/>
+    <Mute channel="Master" val="0"/>
+  </InstanceID>
+</Event>`,
+			want: map[string]string{
+				"Volume": "42",
+				"Mute":   "0",
+			},
+		},
+		{
+			raw:        `<Event><InstanceID val="0"><TransportState val="PLAYING"/></InstanceID></Event>`,
+			instanceID: 3,
+			want:       map[string]string{},
+		},
+		{
+			raw:     `<Event><InstanceID`,
+			wantErr: true,
+		},
+	}
+
+	for i, tt := range tests {
+		lc, err := ParseLastChange(tt.raw)
+		if !tt.wantErr && err != nil {
+			t.Errorf("[%d]: got error: %v", i, err)
+			continue
+		}
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("[%d]: wanted error, got nil", i)
+			}
+			continue
+		}
+
+		got := lc.Variables(tt.instanceID)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: got %v, want %v", i, got, tt.want)
+		}
+	}
+}
+
+func TestLastChangeMarshal(t *testing.T) {
+	lc := NewLastChange(0, map[string]string{
+		"TransportState":  "PLAYING",
+		"CurrentTrackURI": "http://foo/1.mp3",
+	})
+
+	got := lc.Marshal(LastChangeNamespaceAVTransport)
+	want := `<Event xmlns="urn:schemas-upnp-org:metadata-1-0/AVT/"><InstanceID val="0"><CurrentTrackURI val="http://foo/1.mp3"></CurrentTrackURI><TransportState val="PLAYING"></TransportState></InstanceID></Event>`
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	parsed, err := ParseLastChange(got)
+	if err != nil {
+		t.Fatalf("could not parse marshaled LastChange: %v", err)
+	}
+	wantVariables := map[string]string{
+		"TransportState":  "PLAYING",
+		"CurrentTrackURI": "http://foo/1.mp3",
+	}
+	if variables := parsed.Variables(0); !reflect.DeepEqual(variables, wantVariables) {
+		t.Errorf("got variables %v, want %v", variables, wantVariables)
+	}
+}
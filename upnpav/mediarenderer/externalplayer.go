@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package mediarenderer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// ExternalPlayer is a Player that runs an external command, e.g. mpv or ffplay, for each track.
+	ExternalPlayer struct {
+		command []string
+
+		mu      sync.Mutex
+		process *process
+		clock   stopwatch
+	}
+
+	process struct {
+		cmd *exec.Cmd
+		// done is closed when the command exits.
+		done chan struct{}
+	}
+)
+
+const (
+	// URIPlaceholder and StartPlaceholder are replaced in the arguments of an ExternalPlayer's command.
+	URIPlaceholder   = "{uri}"
+	StartPlaceholder = "{start}"
+)
+
+// NewExternalPlayer returns a Player that runs command for each track.
+// In the arguments of the command, {uri} is replaced with the URI to play, and {start} with the offset in seconds to start playing from.
+// Commands without {start} can only play from the start of a track.
+func NewExternalPlayer(command []string) (*ExternalPlayer, error) {
+	if len(command) == 0 {
+		return nil, errors.New("command must not be empty")
+	}
+
+	hasURI := false
+	for _, arg := range command[1:] {
+		if strings.Contains(arg, URIPlaceholder) {
+			hasURI = true
+		}
+	}
+	if !hasURI {
+		return nil, fmt.Errorf("command must have an argument containing %v", URIPlaceholder)
+	}
+
+	return &ExternalPlayer{
+		command: command,
+		clock:   stopwatch{now: time.Now},
+	}, nil
+}
+
+func (p *ExternalPlayer) canSeek() bool {
+	for _, arg := range p.command[1:] {
+		if strings.Contains(arg, StartPlaceholder) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *ExternalPlayer) Play(ctx context.Context, uri string, _, start time.Duration) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if start != 0 && !p.canSeek() {
+		return ErrSeekNotSupported
+	}
+
+	p.stop()
+
+	replacer := strings.NewReplacer(
+		URIPlaceholder, uri,
+		StartPlaceholder, fmt.Sprintf("%.3f", start.Seconds()),
+	)
+	var args []string
+	for _, arg := range p.command[1:] {
+		args = append(args, replacer.Replace(arg))
+	}
+
+	cmd := exec.Command(p.command[0], args...)
+	configureProcess(cmd)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("could not start player: %w", err)
+	}
+	proc := &process{cmd: cmd, done: make(chan struct{})}
+	go func() {
+		_ = cmd.Wait()
+		close(proc.done)
+	}()
+
+	p.process = proc
+	p.clock.reset(start)
+	p.clock.start()
+	return nil
+}
+
+// Pause suspends the player's process, and Resume continues it.
+// They are only supported on Unix.
+func (p *ExternalPlayer) Pause(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.process == nil {
+		return nil
+	}
+	if err := p.process.pause(); err != nil {
+		return fmt.Errorf("could not pause player: %w", err)
+	}
+	p.clock.stop()
+	return nil
+}
+func (p *ExternalPlayer) Resume(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.process == nil {
+		return nil
+	}
+	if err := p.process.resume(); err != nil {
+		return fmt.Errorf("could not resume player: %w", err)
+	}
+	p.clock.start()
+	return nil
+}
+
+func (p *ExternalPlayer) Stop(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stop()
+	p.clock.reset(0)
+	return nil
+}
+
+// stop kills the current process, if any, and waits for it to exit.
+func (p *ExternalPlayer) stop() {
+	if p.process == nil {
+		return
+	}
+	_ = p.process.kill()
+	<-p.process.done
+	p.process = nil
+}
+
+// Position returns the time since the process started, less time spent paused.
+// The track has finished when the process exits by itself.
+func (p *ExternalPlayer) Position() (time.Duration, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.process == nil {
+		return p.clock.position(), false
+	}
+	select {
+	case <-p.process.done:
+		p.clock.stop()
+		return p.clock.position(), true
+	default:
+		return p.clock.position(), false
+	}
+}
+
+// SetVolume does nothing, as there is no general way to set an external command's volume.
+func (p *ExternalPlayer) SetVolume(_ context.Context, _ int, _ bool) error {
+	return nil
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+//go:build !unix
+// +build !unix
+
+package mediarenderer
+
+import (
+	"errors"
+	"os/exec"
+)
+
+var (
+	errPauseUnsupported = errors.New("pausing an external player is only supported on Unix")
+)
+
+// configureProcess does nothing, as process groups are only supported on Unix.
+func configureProcess(_ *exec.Cmd) {}
+
+func (p *process) pause() error {
+	return errPauseUnsupported
+}
+func (p *process) resume() error {
+	return errPauseUnsupported
+}
+
+// kill only kills the command itself, not any children it has started.
+func (p *process) kill() error {
+	return p.cmd.Process.Kill()
+}
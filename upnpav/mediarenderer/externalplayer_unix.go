@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+//go:build unix
+// +build unix
+
+package mediarenderer
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// configureProcess gives the command its own process group, so that signals also reach any children, e.g. of a shell script.
+func configureProcess(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+func (p *process) pause() error {
+	return p.signal(syscall.SIGSTOP)
+}
+func (p *process) resume() error {
+	return p.signal(syscall.SIGCONT)
+}
+func (p *process) kill() error {
+	return p.signal(syscall.SIGKILL)
+}
+
+// signal sends a signal to the process group of the command.
+func (p *process) signal(sig syscall.Signal) error {
+	return syscall.Kill(-p.cmd.Process.Pid, sig)
+}
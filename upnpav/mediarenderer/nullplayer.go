@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package mediarenderer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type (
+	// NullPlayer is a Player that outputs nothing, but keeps time as if it were playing and records the calls made to it.
+	// It is for running a Renderer without audio, e.g. in tests.
+	NullPlayer struct {
+		mu sync.Mutex
+
+		uri      string
+		duration time.Duration
+		clock    stopwatch
+
+		calls []string
+	}
+)
+
+func NewNullPlayer() *NullPlayer {
+	return &NullPlayer{
+		clock: stopwatch{now: time.Now},
+	}
+}
+
+// Calls returns the calls made to the NullPlayer so far, e.g. "Play http://foo/bar.mp3 0s".
+func (p *NullPlayer) Calls() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]string(nil), p.calls...)
+}
+
+func (p *NullPlayer) Play(_ context.Context, uri string, duration, start time.Duration) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls = append(p.calls, fmt.Sprintf("Play %v %v", uri, start))
+	p.uri = uri
+	p.duration = duration
+	p.clock.reset(start)
+	p.clock.start()
+	return nil
+}
+func (p *NullPlayer) Pause(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls = append(p.calls, "Pause")
+	p.clock.stop()
+	return nil
+}
+func (p *NullPlayer) Resume(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls = append(p.calls, "Resume")
+	p.clock.start()
+	return nil
+}
+func (p *NullPlayer) Stop(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls = append(p.calls, "Stop")
+	p.uri = ""
+	p.duration = 0
+	p.clock.reset(0)
+	return nil
+}
+
+// Position returns the time since playback started, less time spent paused.
+// Tracks with an unknown duration never finish.
+func (p *NullPlayer) Position() (time.Duration, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	position := p.clock.position()
+	if p.uri == "" || p.duration == 0 || position < p.duration {
+		return position, false
+	}
+	return p.duration, true
+}
+
+func (p *NullPlayer) SetVolume(_ context.Context, volume int, mute bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls = append(p.calls, fmt.Sprintf("SetVolume %v %v", volume, mute))
+	return nil
+}
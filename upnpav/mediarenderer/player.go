@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package mediarenderer
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type (
+	// Player is the backend that a Renderer plays media with.
+	Player interface {
+		// Play starts playing a URI from a given offset, replacing anything already playing.
+		// duration is the length of the track from its metadata, or 0 if it is not known.
+		Play(ctx context.Context, uri string, duration, start time.Duration) error
+
+		// Pause pauses playback, and Resume continues it.
+		Pause(context.Context) error
+		Resume(context.Context) error
+
+		// Stop stops playback altogether.
+		Stop(context.Context) error
+
+		// Position returns how far into the current track playback is, and whether the track has finished.
+		Position() (time.Duration, bool)
+
+		// SetVolume sets the output volume, between renderingcontrol.MinVolume and renderingcontrol.MaxVolume.
+		SetVolume(ctx context.Context, volume int, mute bool) error
+	}
+
+	// stopwatch keeps track of the position of playback for Players that cannot ask their backend.
+	stopwatch struct {
+		now func() time.Time
+
+		// elapsed is the position when the stopwatch was last stopped.
+		elapsed time.Duration
+		started time.Time
+		running bool
+	}
+)
+
+var (
+	// ErrSeekNotSupported is returned by Players that can only play from the start of a track.
+	ErrSeekNotSupported = errors.New("player cannot seek")
+)
+
+func (s *stopwatch) reset(position time.Duration) {
+	s.elapsed = position
+	s.running = false
+}
+func (s *stopwatch) start() {
+	if !s.running {
+		s.started = s.now()
+		s.running = true
+	}
+}
+func (s *stopwatch) stop() {
+	if s.running {
+		s.elapsed = s.position()
+		s.running = false
+	}
+}
+func (s *stopwatch) position() time.Duration {
+	if !s.running {
+		return s.elapsed
+	}
+	return s.elapsed + s.now().Sub(s.started)
+}
@@ -0,0 +1,589 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+// Package mediarenderer is a UPnP AV MediaRenderer, with AVTransport, RenderingControl, and ConnectionManager services backed by a Player.
+package mediarenderer
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethulhu/helix/logger"
+	"github.com/ethulhu/helix/upnp"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/upnpav/avtransport"
+	"github.com/ethulhu/helix/upnpav/connectionmanager"
+	"github.com/ethulhu/helix/upnpav/renderingcontrol"
+)
+
+type (
+	// Renderer implements avtransport.Interface, renderingcontrol.Interface, and connectionmanager.Interface.
+	Renderer struct {
+		*connectionmanager.Manager
+
+		player Player
+
+		mu sync.Mutex
+
+		state        avtransport.State
+		uri          string
+		metadata     *upnpav.DIDLLite
+		duration     time.Duration
+		nextURI      string
+		nextMetadata *upnpav.DIDLLite
+		playMode     avtransport.PlayMode
+
+		volume int
+		mute   bool
+
+		notify func(upnp.URN, map[string]string) error
+
+		done chan struct{}
+	}
+)
+
+const (
+	defaultVolume = 50
+
+	// minVolumeDB and maxVolumeDB are in units of 1/256 dB.
+	minVolumeDB = -60 * 256
+	maxVolumeDB = 0
+
+	// pollInterval is how often the Renderer checks whether the Player has finished the current track.
+	pollInterval = 500 * time.Millisecond
+)
+
+var (
+	_ avtransport.Interface       = &Renderer{}
+	_ connectionmanager.Interface = &Renderer{}
+	_ renderingcontrol.Interface  = &Renderer{}
+)
+
+// NewRenderer returns a Renderer that plays media with player, and accepts media matching sinks.
+// The Renderer advances to the next URI when the player finishes a track, until it is closed.
+func NewRenderer(player Player, sinks []upnpav.ProtocolInfo) *Renderer {
+	r := &Renderer{
+		Manager: connectionmanager.NewManager(connectionmanager.DirectionInput, nil, sinks),
+
+		player: player,
+
+		state:    avtransport.StateNoMediaPresent,
+		playMode: avtransport.PlayModeNormal,
+		volume:   defaultVolume,
+
+		done: make(chan struct{}),
+	}
+
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.done:
+				return
+			case <-ticker.C:
+				r.checkFinished(context.Background())
+			}
+		}
+	}()
+	return r
+}
+
+// Close stops playback and the Renderer's background goroutine.
+func (r *Renderer) Close() {
+	close(r.done)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_ = r.player.Stop(context.Background())
+}
+
+// SetEventNotifier sets the function used to event LastChange to subscribers, e.g. upnp.Device.Notify.
+func (r *Renderer) SetEventNotifier(notify func(upnp.URN, map[string]string) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.notify = notify
+	r.notifyTransport()
+	r.notifyRenderingControl()
+}
+
+// checkFinished moves on to the next URI, repeats, or stops, when the Player has finished the current track.
+func (r *Renderer) checkFinished(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.state != avtransport.StatePlaying {
+		return
+	}
+	if _, finished := r.player.Position(); !finished {
+		return
+	}
+	defer r.notifyTransport()
+
+	// With only the current and next URIs, repeating all of them means repeating the current URI once there is no next.
+	repeat := r.playMode == avtransport.PlayModeRepeatOne || (r.playMode == avtransport.PlayModeRepeatAll && r.nextURI == "")
+	if repeat {
+		if err := r.play(ctx, 0); err != nil {
+			log, _ := logger.FromContext(ctx)
+			log.AddField("uri", r.uri)
+			log.WithError(err).Warning("could not repeat URI")
+		}
+		return
+	}
+
+	if r.nextURI == "" {
+		_ = r.player.Stop(ctx)
+		r.state = avtransport.StateStopped
+		return
+	}
+	if err := r.advance(ctx); err != nil {
+		log, _ := logger.FromContext(ctx)
+		log.AddField("uri", r.uri)
+		log.WithError(err).Warning("could not play next URI")
+	}
+}
+
+// AVTransport.
+
+func (r *Renderer) SetCurrentURI(ctx context.Context, uri string, metadata *upnpav.DIDLLite) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.notifyTransport()
+
+	r.uri = uri
+	r.metadata = metadata
+	r.duration = durationForURI(metadata, uri)
+
+	if uri == "" {
+		_ = r.player.Stop(ctx)
+		r.state = avtransport.StateNoMediaPresent
+		return nil
+	}
+
+	// A playing Renderer keeps playing the new URI.
+	if r.state == avtransport.StatePlaying {
+		return r.play(ctx, 0)
+	}
+	_ = r.player.Stop(ctx)
+	r.state = avtransport.StateStopped
+	return nil
+}
+func (r *Renderer) SetNextURI(ctx context.Context, uri string, metadata *upnpav.DIDLLite) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.notifyTransport()
+
+	r.nextURI = uri
+	r.nextMetadata = metadata
+	return nil
+}
+
+func (r *Renderer) Play(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.notifyTransport()
+
+	switch r.state {
+	case avtransport.StateNoMediaPresent:
+		return avtransport.ErrNoContents
+	case avtransport.StatePlaying:
+		return nil
+	case avtransport.StatePaused:
+		if err := r.player.Resume(ctx); err != nil {
+			return playerError(ctx, err)
+		}
+		r.state = avtransport.StatePlaying
+		return nil
+	default:
+		return r.play(ctx, 0)
+	}
+}
+func (r *Renderer) Pause(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.notifyTransport()
+
+	switch r.state {
+	case avtransport.StatePaused:
+		return nil
+	case avtransport.StatePlaying:
+		if err := r.player.Pause(ctx); err != nil {
+			return playerError(ctx, err)
+		}
+		r.state = avtransport.StatePaused
+		return nil
+	default:
+		return avtransport.ErrTransitionNotAvailable
+	}
+}
+func (r *Renderer) Stop(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.notifyTransport()
+
+	if r.state == avtransport.StateNoMediaPresent {
+		return nil
+	}
+	if err := r.player.Stop(ctx); err != nil {
+		return playerError(ctx, err)
+	}
+	r.state = avtransport.StateStopped
+	return nil
+}
+
+// Seek supports seeking by time, and to the start of the current track, as track 1.
+func (r *Renderer) Seek(ctx context.Context, target avtransport.SeekTarget) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.notifyTransport()
+
+	if r.state != avtransport.StatePlaying && r.state != avtransport.StatePaused {
+		return avtransport.ErrTransitionNotAvailable
+	}
+
+	var position time.Duration
+	switch target.Mode {
+	case avtransport.SeekRelativeTime, avtransport.SeekAbsoluteTime:
+		position = target.Time
+	case avtransport.SeekTrack:
+		if target.Track != 1 {
+			return avtransport.ErrIllegalSeekTarget
+		}
+	default:
+		return avtransport.ErrSeekModeNotSupported
+	}
+	if position < 0 || (r.duration != 0 && position > r.duration) {
+		return avtransport.ErrIllegalSeekTarget
+	}
+
+	wasPaused := r.state == avtransport.StatePaused
+	if err := r.play(ctx, position); err != nil {
+		return err
+	}
+	if wasPaused {
+		if err := r.player.Pause(ctx); err != nil {
+			return playerError(ctx, err)
+		}
+		r.state = avtransport.StatePaused
+	}
+	return nil
+}
+func (r *Renderer) Next(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.notifyTransport()
+
+	if r.nextURI == "" {
+		return avtransport.ErrTransitionNotAvailable
+	}
+	return r.advance(ctx)
+}
+
+// Previous restarts the current track, as the Renderer only knows the current and next URIs.
+func (r *Renderer) Previous(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.notifyTransport()
+
+	switch r.state {
+	case avtransport.StateNoMediaPresent:
+		return avtransport.ErrTransitionNotAvailable
+	case avtransport.StatePlaying:
+		return r.play(ctx, 0)
+	case avtransport.StatePaused:
+		if err := r.play(ctx, 0); err != nil {
+			return err
+		}
+		if err := r.player.Pause(ctx); err != nil {
+			return playerError(ctx, err)
+		}
+		r.state = avtransport.StatePaused
+		return nil
+	default:
+		return nil
+	}
+}
+
+// SetPlayMode supports repeating, but not shuffling, as the Renderer only knows the current and next URIs.
+func (r *Renderer) SetPlayMode(_ context.Context, mode avtransport.PlayMode) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.notifyTransport()
+
+	switch mode {
+	case avtransport.PlayModeNormal, avtransport.PlayModeRepeatOne, avtransport.PlayModeRepeatAll:
+		r.playMode = mode
+		return nil
+	default:
+		return avtransport.ErrPlayModeNotSupported
+	}
+}
+
+func (r *Renderer) MediaInfo(_ context.Context) (avtransport.MediaInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	info := avtransport.MediaInfo{
+		Duration:        r.duration,
+		CurrentURI:      r.uri,
+		CurrentMetadata: r.metadata,
+		NextURI:         r.nextURI,
+		NextMetadata:    r.nextMetadata,
+		PlayMedium:      "NETWORK",
+		RecordMedium:    avtransport.NotImplemented,
+		WriteStatus:     avtransport.NotImplemented,
+	}
+	if r.uri != "" {
+		info.TrackCount = 1
+	}
+	return info, nil
+}
+func (r *Renderer) PositionInfo(_ context.Context) (avtransport.PositionInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var elapsed time.Duration
+	if r.state == avtransport.StatePlaying || r.state == avtransport.StatePaused {
+		elapsed, _ = r.player.Position()
+	}
+	if r.duration != 0 && elapsed > r.duration {
+		elapsed = r.duration
+	}
+
+	info := avtransport.PositionInfo{
+		TrackDuration: r.duration,
+		TrackMetadata: r.metadata,
+		TrackURI:      r.uri,
+		RelativeTime:  elapsed,
+		AbsoluteTime:  elapsed,
+		RelativeCount: avtransport.CounterNotImplemented,
+		AbsoluteCount: avtransport.CounterNotImplemented,
+	}
+	if r.uri != "" {
+		info.Track = 1
+	}
+	return info, nil
+}
+func (r *Renderer) TransportInfo(_ context.Context) (avtransport.TransportInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return avtransport.TransportInfo{
+		State:  r.state,
+		Status: avtransport.StatusOK,
+		Speed:  "1",
+	}, nil
+}
+func (r *Renderer) TransportSettings(_ context.Context) (avtransport.TransportSettings, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return avtransport.TransportSettings{
+		PlayMode:          r.playMode,
+		RecordQualityMode: avtransport.NotImplemented,
+	}, nil
+}
+func (r *Renderer) DeviceCapabilities(_ context.Context) (avtransport.DeviceCapabilities, error) {
+	return avtransport.DeviceCapabilities{
+		PlayMedia:          []string{"NETWORK"},
+		RecordMedia:        []string{avtransport.NotImplemented},
+		RecordQualityModes: []string{avtransport.NotImplemented},
+	}, nil
+}
+func (r *Renderer) CurrentTransportActions(_ context.Context) ([]avtransport.TransportAction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.transportActions(), nil
+}
+
+// transportActions must be called with the lock held.
+func (r *Renderer) transportActions() []avtransport.TransportAction {
+	var actions []avtransport.TransportAction
+	switch r.state {
+	case avtransport.StateStopped:
+		actions = []avtransport.TransportAction{avtransport.ActionPlay}
+	case avtransport.StatePlaying:
+		actions = []avtransport.TransportAction{avtransport.ActionPause, avtransport.ActionStop, avtransport.ActionSeek, avtransport.ActionPrevious}
+	case avtransport.StatePaused:
+		actions = []avtransport.TransportAction{avtransport.ActionPlay, avtransport.ActionStop, avtransport.ActionSeek, avtransport.ActionPrevious}
+	}
+	if r.nextURI != "" {
+		actions = append(actions, avtransport.ActionNext)
+	}
+	return actions
+}
+
+// play starts the current URI playing from start.
+// It must be called with the lock held.
+func (r *Renderer) play(ctx context.Context, start time.Duration) error {
+	if err := r.player.Play(ctx, r.uri, r.duration, start); err != nil {
+		if errors.Is(err, ErrSeekNotSupported) {
+			return avtransport.ErrSeekModeNotSupported
+		}
+		r.state = avtransport.StateStopped
+		return playerError(ctx, err)
+	}
+	r.state = avtransport.StatePlaying
+	return nil
+}
+
+// advance replaces the current URI with the next URI, and plays it if the Renderer was playing.
+// It must be called with the lock held.
+func (r *Renderer) advance(ctx context.Context) error {
+	r.uri, r.metadata = r.nextURI, r.nextMetadata
+	r.nextURI, r.nextMetadata = "", nil
+	r.duration = durationForURI(r.metadata, r.uri)
+
+	if r.state == avtransport.StatePlaying {
+		return r.play(ctx, 0)
+	}
+	_ = r.player.Stop(ctx)
+	r.state = avtransport.StateStopped
+	return nil
+}
+
+// notifyTransport events the AVTransport state variables with LastChange.
+// It must be called with the lock held.
+func (r *Renderer) notifyTransport() {
+	if r.notify == nil {
+		return
+	}
+	var actions []string
+	for _, action := range r.transportActions() {
+		actions = append(actions, string(action))
+	}
+	lastChange := upnpav.NewLastChange(0, map[string]string{
+		"TransportState":          string(r.state),
+		"CurrentPlayMode":         string(r.playMode),
+		"CurrentTransportActions": strings.Join(actions, ","),
+		"TransportStatus":         string(avtransport.StatusOK),
+		"AVTransportURI":          r.uri,
+		"CurrentTrackURI":         r.uri,
+		"CurrentTrackDuration":    upnpav.Duration{Duration: r.duration}.String(),
+		"NextAVTransportURI":      r.nextURI,
+	})
+	r.sendEvent(avtransport.Version1, lastChange.Marshal(upnpav.LastChangeNamespaceAVTransport))
+}
+
+// RenderingControl.
+
+func (r *Renderer) GetVolume(_ context.Context) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.volume, nil
+}
+func (r *Renderer) SetVolume(ctx context.Context, volume int) error {
+	if volume < renderingcontrol.MinVolume || volume > renderingcontrol.MaxVolume {
+		return upnpav.ErrInvalidArgs
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.setVolume(ctx, volume, r.mute)
+}
+func (r *Renderer) GetMute(_ context.Context) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.mute, nil
+}
+func (r *Renderer) SetMute(ctx context.Context, mute bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.setVolume(ctx, r.volume, mute)
+}
+
+// GetVolumeDB maps the volume linearly onto the decibel range.
+func (r *Renderer) GetVolumeDB(_ context.Context) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return minVolumeDB + (maxVolumeDB-minVolumeDB)*r.volume/renderingcontrol.MaxVolume, nil
+}
+func (r *Renderer) GetVolumeDBRange(_ context.Context) (int, int, error) {
+	return minVolumeDB, maxVolumeDB, nil
+}
+
+func (r *Renderer) ListPresets(_ context.Context) ([]string, error) {
+	return []string{renderingcontrol.PresetFactoryDefaults}, nil
+}
+func (r *Renderer) SelectPreset(ctx context.Context, name string) error {
+	if name != renderingcontrol.PresetFactoryDefaults {
+		return renderingcontrol.ErrInvalidPresetName
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.setVolume(ctx, defaultVolume, false)
+}
+
+// setVolume must be called with the lock held.
+func (r *Renderer) setVolume(ctx context.Context, volume int, mute bool) error {
+	if err := r.player.SetVolume(ctx, volume, mute); err != nil {
+		return playerError(ctx, err)
+	}
+	r.volume, r.mute = volume, mute
+	r.notifyRenderingControl()
+	return nil
+}
+
+// notifyRenderingControl events the RenderingControl state variables with LastChange.
+// It must be called with the lock held.
+func (r *Renderer) notifyRenderingControl() {
+	if r.notify == nil {
+		return
+	}
+	mute := "0"
+	if r.mute {
+		mute = "1"
+	}
+	lastChange := upnpav.NewLastChange(0, map[string]string{
+		"Volume": strconv.Itoa(r.volume),
+		"Mute":   mute,
+	})
+	for i := range lastChange.Instances[0].Variables {
+		lastChange.Instances[0].Variables[i].Channel = upnpav.ChannelMaster
+	}
+	r.sendEvent(renderingcontrol.Version1, lastChange.Marshal(upnpav.LastChangeNamespaceRenderingControl))
+}
+
+func (r *Renderer) sendEvent(urn upnp.URN, lastChange string) {
+	if err := r.notify(urn, map[string]string{upnpav.LastChangeVariableName: lastChange}); err != nil {
+		log := logger.Background()
+		log.AddField("upnp.urn", urn)
+		log.WithError(err).Warning("could not send LastChange event")
+	}
+}
+
+// playerError logs errors from the Player, which have no UPnP error code of their own.
+func playerError(ctx context.Context, err error) error {
+	log, _ := logger.FromContext(ctx)
+	log.WithError(err).Error("player failed")
+	return upnpav.ErrActionFailed
+}
+
+// durationForURI returns the duration of the resource for uri in metadata, or 0 if it is unknown.
+func durationForURI(metadata *upnpav.DIDLLite, uri string) time.Duration {
+	if metadata == nil {
+		return 0
+	}
+	for _, item := range metadata.Items {
+		for _, resource := range item.Resources {
+			if resource.URI == uri && resource.Duration != nil {
+				return resource.Duration.Duration
+			}
+		}
+	}
+	return 0
+}
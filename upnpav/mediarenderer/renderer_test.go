@@ -0,0 +1,360 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package mediarenderer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethulhu/helix/upnp"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/upnpav/avtransport"
+	"github.com/ethulhu/helix/upnpav/connectionmanager"
+	"github.com/ethulhu/helix/upnpav/renderingcontrol"
+)
+
+func TestRendererTransport(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	player := NewNullPlayer()
+	player.clock.now = clock.Now
+
+	renderer := NewRenderer(player, nil)
+	defer renderer.Close()
+
+	events := &fakeNotifier{}
+	renderer.SetEventNotifier(events.Notify)
+
+	ctx := context.Background()
+	transport := avtransport.NewClient(avtransport.SOAPHandler{Interface: renderer})
+
+	if err := transport.Play(ctx); !isError(err, avtransport.ErrNoContents) {
+		t.Errorf("Play(_) with no URI returned error %v, want %v", err, avtransport.ErrNoContents)
+	}
+
+	if err := transport.SetCurrentURI(ctx, "http://foo/a.mp3", didlWithDuration("http://foo/a.mp3", 10*time.Second)); err != nil {
+		t.Fatalf("SetCurrentURI(_, a, _) returned error: %v", err)
+	}
+	assertState(t, transport, avtransport.StateStopped)
+
+	if err := transport.Play(ctx); err != nil {
+		t.Fatalf("Play(_) returned error: %v", err)
+	}
+	assertState(t, transport, avtransport.StatePlaying)
+
+	clock.Advance(3 * time.Second)
+	assertPosition(t, transport, "http://foo/a.mp3", 10*time.Second, 3*time.Second)
+
+	if err := transport.Pause(ctx); err != nil {
+		t.Fatalf("Pause(_) returned error: %v", err)
+	}
+	clock.Advance(5 * time.Second)
+	assertState(t, transport, avtransport.StatePaused)
+	assertPosition(t, transport, "http://foo/a.mp3", 10*time.Second, 3*time.Second)
+
+	if err := transport.Seek(ctx, avtransport.SeekTarget{Mode: avtransport.SeekRelativeTime, Time: 5 * time.Second}); err != nil {
+		t.Fatalf("Seek(_, 5s) returned error: %v", err)
+	}
+	assertState(t, transport, avtransport.StatePaused)
+	assertPosition(t, transport, "http://foo/a.mp3", 10*time.Second, 5*time.Second)
+
+	if err := transport.Seek(ctx, avtransport.SeekTarget{Mode: avtransport.SeekRelativeTime, Time: time.Hour}); !isError(err, avtransport.ErrIllegalSeekTarget) {
+		t.Errorf("Seek(_, 1h) returned error %v, want %v", err, avtransport.ErrIllegalSeekTarget)
+	}
+
+	if err := transport.Next(ctx); !isError(err, avtransport.ErrTransitionNotAvailable) {
+		t.Errorf("Next(_) with no next URI returned error %v, want %v", err, avtransport.ErrTransitionNotAvailable)
+	}
+
+	if err := transport.SetNextURI(ctx, "http://foo/b.mp3", didlWithDuration("http://foo/b.mp3", 20*time.Second)); err != nil {
+		t.Fatalf("SetNextURI(_, b, _) returned error: %v", err)
+	}
+	if err := transport.Play(ctx); err != nil {
+		t.Fatalf("Play(_) returned error: %v", err)
+	}
+
+	// When a track finishes, the Renderer moves on to the next URI.
+	clock.Advance(5 * time.Second)
+	renderer.checkFinished(ctx)
+	assertState(t, transport, avtransport.StatePlaying)
+	assertPosition(t, transport, "http://foo/b.mp3", 20*time.Second, 0)
+
+	mediaInfo, err := transport.MediaInfo(ctx)
+	if err != nil {
+		t.Fatalf("MediaInfo(_) returned error: %v", err)
+	}
+	if mediaInfo.CurrentURI != "http://foo/b.mp3" || mediaInfo.NextURI != "" {
+		t.Errorf("MediaInfo(_) == %q, %q, want %q, %q", mediaInfo.CurrentURI, mediaInfo.NextURI, "http://foo/b.mp3", "")
+	}
+
+	// When the last track finishes, the Renderer stops.
+	clock.Advance(20 * time.Second)
+	renderer.checkFinished(ctx)
+	assertState(t, transport, avtransport.StateStopped)
+
+	wantCalls := []string{
+		"Stop",
+		"Play http://foo/a.mp3 0s",
+		"Pause",
+		"Play http://foo/a.mp3 5s",
+		"Pause",
+		"Resume",
+		"Play http://foo/b.mp3 0s",
+		"Stop",
+	}
+	if calls := player.Calls(); !reflect.DeepEqual(calls, wantCalls) {
+		t.Errorf("player calls == %q, want %q", calls, wantCalls)
+	}
+
+	lastChange, err := upnpav.ParseLastChange(events.Last(avtransport.Version1))
+	if err != nil {
+		t.Fatalf("could not parse AVTransport LastChange event: %v", err)
+	}
+	if state := lastChange.Variables(0)["TransportState"]; state != string(avtransport.StateStopped) {
+		t.Errorf("evented TransportState == %q, want %q", state, avtransport.StateStopped)
+	}
+}
+
+func TestRendererPlayMode(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	player := NewNullPlayer()
+	player.clock.now = clock.Now
+
+	renderer := NewRenderer(player, nil)
+	defer renderer.Close()
+
+	ctx := context.Background()
+	transport := avtransport.NewClient(avtransport.SOAPHandler{Interface: renderer})
+
+	if err := transport.SetPlayMode(ctx, avtransport.PlayModeShuffle); !isError(err, avtransport.ErrPlayModeNotSupported) {
+		t.Errorf("SetPlayMode(_, %v) returned error %v, want %v", avtransport.PlayModeShuffle, err, avtransport.ErrPlayModeNotSupported)
+	}
+	if err := transport.SetPlayMode(ctx, avtransport.PlayModeRepeatOne); err != nil {
+		t.Fatalf("SetPlayMode(_, %v) returned error: %v", avtransport.PlayModeRepeatOne, err)
+	}
+	settings, err := transport.TransportSettings(ctx)
+	if err != nil {
+		t.Fatalf("TransportSettings(_) returned error: %v", err)
+	}
+	if settings.PlayMode != avtransport.PlayModeRepeatOne {
+		t.Errorf("TransportSettings(_).PlayMode == %v, want %v", settings.PlayMode, avtransport.PlayModeRepeatOne)
+	}
+
+	if actions, err := transport.CurrentTransportActions(ctx); err != nil || len(actions) != 0 {
+		t.Errorf("CurrentTransportActions(_) with no media == %v, %v, want none", actions, err)
+	}
+
+	if err := transport.SetCurrentURI(ctx, "http://foo/a.mp3", didlWithDuration("http://foo/a.mp3", 10*time.Second)); err != nil {
+		t.Fatalf("SetCurrentURI(_, a, _) returned error: %v", err)
+	}
+	if err := transport.SetNextURI(ctx, "http://foo/b.mp3", didlWithDuration("http://foo/b.mp3", 10*time.Second)); err != nil {
+		t.Fatalf("SetNextURI(_, b, _) returned error: %v", err)
+	}
+	if err := transport.Play(ctx); err != nil {
+		t.Fatalf("Play(_) returned error: %v", err)
+	}
+
+	wantActions := []avtransport.TransportAction{
+		avtransport.ActionPause,
+		avtransport.ActionStop,
+		avtransport.ActionSeek,
+		avtransport.ActionPrevious,
+		avtransport.ActionNext,
+	}
+	if actions, err := transport.CurrentTransportActions(ctx); err != nil || !reflect.DeepEqual(actions, wantActions) {
+		t.Errorf("CurrentTransportActions(_) == %v, %v, want %v", actions, err, wantActions)
+	}
+
+	// REPEAT_ONE replays the current URI instead of moving to the next.
+	clock.Advance(10 * time.Second)
+	renderer.checkFinished(ctx)
+	assertState(t, transport, avtransport.StatePlaying)
+	assertPosition(t, transport, "http://foo/a.mp3", 10*time.Second, 0)
+
+	if err := transport.Seek(ctx, avtransport.SeekTarget{Mode: avtransport.SeekTrack, Track: 2}); !isError(err, avtransport.ErrIllegalSeekTarget) {
+		t.Errorf("Seek(_, track 2) returned error %v, want %v", err, avtransport.ErrIllegalSeekTarget)
+	}
+	if err := transport.Seek(ctx, avtransport.SeekTarget{Mode: avtransport.SeekAbsoluteCount, Count: 2}); !isError(err, avtransport.ErrSeekModeNotSupported) {
+		t.Errorf("Seek(_, count 2) returned error %v, want %v", err, avtransport.ErrSeekModeNotSupported)
+	}
+
+	// REPEAT_ALL moves on to the next URI, then repeats it once there are no more.
+	if err := transport.SetPlayMode(ctx, avtransport.PlayModeRepeatAll); err != nil {
+		t.Fatalf("SetPlayMode(_, %v) returned error: %v", avtransport.PlayModeRepeatAll, err)
+	}
+	clock.Advance(10 * time.Second)
+	renderer.checkFinished(ctx)
+	assertPosition(t, transport, "http://foo/b.mp3", 10*time.Second, 0)
+
+	clock.Advance(10 * time.Second)
+	renderer.checkFinished(ctx)
+	assertState(t, transport, avtransport.StatePlaying)
+	assertPosition(t, transport, "http://foo/b.mp3", 10*time.Second, 0)
+}
+
+func TestRendererRenderingControl(t *testing.T) {
+	player := NewNullPlayer()
+	renderer := NewRenderer(player, nil)
+	defer renderer.Close()
+
+	events := &fakeNotifier{}
+	renderer.SetEventNotifier(events.Notify)
+
+	ctx := context.Background()
+	renderingControl := renderingcontrol.NewClient(renderingcontrol.SOAPHandler{Interface: renderer})
+
+	if err := renderingControl.SetVolume(ctx, 30); err != nil {
+		t.Fatalf("SetVolume(_, 30) returned error: %v", err)
+	}
+	if err := renderingControl.SetMute(ctx, true); err != nil {
+		t.Fatalf("SetMute(_, true) returned error: %v", err)
+	}
+	volume, err := renderingControl.GetVolume(ctx)
+	if err != nil {
+		t.Fatalf("GetVolume(_) returned error: %v", err)
+	}
+	if volume != 30 {
+		t.Errorf("GetVolume(_) == %v, want %v", volume, 30)
+	}
+
+	volumeDB, err := renderingControl.GetVolumeDB(ctx)
+	if err != nil {
+		t.Fatalf("GetVolumeDB(_) returned error: %v", err)
+	}
+	if want := -42 * 256; volumeDB != want {
+		t.Errorf("GetVolumeDB(_) == %v, want %v", volumeDB, want)
+	}
+
+	lastChange, err := upnpav.ParseLastChange(events.Last(renderingcontrol.Version1))
+	if err != nil {
+		t.Fatalf("could not parse RenderingControl LastChange event: %v", err)
+	}
+	wantVariables := map[string]string{"Volume": "30", "Mute": "1"}
+	if variables := lastChange.Variables(0); !reflect.DeepEqual(variables, wantVariables) {
+		t.Errorf("evented variables == %v, want %v", variables, wantVariables)
+	}
+
+	if err := renderingControl.SelectPreset(ctx, "Night"); !isError(err, renderingcontrol.ErrInvalidPresetName) {
+		t.Errorf("SelectPreset(_, Night) returned error %v, want %v", err, renderingcontrol.ErrInvalidPresetName)
+	}
+	if err := renderingControl.SelectPreset(ctx, renderingcontrol.PresetFactoryDefaults); err != nil {
+		t.Fatalf("SelectPreset(_, %v) returned error: %v", renderingcontrol.PresetFactoryDefaults, err)
+	}
+
+	wantCalls := []string{
+		"SetVolume 30 false",
+		"SetVolume 30 true",
+		"SetVolume 50 false",
+	}
+	if calls := player.Calls(); !reflect.DeepEqual(calls, wantCalls) {
+		t.Errorf("player calls == %q, want %q", calls, wantCalls)
+	}
+}
+
+func TestRendererProtocolInfo(t *testing.T) {
+	sinks := []upnpav.ProtocolInfo{{
+		Protocol:       upnpav.ProtocolHTTP,
+		Network:        "*",
+		ContentFormat:  "audio/mpeg",
+		AdditionalInfo: "*",
+	}}
+	renderer := NewRenderer(NewNullPlayer(), sinks)
+	defer renderer.Close()
+
+	manager := connectionmanager.NewClient(connectionmanager.SOAPHandler{Interface: renderer})
+	sources, gotSinks, err := manager.ProtocolInfo(context.Background())
+	if err != nil {
+		t.Fatalf("ProtocolInfo(_) returned error: %v", err)
+	}
+	if len(sources) != 0 {
+		t.Errorf("ProtocolInfo(_) returned sources %v, want none", sources)
+	}
+	if !reflect.DeepEqual(gotSinks, sinks) {
+		t.Errorf("ProtocolInfo(_) returned sinks %v, want %v", gotSinks, sinks)
+	}
+}
+
+func didlWithDuration(uri string, duration time.Duration) *upnpav.DIDLLite {
+	return &upnpav.DIDLLite{
+		Items: []upnpav.Item{{
+			ID:    upnpav.ObjectID(uri),
+			Title: uri,
+			Class: upnpav.AudioItem,
+			Resources: []upnpav.Resource{{
+				URI:          uri,
+				ProtocolInfo: &upnpav.ProtocolInfo{Protocol: upnpav.ProtocolHTTP, Network: "*", ContentFormat: "audio/mpeg", AdditionalInfo: "*"},
+				Duration:     &upnpav.Duration{Duration: duration},
+			}},
+		}},
+	}
+}
+
+func assertState(t *testing.T, transport avtransport.Interface, want avtransport.State) {
+	t.Helper()
+
+	info, err := transport.TransportInfo(context.Background())
+	if err != nil {
+		t.Fatalf("TransportInfo(_) returned error: %v", err)
+	}
+	if info.State != want {
+		t.Errorf("TransportInfo(_) == %v, want %v", info.State, want)
+	}
+}
+func assertPosition(t *testing.T, transport avtransport.Interface, wantURI string, wantDuration, wantElapsed time.Duration) {
+	t.Helper()
+
+	info, err := transport.PositionInfo(context.Background())
+	if err != nil {
+		t.Fatalf("PositionInfo(_) returned error: %v", err)
+	}
+	if info.TrackURI != wantURI || info.TrackDuration != wantDuration || info.RelativeTime != wantElapsed {
+		t.Errorf("PositionInfo(_) == %q, %v, %v, want %q, %v, %v", info.TrackURI, info.TrackDuration, info.RelativeTime, wantURI, wantDuration, wantElapsed)
+	}
+}
+
+func isError(err error, want upnpav.Error) bool {
+	var upnpErr upnpav.Error
+	return errors.As(err, &upnpErr) && upnpErr.Code == want.Code
+}
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+type fakeNotifier struct {
+	mu     sync.Mutex
+	events map[upnp.URN]string
+}
+
+func (n *fakeNotifier) Notify(urn upnp.URN, variables map[string]string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.events == nil {
+		n.events = map[upnp.URN]string{}
+	}
+	n.events[urn] = variables[upnpav.LastChangeVariableName]
+	return nil
+}
+func (n *fakeNotifier) Last(urn upnp.URN) string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.events[urn]
+}
@@ -0,0 +1,543 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+// Package mediaserver serves the media of a UPnP AV MediaServer over HTTP, with the headers that DLNA renderers expect.
+package mediaserver
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethulhu/helix/logger"
+	"github.com/ethulhu/helix/media"
+	"github.com/ethulhu/helix/upnpav"
+)
+
+type (
+	fileHandler struct {
+		basePath      string
+		metadataCache media.MetadataCache
+		transcoder    *media.Transcoder
+		resizer       *media.Resizer
+		coverArt      *media.CoverArtExtractor
+
+		root  http.FileSystem
+		files http.Handler
+	}
+)
+
+const (
+	getContentFeaturesHeader = "getcontentFeatures.dlna.org"
+	contentFeaturesHeader    = "contentFeatures.dlna.org"
+	transferModeHeader       = "transferMode.dlna.org"
+	timeSeekRangeHeader      = "TimeSeekRange.dlna.org"
+
+	transferModeStreaming   = "Streaming"
+	transferModeInteractive = "Interactive"
+	transferModeBackground  = "Background"
+
+	// TranscodeParameter is the URL query parameter that selects a TranscodeProfile by name.
+	TranscodeParameter = "transcode"
+
+	// ResizeParameter is the URL query parameter that selects an ImageProfile by name.
+	ResizeParameter = "resize"
+
+	// CoverArtParameter is the URL query parameter that selects the cover art embedded in a media file's tags.
+	CoverArtParameter = "coverart"
+)
+
+// NewFileHandler returns an http.Handler that serves the files under basePath.
+// As well as HEAD and Range requests, it answers the DLNA getcontentFeatures.dlna.org, transferMode.dlna.org,
+// and TimeSeekRange.dlna.org headers, using metadataCache for durations.
+// If transcoder is not nil, files can be transcoded with the ?transcode=<profile> query parameter.
+// The thumbnails embedded in JPEGs are served with the ?thumbnail=1 query parameter.
+// If resizer is not nil, images can be resized with the ?resize=<profile> query parameter.
+// If coverArt is not nil, the cover art embedded in media files is served with the ?coverart=1 query parameter,
+// which can be combined with ?resize=<profile>.
+// Directories are listed as with http.FileServer.
+func NewFileHandler(basePath string, metadataCache media.MetadataCache, transcoder *media.Transcoder, resizer *media.Resizer, coverArt *media.CoverArtExtractor) (http.Handler, error) {
+	absPath, err := filepath.Abs(basePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not get absolute path: %w", err)
+	}
+
+	root := http.Dir(absPath)
+	return &fileHandler{
+		basePath:      absPath,
+		metadataCache: metadataCache,
+		transcoder:    transcoder,
+		resizer:       resizer,
+		coverArt:      coverArt,
+
+		root:  root,
+		files: http.FileServer(root),
+	}, nil
+}
+
+// DLNAInfo returns the DLNA parameters for a file served by a FileHandler.
+// Files can always be seeked by bytes, and by time if their duration is known.
+func DLNAInfo(mimeType string, duration time.Duration) upnpav.DLNAInfo {
+	transferMode := upnpav.DLNAFlagInteractiveTransferMode
+	if isStreamed(mimeType) {
+		transferMode = upnpav.DLNAFlagStreamingTransferMode
+	}
+
+	return upnpav.DLNAInfo{
+		Operations: upnpav.DLNAOperations{
+			TimeSeek: duration > 0,
+			ByteSeek: true,
+		},
+		Flags: transferMode | upnpav.DLNAFlagBackgroundTransferMode | upnpav.DLNAFlagConnectionStall | upnpav.DLNAFlagDLNAv15,
+	}
+}
+
+// TranscodedDLNAInfo returns the DLNA parameters for a file transcoded to profile by a FileHandler.
+func TranscodedDLNAInfo(profile media.TranscodeProfile, duration time.Duration) upnpav.DLNAInfo {
+	info := DLNAInfo(profile.MIMEType, duration)
+	info.Profile = profile.DLNAProfile
+	info.Converted = true
+	return info
+}
+
+// TranscodedResources returns a Resource for each of profiles that md can be transcoded to, given the URI of the original.
+// Only audio is transcoded, and never to its own MIME-type.
+func TranscodedResources(uri string, md *media.Metadata, profiles []media.TranscodeProfile) []upnpav.Resource {
+	if !strings.HasPrefix(md.MIMEType, "audio/") {
+		return nil
+	}
+
+	var resources []upnpav.Resource
+	for _, profile := range profiles {
+		if baseMIMEType(profile.MIMEType) == baseMIMEType(md.MIMEType) {
+			continue
+		}
+
+		resources = append(resources, upnpav.Resource{
+			URI:      withQuery(uri, TranscodeParameter, profile.Name),
+			Duration: &upnpav.Duration{Duration: md.Duration},
+			ProtocolInfo: &upnpav.ProtocolInfo{
+				Protocol:       upnpav.ProtocolHTTP,
+				ContentFormat:  profile.MIMEType,
+				AdditionalInfo: TranscodedDLNAInfo(profile, md.Duration).String(),
+			},
+		})
+	}
+	return resources
+}
+
+// EmbeddedCoverArtURI returns the URI of the cover art embedded in a media file, given the URI of the file.
+func EmbeddedCoverArtURI(uri string) string {
+	return withQuery(uri, CoverArtParameter, "1")
+}
+
+// withQuery adds a query parameter to a URI, which may already have some.
+func withQuery(uri, key, value string) string {
+	sep := "?"
+	if strings.Contains(uri, "?") {
+		sep = "&"
+	}
+	return fmt.Sprintf("%v%v%v=%v", uri, sep, key, value)
+}
+
+func (h *fileHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := path.Clean("/" + r.URL.Path)
+	f, err := h.root.Open(name)
+	if err != nil {
+		switch {
+		case os.IsNotExist(err):
+			http.NotFound(w, r)
+		case os.IsPermission(err):
+			http.Error(w, "forbidden", http.StatusForbidden)
+		default:
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+		}
+		return
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	if fi.IsDir() {
+		h.files.ServeHTTP(w, r)
+		return
+	}
+
+	mimeType := mime.TypeByExtension(filepath.Ext(name))
+	if media.IsPlaylist(name) {
+		mimeType = media.PlaylistMIMEType(name)
+	}
+	p := filepath.Join(h.basePath, filepath.FromSlash(name))
+
+	if r.URL.Query().Get(ThumbnailParameter) != "" {
+		h.serveThumbnail(w, r, p, fi)
+		return
+	}
+	if profileName := r.URL.Query().Get(ResizeParameter); profileName != "" {
+		h.serveResized(w, r, p, profileName)
+		return
+	}
+	if r.URL.Query().Get(CoverArtParameter) != "" {
+		h.serveCoverArt(w, r, p, fi)
+		return
+	}
+
+	var profile *media.TranscodeProfile
+	if profileName := r.URL.Query().Get(TranscodeParameter); profileName != "" {
+		if h.transcoder == nil {
+			http.Error(w, "transcoding is not enabled", http.StatusNotFound)
+			return
+		}
+		tp, ok := h.transcoder.Profile(profileName)
+		if !ok {
+			http.Error(w, fmt.Sprintf("unknown transcode profile %q", profileName), http.StatusNotFound)
+			return
+		}
+		profile = &tp
+	}
+
+	var duration time.Duration
+	if r.Header.Get(getContentFeaturesHeader) == "1" || r.Header.Get(timeSeekRangeHeader) != "" {
+		// Metadata can be slow to get, so only get it when it is needed.
+		if md, err := h.metadataCache.MetadataForPath(p); err == nil {
+			duration = md.Duration
+			if md.MIMEType != "" {
+				mimeType = md.MIMEType
+			}
+		}
+	}
+
+	dlnaInfo := DLNAInfo(mimeType, duration)
+	if media.IsImage(name) && r.Header.Get(getContentFeaturesHeader) == "1" {
+		if md, err := media.ProbeImage(p); err == nil {
+			dlnaInfo = ImageDLNAInfo(mimeType, md.Width, md.Height)
+		}
+	}
+	var stream bool
+	if profile != nil {
+		mimeType = profile.MIMEType
+		dlnaInfo = TranscodedDLNAInfo(*profile, duration)
+
+		transcoded, ok := h.transcoder.Cached(p, *profile)
+		// Seeking needs the whole transcode, but otherwise it can be streamed as ffmpeg makes it.
+		stream = !ok && (r.Method == http.MethodHead || !isSeek(r))
+		if !ok && !stream {
+			transcoded, err = h.transcoder.Transcode(r.Context(), p, *profile)
+			if err != nil {
+				log, _ := logger.FromContext(r.Context())
+				log.AddField("path", p)
+				log.AddField("transcode.profile", profile.Name)
+				log.WithError(err).Warning("could not transcode")
+				http.Error(w, "could not transcode", http.StatusInternalServerError)
+				return
+			}
+		}
+		if !stream {
+			tf, err := os.Open(transcoded)
+			if err != nil {
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
+			}
+			defer tf.Close()
+			if fi, err = tf.Stat(); err != nil {
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
+			}
+			f = tf
+		}
+	}
+
+	if mimeType != "" {
+		w.Header().Set("Content-Type", mimeType)
+	}
+
+	transferMode, ok := negotiateTransferMode(r.Header.Get(transferModeHeader), mimeType)
+	if !ok {
+		http.Error(w, "unsupported transfer mode", http.StatusNotAcceptable)
+		return
+	}
+	w.Header().Set(transferModeHeader, transferMode)
+
+	if r.Header.Get(getContentFeaturesHeader) == "1" {
+		w.Header().Set(contentFeaturesHeader, dlnaInfo.String())
+	}
+
+	if stream {
+		h.streamTranscode(w, r, p, *profile)
+		return
+	}
+
+	if raw := r.Header.Get(timeSeekRangeHeader); raw != "" {
+		if r.Header.Get("Range") != "" {
+			http.Error(w, "cannot have both Range and TimeSeekRange.dlna.org", http.StatusBadRequest)
+			return
+		}
+		if duration <= 0 {
+			http.Error(w, "time-based seeking is not supported for this file", http.StatusNotAcceptable)
+			return
+		}
+
+		seek, err := parseTimeSeekRange(raw)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid TimeSeekRange.dlna.org: %v", err), http.StatusBadRequest)
+			return
+		}
+		if seek.start >= duration || (seek.hasEnd && seek.end < seek.start) {
+			w.Header().Set(timeSeekRangeHeader, fmt.Sprintf("npt=*/%v", formatNPT(duration)))
+			http.Error(w, "time range not satisfiable", http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		end := duration
+		if seek.hasEnd && seek.end < duration {
+			end = seek.end
+		}
+
+		// Assume a constant bitrate to turn times into byte offsets.
+		size := fi.Size()
+		first := byteOffset(seek.start, duration, size)
+		last := byteOffset(end, duration, size) - 1
+		if last < first {
+			last = first
+		}
+
+		r = r.Clone(r.Context())
+		r.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", first, last))
+		w.Header().Set(timeSeekRangeHeader, fmt.Sprintf("npt=%v-%v/%v bytes=%d-%d/%d", formatNPT(seek.start), formatNPT(end), formatNPT(duration), first, last, size))
+	}
+
+	http.ServeContent(w, r, fi.Name(), fi.ModTime(), f)
+}
+
+// streamTranscode serves p transcoded to profile as it is transcoded, so that the client need not wait for the whole transcode.
+// As the length is not known until the transcode finishes, there is no Content-Length, and HEAD requests do not transcode at all.
+func (h *fileHandler) streamTranscode(w http.ResponseWriter, r *http.Request, p string, profile media.TranscodeProfile) {
+	if r.Method == http.MethodHead {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	cw := &countingWriter{w: w}
+	if err := h.transcoder.Stream(r.Context(), p, profile, cw); err != nil {
+		log, _ := logger.FromContext(r.Context())
+		log.AddField("path", p)
+		log.AddField("transcode.profile", profile.Name)
+		log.WithError(err).Warning("could not stream transcode")
+		if cw.n == 0 {
+			http.Error(w, "could not transcode", http.StatusInternalServerError)
+		}
+	}
+}
+
+// isSeek returns whether r asks for anything other than the whole file from the start.
+func isSeek(r *http.Request) bool {
+	if r.Header.Get(timeSeekRangeHeader) != "" {
+		return true
+	}
+	rangeHeader := r.Header.Get("Range")
+	return rangeHeader != "" && rangeHeader != "bytes=0-"
+}
+
+// serveThumbnail serves the JPEG thumbnail embedded in the EXIF metadata of the image at p.
+func (h *fileHandler) serveThumbnail(w http.ResponseWriter, r *http.Request, p string, fi os.FileInfo) {
+	thumbnail, err := media.ExifThumbnail(p)
+	if err != nil {
+		http.Error(w, "image has no thumbnail", http.StatusNotFound)
+		return
+	}
+
+	mimeType := "image/jpeg"
+	w.Header().Set("Content-Type", mimeType)
+
+	transferMode, ok := negotiateTransferMode(r.Header.Get(transferModeHeader), mimeType)
+	if !ok {
+		http.Error(w, "unsupported transfer mode", http.StatusNotAcceptable)
+		return
+	}
+	w.Header().Set(transferModeHeader, transferMode)
+
+	if r.Header.Get(getContentFeaturesHeader) == "1" {
+		dlnaInfo := DLNAInfo(mimeType, 0)
+		if config, err := jpeg.DecodeConfig(bytes.NewReader(thumbnail)); err == nil {
+			dlnaInfo = ImageDLNAInfo(mimeType, config.Width, config.Height)
+		}
+		w.Header().Set(contentFeaturesHeader, dlnaInfo.String())
+	}
+
+	http.ServeContent(w, r, fi.Name(), fi.ModTime(), bytes.NewReader(thumbnail))
+}
+
+// serveCoverArt serves the cover art embedded in the tags of the media file at p.
+func (h *fileHandler) serveCoverArt(w http.ResponseWriter, r *http.Request, p string, fi os.FileInfo) {
+	if h.coverArt == nil {
+		http.Error(w, "embedded cover art is not enabled", http.StatusNotFound)
+		return
+	}
+	if !media.IsAudioOrVideo(p) {
+		http.Error(w, "not a media file", http.StatusNotFound)
+		return
+	}
+
+	extracted, err := h.coverArt.Extract(r.Context(), p)
+	if err != nil {
+		http.Error(w, "file has no embedded cover art", http.StatusNotFound)
+		return
+	}
+	f, err := os.Open(extracted)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	// The art is whatever format the tagger embedded, so sniff it.
+	header := make([]byte, 512)
+	n, _ := io.ReadFull(f, header)
+	mimeType := http.DetectContentType(header[:n])
+	w.Header().Set("Content-Type", mimeType)
+
+	transferMode, ok := negotiateTransferMode(r.Header.Get(transferModeHeader), mimeType)
+	if !ok {
+		http.Error(w, "unsupported transfer mode", http.StatusNotAcceptable)
+		return
+	}
+	w.Header().Set(transferModeHeader, transferMode)
+
+	if r.Header.Get(getContentFeaturesHeader) == "1" {
+		dlnaInfo := DLNAInfo(mimeType, 0)
+		if _, err := f.Seek(0, io.SeekStart); err == nil {
+			if config, _, err := image.DecodeConfig(f); err == nil {
+				dlnaInfo = ImageDLNAInfo(mimeType, config.Width, config.Height)
+			}
+		}
+		w.Header().Set(contentFeaturesHeader, dlnaInfo.String())
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	// The cache touches the extracted art whenever it is used, so its modification time is that of the media file.
+	http.ServeContent(w, r, fi.Name(), fi.ModTime(), f)
+}
+
+// serveResized serves the image at p, or with ?coverart=1 the cover art embedded in the media file at p,
+// resized to the named ImageProfile.
+func (h *fileHandler) serveResized(w http.ResponseWriter, r *http.Request, p, profileName string) {
+	if h.resizer == nil {
+		http.Error(w, "resizing is not enabled", http.StatusNotFound)
+		return
+	}
+	profile, ok := h.resizer.Profile(profileName)
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown image profile %q", profileName), http.StatusNotFound)
+		return
+	}
+	isImage := media.IsImage(p)
+	if r.URL.Query().Get(CoverArtParameter) != "" {
+		isImage = media.IsAudioOrVideo(p)
+	}
+	if !isImage {
+		http.Error(w, "not an image", http.StatusNotFound)
+		return
+	}
+
+	resized, err := h.resizer.Resize(r.Context(), p, profile)
+	if err != nil {
+		log, _ := logger.FromContext(r.Context())
+		log.AddField("path", p)
+		log.AddField("resize.profile", profile.Name)
+		log.WithError(err).Warning("could not resize")
+		http.Error(w, "could not resize", http.StatusInternalServerError)
+		return
+	}
+	f, err := os.Open(resized)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	mimeType := "image/jpeg"
+	w.Header().Set("Content-Type", mimeType)
+
+	transferMode, ok := negotiateTransferMode(r.Header.Get(transferModeHeader), mimeType)
+	if !ok {
+		http.Error(w, "unsupported transfer mode", http.StatusNotAcceptable)
+		return
+	}
+	w.Header().Set(transferModeHeader, transferMode)
+
+	if r.Header.Get(getContentFeaturesHeader) == "1" {
+		w.Header().Set(contentFeaturesHeader, ResizedDLNAInfo(profile).String())
+	}
+
+	http.ServeContent(w, r, fi.Name(), fi.ModTime(), f)
+}
+
+// negotiateTransferMode returns the transfer mode to use for a requested transferMode.dlna.org, or false if it is not supported.
+func negotiateTransferMode(requested, mimeType string) (string, bool) {
+	switch strings.ToLower(requested) {
+	case "":
+		if isStreamed(mimeType) {
+			return transferModeStreaming, true
+		}
+		return transferModeInteractive, true
+	case strings.ToLower(transferModeStreaming):
+		return transferModeStreaming, isStreamed(mimeType)
+	case strings.ToLower(transferModeInteractive):
+		return transferModeInteractive, true
+	case strings.ToLower(transferModeBackground):
+		return transferModeBackground, true
+	default:
+		return "", false
+	}
+}
+
+// isStreamed is whether a MIME-type is for media that is played as it arrives, i.e. audio or video.
+func isStreamed(mimeType string) bool {
+	return strings.HasPrefix(mimeType, "audio/") || strings.HasPrefix(mimeType, "video/")
+}
+
+// baseMIMEType strips the parameters from a MIME-type, e.g. "audio/L16;rate=44100" becomes "audio/L16".
+func baseMIMEType(mimeType string) string {
+	return strings.TrimSpace(strings.SplitN(mimeType, ";", 2)[0])
+}
+
+func byteOffset(t, duration time.Duration, size int64) int64 {
+	return int64(float64(size) * (float64(t) / float64(duration)))
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	cw.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,463 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package mediaserver
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethulhu/helix/media"
+)
+
+func TestFileHandler(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mediaserver")
+	if err != nil {
+		t.Fatalf("could not create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// 100 bytes of "audio", lasting 100 seconds.
+	content := make([]byte, 100)
+	for i := range content {
+		content[i] = byte(i)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "track.mp3"), content, 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "cover.jpg"), content, 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "track.flac"), content, 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+
+	var art bytes.Buffer
+	if err := png.Encode(&art, image.NewGray(image.Rect(0, 0, 400, 300))); err != nil {
+		t.Fatalf("could not encode PNG: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "art.png"), art.Bytes(), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+
+	// An MP3 with the PNG embedded as its front cover, in an ID3v2.3 APIC frame.
+	apic := append([]byte("\x00image/png\x00\x03\x00"), art.Bytes()...)
+	frame := append([]byte{'A', 'P', 'I', 'C', byte(len(apic) >> 24), byte(len(apic) >> 16), byte(len(apic) >> 8), byte(len(apic)), 0, 0}, apic...)
+	size := len(frame)
+	tagged := append([]byte{'I', 'D', '3', 3, 0, 0, byte(size>>21) & 0x7f, byte(size>>14) & 0x7f, byte(size>>7) & 0x7f, byte(size) & 0x7f}, frame...)
+	if err := ioutil.WriteFile(filepath.Join(dir, "tagged.mp3"), append(tagged, content...), 0644); err != nil {
+		t.Fatalf("could not write file: %v", err)
+	}
+
+	transcoder, err := media.NewTranscoder(upperCaseEncoder{}, []media.TranscodeProfile{media.TranscodeProfileMP3}, filepath.Join(dir, ".transcodes"), 0)
+	if err != nil {
+		t.Fatalf("could not create transcoder: %v", err)
+	}
+
+	resizer, err := media.NewResizer([]media.ImageProfile{media.ImageProfileJPEGTN}, filepath.Join(dir, ".resized"), 0)
+	if err != nil {
+		t.Fatalf("could not create resizer: %v", err)
+	}
+
+	coverArt, err := media.NewCoverArtExtractor(filepath.Join(dir, ".cover-art"), 0)
+	if err != nil {
+		t.Fatalf("could not create cover art extractor: %v", err)
+	}
+
+	handler, err := NewFileHandler(dir, fakeMetadataCache{
+		filepath.Join(dir, "track.mp3"):  {MIMEType: "audio/mpeg", Duration: 100 * time.Second},
+		filepath.Join(dir, "track.flac"): {MIMEType: "audio/flac", Duration: 100 * time.Second},
+	}, transcoder, resizer, coverArt)
+	if err != nil {
+		t.Fatalf("NewFileHandler(%q, _) returned error: %v", dir, err)
+	}
+
+	tests := []struct {
+		method  string
+		path    string
+		headers map[string]string
+
+		wantStatus  int
+		wantHeaders map[string]string
+		wantBody    string
+	}{
+		{
+			method:     "GET",
+			path:       "/track.mp3",
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				"Content-Type":     "audio/mpeg",
+				"Content-Length":   "100",
+				"Accept-Ranges":    "bytes",
+				transferModeHeader: "Streaming",
+			},
+			wantBody: string(content),
+		},
+		{
+			method:     "HEAD",
+			path:       "/track.mp3",
+			headers:    map[string]string{getContentFeaturesHeader: "1"},
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				"Content-Length":      "100",
+				contentFeaturesHeader: "DLNA.ORG_OP=11;DLNA.ORG_FLAGS=01700000000000000000000000000000",
+			},
+		},
+		{
+			method:     "GET",
+			path:       "/track.mp3",
+			headers:    map[string]string{"Range": "bytes=10-19"},
+			wantStatus: http.StatusPartialContent,
+			wantHeaders: map[string]string{
+				"Content-Range": "bytes 10-19/100",
+			},
+			wantBody: string(content[10:20]),
+		},
+		{
+			method:     "GET",
+			path:       "/track.mp3",
+			headers:    map[string]string{timeSeekRangeHeader: "npt=90-"},
+			wantStatus: http.StatusPartialContent,
+			wantHeaders: map[string]string{
+				"Content-Range":     "bytes 90-99/100",
+				timeSeekRangeHeader: "npt=90.000-100.000/100.000 bytes=90-99/100",
+			},
+			wantBody: string(content[90:]),
+		},
+		{
+			method:     "GET",
+			path:       "/track.mp3",
+			headers:    map[string]string{timeSeekRangeHeader: "npt=0:00:10.000-0:00:20"},
+			wantStatus: http.StatusPartialContent,
+			wantHeaders: map[string]string{
+				"Content-Range": "bytes 10-19/100",
+			},
+			wantBody: string(content[10:20]),
+		},
+		{
+			method:     "GET",
+			path:       "/track.mp3",
+			headers:    map[string]string{timeSeekRangeHeader: "npt=120-"},
+			wantStatus: http.StatusRequestedRangeNotSatisfiable,
+		},
+		{
+			method:     "GET",
+			path:       "/track.mp3",
+			headers:    map[string]string{timeSeekRangeHeader: "bytes=1-"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			method:     "GET",
+			path:       "/track.mp3",
+			headers:    map[string]string{timeSeekRangeHeader: "npt=1-", "Range": "bytes=1-"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			method:     "GET",
+			path:       "/track.mp3",
+			headers:    map[string]string{transferModeHeader: "Background"},
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				transferModeHeader: "Background",
+			},
+		},
+		{
+			method:     "GET",
+			path:       "/track.mp3",
+			headers:    map[string]string{transferModeHeader: "Telepathy"},
+			wantStatus: http.StatusNotAcceptable,
+		},
+		{
+			method:     "GET",
+			path:       "/cover.jpg",
+			headers:    map[string]string{transferModeHeader: "Streaming"},
+			wantStatus: http.StatusNotAcceptable,
+		},
+		{
+			method:     "GET",
+			path:       "/cover.jpg",
+			headers:    map[string]string{getContentFeaturesHeader: "1"},
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				transferModeHeader:    "Interactive",
+				contentFeaturesHeader: "DLNA.ORG_OP=01;DLNA.ORG_FLAGS=00f00000000000000000000000000000",
+			},
+		},
+		{
+			method:     "GET",
+			path:       "/cover.jpg",
+			headers:    map[string]string{timeSeekRangeHeader: "npt=1-"},
+			wantStatus: http.StatusNotAcceptable,
+		},
+		{
+			method:     "GET",
+			path:       "/cover.jpg?thumbnail=1",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			method:     "GET",
+			path:       "/art.png?resize=JPEG_TN",
+			headers:    map[string]string{getContentFeaturesHeader: "1"},
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				"Content-Type":        "image/jpeg",
+				transferModeHeader:    "Interactive",
+				contentFeaturesHeader: "DLNA.ORG_PN=JPEG_TN;DLNA.ORG_OP=01;DLNA.ORG_CI=1;DLNA.ORG_FLAGS=00f00000000000000000000000000000",
+			},
+		},
+		{
+			method:     "GET",
+			path:       "/art.png?resize=JPEG_SM",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			method:     "GET",
+			path:       "/track.mp3?resize=JPEG_TN",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			method:     "GET",
+			path:       "/cover.jpg?resize=JPEG_TN",
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			method:     "GET",
+			path:       "/tagged.mp3?coverart=1",
+			headers:    map[string]string{getContentFeaturesHeader: "1"},
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				"Content-Type":        "image/png",
+				transferModeHeader:    "Interactive",
+				contentFeaturesHeader: "DLNA.ORG_PN=PNG_LRG;DLNA.ORG_OP=01;DLNA.ORG_FLAGS=00f00000000000000000000000000000",
+			},
+			wantBody: art.String(),
+		},
+		{
+			method:     "GET",
+			path:       "/tagged.mp3?coverart=1&resize=JPEG_TN",
+			headers:    map[string]string{getContentFeaturesHeader: "1"},
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				"Content-Type":        "image/jpeg",
+				contentFeaturesHeader: "DLNA.ORG_PN=JPEG_TN;DLNA.ORG_OP=01;DLNA.ORG_CI=1;DLNA.ORG_FLAGS=00f00000000000000000000000000000",
+			},
+		},
+		{
+			method:     "GET",
+			path:       "/track.mp3?coverart=1",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			method:     "GET",
+			path:       "/art.png?coverart=1",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			method:     "HEAD",
+			path:       "/track.flac?transcode=mp3",
+			headers:    map[string]string{getContentFeaturesHeader: "1"},
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				"Content-Type":        "audio/mpeg",
+				"Content-Length":      "",
+				contentFeaturesHeader: "DLNA.ORG_PN=MP3;DLNA.ORG_OP=11;DLNA.ORG_CI=1;DLNA.ORG_FLAGS=01700000000000000000000000000000",
+			},
+		},
+		{
+			// The HEAD did not transcode, so this is streamed, without a Content-Length.
+			method:     "GET",
+			path:       "/track.flac?transcode=mp3",
+			headers:    map[string]string{getContentFeaturesHeader: "1", "Range": "bytes=0-"},
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				"Content-Type":        "audio/mpeg",
+				"Content-Length":      "",
+				contentFeaturesHeader: "DLNA.ORG_PN=MP3;DLNA.ORG_OP=11;DLNA.ORG_CI=1;DLNA.ORG_FLAGS=01700000000000000000000000000000",
+			},
+			wantBody: strings.ToUpper(string(content)),
+		},
+		{
+			method:     "GET",
+			path:       "/track.flac?transcode=mp3",
+			wantStatus: http.StatusOK,
+			wantHeaders: map[string]string{
+				"Content-Type":   "audio/mpeg",
+				"Content-Length": "100",
+			},
+			wantBody: strings.ToUpper(string(content)),
+		},
+		{
+			method:     "GET",
+			path:       "/track.flac?transcode=mp3",
+			headers:    map[string]string{timeSeekRangeHeader: "npt=50-"},
+			wantStatus: http.StatusPartialContent,
+			wantBody:   strings.ToUpper(string(content[50:])),
+		},
+		{
+			method:     "GET",
+			path:       "/track.flac?transcode=lpcm",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			method:     "GET",
+			path:       "/../../etc/passwd",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			method:     "POST",
+			path:       "/track.mp3",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for i, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		for k, v := range tt.headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != tt.wantStatus {
+			t.Errorf("[%d]: got status %v, want %v", i, rec.Code, tt.wantStatus)
+			continue
+		}
+		for k, want := range tt.wantHeaders {
+			if got := rec.Header().Get(k); got != want {
+				t.Errorf("[%d]: got header %v: %q, want %q", i, k, got, want)
+			}
+		}
+		if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+			t.Errorf("[%d]: got body %q, want %q", i, rec.Body.String(), tt.wantBody)
+		}
+	}
+}
+
+func TestParseTimeSeekRange(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    timeSeekRange
+		wantErr bool
+	}{
+		{
+			raw:  "npt=0-",
+			want: timeSeekRange{},
+		},
+		{
+			raw:  "npt=10.5-20",
+			want: timeSeekRange{start: 10500 * time.Millisecond, end: 20 * time.Second, hasEnd: true},
+		},
+		{
+			raw:  "npt=1:02:03.500-",
+			want: timeSeekRange{start: time.Hour + 2*time.Minute + 3500*time.Millisecond},
+		},
+		{
+			raw:     "npt=10",
+			wantErr: true,
+		},
+		{
+			raw:     "npt=ten-",
+			wantErr: true,
+		},
+		{
+			raw:     "npt=NaN-",
+			wantErr: true,
+		},
+		{
+			raw:     "npt=Inf-",
+			wantErr: true,
+		},
+		{
+			raw:     "npt=1e400-",
+			wantErr: true,
+		},
+		{
+			raw:     "npt=+10-",
+			wantErr: true,
+		},
+		{
+			raw:     "npt=10e2-",
+			wantErr: true,
+		},
+		{
+			raw:     "npt=0x10-",
+			wantErr: true,
+		},
+		{
+			raw:     "npt=99999999999999999999-",
+			wantErr: true,
+		},
+		{
+			raw:     "npt=.5-",
+			wantErr: true,
+		},
+		{
+			raw:  "npt=10.-1.2345678901",
+			want: timeSeekRange{start: 10 * time.Second, end: 1234567890 * time.Nanosecond, hasEnd: true},
+		},
+		{
+			raw:     "10-20",
+			wantErr: true,
+		},
+	}
+
+	for i, tt := range tests {
+		got, err := parseTimeSeekRange(tt.raw)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("[%d]: parseTimeSeekRange(%q) returned error %v, wantErr %v", i, tt.raw, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("[%d]: parseTimeSeekRange(%q) == %+v, want %+v", i, tt.raw, got, tt.want)
+		}
+	}
+}
+
+// upperCaseEncoder "transcodes" by upper-casing the bytes of a file.
+type upperCaseEncoder struct{}
+
+func (_ upperCaseEncoder) Encode(_ context.Context, src string, _ media.TranscodeProfile, w io.Writer) error {
+	content, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes.ToUpper(content))
+	return err
+}
+
+type fakeMetadataCache map[string]*media.Metadata
+
+func (c fakeMetadataCache) MetadataForPath(p string) (*media.Metadata, error) {
+	if md, ok := c[p]; ok {
+		return md, nil
+	}
+	return nil, os.ErrNotExist
+}
+func (c fakeMetadataCache) MetadataForPaths(paths []string) []*media.Metadata {
+	var mds []*media.Metadata
+	for _, p := range paths {
+		md, _ := c.MetadataForPath(p)
+		mds = append(mds, md)
+	}
+	return mds
+}
+func (c fakeMetadataCache) Warm(_ context.Context, _ string) error {
+	return nil
+}
+func (c fakeMetadataCache) WarmStatus() media.WarmStatus {
+	return media.WarmStatus{}
+}
+func (c fakeMetadataCache) Invalidate(_ string) {}
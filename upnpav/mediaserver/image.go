@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package mediaserver
+
+import (
+	"github.com/ethulhu/helix/media"
+	"github.com/ethulhu/helix/upnpav"
+)
+
+const (
+	// ThumbnailParameter is the URL query parameter that selects the JPEG thumbnail embedded in an image's EXIF metadata.
+	ThumbnailParameter = "thumbnail"
+)
+
+type (
+	// imageProfile is a DLNA image profile, and the largest image it allows.
+	imageProfile struct {
+		name          string
+		width, height int
+	}
+)
+
+var (
+	// imageProfiles are the DLNA image profiles for each MIME-type, smallest first.
+	imageProfiles = map[string][]imageProfile{
+		"image/jpeg": {
+			{"JPEG_TN", 160, 160},
+			{"JPEG_SM", 640, 480},
+			{"JPEG_MED", 1024, 768},
+			{"JPEG_LRG", 4096, 4096},
+		},
+		"image/png": {
+			{"PNG_TN", 160, 160},
+			{"PNG_LRG", 4096, 4096},
+		},
+	}
+)
+
+// PhotoItem returns an Item for an image served at uri, with a Resource for its embedded thumbnail if it has one,
+// and for each of profiles that it is larger than.
+// The caller must set its ID, Parent, and Title, and may set its Date if the image has none.
+func PhotoItem(uri string, md *media.ImageMetadata, profiles []media.ImageProfile) upnpav.Item {
+	item := upnpav.Item{
+		Class:     upnpav.Photo,
+		Resources: []upnpav.Resource{ImageResource(uri, md)},
+	}
+	if !md.Date.IsZero() {
+		item.Date = &upnpav.Date{Time: md.Date}
+	}
+
+	if md.ThumbnailWidth > 0 && md.ThumbnailHeight > 0 {
+		item.Resources = append(item.Resources, upnpav.Resource{
+			URI:        withQuery(uri, ThumbnailParameter, "1"),
+			Resolution: &upnpav.Resolution{Width: md.ThumbnailWidth, Height: md.ThumbnailHeight},
+			ProtocolInfo: &upnpav.ProtocolInfo{
+				Protocol:       upnpav.ProtocolHTTP,
+				ContentFormat:  "image/jpeg",
+				AdditionalInfo: ImageDLNAInfo("image/jpeg", md.ThumbnailWidth, md.ThumbnailHeight).String(),
+			},
+		})
+	}
+
+	item.Resources = append(item.Resources, ResizedResources(uri, md, profiles)...)
+	return item
+}
+
+// ResizedResources returns a Resource for each of profiles that an image is larger than, given the URI of the original.
+// Images of unknown size are assumed to be larger.
+func ResizedResources(uri string, md *media.ImageMetadata, profiles []media.ImageProfile) []upnpav.Resource {
+	var resources []upnpav.Resource
+	for _, profile := range profiles {
+		resource := upnpav.Resource{
+			URI: withQuery(uri, ResizeParameter, profile.Name),
+			ProtocolInfo: &upnpav.ProtocolInfo{
+				Protocol:       upnpav.ProtocolHTTP,
+				ContentFormat:  "image/jpeg",
+				AdditionalInfo: ResizedDLNAInfo(profile).String(),
+			},
+		}
+		if md.Width > 0 && md.Height > 0 {
+			width, height := profile.Fit(md.Width, md.Height)
+			if width == md.Width && height == md.Height {
+				continue
+			}
+			resource.Resolution = &upnpav.Resolution{Width: width, Height: height}
+		}
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+// AlbumArtURIs returns an AlbumArtURI for each of profiles, then for the original, given the URI of the original.
+// Renderers tend to use the first, so the smallest comes first.
+func AlbumArtURIs(uri string, profiles []media.ImageProfile) []upnpav.AlbumArtURI {
+	var uris []upnpav.AlbumArtURI
+	for _, profile := range profiles {
+		uris = append(uris, upnpav.AlbumArtURI{
+			URI:       withQuery(uri, ResizeParameter, profile.Name),
+			ProfileID: profile.Name,
+		})
+	}
+	return append(uris, upnpav.AlbumArtURI{URI: uri})
+}
+
+// ResizedDLNAInfo returns the DLNA parameters for an image resized to profile by a FileHandler.
+func ResizedDLNAInfo(profile media.ImageProfile) upnpav.DLNAInfo {
+	info := DLNAInfo("image/jpeg", 0)
+	info.Profile = profile.Name
+	info.Converted = true
+	return info
+}
+
+// ImageResource returns the Resource for the original of an image served at uri.
+func ImageResource(uri string, md *media.ImageMetadata) upnpav.Resource {
+	resource := upnpav.Resource{
+		URI: uri,
+		ProtocolInfo: &upnpav.ProtocolInfo{
+			Protocol:       upnpav.ProtocolHTTP,
+			ContentFormat:  md.MIMEType,
+			AdditionalInfo: ImageDLNAInfo(md.MIMEType, md.Width, md.Height).String(),
+		},
+		SizeBytes: uint(md.SizeBytes),
+	}
+	if md.Width > 0 && md.Height > 0 {
+		resource.Resolution = &upnpav.Resolution{Width: md.Width, Height: md.Height}
+	}
+	return resource
+}
+
+// ImageDLNAInfo returns the DLNA parameters for an image served by a FileHandler, with the smallest DLNA profile that fits it.
+// Images can be in either orientation, so e.g. a 480x640 JPEG is JPEG_SM.
+func ImageDLNAInfo(mimeType string, width, height int) upnpav.DLNAInfo {
+	info := DLNAInfo(mimeType, 0)
+	if width <= 0 || height <= 0 {
+		return info
+	}
+	for _, profile := range imageProfiles[baseMIMEType(mimeType)] {
+		if (width <= profile.width && height <= profile.height) || (width <= profile.height && height <= profile.width) {
+			info.Profile = profile.name
+			break
+		}
+	}
+	return info
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package mediaserver
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethulhu/helix/media"
+	"github.com/ethulhu/helix/upnpav"
+)
+
+func TestImageDLNAInfo(t *testing.T) {
+	tests := []struct {
+		mimeType      string
+		width, height int
+		wantProfile   string
+	}{
+		{"image/jpeg", 160, 120, "JPEG_TN"},
+		{"image/jpeg", 161, 120, "JPEG_SM"},
+		{"image/jpeg", 480, 640, "JPEG_SM"},
+		{"image/jpeg", 1024, 768, "JPEG_MED"},
+		{"image/jpeg", 4000, 3000, "JPEG_LRG"},
+		{"image/jpeg", 5000, 3000, ""},
+		{"image/jpeg", 0, 0, ""},
+		{"image/png", 100, 100, "PNG_TN"},
+		{"image/png", 2000, 1000, "PNG_LRG"},
+		{"image/gif", 100, 100, ""},
+	}
+
+	for i, tt := range tests {
+		info := ImageDLNAInfo(tt.mimeType, tt.width, tt.height)
+		if info.Profile != tt.wantProfile {
+			t.Errorf("[%d]: ImageDLNAInfo(%q, %d, %d) has profile %q, want %q", i, tt.mimeType, tt.width, tt.height, info.Profile, tt.wantProfile)
+		}
+		if info.Operations.TimeSeek || !info.Operations.ByteSeek {
+			t.Errorf("[%d]: ImageDLNAInfo(%q, %d, %d) has operations %v, want byte seeking only", i, tt.mimeType, tt.width, tt.height, info.Operations)
+		}
+	}
+}
+
+func TestPhotoItem(t *testing.T) {
+	date := time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)
+	got := PhotoItem("http://foo/photo.jpg", &media.ImageMetadata{
+		MIMEType:        "image/jpeg",
+		SizeBytes:       1234,
+		Width:           640,
+		Height:          480,
+		Date:            date,
+		ThumbnailWidth:  160,
+		ThumbnailHeight: 120,
+	}, []media.ImageProfile{media.ImageProfileJPEGTN, media.ImageProfileJPEGSM})
+
+	want := upnpav.Item{
+		Class: upnpav.Photo,
+		Date:  &upnpav.Date{Time: date},
+		Resources: []upnpav.Resource{
+			{
+				URI: "http://foo/photo.jpg",
+				ProtocolInfo: &upnpav.ProtocolInfo{
+					Protocol:       upnpav.ProtocolHTTP,
+					ContentFormat:  "image/jpeg",
+					AdditionalInfo: "DLNA.ORG_PN=JPEG_SM;DLNA.ORG_OP=01;DLNA.ORG_FLAGS=00f00000000000000000000000000000",
+				},
+				Resolution: &upnpav.Resolution{Width: 640, Height: 480},
+				SizeBytes:  1234,
+			},
+			{
+				URI: "http://foo/photo.jpg?thumbnail=1",
+				ProtocolInfo: &upnpav.ProtocolInfo{
+					Protocol:       upnpav.ProtocolHTTP,
+					ContentFormat:  "image/jpeg",
+					AdditionalInfo: "DLNA.ORG_PN=JPEG_TN;DLNA.ORG_OP=01;DLNA.ORG_FLAGS=00f00000000000000000000000000000",
+				},
+				Resolution: &upnpav.Resolution{Width: 160, Height: 120},
+			},
+			{
+				URI: "http://foo/photo.jpg?resize=JPEG_TN",
+				ProtocolInfo: &upnpav.ProtocolInfo{
+					Protocol:       upnpav.ProtocolHTTP,
+					ContentFormat:  "image/jpeg",
+					AdditionalInfo: "DLNA.ORG_PN=JPEG_TN;DLNA.ORG_OP=01;DLNA.ORG_CI=1;DLNA.ORG_FLAGS=00f00000000000000000000000000000",
+				},
+				Resolution: &upnpav.Resolution{Width: 160, Height: 120},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PhotoItem(_) == %+v, want %+v", got, want)
+	}
+}
+
+func TestAlbumArtURIs(t *testing.T) {
+	got := AlbumArtURIs("http://foo/Pale/cover.png", []media.ImageProfile{media.ImageProfileJPEGTN, media.ImageProfileJPEGSM})
+	want := []upnpav.AlbumArtURI{
+		{URI: "http://foo/Pale/cover.png?resize=JPEG_TN", ProfileID: "JPEG_TN"},
+		{URI: "http://foo/Pale/cover.png?resize=JPEG_SM", ProfileID: "JPEG_SM"},
+		{URI: "http://foo/Pale/cover.png"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AlbumArtURIs(_) == %+v, want %+v", got, want)
+	}
+}
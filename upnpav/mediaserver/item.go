@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package mediaserver
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethulhu/helix/media"
+	"github.com/ethulhu/helix/upnpav"
+)
+
+const (
+	// AlbumArtistRole is the upnp:artist@role of album artists.
+	AlbumArtistRole = "AlbumArtist"
+)
+
+// Item returns an Item for media served at uri, with its class, tags, and resources from md.
+// The caller must set its ID and Parent, and may replace its Title.
+func Item(uri string, md *media.Metadata, profiles []media.TranscodeProfile) (upnpav.Item, error) {
+	class, err := ClassForMetadata(md)
+	if err != nil {
+		return upnpav.Item{}, err
+	}
+
+	item := upnpav.Item{
+		Class:       class,
+		Title:       md.Title,
+		Albums:      splitTag(tag(md, "album")),
+		Genres:      splitTag(tag(md, "genre")),
+		Date:        dateFromTag(tag(md, "date", "year")),
+		TrackNumber: trackNumberFromTag(tag(md, "track", "tracknumber")),
+		Resources:   append([]upnpav.Resource{Resource(uri, md)}, TranscodedResources(uri, md, profiles)...),
+	}
+
+	for _, artist := range splitTag(tag(md, "artist")) {
+		item.Artists = append(item.Artists, upnpav.Person{Name: artist})
+	}
+	for _, artist := range splitTag(tag(md, "album_artist", "albumartist", "album artist")) {
+		item.Artists = append(item.Artists, upnpav.Person{Name: artist, Role: AlbumArtistRole})
+	}
+	if len(item.Artists) > 0 {
+		item.Creator = item.Artists[0].Name
+	}
+
+	return item, nil
+}
+
+// ClassForMetadata returns the class of media, which is more specific than upnpav.ClassForMIMEType for audio.
+func ClassForMetadata(md *media.Metadata) (upnpav.Class, error) {
+	class, err := upnpav.ClassForMIMEType(md.MIMEType)
+	if err != nil {
+		return class, err
+	}
+	if class == upnpav.AudioItem {
+		return upnpav.MusicTrack, nil
+	}
+	return class, nil
+}
+
+// Resource returns the Resource for the original of media served at uri.
+func Resource(uri string, md *media.Metadata) upnpav.Resource {
+	return upnpav.Resource{
+		URI:      uri,
+		Duration: &upnpav.Duration{Duration: md.Duration},
+		ProtocolInfo: &upnpav.ProtocolInfo{
+			Protocol:       upnpav.ProtocolHTTP,
+			ContentFormat:  md.MIMEType,
+			AdditionalInfo: DLNAInfo(md.MIMEType, md.Duration).String(),
+		},
+
+		// UPnP AV's res@bitrate is in bytes per second.
+		BitsPerSecond:     uint(md.Bitrate / 8),
+		SampleFrequencyHz: uint(md.SampleRate),
+		AudioChannels:     uint(md.Channels),
+		BitsPerSample:     uint(md.BitsPerSample),
+		SizeBytes:         uint(md.SizeBytes),
+	}
+}
+
+// tag returns the first of keys that md has a tag for.
+// Different formats and probers use different names for some tags, e.g. "track" and "tracknumber".
+func tag(md *media.Metadata, keys ...string) string {
+	for _, key := range keys {
+		if value := md.Tag(key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// splitTag splits a tag with multiple values, e.g. "Pop; Rock".
+func splitTag(raw string) []string {
+	var values []string
+	for _, value := range strings.Split(raw, ";") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// trackNumberFromTag parses track numbers from tags, which are frequently "3/12".
+func trackNumberFromTag(raw string) int {
+	if i := strings.Index(raw, "/"); i != -1 {
+		raw = raw[:i]
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// dateFromTag parses dates from tags, which are frequently just a year, or a year and month.
+func dateFromTag(raw string) *upnpav.Date {
+	if len(raw) < 4 {
+		return nil
+	}
+	if date, err := upnpav.ParseDate(raw); err == nil {
+		return &date
+	}
+	if year, err := strconv.Atoi(raw[:4]); err == nil && year > 0 {
+		return &upnpav.Date{Time: time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	}
+	return nil
+}
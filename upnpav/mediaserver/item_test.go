@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package mediaserver
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethulhu/helix/media"
+	"github.com/ethulhu/helix/upnpav"
+)
+
+func TestItem(t *testing.T) {
+	tests := []struct {
+		md   *media.Metadata
+		want upnpav.Item
+	}{
+		{
+			md: &media.Metadata{
+				Title:         "Pale",
+				MIMEType:      "audio/flac",
+				Duration:      3 * time.Minute,
+				Bitrate:       1024000,
+				SampleRate:    44100,
+				Channels:      2,
+				BitsPerSample: 16,
+				SizeBytes:     23040000,
+				Tags: map[string]string{
+					"ARTIST":       "Ethel Morgan; Someone Else",
+					"album_artist": "Various",
+					"album":        "Colours",
+					"genre":        "Pop;Rock",
+					"TRACKNUMBER":  "3/12",
+					"date":         "2020-06",
+				},
+			},
+			want: upnpav.Item{
+				Class:   upnpav.MusicTrack,
+				Title:   "Pale",
+				Creator: "Ethel Morgan",
+				Artists: []upnpav.Person{
+					{Name: "Ethel Morgan"},
+					{Name: "Someone Else"},
+					{Name: "Various", Role: "AlbumArtist"},
+				},
+				Albums:      []string{"Colours"},
+				Genres:      []string{"Pop", "Rock"},
+				TrackNumber: 3,
+				Date:        &upnpav.Date{Time: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)},
+				Resources: []upnpav.Resource{{
+					URI:      "http://mew/pale.flac",
+					Duration: &upnpav.Duration{Duration: 3 * time.Minute},
+					ProtocolInfo: &upnpav.ProtocolInfo{
+						Protocol:       upnpav.ProtocolHTTP,
+						ContentFormat:  "audio/flac",
+						AdditionalInfo: DLNAInfo("audio/flac", 3*time.Minute).String(),
+					},
+					BitsPerSecond:     128000,
+					SampleFrequencyHz: 44100,
+					AudioChannels:     2,
+					BitsPerSample:     16,
+					SizeBytes:         23040000,
+				}},
+			},
+		},
+		{
+			md: &media.Metadata{
+				Title:    "holiday",
+				MIMEType: "video/mp4",
+				Tags: map[string]string{
+					"date": "2019-08-02",
+				},
+			},
+			want: upnpav.Item{
+				Class: upnpav.VideoItem,
+				Title: "holiday",
+				Date:  &upnpav.Date{Time: time.Date(2019, time.August, 2, 0, 0, 0, 0, time.UTC)},
+				Resources: []upnpav.Resource{{
+					URI:      "http://mew/pale.flac",
+					Duration: &upnpav.Duration{},
+					ProtocolInfo: &upnpav.ProtocolInfo{
+						Protocol:       upnpav.ProtocolHTTP,
+						ContentFormat:  "video/mp4",
+						AdditionalInfo: DLNAInfo("video/mp4", 0).String(),
+					},
+				}},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		got, err := Item("http://mew/pale.flac", tt.md, nil)
+		if err != nil {
+			t.Errorf("[%d]: got error: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("[%d]: got %+v, want %+v", i, got, tt.want)
+		}
+	}
+
+	if _, err := Item("http://mew/unknown", &media.Metadata{}, nil); err == nil {
+		t.Errorf("Item() with no MIME-Type returned nil error")
+	}
+}
+
+func TestTrackNumberFromTag(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want int
+	}{
+		{"", 0},
+		{"3", 3},
+		{"03", 3},
+		{"3/12", 3},
+		{" 4 / 12", 4},
+		{"A1", 0},
+		{"-1", 0},
+	}
+
+	for i, tt := range tests {
+		if got := trackNumberFromTag(tt.raw); got != tt.want {
+			t.Errorf("[%d]: trackNumberFromTag(%q) == %v, want %v", i, tt.raw, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package mediaserver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethulhu/helix/upnpav"
+)
+
+type (
+	// timeSeekRange is a TimeSeekRange.dlna.org request, e.g. "npt=10.5-" or "npt=0:01:00-0:02:00".
+	timeSeekRange struct {
+		start  time.Duration
+		end    time.Duration
+		hasEnd bool
+	}
+)
+
+func parseTimeSeekRange(raw string) (timeSeekRange, error) {
+	raw = strings.TrimSpace(raw)
+	if !strings.HasPrefix(raw, "npt=") {
+		return timeSeekRange{}, errors.New("must start with npt=")
+	}
+	raw = strings.TrimPrefix(raw, "npt=")
+
+	parts := strings.SplitN(raw, "-", 2)
+	if len(parts) != 2 {
+		return timeSeekRange{}, errors.New("must be of the form start-[end]")
+	}
+
+	start, err := parseNPT(parts[0])
+	if err != nil {
+		return timeSeekRange{}, fmt.Errorf("invalid start: %w", err)
+	}
+	seek := timeSeekRange{start: start}
+
+	if parts[1] != "" {
+		end, err := parseNPT(parts[1])
+		if err != nil {
+			return timeSeekRange{}, fmt.Errorf("invalid end: %w", err)
+		}
+		seek.end = end
+		seek.hasEnd = true
+	}
+	return seek, nil
+}
+
+// parseNPT parses a Normal Play Time, either seconds (e.g. "90.5"), or H+:MM:SS[.F+] (e.g. "0:01:30.500").
+func parseNPT(raw string) (time.Duration, error) {
+	if strings.Contains(raw, ":") {
+		d, err := upnpav.ParseDuration(raw)
+		if err != nil {
+			return 0, err
+		}
+		return d.Duration, nil
+	}
+
+	// npt-sec is 1*DIGIT [ "." *DIGIT ], so signs, exponents, NaN, and Inf are all invalid.
+	whole, fraction := raw, ""
+	if i := strings.Index(raw, "."); i != -1 {
+		whole, fraction = raw[:i], raw[i+1:]
+	}
+	if !isDigits(whole) || (fraction != "" && !isDigits(fraction)) {
+		return 0, fmt.Errorf("invalid seconds %q", raw)
+	}
+
+	seconds, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || seconds > int64(math.MaxInt64/time.Second) {
+		return 0, fmt.Errorf("seconds %q out of range", raw)
+	}
+	if len(fraction) > 9 {
+		fraction = fraction[:9]
+	}
+	nanoseconds, _ := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+	return time.Duration(seconds)*time.Second + time.Duration(nanoseconds), nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// formatNPT formats a duration as Normal Play Time seconds, e.g. "90.500".
+func formatNPT(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
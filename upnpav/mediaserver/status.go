@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package mediaserver
+
+import (
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/ethulhu/helix/httputil"
+	"github.com/ethulhu/helix/media"
+)
+
+type (
+	statusHandler struct {
+		metadataCache media.MetadataCache
+		now           func() time.Time
+	}
+
+	// status is the JSON and template data of the status page.
+	status struct {
+		Running bool `json:"running"`
+		Walked  bool `json:"walked"`
+
+		Started  time.Time `json:"started"`
+		Finished time.Time `json:"finished"`
+
+		Seen   int `json:"seen"`
+		Cached int `json:"cached"`
+		Probed int `json:"probed"`
+		Failed int `json:"failed"`
+
+		// ETA is in seconds, and -1 if unknown.
+		ETA float64 `json:"eta"`
+	}
+)
+
+var statusTemplate = template.Must(template.New("status").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+{{ if .Running }}<meta http-equiv="refresh" content="5">{{ end }}
+<title>Helix status</title>
+</head>
+<body>
+<h1>Metadata cache</h1>
+{{ if .Running }}
+<p>Warming since {{ .Started.Format "2006-01-02 15:04:05" }}.</p>
+{{ else if .Started.IsZero }}
+<p>Not warmed.</p>
+{{ else }}
+<p>Warmed from {{ .Started.Format "2006-01-02 15:04:05" }} to {{ .Finished.Format "2006-01-02 15:04:05" }}{{ if not .Walked }}, but did not finish{{ end }}.</p>
+{{ end }}
+<table>
+<tr><th>Seen</th><td>{{ .Seen }}</td></tr>
+<tr><th>Already cached</th><td>{{ .Cached }}</td></tr>
+<tr><th>Probed</th><td>{{ .Probed }}</td></tr>
+<tr><th>Failed</th><td>{{ .Failed }}</td></tr>
+{{ if .Running }}<tr><th>ETA</th><td>{{ if lt .ETA 0.0 }}unknown{{ else }}{{ printf "%.0f" .ETA }}s{{ end }}</td></tr>{{ end }}
+</table>
+</body>
+</html>
+`))
+
+// NewStatusHandler returns an http.Handler for a status page showing the progress of warming metadataCache.
+// The page is HTML, or JSON with ?format=json.
+func NewStatusHandler(metadataCache media.MetadataCache) http.Handler {
+	return &statusHandler{
+		metadataCache: metadataCache,
+		now:           time.Now,
+	}
+}
+
+func (h *statusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	warm := h.metadataCache.WarmStatus()
+
+	s := status{
+		Running:  warm.Running,
+		Walked:   warm.Walked,
+		Started:  warm.Started,
+		Finished: warm.Finished,
+		Seen:     warm.Seen,
+		Cached:   warm.Cached,
+		Probed:   warm.Probed,
+		Failed:   warm.Failed,
+		ETA:      -1,
+	}
+	if eta, ok := warm.ETA(h.now()); ok {
+		s.ETA = eta.Seconds()
+	}
+
+	if r.URL.Query().Get("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		httputil.MustWriteJSON(w, s)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := statusTemplate.Execute(w, s); err != nil {
+		http.Error(w, "could not render status page", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package mediaserver
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethulhu/helix/media"
+)
+
+func TestStatusHandler(t *testing.T) {
+	started := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	cache := warmingMetadataCache{
+		status: media.WarmStatus{
+			Running: true,
+			Walked:  true,
+			Started: started,
+			Seen:    100,
+			Cached:  10,
+			Probed:  10,
+			Failed:  5,
+		},
+	}
+	handler := &statusHandler{
+		metadataCache: cache,
+		now:           func() time.Time { return started.Add(25 * time.Second) },
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/status?format=json", nil))
+
+	var got status
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("could not unmarshal JSON status %q: %v", rec.Body.String(), err)
+	}
+	want := status{
+		Running: true,
+		Walked:  true,
+		Started: started,
+		Seen:    100,
+		Cached:  10,
+		Probed:  10,
+		Failed:  5,
+		// 25 files in 25 seconds, so 75 more files takes 75 seconds.
+		ETA: 75,
+	}
+	if got != want {
+		t.Errorf("got JSON status %+v, want %+v", got, want)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/status", nil))
+	for _, want := range []string{"Warming since 2020-06-01 12:00:00", "<td>100</td>", "75s"} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("status page does not contain %q:\n%s", want, rec.Body.String())
+		}
+	}
+}
+
+type warmingMetadataCache struct {
+	fakeMetadataCache
+	status media.WarmStatus
+}
+
+func (c warmingMetadataCache) WarmStatus() media.WarmStatus {
+	return c.status
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package upnpav
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Properties returns the values of the container's properties, keyed by their DIDL-Lite names, e.g. "dc:title" or "@id".
+func (c Container) Properties() map[string][]string {
+	ps := properties{}
+	ps.add("@id", string(c.ID))
+	ps.add("@parentID", string(c.Parent))
+	ps.add("@restricted", boolString(bool(c.Restricted)))
+	ps.add("@searchable", boolString(bool(c.Searchable)))
+	ps.add("@childCount", strconv.Itoa(c.ChildCount))
+
+	ps.add("dc:title", c.Title)
+	ps.add("upnp:class", string(c.Class))
+	ps.add("dc:description", c.Description)
+	ps.add("upnp:longDescription", c.LongDescription)
+	ps.add("upnp:region", c.Region)
+	ps.add("upnp:rating", c.AgeRating)
+	ps.add("dc:rights", c.Rights...)
+	if c.Date != nil {
+		ps.add("dc:date", c.Date.String())
+	}
+	ps.add("dc:language", c.Language)
+	ps.add("upnp:userAnnotation", c.UserAnnotations...)
+	ps.add("upnp:toc", c.TOC)
+	ps.add("upnp:writeStatus", c.WriteStatus)
+	ps.add("upnp:storageMedium", c.StorageMedium)
+	return ps
+}
+
+// Properties returns the values of the item's properties, keyed by their DIDL-Lite names, e.g. "upnp:artist", "upnp:artist@role", or "res@duration".
+func (item Item) Properties() map[string][]string {
+	ps := properties{}
+	ps.add("@id", string(item.ID))
+	ps.add("@parentID", string(item.Parent))
+	ps.add("@refID", item.RefID)
+	ps.add("@restricted", boolString(bool(item.Restricted)))
+	ps.add("@searchable", boolString(bool(item.Searchable)))
+
+	ps.add("dc:title", item.Title)
+	ps.add("upnp:class", string(item.Class))
+	ps.add("dc:description", item.Description)
+	ps.add("upnp:longDescription", item.LongDescription)
+	ps.add("upnp:region", item.Region)
+	ps.add("upnp:rating", item.AgeRating)
+	ps.add("dc:rights", item.Rights...)
+	if item.Date != nil {
+		ps.add("dc:date", item.Date.String())
+	}
+	ps.add("dc:language", item.Language)
+	ps.add("upnp:userAnnotation", item.UserAnnotations...)
+	ps.add("upnp:toc", item.TOC)
+	ps.add("upnp:writeStatus", item.WriteStatus)
+
+	ps.add("dc:creator", item.Creator)
+	ps.addPeople("upnp:artist", item.Artists)
+	ps.addPeople("upnp:actor", item.Actors)
+	ps.addPeople("upnp:author", item.Authors)
+	ps.add("upnp:director", item.Directors...)
+	ps.add("upnp:producer", item.Producers...)
+	ps.add("dc:publisher", item.Publishers...)
+	ps.add("dc:contributor", item.Contributors...)
+	ps.add("upnp:genre", item.Genres...)
+	ps.add("upnp:album", item.Albums...)
+	ps.add("upnp:playlist", item.Playlists...)
+	for _, art := range item.AlbumArtURIs {
+		ps.add("upnp:albumArtURI", art.URI)
+		ps.add("upnp:albumArtURI@dlna:profileID", art.ProfileID)
+	}
+	ps.add("upnp:artistDiscographyURI", item.ArtistDiscographyURI)
+	ps.add("upnp:lyricsURI", item.LyricsURI)
+	ps.add("dc:relation", item.RelationURI)
+	if item.TrackNumber != 0 {
+		ps.add("upnp:originalTrackNumber", strconv.Itoa(item.TrackNumber))
+	}
+
+	for _, r := range item.Resources {
+		ps.add("res", r.URI)
+		if r.ProtocolInfo != nil {
+			ps.add("res@protocolInfo", r.ProtocolInfo.String())
+		}
+		ps.addUint("res@nrAudioChannels", r.AudioChannels)
+		ps.addUint("res@bitsPerSample", r.BitsPerSample)
+		ps.addUint("res@bitrate", r.BitsPerSecond)
+		ps.addUint("res@colorDepth", r.ColorDepth)
+		if r.Duration != nil {
+			ps.add("res@duration", r.Duration.String())
+		}
+		if r.Resolution != nil {
+			ps.add("res@resolution", r.Resolution.String())
+		}
+		ps.addUint("res@sampleFrequency", r.SampleFrequencyHz)
+		ps.addUint("res@size", r.SizeBytes)
+		ps.add("res@protection", r.Protection)
+		ps.add("res@importURI", r.ImportURI)
+	}
+	return ps
+}
+
+// ComparePropertyValues compares two property values, returning -1, 0, or 1.
+// Integers, durations, and dates are compared by value, and everything else is compared case-insensitively.
+func ComparePropertyValues(a, b string) int {
+	if ai, err := strconv.ParseInt(a, 10, 64); err == nil {
+		if bi, err := strconv.ParseInt(b, 10, 64); err == nil {
+			return compareInts(ai, bi)
+		}
+	}
+	if strings.Contains(a, ":") && strings.Contains(b, ":") {
+		if ad, err := ParseDuration(a); err == nil {
+			if bd, err := ParseDuration(b); err == nil {
+				return compareInts(int64(ad.Duration), int64(bd.Duration))
+			}
+		}
+	}
+	if ad, err := ParseDate(a); err == nil {
+		if bd, err := ParseDate(b); err == nil {
+			return compareInts(ad.UnixNano(), bd.UnixNano())
+		}
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+type properties map[string][]string
+
+func (ps properties) add(name string, values ...string) {
+	for _, v := range values {
+		if v != "" {
+			ps[name] = append(ps[name], v)
+		}
+	}
+}
+func (ps properties) addUint(name string, v uint) {
+	if v != 0 {
+		ps.add(name, fmt.Sprint(v))
+	}
+}
+func (ps properties) addPeople(name string, people []Person) {
+	for _, p := range people {
+		ps.add(name, p.Name)
+		ps.add(name+"@role", p.Role)
+	}
+}
+
+func boolString(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
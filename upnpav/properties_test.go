@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package upnpav
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestItemProperties(t *testing.T) {
+	item := Item{
+		ID:     "foo/bar.mp3",
+		Parent: "foo",
+		Title:  "Bar",
+		Class:  MusicTrack,
+		Artists: []Person{
+			{Name: "The Birthday Massacre", Role: "Performer"},
+			{Name: "Chibi"},
+		},
+		Albums:      []string{"Walking With Strangers"},
+		TrackNumber: 3,
+		Resources: []Resource{{
+			URI:       "http://foo/bar.mp3",
+			Duration:  &Duration{3 * time.Minute},
+			SizeBytes: 1024,
+		}},
+	}
+
+	want := map[string][]string{
+		"@id":                      {"foo/bar.mp3"},
+		"@parentID":                {"foo"},
+		"@restricted":              {"0"},
+		"@searchable":              {"0"},
+		"dc:title":                 {"Bar"},
+		"upnp:class":               {"object.item.audioItem.musicTrack"},
+		"upnp:artist":              {"The Birthday Massacre", "Chibi"},
+		"upnp:artist@role":         {"Performer"},
+		"upnp:album":               {"Walking With Strangers"},
+		"upnp:originalTrackNumber": {"3"},
+		"res":                      {"http://foo/bar.mp3"},
+		"res@duration":             {"0:03:00"},
+		"res@size":                 {"1024"},
+	}
+
+	got := item.Properties()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestComparePropertyValues(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2", "10", -1},
+		{"10", "10", 0},
+		{"0:10:00", "0:09:00", 1},
+		{"10:00:00", "9:00:00", 1},
+		{"apple", "Banana", -1},
+		{"Apple", "apple", 0},
+		{"2020-01-02", "2019-12-31", 1},
+		{"2020-01-02T00:00:00", "2020-01-02", 0},
+		{"10", "9a", -1},
+	}
+
+	for i, tt := range tests {
+		if got := ComparePropertyValues(tt.a, tt.b); got != tt.want {
+			t.Errorf("[%d]: ComparePropertyValues(%q, %q) == %d, want %d", i, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
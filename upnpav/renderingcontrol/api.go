@@ -4,9 +4,76 @@
 
 package renderingcontrol
 
-import "github.com/ethulhu/helix/upnp"
+import (
+	"context"
+
+	"github.com/ethulhu/helix/upnp"
+	"github.com/ethulhu/helix/upnp/scpd"
+	"github.com/ethulhu/helix/upnpav"
+)
+
+type (
+	// Interface is the UPnP RenderingControl:1 interface.
+	// All methods act on the Master channel.
+	Interface interface {
+		// GetVolume returns the current volume, between MinVolume and MaxVolume.
+		GetVolume(context.Context) (int, error)
+
+		// SetVolume sets the volume, between MinVolume and MaxVolume.
+		SetVolume(context.Context, int) error
+
+		// GetMute returns whether or not the Renderer is muted.
+		GetMute(context.Context) (bool, error)
+
+		// SetMute mutes or unmutes the Renderer.
+		SetMute(context.Context, bool) error
+
+		// GetVolumeDB returns the current volume in units of 1/256 decibels.
+		GetVolumeDB(context.Context) (int, error)
+
+		// GetVolumeDBRange returns the minimum and maximum volume in units of 1/256 decibels.
+		GetVolumeDBRange(context.Context) (int, int, error)
+
+		// ListPresets lists the names of the presets the Renderer supports.
+		// All Renderers support PresetFactoryDefaults.
+		ListPresets(context.Context) ([]string, error)
+
+		// SelectPreset restores the state variables saved by a given preset.
+		SelectPreset(context.Context, string) error
+	}
+)
 
 const (
-	Version1 = upnp.URN("urn:schemas-upnp-org:service:RenderingControl:1")
-	Version2 = upnp.URN("urn:schemas-upnp-org:service:RenderingControl:2")
+	Version1  = upnp.URN("urn:schemas-upnp-org:service:RenderingControl:1")
+	Version2  = upnp.URN("urn:schemas-upnp-org:service:RenderingControl:2")
+	ServiceID = upnp.ServiceID("urn:upnp-org:serviceId:RenderingControl")
 )
+
+const (
+	MinVolume = 0
+	MaxVolume = 100
+
+	// PresetFactoryDefaults is the preset that all Renderers must support.
+	PresetFactoryDefaults = "FactoryDefaults"
+)
+
+var (
+	ErrInvalidPresetName = upnpav.Error{Code: 701, Description: "Invalid Name"}
+	ErrInvalidInstanceID = upnpav.Error{Code: 702, Description: "Invalid InstanceID"}
+)
+
+// SCPD describes the actions that SOAPHandler supports.
+// Changes to the other state variables are evented with LastChange.
+var SCPD = scpd.Must(scpd.WithEvents(
+	scpd.Must(scpd.Merge(
+		scpd.Must(scpd.FromAction(listPresets, listPresetsRequest{}, listPresetsResponse{})),
+		scpd.Must(scpd.FromAction(selectPreset, selectPresetRequest{}, selectPresetResponse{})),
+		scpd.Must(scpd.FromAction(getMute, getMuteRequest{}, getMuteResponse{})),
+		scpd.Must(scpd.FromAction(setMute, setMuteRequest{}, setMuteResponse{})),
+		scpd.Must(scpd.FromAction(getVolume, getVolumeRequest{}, getVolumeResponse{})),
+		scpd.Must(scpd.FromAction(setVolume, setVolumeRequest{}, setVolumeResponse{})),
+		scpd.Must(scpd.FromAction(getVolumeDB, getVolumeDBRequest{}, getVolumeDBResponse{})),
+		scpd.Must(scpd.FromAction(getVolumeDBRange, getVolumeDBRangeRequest{}, getVolumeDBRangeResponse{})),
+	)),
+	scpd.StateVariable{Name: upnpav.LastChangeVariableName, DataType: "string"},
+))
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package renderingcontrol
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/ethulhu/helix/soap"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/xmltypes"
+)
+
+type (
+	client struct{ soap.Interface }
+)
+
+func NewClient(soapClient soap.Interface) Interface {
+	return &client{soapClient}
+}
+
+func (c *client) call(ctx context.Context, method string, input, output interface{}) error {
+	req, err := xml.Marshal(input)
+	if err != nil {
+		panic(fmt.Sprintf("could not marshal SOAP request: %v", err))
+	}
+
+	rsp, err := c.Call(ctx, string(Version1), method, req)
+	if err != nil {
+		return upnpav.MaybeError(err)
+	}
+	if output != nil {
+		return xml.Unmarshal(rsp, output)
+	}
+	return nil
+}
+
+func (c *client) GetVolume(ctx context.Context) (int, error) {
+	req := getVolumeRequest{
+		InstanceID: 0,
+		Channel:    upnpav.ChannelMaster,
+	}
+	rsp := getVolumeResponse{}
+	if err := c.call(ctx, getVolume, req, &rsp); err != nil {
+		return 0, err
+	}
+	return rsp.Volume, nil
+}
+func (c *client) SetVolume(ctx context.Context, volume int) error {
+	req := setVolumeRequest{
+		InstanceID: 0,
+		Channel:    upnpav.ChannelMaster,
+		Volume:     volume,
+	}
+	return c.call(ctx, setVolume, req, nil)
+}
+
+func (c *client) GetMute(ctx context.Context) (bool, error) {
+	req := getMuteRequest{
+		InstanceID: 0,
+		Channel:    upnpav.ChannelMaster,
+	}
+	rsp := getMuteResponse{}
+	if err := c.call(ctx, getMute, req, &rsp); err != nil {
+		return false, err
+	}
+	return bool(rsp.Mute), nil
+}
+func (c *client) SetMute(ctx context.Context, mute bool) error {
+	req := setMuteRequest{
+		InstanceID: 0,
+		Channel:    upnpav.ChannelMaster,
+		Mute:       xmltypes.IntBool(mute),
+	}
+	return c.call(ctx, setMute, req, nil)
+}
+
+func (c *client) GetVolumeDB(ctx context.Context) (int, error) {
+	req := getVolumeDBRequest{
+		InstanceID: 0,
+		Channel:    upnpav.ChannelMaster,
+	}
+	rsp := getVolumeDBResponse{}
+	if err := c.call(ctx, getVolumeDB, req, &rsp); err != nil {
+		return 0, err
+	}
+	return rsp.VolumeDB, nil
+}
+func (c *client) GetVolumeDBRange(ctx context.Context) (int, int, error) {
+	req := getVolumeDBRangeRequest{
+		InstanceID: 0,
+		Channel:    upnpav.ChannelMaster,
+	}
+	rsp := getVolumeDBRangeResponse{}
+	if err := c.call(ctx, getVolumeDBRange, req, &rsp); err != nil {
+		return 0, 0, err
+	}
+	return rsp.Min, rsp.Max, nil
+}
+
+func (c *client) ListPresets(ctx context.Context) ([]string, error) {
+	req := listPresetsRequest{InstanceID: 0}
+	rsp := listPresetsResponse{}
+	if err := c.call(ctx, listPresets, req, &rsp); err != nil {
+		return nil, err
+	}
+	return rsp.Presets, nil
+}
+func (c *client) SelectPreset(ctx context.Context, preset string) error {
+	req := selectPresetRequest{
+		InstanceID: 0,
+		Preset:     preset,
+	}
+	return c.call(ctx, selectPreset, req, nil)
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package renderingcontrol
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ethulhu/helix/upnpav"
+)
+
+func TestClientAndHandler(t *testing.T) {
+	fh := &fakeHandler{
+		volume:      30,
+		volumeDB:    -2560,
+		minVolumeDB: -25600,
+		maxVolumeDB: 0,
+		presets:     []string{PresetFactoryDefaults, "Night"},
+	}
+
+	ctx := context.Background()
+	client := NewClient(SOAPHandler{fh})
+
+	volume, err := client.GetVolume(ctx)
+	if err != nil {
+		t.Fatalf("GetVolume(_) returned error: %v", err)
+	}
+	if volume != 30 {
+		t.Errorf("GetVolume(_) == %v, want %v", volume, 30)
+	}
+
+	if err := client.SetVolume(ctx, 45); err != nil {
+		t.Fatalf("SetVolume(_, 45) returned error: %v", err)
+	}
+	if fh.volume != 45 {
+		t.Errorf("after SetVolume(_, 45), volume == %v, want %v", fh.volume, 45)
+	}
+
+	var upnpErr upnpav.Error
+	if err := client.SetVolume(ctx, 101); !errors.As(err, &upnpErr) || upnpErr.Code != upnpav.ErrInvalidArgs.Code {
+		t.Errorf("SetVolume(_, 101) returned error %v, want %v", err, upnpav.ErrInvalidArgs)
+	}
+
+	if err := client.SetMute(ctx, true); err != nil {
+		t.Fatalf("SetMute(_, true) returned error: %v", err)
+	}
+	mute, err := client.GetMute(ctx)
+	if err != nil {
+		t.Fatalf("GetMute(_) returned error: %v", err)
+	}
+	if !mute {
+		t.Errorf("after SetMute(_, true), GetMute(_) == %v, want %v", mute, true)
+	}
+
+	volumeDB, err := client.GetVolumeDB(ctx)
+	if err != nil {
+		t.Fatalf("GetVolumeDB(_) returned error: %v", err)
+	}
+	if volumeDB != fh.volumeDB {
+		t.Errorf("GetVolumeDB(_) == %v, want %v", volumeDB, fh.volumeDB)
+	}
+
+	min, max, err := client.GetVolumeDBRange(ctx)
+	if err != nil {
+		t.Fatalf("GetVolumeDBRange(_) returned error: %v", err)
+	}
+	if min != fh.minVolumeDB || max != fh.maxVolumeDB {
+		t.Errorf("GetVolumeDBRange(_) == %v, %v, want %v, %v", min, max, fh.minVolumeDB, fh.maxVolumeDB)
+	}
+
+	presets, err := client.ListPresets(ctx)
+	if err != nil {
+		t.Fatalf("ListPresets(_) returned error: %v", err)
+	}
+	if !reflect.DeepEqual(presets, fh.presets) {
+		t.Errorf("ListPresets(_) == %v, want %v", presets, fh.presets)
+	}
+
+	if err := client.SelectPreset(ctx, PresetFactoryDefaults); err != nil {
+		t.Fatalf("SelectPreset(_, %q) returned error: %v", PresetFactoryDefaults, err)
+	}
+	if fh.volume != 30 || fh.mute {
+		t.Errorf("after SelectPreset(_, %q), volume & mute == %v & %v, want %v & %v", PresetFactoryDefaults, fh.volume, fh.mute, 30, false)
+	}
+
+	if err := client.SelectPreset(ctx, "Morning"); !errors.As(err, &upnpErr) || upnpErr.Code != ErrInvalidPresetName.Code {
+		t.Errorf("SelectPreset(_, %q) returned error %v, want %v", "Morning", err, ErrInvalidPresetName)
+	}
+}
+
+type fakeHandler struct {
+	volume      int
+	mute        bool
+	volumeDB    int
+	minVolumeDB int
+	maxVolumeDB int
+	presets     []string
+}
+
+func (fh *fakeHandler) GetVolume(_ context.Context) (int, error) {
+	return fh.volume, nil
+}
+func (fh *fakeHandler) SetVolume(_ context.Context, volume int) error {
+	fh.volume = volume
+	return nil
+}
+func (fh *fakeHandler) GetMute(_ context.Context) (bool, error) {
+	return fh.mute, nil
+}
+func (fh *fakeHandler) SetMute(_ context.Context, mute bool) error {
+	fh.mute = mute
+	return nil
+}
+func (fh *fakeHandler) GetVolumeDB(_ context.Context) (int, error) {
+	return fh.volumeDB, nil
+}
+func (fh *fakeHandler) GetVolumeDBRange(_ context.Context) (int, int, error) {
+	return fh.minVolumeDB, fh.maxVolumeDB, nil
+}
+func (fh *fakeHandler) ListPresets(_ context.Context) ([]string, error) {
+	return fh.presets, nil
+}
+func (fh *fakeHandler) SelectPreset(_ context.Context, preset string) error {
+	if preset != PresetFactoryDefaults {
+		return ErrInvalidPresetName
+	}
+	fh.volume = 30
+	fh.mute = false
+	return nil
+}
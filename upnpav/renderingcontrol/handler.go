@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package renderingcontrol
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/ethulhu/helix/logger"
+	"github.com/ethulhu/helix/upnpav"
+	"github.com/ethulhu/helix/xmltypes"
+)
+
+type (
+	SOAPHandler struct {
+		Interface
+	}
+)
+
+func (h SOAPHandler) Call(ctx context.Context, namespace, action string, in []byte) ([]byte, error) {
+	if namespace != string(Version1) {
+		return nil, fmt.Errorf("invalid namespace")
+	}
+
+	switch action {
+	case listPresets:
+		return h.listPresets(ctx, in)
+	case selectPreset:
+		return h.selectPreset(ctx, in)
+	case getMute:
+		return h.getMute(ctx, in)
+	case setMute:
+		return h.setMute(ctx, in)
+	case getVolume:
+		return h.getVolume(ctx, in)
+	case setVolume:
+		return h.setVolume(ctx, in)
+	case getVolumeDB:
+		return h.getVolumeDB(ctx, in)
+	case getVolumeDBRange:
+		return h.getVolumeDBRange(ctx, in)
+	default:
+		return nil, upnpav.ErrInvalidAction
+	}
+}
+
+func (h SOAPHandler) listPresets(ctx context.Context, in []byte) ([]byte, error) {
+	req := listPresetsRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	presets, err := h.Interface.ListPresets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := listPresetsResponse{
+		Presets: presets,
+	}
+	return xml.Marshal(rsp)
+}
+func (h SOAPHandler) selectPreset(ctx context.Context, in []byte) ([]byte, error) {
+	req := selectPresetRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if req.InstanceID != 0 {
+		return nil, ErrInvalidInstanceID
+	}
+
+	if err := h.Interface.SelectPreset(ctx, req.Preset); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(selectPresetResponse{})
+}
+
+func (h SOAPHandler) getMute(ctx context.Context, in []byte) ([]byte, error) {
+	req := getMuteRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if err := checkInstanceAndChannel(req.InstanceID, req.Channel); err != nil {
+		return nil, err
+	}
+
+	mute, err := h.Interface.GetMute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getMuteResponse{
+		Mute: xmltypes.IntBool(mute),
+	}
+	return xml.Marshal(rsp)
+}
+func (h SOAPHandler) setMute(ctx context.Context, in []byte) ([]byte, error) {
+	req := setMuteRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if err := checkInstanceAndChannel(req.InstanceID, req.Channel); err != nil {
+		return nil, err
+	}
+
+	if err := h.Interface.SetMute(ctx, bool(req.Mute)); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(setMuteResponse{})
+}
+
+func (h SOAPHandler) getVolume(ctx context.Context, in []byte) ([]byte, error) {
+	req := getVolumeRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if err := checkInstanceAndChannel(req.InstanceID, req.Channel); err != nil {
+		return nil, err
+	}
+
+	volume, err := h.Interface.GetVolume(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getVolumeResponse{
+		Volume: volume,
+	}
+	return xml.Marshal(rsp)
+}
+func (h SOAPHandler) setVolume(ctx context.Context, in []byte) ([]byte, error) {
+	req := setVolumeRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if err := checkInstanceAndChannel(req.InstanceID, req.Channel); err != nil {
+		return nil, err
+	}
+	if req.Volume < MinVolume || req.Volume > MaxVolume {
+		return nil, upnpav.ErrInvalidArgs
+	}
+
+	if err := h.Interface.SetVolume(ctx, req.Volume); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(setVolumeResponse{})
+}
+
+func (h SOAPHandler) getVolumeDB(ctx context.Context, in []byte) ([]byte, error) {
+	req := getVolumeDBRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if err := checkInstanceAndChannel(req.InstanceID, req.Channel); err != nil {
+		return nil, err
+	}
+
+	volumeDB, err := h.Interface.GetVolumeDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getVolumeDBResponse{
+		VolumeDB: volumeDB,
+	}
+	return xml.Marshal(rsp)
+}
+func (h SOAPHandler) getVolumeDBRange(ctx context.Context, in []byte) ([]byte, error) {
+	req := getVolumeDBRangeRequest{}
+	if err := unmarshal(ctx, in, &req); err != nil {
+		return nil, err
+	}
+	if err := checkInstanceAndChannel(req.InstanceID, req.Channel); err != nil {
+		return nil, err
+	}
+
+	min, max, err := h.Interface.GetVolumeDBRange(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := getVolumeDBRangeResponse{
+		Min: min,
+		Max: max,
+	}
+	return xml.Marshal(rsp)
+}
+
+func unmarshal(ctx context.Context, in []byte, req interface{}) error {
+	if err := xml.Unmarshal(in, req); err != nil {
+		log, _ := logger.FromContext(ctx)
+		log.WithError(err).Warning("could not unmarshal request")
+		return upnpav.ErrInvalidArgs
+	}
+	return nil
+}
+
+// checkInstanceAndChannel checks that a request is for the only instance and channel that are supported.
+func checkInstanceAndChannel(instanceID int, channel string) error {
+	if instanceID != 0 {
+		return ErrInvalidInstanceID
+	}
+	if channel != upnpav.ChannelMaster {
+		return upnpav.ErrInvalidArgs
+	}
+	return nil
+}
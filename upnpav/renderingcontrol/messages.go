@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2020 Ethel Morgan
+//
+// SPDX-License-Identifier: MIT
+
+package renderingcontrol
+
+import (
+	"encoding/xml"
+
+	"github.com/ethulhu/helix/xmltypes"
+)
+
+type (
+	listPresetsRequest struct {
+		XMLName    xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 ListPresets"`
+		InstanceID int      `xml:"InstanceID" scpd:"A_ARG_TYPE_InstanceID,ui4"`
+	}
+	listPresetsResponse struct {
+		XMLName xml.Name                       `xml:"urn:schemas-upnp-org:service:RenderingControl:1 ListPresetsResponse"`
+		Presets xmltypes.CommaSeparatedStrings `xml:"CurrentPresetNameList" scpd:"PresetNameList,string"`
+	}
+
+	selectPresetRequest struct {
+		XMLName    xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 SelectPreset"`
+		InstanceID int      `xml:"InstanceID" scpd:"A_ARG_TYPE_InstanceID,ui4"`
+		Preset     string   `xml:"PresetName" scpd:"A_ARG_TYPE_PresetName,string,FactoryDefaults"`
+	}
+	selectPresetResponse struct {
+		XMLName xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 SelectPresetResponse"`
+	}
+
+	getMuteRequest struct {
+		XMLName    xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 GetMute"`
+		InstanceID int      `xml:"InstanceID" scpd:"A_ARG_TYPE_InstanceID,ui4"`
+		Channel    string   `xml:"Channel"    scpd:"A_ARG_TYPE_Channel,string,Master"`
+	}
+	getMuteResponse struct {
+		XMLName xml.Name         `xml:"urn:schemas-upnp-org:service:RenderingControl:1 GetMuteResponse"`
+		Mute    xmltypes.IntBool `xml:"CurrentMute" scpd:"Mute,boolean"`
+	}
+
+	setMuteRequest struct {
+		XMLName    xml.Name         `xml:"urn:schemas-upnp-org:service:RenderingControl:1 SetMute"`
+		InstanceID int              `xml:"InstanceID"  scpd:"A_ARG_TYPE_InstanceID,ui4"`
+		Channel    string           `xml:"Channel"     scpd:"A_ARG_TYPE_Channel,string,Master"`
+		Mute       xmltypes.IntBool `xml:"DesiredMute" scpd:"Mute,boolean"`
+	}
+	setMuteResponse struct {
+		XMLName xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 SetMuteResponse"`
+	}
+
+	getVolumeRequest struct {
+		XMLName    xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 GetVolume"`
+		InstanceID int      `xml:"InstanceID" scpd:"A_ARG_TYPE_InstanceID,ui4"`
+		Channel    string   `xml:"Channel"    scpd:"A_ARG_TYPE_Channel,string,Master"`
+	}
+	getVolumeResponse struct {
+		XMLName xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 GetVolumeResponse"`
+		Volume  int      `xml:"CurrentVolume" scpd:"Volume,ui2,min=0,max=100,step=1"`
+	}
+
+	setVolumeRequest struct {
+		XMLName    xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 SetVolume"`
+		InstanceID int      `xml:"InstanceID"    scpd:"A_ARG_TYPE_InstanceID,ui4"`
+		Channel    string   `xml:"Channel"       scpd:"A_ARG_TYPE_Channel,string,Master"`
+		Volume     int      `xml:"DesiredVolume" scpd:"Volume,ui2,min=0,max=100,step=1"`
+	}
+	setVolumeResponse struct {
+		XMLName xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 SetVolumeResponse"`
+	}
+
+	getVolumeDBRequest struct {
+		XMLName    xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 GetVolumeDB"`
+		InstanceID int      `xml:"InstanceID" scpd:"A_ARG_TYPE_InstanceID,ui4"`
+		Channel    string   `xml:"Channel"    scpd:"A_ARG_TYPE_Channel,string,Master"`
+	}
+	getVolumeDBResponse struct {
+		XMLName  xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 GetVolumeDBResponse"`
+		VolumeDB int      `xml:"CurrentVolume" scpd:"VolumeDB,i2"`
+	}
+
+	getVolumeDBRangeRequest struct {
+		XMLName    xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 GetVolumeDBRange"`
+		InstanceID int      `xml:"InstanceID" scpd:"A_ARG_TYPE_InstanceID,ui4"`
+		Channel    string   `xml:"Channel"    scpd:"A_ARG_TYPE_Channel,string,Master"`
+	}
+	getVolumeDBRangeResponse struct {
+		XMLName xml.Name `xml:"urn:schemas-upnp-org:service:RenderingControl:1 GetVolumeDBRangeResponse"`
+		Min     int      `xml:"MinValue" scpd:"VolumeDB,i2"`
+		Max     int      `xml:"MaxValue" scpd:"VolumeDB,i2"`
+	}
+)
+
+const (
+	listPresets  = "ListPresets"
+	selectPreset = "SelectPreset"
+
+	getMute = "GetMute"
+	setMute = "SetMute"
+
+	getVolume        = "GetVolume"
+	setVolume        = "SetVolume"
+	getVolumeDB      = "GetVolumeDB"
+	getVolumeDBRange = "GetVolumeDBRange"
+)